@@ -201,6 +201,7 @@ var (
 		wasmtypes.ModuleName:           {authtypes.Burner},
 		assetfttypes.ModuleName:        {authtypes.Minter, authtypes.Burner},
 		assetnfttypes.ModuleName:       {authtypes.Burner},
+		dextypes.FeeCollectorName:      nil,
 		// the line is required by the nft module to have the module account stored in the account keeper
 		nft.ModuleName: {},
 	}
@@ -436,6 +437,19 @@ func New(
 	); err != nil {
 		panic(err)
 	}
+	if err := delayRouter.RegisterHandler(
+		&assetfttypes.DelayedUnfreeze{},
+		// pointer is used here because the AssetFTKeeper is initialized later
+		assetftkeeper.NewDelayUnfreezeHandler(&app.AssetFTKeeper),
+	); err != nil {
+		panic(err)
+	}
+	if err := delayRouter.RegisterHandler(
+		&assetfttypes.DelayedGloballyUnfreeze{},
+		assetftkeeper.NewDelayGloballyUnfreezeHandler(&app.AssetFTKeeper),
+	); err != nil {
+		panic(err)
+	}
 
 	app.BankKeeper = wbankkeeper.NewKeeper(
 		appCodec,
@@ -745,7 +759,10 @@ func New(
 		wasmkeeper.WithQueryPlugins(wasmcustomhandler.NewCoreumQueryHandler(
 			assetftkeeper.NewQueryService(app.AssetFTKeeper, app.BankKeeper),
 			assetnftkeeper.NewQueryService(app.AssetNFTKeeper),
-			app.NFTKeeper, app.GRPCQueryRouter(), appCodec,
+			app.NFTKeeper,
+			// the pointer is used since the DEX keeper is initialized after the wasm keeper
+			dexkeeper.NewQueryService(&app.DEXKeeper),
+			app.GRPCQueryRouter(), appCodec,
 		)),
 	}
 
@@ -815,6 +832,7 @@ func New(
 		runtime.NewKVStoreService(keys[dextypes.StoreKey]),
 		app.AccountKeeper,
 		authkeeper.NewQueryServer(app.AccountKeeper),
+		app.BankKeeper,
 		app.AssetFTKeeper,
 		app.DelayKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -825,6 +843,24 @@ func New(
 	); err != nil {
 		panic(err)
 	}
+	if err := delayRouter.RegisterHandler(
+		&dextypes.CancelAll{},
+		dexkeeper.NewDelayCancelAllHandler(app.DEXKeeper),
+	); err != nil {
+		panic(err)
+	}
+	if err := delayRouter.RegisterHandler(
+		&dextypes.ResumeOrderBook{},
+		dexkeeper.NewDelayResumeOrderBookHandler(app.DEXKeeper),
+	); err != nil {
+		panic(err)
+	}
+	if err := delayRouter.RegisterHandler(
+		&dextypes.CloseOrderBook{},
+		dexkeeper.NewDelayCloseOrderBookHandler(app.DEXKeeper),
+	); err != nil {
+		panic(err)
+	}
 
 	/****  Module Options ****/
 
@@ -911,7 +947,7 @@ func New(
 		wnftModule,
 		customParamsModule,
 		delayModule,
-		dex.NewAppModule(appCodec, app.DEXKeeper, app.AccountKeeper),
+		dex.NewAppModule(appCodec, app.DEXKeeper, app.AccountKeeper, app.AssetFTKeeper),
 
 		// IBC modules
 		ibc.NewAppModule(app.IBCKeeper),
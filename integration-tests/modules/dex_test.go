@@ -2914,6 +2914,167 @@ func TestTradeByAdmin(t *testing.T) {
 	requireT.NoError(err)
 }
 
+// TestDEXWithSmartContract tests the dex messages and queries sent by the smart contract.
+func TestDEXWithSmartContract(t *testing.T) {
+	t.Parallel()
+	ctx, chain := integrationtests.NewCoreumTestingContext(t)
+
+	requireT := require.New(t)
+
+	issuer, denom1 := genAccountAndIssueFT(
+		ctx, t, chain, 10_000_000, sdkmath.NewIntWithDecimal(1, 6), assetfttypes.Feature_dex_order_cancellation,
+	)
+	_, denom2 := genAccountAndIssueFT(ctx, t, chain, 10_000_000, sdkmath.NewIntWithDecimal(1, 6))
+	dexParams := chain.QueryDEXParams(ctx, t)
+
+	contractAddr, _, err := chain.Wasm.DeployAndInstantiateWASMContract(
+		ctx,
+		chain.TxFactoryAuto(),
+		issuer,
+		testcontracts.DexWasm,
+		integration.InstantiateConfig{
+			AccessType: wasmtypes.AccessTypeUnspecified,
+			Payload:    moduleswasm.EmptyPayload,
+			Label:      "dex",
+		},
+	)
+	requireT.NoError(err)
+
+	// fund the contract to place orders
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactoryAuto(),
+		&banktypes.MsgSend{
+			FromAddress: issuer.String(),
+			ToAddress:   contractAddr,
+			Amount: sdk.NewCoins(
+				dexParams.OrderReserve,
+				sdk.NewInt64Coin(denom1, 100_000),
+			),
+		},
+	)
+	requireT.NoError(err)
+
+	executeContract := func(payload map[string]any) error {
+		payloadBytes, err := json.Marshal(payload)
+		requireT.NoError(err)
+		_, err = chain.Wasm.ExecuteWASMContract(
+			ctx, chain.TxFactoryAuto(), issuer, contractAddr, payloadBytes, sdk.Coin{},
+		)
+		return err
+	}
+	queryContract := func(payload map[string]any, res any) {
+		payloadBytes, err := json.Marshal(payload)
+		requireT.NoError(err)
+		resBytes, err := chain.Wasm.QueryWASMContract(ctx, contractAddr, payloadBytes)
+		requireT.NoError(err)
+		requireT.NoError(json.Unmarshal(resBytes, res))
+	}
+	placeOrder := func(id string) {
+		requireT.NoError(executeContract(map[string]any{
+			"place_order": map[string]any{
+				"order": map[string]any{
+					"type":          dextypes.ORDER_TYPE_LIMIT,
+					"id":            id,
+					"base_denom":    denom1,
+					"quote_denom":   denom2,
+					"price":         "1e-1",
+					"quantity":      "10000",
+					"side":          dextypes.SIDE_SELL,
+					"time_in_force": dextypes.TIME_IN_FORCE_GTC,
+				},
+			},
+		}))
+	}
+	queryOrdersCount := func() uint64 {
+		var res dextypes.QueryAccountDenomOrdersCountResponse
+		queryContract(map[string]any{
+			"account_denom_orders_count": map[string]any{
+				"account": contractAddr,
+				"denom":   denom1,
+			},
+		}, &res)
+		return res.Count
+	}
+
+	var paramsRes dextypes.QueryParamsResponse
+	queryContract(map[string]any{"params": map[string]any{}}, &paramsRes)
+	requireT.Equal(dexParams.OrderReserve.String(), paramsRes.Params.OrderReserve.String())
+
+	placeOrder("id1")
+
+	var orderRes dextypes.QueryOrderResponse
+	queryContract(map[string]any{
+		"order": map[string]any{
+			"creator": contractAddr,
+			"id":      "id1",
+		},
+	}, &orderRes)
+	requireT.Equal(contractAddr, orderRes.Order.Creator)
+	requireT.Equal(dextypes.SIDE_SELL, orderRes.Order.Side)
+	requireT.Equal("10000", orderRes.Order.RemainingBaseQuantity.String())
+
+	var ordersRes dextypes.QueryOrdersResponse
+	queryContract(map[string]any{
+		"orders": map[string]any{
+			"creator": contractAddr,
+		},
+	}, &ordersRes)
+	requireT.Len(ordersRes.Orders, 1)
+
+	var orderBooksRes dextypes.QueryOrderBooksResponse
+	queryContract(map[string]any{"order_books": map[string]any{}}, &orderBooksRes)
+	requireT.NotEmpty(orderBooksRes.OrderBooks)
+
+	var orderBookOrdersRes dextypes.QueryOrderBookOrdersResponse
+	queryContract(map[string]any{
+		"order_book_orders": map[string]any{
+			"base_denom":  denom1,
+			"quote_denom": denom2,
+			"side":        dextypes.SIDE_SELL,
+		},
+	}, &orderBookOrdersRes)
+	requireT.Len(orderBookOrdersRes.Orders, 1)
+	requireT.Equal("id1", orderBookOrdersRes.Orders[0].ID)
+
+	requireT.Equal(uint64(1), queryOrdersCount())
+
+	requireT.NoError(executeContract(map[string]any{
+		"cancel_order": map[string]any{
+			"id": "id1",
+		},
+	}))
+	requireT.Zero(queryOrdersCount())
+
+	placeOrder("id2")
+	requireT.Equal(uint64(1), queryOrdersCount())
+
+	cancelOrdersByDenomPayload := map[string]any{
+		"cancel_orders_by_denom": map[string]any{
+			"account": contractAddr,
+			"denom":   denom1,
+		},
+	}
+	// the contract isn't the admin of the denom
+	requireT.ErrorIs(executeContract(cancelOrdersByDenomPayload), cosmoserrors.ErrUnauthorized)
+
+	_, err = client.BroadcastTx(
+		ctx,
+		chain.ClientContext.WithFromAddress(issuer),
+		chain.TxFactoryAuto(),
+		&assetfttypes.MsgTransferAdmin{
+			Sender:  issuer.String(),
+			Account: contractAddr,
+			Denom:   denom1,
+		},
+	)
+	requireT.NoError(err)
+
+	requireT.NoError(executeContract(cancelOrdersByDenomPayload))
+	requireT.Zero(queryOrdersCount())
+}
+
 func issueFT(
 	ctx context.Context,
 	t *testing.T,
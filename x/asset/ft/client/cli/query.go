@@ -31,6 +31,9 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryFrozenBalances())
 	cmd.AddCommand(CmdQueryWhitelistedBalance())
 	cmd.AddCommand(CmdQueryWhitelistedBalances())
+	cmd.AddCommand(CmdQueryVelocityAllowance())
+	cmd.AddCommand(CmdQueryDenylistedDenoms())
+	cmd.AddCommand(CmdQueryDenylisted())
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryDEXSettings())
 
@@ -358,6 +361,125 @@ $ %[1]s query %s whitelisted-balance [account] [denom]
 	return cmd
 }
 
+// CmdQueryVelocityAllowance returns the QueryVelocityAllowance cobra command.
+func CmdQueryVelocityAllowance() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "velocity-allowance [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query fungible token velocity allowance",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount of the fungible token an account is allowed to send within the current window.
+
+Example:
+$ %[1]s query %s velocity-allowance [account] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			account := args[0]
+			denom := args[1]
+			res, err := queryClient.VelocityAllowance(cmd.Context(), &types.QueryVelocityAllowanceRequest{
+				Account: account,
+				Denom:   denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryDenylistedDenoms returns the QueryDenylistedDenoms cobra command.
+func CmdQueryDenylistedDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denylisted-denoms [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query fungible token denoms the account is denylisted for",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query fungible token denoms the account is denylisted for.
+
+Example:
+$ %[1]s query %s denylisted-denoms [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			account := args[0]
+			res, err := queryClient.DenylistedDenoms(cmd.Context(), &types.QueryDenylistedDenomsRequest{
+				Account:    account,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denylisted denoms")
+
+	return cmd
+}
+
+// CmdQueryDenylisted returns the QueryDenylisted cobra command.
+func CmdQueryDenylisted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denylisted [account] [denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query whether the account is denylisted for the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the account is denylisted for the fungible token.
+
+Example:
+$ %[1]s query %s denylisted [account] [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			account := args[0]
+			denom := args[1]
+			res, err := queryClient.Denylisted(cmd.Context(), &types.QueryDenylistedRequest{
+				Account: account,
+				Denom:   denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdQueryParams implements a command to fetch assetft parameters.
 func CmdQueryParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -53,6 +53,7 @@ func TestQueryTokens(t *testing.T) {
 	expectedToken.Issuer = testNetwork.Validators[0].Address.String()
 	expectedToken.Version = types.CurrentTokenVersion
 	expectedToken.Admin = testNetwork.Validators[0].Address.String()
+	expectedToken.RoleHolders = []types.RoleHolder{}
 	requireT.Equal(expectedToken, resp.Tokens[0])
 }
 
@@ -84,6 +85,7 @@ func TestQueryToken(t *testing.T) {
 	expectedToken.Issuer = testNetwork.Validators[0].Address.String()
 	expectedToken.Version = types.CurrentTokenVersion
 	expectedToken.Admin = testNetwork.Validators[0].Address.String()
+	expectedToken.RoleHolders = []types.RoleHolder{}
 	requireT.Equal(expectedToken, resp.Token)
 
 	// query balance
@@ -40,6 +40,9 @@ const (
 	ExtensionIssuanceMsgFlag = "extension-issuance-msg"
 	DEXUnifiedRefAmountFlag  = "dex-unified-ref-amount"
 	DEXWhitelistedDenomsFlag = "dex-whitelisted-denoms"
+	MaxSupplyFlag            = "max-supply"
+	VelocityLimitWindowFlag  = "velocity-limit-window"
+	VelocityLimitAmountFlag  = "velocity-limit-amount"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -63,8 +66,14 @@ func GetTxCmd() *cobra.Command {
 		CmdTxGloballyUnfreeze(),
 		CmdTxClawback(),
 		CmdTxSetWhitelistedLimit(),
+		CmdTxSetVelocityLimitOverride(),
+		CmdTxAddToDenylist(),
+		CmdTxRemoveFromDenylist(),
 		CmdTxTransferAdmin(),
 		CmdTxClearAdmin(),
+		CmdTxGrantRole(),
+		CmdTxRevokeRole(),
+		CmdTxUpdateMaxSupply(),
 		CmdGrantAuthorization(),
 		CmdUpdateDEXUnifiedRefAmount(),
 		CmdUpdateDEXWhitelistedDenoms(),
@@ -84,7 +93,7 @@ func CmdTxIssue() *cobra.Command {
 	sort.Strings(allowedFeatures)
 	cmd := &cobra.Command{
 		//nolint:lll // breaking this down will make it look worse when printed to user screen.
-		Use:   fmt.Sprintf("issue [symbol] [subunit] [precision] [initial_amount] [description] --from [issuer] --features="+strings.Join(allowedFeatures, ",")+" --burn-rate=0.12 --send-commission-rate=0.2 --uri https://my-token-meta.invalid/1 --uri-hash e000624 --extension-code-id=1 --extension-label=my-extension --extension-funds=100000ABC-%s --extension-instantiation-msg={} --dex-unified-ref-amount=1000.5", constant.AddressSampleTest),
+		Use:   fmt.Sprintf("issue [symbol] [subunit] [precision] [initial_amount] [description] --from [issuer] --features="+strings.Join(allowedFeatures, ",")+" --burn-rate=0.12 --send-commission-rate=0.2 --uri https://my-token-meta.invalid/1 --uri-hash e000624 --extension-code-id=1 --extension-label=my-extension --extension-funds=100000ABC-%s --extension-instantiation-msg={} --dex-unified-ref-amount=1000.5 --max-supply=1000000", constant.AddressSampleTest),
 		Args:  cobra.ExactArgs(5),
 		Short: "Issue new fungible token",
 		Long: strings.TrimSpace(
@@ -217,6 +226,41 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 				}
 			}
 
+			var maxSupply *sdkmath.Int
+			maxSupplyStr, err := cmd.Flags().GetString(MaxSupplyFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if len(maxSupplyStr) > 0 {
+				maxSupplyValue, ok := sdkmath.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return sdkerrors.Wrapf(types.ErrInvalidInput, "%s is not a number or is too big", MaxSupplyFlag)
+				}
+				maxSupply = &maxSupplyValue
+			}
+
+			var velocityLimit *types.VelocityLimit
+			velocityLimitAmountStr, err := cmd.Flags().GetString(VelocityLimitAmountFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if len(velocityLimitAmountStr) > 0 {
+				velocityLimitAmount, ok := sdkmath.NewIntFromString(velocityLimitAmountStr)
+				if !ok {
+					return sdkerrors.Wrapf(
+						types.ErrInvalidInput, "%s is not a number or is too big", VelocityLimitAmountFlag,
+					)
+				}
+				velocityLimitWindow, err := cmd.Flags().GetDuration(VelocityLimitWindowFlag)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				velocityLimit = &types.VelocityLimit{
+					Window: velocityLimitWindow,
+					Amount: velocityLimitAmount,
+				}
+			}
+
 			msg := &types.MsgIssue{
 				Issuer:             issuer.String(),
 				Symbol:             symbol,
@@ -231,6 +275,8 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 				URIHash:            uriHash,
 				ExtensionSettings:  extensionSettings,
 				DEXSettings:        dexSettings,
+				MaxSupply:          maxSupply,
+				VelocityLimit:      velocityLimit,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -252,6 +298,22 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 	cmd.Flags().String(ExtensionIssuanceMsgFlag, "{}", "Optional json encoded data to pass to WASM on instantiation by the ft issuer.")
 	//nolint:lll // breaking this down will make it look worse when printed to user screen.
 	cmd.Flags().String(DEXUnifiedRefAmountFlag, "", "DEX unified ref amount is the approximate amount you need to buy 1USD, used to define the price tick size.")
+	cmd.Flags().String(
+		MaxSupplyFlag,
+		"",
+		"Max supply of the token which can't be exceeded by minting, the supply isn't capped if not set.",
+	)
+	cmd.Flags().String(
+		VelocityLimitAmountFlag,
+		"",
+		"Max amount an account is allowed to send within the velocity window, "+
+			"required if the velocity_limiting feature is enabled.",
+	)
+	cmd.Flags().Duration(
+		VelocityLimitWindowFlag,
+		24*time.Hour,
+		"Window within which the outflow of an account is limited by the velocity limit amount.",
+	)
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -363,7 +425,7 @@ func CmdTxFreeze() *cobra.Command {
 			fmt.Sprintf(`Freeze a portion of fungible token.
 
 Example:
-$ %s tx %s freeze [account_address] 100000ABC-%s --from [sender]
+$ %s tx %s freeze [account_address] 100000ABC-%s --from [sender] --expiration 1767225600
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -381,16 +443,25 @@ $ %s tx %s freeze [account_address] 100000ABC-%s --from [sender]
 				return sdkerrors.Wrap(err, "invalid amount")
 			}
 
+			expiresAt, err := getExpireTime(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgFreeze{
-				Sender:  sender.String(),
-				Account: account,
-				Coin:    amount,
+				Sender:    sender.String(),
+				Account:   account,
+				Coin:      amount,
+				ExpiresAt: expiresAt,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(
+		ExpirationFlag, 0, "Unfreeze time as Unix timestamp. Set zero (0) to keep the balance frozen indefinitely.",
+	)
 
 	return cmd
 }
@@ -452,7 +523,7 @@ func CmdTxSetFrozen() *cobra.Command {
 			fmt.Sprintf(`Set absolute frozen amount for the specific account.
 
 Example:
-$ %s tx %s set-frozen [account_address] 100000ABC-%s --from [sender]
+$ %s tx %s set-frozen [account_address] 100000ABC-%s --from [sender] --expiration 1767225600
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -470,16 +541,25 @@ $ %s tx %s set-frozen [account_address] 100000ABC-%s --from [sender]
 				return sdkerrors.Wrap(err, "invalid amount")
 			}
 
+			expiresAt, err := getExpireTime(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgSetFrozen{
-				Sender:  sender.String(),
-				Account: account,
-				Coin:    amount,
+				Sender:    sender.String(),
+				Account:   account,
+				Coin:      amount,
+				ExpiresAt: expiresAt,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(
+		ExpirationFlag, 0, "Unfreeze time as Unix timestamp. Set zero (0) to keep the balance frozen indefinitely.",
+	)
 
 	return cmd
 }
@@ -572,6 +652,132 @@ $ %s tx %s set-whitelisted-limit [account_address] 100000ABC-%s --from [sender]
 	return cmd
 }
 
+// CmdTxSetVelocityLimitOverride returns SetVelocityLimitOverride cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdTxSetVelocityLimitOverride() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-velocity-limit-override [account_address] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Override the velocity limit of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Override the velocity limit amount of an account, zero amount removes the override.
+
+Example:
+$ %s tx %s set-velocity-limit-override [account_address] 100000ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgSetVelocityLimitOverride{
+				Sender:  sender.String(),
+				Account: account,
+				Coin:    amount,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxAddToDenylist returns AddToDenylist cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdTxAddToDenylist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-to-denylist [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Block the account from sending and receiving the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Block the account from sending and receiving the fungible token.
+
+Example:
+$ %s tx %s add-to-denylist [account_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgAddToDenylist{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRemoveFromDenylist returns RemoveFromDenylist cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdTxRemoveFromDenylist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-from-denylist [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Unblock the denylisted account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unblock the account denylisted previously.
+
+Example:
+$ %s tx %s remove-from-denylist [account_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgRemoveFromDenylist{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdTxGloballyFreeze returns GlobalFreeze cobra command.
 func CmdTxGloballyFreeze() *cobra.Command {
 	cmd := &cobra.Command{
@@ -583,7 +789,7 @@ func CmdTxGloballyFreeze() *cobra.Command {
 This operation is idempotent so global freeze of already frozen token does nothing.
 
 Example:
-$ %s tx %s globally-freeze ABC-%s --from [sender]
+$ %s tx %s globally-freeze ABC-%s --from [sender] --expiration 1767225600
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -597,15 +803,24 @@ $ %s tx %s globally-freeze ABC-%s --from [sender]
 			sender := clientCtx.GetFromAddress()
 			denom := args[0]
 
+			expiresAt, err := getExpireTime(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgGloballyFreeze{
-				Sender: sender.String(),
-				Denom:  denom,
+				Sender:    sender.String(),
+				Denom:     denom,
+				ExpiresAt: expiresAt,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(
+		ExpirationFlag, 0, "Global unfreeze time as Unix timestamp. Set zero (0) to keep the token frozen indefinitely.",
+	)
 
 	return cmd
 }
@@ -732,6 +947,144 @@ $ %s tx %s clear-admin ABC-%s --from [sender]
 	return cmd
 }
 
+// CmdTxGrantRole returns GrantRole cobra command.
+func CmdTxGrantRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [account_address] [denom] [role] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Grant the role of a fungible token to the specific account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant the role of a fungible token to the specific account, allowed roles: %s.
+
+Example:
+$ %s tx %s grant-role [account_address] ABC-%s minter --from [sender]
+`,
+				strings.Join(allowedRoles(), ","), version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+			err = sdk.ValidateDenom(denom)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid denom")
+			}
+			role, err := parseRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgGrantRole{
+				Sender:  sender.String(),
+				Denom:   denom,
+				Role:    role,
+				Account: account,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRevokeRole returns RevokeRole cobra command.
+func CmdTxRevokeRole() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [account_address] [denom] [role] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Revoke the role of a fungible token from the specific account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the role of a fungible token from the specific account, allowed roles: %s.
+
+Example:
+$ %s tx %s revoke-role [account_address] ABC-%s minter --from [sender]
+`,
+				strings.Join(allowedRoles(), ","), version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+			err = sdk.ValidateDenom(denom)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid denom")
+			}
+			role, err := parseRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevokeRole{
+				Sender:  sender.String(),
+				Denom:   denom,
+				Role:    role,
+				Account: account,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateMaxSupply returns UpdateMaxSupply cobra command.
+func CmdTxUpdateMaxSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-max-supply [denom] [max_supply] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Lower the max supply of the fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lower the max supply of the fungible token, it can't be raised or set below the current supply.
+
+Example:
+$ %s tx %s update-max-supply ABC-%s 1000000 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+			maxSupply, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "max_supply is not a number or is too big")
+			}
+
+			msg := &types.MsgUpdateMaxSupply{
+				Sender:    sender.String(),
+				Denom:     denom,
+				MaxSupply: maxSupply,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
 func CmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
@@ -919,3 +1272,25 @@ func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	e := time.Unix(exp, 0)
 	return &e, nil
 }
+
+func allowedRoles() []string {
+	roles := make([]string, 0, len(types.Role_value))
+	for n, v := range types.Role_value {
+		if types.Role(v) == types.Role_role_unspecified {
+			continue
+		}
+		roles = append(roles, n)
+	}
+	sort.Strings(roles)
+
+	return roles
+}
+
+func parseRole(str string) (types.Role, error) {
+	role, ok := types.Role_value[str]
+	if !ok || types.Role(role) == types.Role_role_unspecified {
+		return 0, errors.Errorf("unknown role '%s', allowed roles: %s", str, strings.Join(allowedRoles(), ","))
+	}
+
+	return types.Role(role), nil
+}
@@ -63,6 +63,7 @@ func TestIssue(t *testing.T) {
 	token.Issuer = resp.Token.Issuer
 	token.Version = resp.Token.Version
 	token.Admin = resp.Token.Admin
+	token.RoleHolders = []types.RoleHolder{}
 	requireT.Equal(token, resp.Token)
 }
 
@@ -126,6 +127,7 @@ func TestIssueWithExtension(t *testing.T) {
 	token.Version = resp.Token.Version
 	token.Admin = resp.Token.Admin
 	token.ExtensionCWAddress = resp.Token.ExtensionCWAddress
+	token.RoleHolders = []types.RoleHolder{}
 	requireT.Equal(token, resp.Token)
 	requireT.NotEmpty(resp.Token.ExtensionCWAddress)
 
@@ -588,6 +590,54 @@ func TestClearAdmin(t *testing.T) {
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 }
 
+func TestGrantAndRevokeRole(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdkmath.NewInt(777)
+	denom := issue(requireT, ctx, token, initialAmount, nil, testNetwork)
+
+	minter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// grant role
+	args := append([]string{minter.String(), denom, types.Role_minter.String()}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxGrantRole(), args)
+	requireT.NoError(err)
+
+	var respToken types.QueryTokenResponse
+	coreumclitestutil.ExecQueryCmd(t, ctx, cli.CmdQueryToken(), []string{denom}, &respToken)
+	requireT.Equal([]types.RoleHolder{
+		{
+			Role:    types.Role_minter,
+			Address: minter.String(),
+		},
+	}, respToken.Token.RoleHolders)
+
+	// revoke role
+	args = append([]string{minter.String(), denom, types.Role_minter.String()}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxRevokeRole(), args)
+	requireT.NoError(err)
+
+	coreumclitestutil.ExecQueryCmd(t, ctx, cli.CmdQueryToken(), []string{denom}, &respToken)
+	requireT.Empty(respToken.Token.RoleHolders)
+
+	// try to revoke not held role
+	args = append([]string{minter.String(), denom, types.Role_minter.String()}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxRevokeRole(), args)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+}
+
 func TestUpdateDEXUnifiedRefAmount(t *testing.T) {
 	requireT := require.New(t)
 	networkCfg, err := config.NetworkConfigByChainID(constant.ChainIDDev)
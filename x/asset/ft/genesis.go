@@ -34,6 +34,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			Version:            token.Version,
 			URI:                token.URI,
 			URIHash:            token.URIHash,
+			RoleHolders:        token.RoleHolders,
+			MaxSupply:          token.MaxSupply,
+			VelocityLimit:      token.VelocityLimit,
 		}
 
 		if err := k.SetDefinition(ctx, issuer, subunit, definition); err != nil {
@@ -49,6 +52,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 				panic(err)
 			}
 		}
+
+		if token.GlobalFreezeExpiresAt != nil {
+			if err := k.ImportGlobalFreezeExpiration(ctx, token.Denom, *token.GlobalFreezeExpiresAt); err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	// Init frozen balances
@@ -97,6 +106,27 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 	}
+
+	// Init velocity limit overrides
+	for _, override := range genState.VelocityLimitOverrides {
+		if err := types.ValidateAssetCoins(override.Coins); err != nil {
+			panic(err)
+		}
+		address := sdk.MustAccAddressFromBech32(override.Address)
+		k.SetVelocityLimitOverrides(ctx, address, override.Coins)
+	}
+
+	if err := k.ImportVelocityUsages(ctx, genState.VelocityUsages); err != nil {
+		panic(err)
+	}
+
+	if err := k.ImportFreezeExpirations(ctx, genState.FreezeExpirations); err != nil {
+		panic(err)
+	}
+
+	if err := k.ImportDenylist(ctx, genState.Denylist); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the asset module's exported genesis.
@@ -143,6 +173,28 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	velocityLimitOverrides, _, err := k.GetAccountsVelocityLimitOverrides(
+		ctx, &query.PageRequest{Limit: query.PaginationMaxLimit},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	velocityUsages, err := k.ExportVelocityUsages(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	freezeExpirations, err := k.ExportFreezeExpirations(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	denylist, err := k.ExportDenylist(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		panic(err)
@@ -157,5 +209,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		DEXLockedBalances:            dexLockedBalances,
 		DEXExpectedToReceiveBalances: dexExpectedToReceiveBalances,
 		DEXSettings:                  dexSettings,
+		VelocityLimitOverrides:       velocityLimitOverrides,
+		VelocityUsages:               velocityUsages,
+		FreezeExpirations:            freezeExpirations,
+		Denylist:                     denylist,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto/ed25519"
@@ -55,6 +56,10 @@ func TestInitAndExportGenesis(t *testing.T) {
 		if i%2 == 0 {
 			token.GloballyFrozen = true
 		}
+		// Set the global freeze expiration on one of them.
+		if i == 0 {
+			token.GlobalFreezeExpiresAt = lo.ToPtr(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+		}
 		tokens = append(tokens, token)
 		requireT.NoError(ftKeeper.SetDenomMetadata(
 			ctx,
@@ -86,6 +91,35 @@ func TestInitAndExportGenesis(t *testing.T) {
 			})
 	}
 
+	// freeze expirations
+	freezeExpirations := []types.FreezeExpiration{
+		{
+			Account:   frozenBalances[0].Address,
+			Denom:     tokens[0].Denom,
+			ExpiresAt: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Account:   frozenBalances[1].Address,
+			Denom:     tokens[1].Denom,
+			ExpiresAt: time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	// denylist
+	var denylist []types.DenylistEntry
+	for range 3 {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		denylist = append(denylist,
+			types.DenylistEntry{
+				Account: addr.String(),
+				Denom:   tokens[0].Denom,
+			},
+			types.DenylistEntry{
+				Account: addr.String(),
+				Denom:   tokens[1].Denom,
+			})
+	}
+
 	// whitelisted balances
 	var whitelistedBalances []types.Balance
 	for range 4 {
@@ -155,6 +189,8 @@ func TestInitAndExportGenesis(t *testing.T) {
 		DEXLockedBalances:            dexLockedBalances,
 		DEXExpectedToReceiveBalances: dexExpectedToReceiveBalances,
 		DEXSettings:                  dexSettings,
+		FreezeExpirations:            freezeExpirations,
+		Denylist:                     denylist,
 	}
 
 	// init the keeper
@@ -184,6 +220,25 @@ func TestInitAndExportGenesis(t *testing.T) {
 		assertT.Equal(balance.Coins.String(), coins.String())
 	}
 
+	// freeze expirations
+	for _, expiration := range freezeExpirations {
+		address, err := sdk.AccAddressFromBech32(expiration.Account)
+		requireT.NoError(err)
+		expiresAt, err := ftKeeper.GetFreezeExpiration(ctx, address, expiration.Denom)
+		requireT.NoError(err)
+		requireT.NotNil(expiresAt)
+		assertT.Equal(expiration.ExpiresAt, *expiresAt)
+	}
+
+	// denylist
+	for _, entry := range denylist {
+		address, err := sdk.AccAddressFromBech32(entry.Account)
+		requireT.NoError(err)
+		denylisted, err := ftKeeper.IsDenylisted(ctx, address, entry.Denom)
+		requireT.NoError(err)
+		assertT.True(denylisted)
+	}
+
 	// whitelisted balances
 	for _, balance := range whitelistedBalances {
 		address, err := sdk.AccAddressFromBech32(balance.Address)
@@ -229,4 +284,6 @@ func TestInitAndExportGenesis(t *testing.T) {
 	assertT.ElementsMatch(genState.DEXExpectedToReceiveBalances, exportedGenState.DEXExpectedToReceiveBalances)
 	assertT.ElementsMatch(genState.DEXLockedBalances, exportedGenState.DEXLockedBalances)
 	assertT.ElementsMatch(genState.DEXSettings, exportedGenState.DEXSettings)
+	assertT.ElementsMatch(genState.FreezeExpirations, exportedGenState.FreezeExpirations)
+	assertT.ElementsMatch(genState.Denylist, exportedGenState.Denylist)
 }
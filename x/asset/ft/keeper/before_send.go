@@ -120,6 +120,10 @@ func (k Keeper) applyFeatures(ctx sdk.Context, input banktypes.Input, outputs []
 				return err
 			}
 
+			if err := k.consumeVelocityAllowance(ctx, sender, *def, coin.Amount); err != nil {
+				return err
+			}
+
 			if err := k.validateCoinReceivable(ctx, recipient, *def, coin.Amount); err != nil {
 				return err
 			}
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+)
+
+// FreezeExpirationKeeper defines methods required to unfreeze the expired freezes.
+type FreezeExpirationKeeper interface {
+	UnfreezeExpired(ctx sdk.Context, data *types.DelayedUnfreeze) error
+	GloballyUnfreezeExpired(ctx sdk.Context, data *types.DelayedGloballyUnfreeze) error
+}
+
+// NewDelayUnfreezeHandler handles the unfreezing of the expired frozen balance.
+func NewDelayUnfreezeHandler(keeper FreezeExpirationKeeper) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.DelayedUnfreeze)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+
+		return keeper.UnfreezeExpired(ctx, msg)
+	}
+}
+
+// NewDelayGloballyUnfreezeHandler handles the removal of the expired global freeze.
+func NewDelayGloballyUnfreezeHandler(keeper FreezeExpirationKeeper) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.DelayedGloballyUnfreeze)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+
+		return keeper.GloballyUnfreezeExpired(ctx, msg)
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -31,6 +32,8 @@ type QueryKeeper interface {
 		pagination *query.PageRequest,
 	) (sdk.Coins, *query.PageResponse, error)
 	GetFrozenBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error)
+	GetFreezeExpirations(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) ([]types.FreezeExpiration, error)
+	GetFrozenBalanceExpiration(ctx sdk.Context, addr sdk.AccAddress, denom string) (*time.Time, error)
 	GetWhitelistedBalances(
 		ctx sdk.Context,
 		addr sdk.AccAddress,
@@ -40,6 +43,13 @@ type QueryKeeper interface {
 	GetDEXLockedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetDEXExpectedToReceivedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetDEXSettings(ctx sdk.Context, denom string) (types.DEXSettings, error)
+	GetVelocityAllowance(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error)
+	GetDenylistedDenoms(
+		ctx sdk.Context,
+		addr sdk.AccAddress,
+		pagination *query.PageRequest,
+	) ([]string, *query.PageResponse, error)
+	IsDenylisted(ctx sdk.Context, addr sdk.AccAddress, denom string) (bool, error)
 }
 
 // BankKeeper represents required methods of bank keeper.
@@ -163,10 +173,15 @@ func (qs QueryService) FrozenBalances(
 	if err != nil {
 		return nil, err
 	}
+	expirations, err := qs.keeper.GetFreezeExpirations(ctx, account, balances)
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.QueryFrozenBalancesResponse{
-		Balances:   balances,
-		Pagination: pageRes,
+		Balances:    balances,
+		Expirations: expirations,
+		Pagination:  pageRes,
 	}, nil
 }
 
@@ -184,9 +199,14 @@ func (qs QueryService) FrozenBalance(
 	if err != nil {
 		return nil, err
 	}
+	expiresAt, err := qs.keeper.GetFrozenBalanceExpiration(ctx, account, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.QueryFrozenBalanceResponse{
-		Balance: balance,
+		Balance:   balance,
+		ExpiresAt: expiresAt,
 	}, nil
 }
 
@@ -244,3 +264,67 @@ func (qs QueryService) DEXSettings(
 		DEXSettings: settings,
 	}, nil
 }
+
+// VelocityAllowance returns the amount of the denom the account is allowed to send within the current window.
+func (qs QueryService) VelocityAllowance(
+	goCtx context.Context,
+	req *types.QueryVelocityAllowanceRequest,
+) (*types.QueryVelocityAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	allowance, err := qs.keeper.GetVelocityAllowance(ctx, account, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVelocityAllowanceResponse{
+		Allowance: allowance,
+	}, nil
+}
+
+// DenylistedDenoms lists the denoms the account is denylisted for.
+func (qs QueryService) DenylistedDenoms(
+	goCtx context.Context,
+	req *types.QueryDenylistedDenomsRequest,
+) (*types.QueryDenylistedDenomsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	denoms, pageRes, err := qs.keeper.GetDenylistedDenoms(ctx, account, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenylistedDenomsResponse{
+		Denoms:     denoms,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Denylisted returns whether the account is denylisted for the denom.
+func (qs QueryService) Denylisted(
+	goCtx context.Context,
+	req *types.QueryDenylistedRequest,
+) (*types.QueryDenylistedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	denylisted, err := qs.keeper.IsDenylisted(ctx, account, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenylistedResponse{
+		Denylisted: denylisted,
+	}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"time"
 
 	sdkstore "cosmossdk.io/core/store"
 	sdkerrors "cosmossdk.io/errors"
@@ -20,6 +21,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/samber/lo"
 
 	"github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
 	"github.com/CoreumFoundation/coreum/v6/x/wasm"
@@ -223,10 +225,23 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 		return "", err
 	}
 
+	if err := types.ValidateVelocityLimitSettings(settings.Features, settings.VelocityLimit); err != nil {
+		return "", err
+	}
+
 	if settings.InitialAmount.GT(types.MaxMintableAmount) {
 		return "", sdkerrors.Wrapf(types.ErrInvalidInput, "initial amount is greater than maximum allowed")
 	}
 
+	if settings.MaxSupply != nil {
+		if err := types.ValidateMaxSupply(*settings.MaxSupply); err != nil {
+			return "", err
+		}
+		if settings.InitialAmount.GT(*settings.MaxSupply) {
+			return "", sdkerrors.Wrapf(types.ErrInvalidInput, "initial amount is greater than max supply")
+		}
+	}
+
 	err := types.ValidateSymbol(settings.Symbol)
 	if err != nil {
 		return "", sdkerrors.Wrapf(err, "provided symbol: %s", settings.Symbol)
@@ -266,6 +281,8 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 		URI:                settings.URI,
 		URIHash:            settings.URIHash,
 		Admin:              settings.Issuer.String(),
+		MaxSupply:          settings.MaxSupply,
+		VelocityLimit:      settings.VelocityLimit,
 	}
 
 	if err = k.mintIfReceivable(ctx, definition, settings.InitialAmount, settings.Issuer); err != nil {
@@ -352,6 +369,8 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 		URIHash:            settings.URIHash,
 		Admin:              settings.Issuer.String(),
 		DEXSettings:        settings.DEXSettings,
+		MaxSupply:          settings.MaxSupply,
+		VelocityLimit:      settings.VelocityLimit,
 	}); err != nil {
 		return "", sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssued event: %s", err)
 	}
@@ -469,21 +488,51 @@ func (k Keeper) Burn(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) erro
 	return k.burnIfSpendable(ctx, sender, def, coin.Amount)
 }
 
-// Freeze freezes specified token from the specified account.
-func (k Keeper) Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error {
+// Freeze freezes specified token from the specified account. The whole frozen balance of the denom is unfrozen
+// at the expiration time if it's set, otherwise it stays frozen until it's unfrozen explicitly. The freezing with
+// and without the expiration time can't be mixed on the frozen balance, the SetFrozen replaces it instead.
+func (k Keeper) Freeze(
+	ctx sdk.Context,
+	sender, addr sdk.AccAddress,
+	coin sdk.Coin,
+	expiresAt *time.Time,
+) error {
 	if !coin.IsPositive() {
 		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "freeze amount should be positive")
 	}
 
+	if err := validateFreezeExpiration(ctx, expiresAt); err != nil {
+		return err
+	}
+
 	if err := k.freezingChecks(ctx, sender, addr, coin); err != nil {
 		return err
 	}
 
 	frozenStore := k.frozenAccountBalanceStore(ctx, addr)
 	frozenBalance := frozenStore.Balance(coin.Denom)
+	if frozenBalance.IsPositive() {
+		prevExpiresAt, err := k.GetFreezeExpiration(ctx, addr, coin.Denom)
+		if err != nil {
+			return err
+		}
+		// the expiration time is tracked for the whole frozen balance, so mixing would either unfreeze the
+		// balance frozen without the expiration time, or drop the pending expiration
+		if (prevExpiresAt == nil) != (expiresAt == nil) {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidInput,
+				"the freezing with and without the expiration time can't be mixed, frozen balance: %s",
+				frozenBalance.String(),
+			)
+		}
+	}
 	newFrozenBalance := frozenBalance.Add(coin)
 	frozenStore.SetBalance(newFrozenBalance)
 
+	if err := k.setFreezeExpiration(ctx, addr, coin.Denom, expiresAt); err != nil {
+		return err
+	}
+
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventFrozenAmountChanged{
 		Account:        addr.String(),
 		Denom:          coin.Denom,
@@ -519,6 +568,12 @@ func (k Keeper) Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.
 	newFrozenBalance := frozenBalance.Sub(coin)
 	frozenStore.SetBalance(newFrozenBalance)
 
+	if newFrozenBalance.IsZero() {
+		if err := k.setFreezeExpiration(ctx, addr, coin.Denom, nil); err != nil {
+			return err
+		}
+	}
+
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventFrozenAmountChanged{
 		Account:        addr.String(),
 		Denom:          coin.Denom,
@@ -531,12 +586,22 @@ func (k Keeper) Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.
 	return nil
 }
 
-// SetFrozen sets frozen amount on the specified account.
-func (k Keeper) SetFrozen(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error {
+// SetFrozen sets frozen amount on the specified account. The frozen balance is unfrozen at the expiration time
+// if it's set, otherwise it stays frozen until it's unfrozen explicitly.
+func (k Keeper) SetFrozen(
+	ctx sdk.Context,
+	sender, addr sdk.AccAddress,
+	coin sdk.Coin,
+	expiresAt *time.Time,
+) error {
 	if coin.IsNegative() {
 		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "frozen amount must not be negative")
 	}
 
+	if err := validateFreezeExpiration(ctx, expiresAt); err != nil {
+		return err
+	}
+
 	if err := k.freezingChecks(ctx, sender, addr, coin); err != nil {
 		return err
 	}
@@ -545,6 +610,14 @@ func (k Keeper) SetFrozen(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk
 	frozenBalance := frozenStore.Balance(coin.Denom)
 	frozenStore.SetBalance(coin)
 
+	// there is nothing to unfreeze if the frozen balance is zero
+	if coin.IsZero() {
+		expiresAt = nil
+	}
+	if err := k.setFreezeExpiration(ctx, addr, coin.Denom, expiresAt); err != nil {
+		return err
+	}
+
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventFrozenAmountChanged{
 		Account:        addr.String(),
 		Denom:          coin.Denom,
@@ -557,8 +630,15 @@ func (k Keeper) SetFrozen(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk
 	return nil
 }
 
-// GloballyFreeze enables global freeze on a fungible token. This function is idempotent.
-func (k Keeper) GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+// GloballyFreeze enables global freeze on a fungible token. This function is idempotent, each call replaces the
+// expiration time of the global freeze, and the global freeze lasts until it's removed explicitly if it's not set.
+// The global freezing with and without the expiration time can't be mixed, the global unfreeze is required to
+// change it.
+func (k Keeper) GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string, expiresAt *time.Time) error {
+	if err := validateFreezeExpiration(ctx, expiresAt); err != nil {
+		return err
+	}
+
 	def, err := k.GetDefinition(ctx, denom)
 	if err != nil {
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
@@ -568,6 +648,30 @@ func (k Keeper) GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom str
 		return err
 	}
 
+	isGloballyFrozen, err := k.isGloballyFrozen(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if isGloballyFrozen {
+		prevExpiresAt, err := k.GetGlobalFreezeExpiration(ctx, denom)
+		if err != nil {
+			return err
+		}
+		// the same as for the frozen balance, mixing would either remove the global freeze set without the
+		// expiration time, or drop the pending expiration
+		if (prevExpiresAt == nil) != (expiresAt == nil) {
+			return sdkerrors.Wrapf(
+				types.ErrInvalidInput,
+				"the global freezing with and without the expiration time can't be mixed, denom: %s",
+				denom,
+			)
+		}
+	}
+
+	if err := k.setGlobalFreezeExpiration(ctx, denom, expiresAt); err != nil {
+		return err
+	}
+
 	return k.SetGlobalFreeze(ctx, denom, true)
 }
 
@@ -582,6 +686,10 @@ func (k Keeper) GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom s
 		return err
 	}
 
+	if err := k.setGlobalFreezeExpiration(ctx, denom, nil); err != nil {
+		return err
+	}
+
 	return k.SetGlobalFreeze(ctx, denom, false)
 }
 
@@ -809,10 +917,17 @@ func (k Keeper) TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, deno
 	}
 
 	def.Admin = addr.String()
+	// the roles are granted by the previous admin, so they are revoked together with the administration
+	roleHolders := def.RoleHolders
+	def.RoleHolders = nil
 	if err := k.SetDefinition(ctx, issuer, subunit, def); err != nil {
 		return err
 	}
 
+	if err := emitRolesRevokedEvents(ctx, denom, roleHolders); err != nil {
+		return err
+	}
+
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminTransferred{
 		Denom:         denom,
 		PreviousAdmin: previousAdmin,
@@ -848,11 +963,18 @@ func (k Keeper) ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string)
 	if !def.IsFeatureEnabled(types.Feature_extension) {
 		def.SendCommissionRate = sdkmath.LegacyZeroDec()
 	}
+	// the roles are granted by the admin, so they are revoked together with the administration
+	roleHolders := def.RoleHolders
+	def.RoleHolders = nil
 
 	if err := k.SetDefinition(ctx, issuer, subunit, def); err != nil {
 		return err
 	}
 
+	if err := emitRolesRevokedEvents(ctx, denom, roleHolders); err != nil {
+		return err
+	}
+
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminCleared{
 		Denom:         denom,
 		PreviousAdmin: previousAdmin,
@@ -863,6 +985,143 @@ func (k Keeper) ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string)
 	return nil
 }
 
+// GrantRole grants the role of a fungible token to the account.
+func (k Keeper) GrantRole(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, role types.Role) error {
+	if err := types.ValidateRole(role); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin can grant roles")
+	}
+
+	if def.HasRole(addr, role) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "account %s already holds the %s role", addr.String(), role.String(),
+		)
+	}
+
+	subunit, issuer, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	def.RoleHolders = append(def.RoleHolders, types.RoleHolder{
+		Role:    role,
+		Address: addr.String(),
+	})
+	if err := k.SetDefinition(ctx, issuer, subunit, def); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRoleGranted{
+		Denom:   denom,
+		Role:    role,
+		Account: addr.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRoleGranted event: %s", err)
+	}
+
+	return nil
+}
+
+// RevokeRole revokes the role of a fungible token from the account.
+func (k Keeper) RevokeRole(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, role types.Role) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin can revoke roles")
+	}
+
+	if !def.HasRole(addr, role) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "account %s doesn't hold the %s role", addr.String(), role.String(),
+		)
+	}
+
+	subunit, issuer, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	def.RoleHolders = lo.Reject(def.RoleHolders, func(holder types.RoleHolder, _ int) bool {
+		return holder.Role == role && holder.Address == addr.String()
+	})
+	if err := k.SetDefinition(ctx, issuer, subunit, def); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRoleRevoked{
+		Denom:   denom,
+		Role:    role,
+		Account: addr.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRoleRevoked event: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateMaxSupply lowers the max supply of the token, the max supply can't be raised or set below the current supply.
+func (k Keeper) UpdateMaxSupply(ctx sdk.Context, sender sdk.AccAddress, denom string, maxSupply sdkmath.Int) error {
+	if err := types.ValidateMaxSupply(maxSupply); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin can update max supply")
+	}
+
+	if def.MaxSupply != nil && maxSupply.GTE(*def.MaxSupply) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"max supply can only be lowered, current max supply: %s", def.MaxSupply.String(),
+		)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	if maxSupply.LT(supply.Amount) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"max supply can't be lower than the current supply: %s", supply.Amount.String(),
+		)
+	}
+
+	subunit, issuer, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	previousMaxSupply := def.MaxSupply
+	def.MaxSupply = &maxSupply
+	if err := k.SetDefinition(ctx, issuer, subunit, def); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMaxSupplyUpdated{
+		Denom:             denom,
+		PreviousMaxSupply: previousMaxSupply,
+		CurrentMaxSupply:  maxSupply,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventMaxSupplyUpdated event: %s", err)
+	}
+
+	return nil
+}
+
 // HasSupply checks if the supply of denom exists in store.
 func (k Keeper) HasSupply(ctx context.Context, denom string) bool {
 	return k.bankKeeper.HasSupply(ctx, denom)
@@ -882,6 +1141,17 @@ func (k Keeper) mintIfReceivable(
 		ctx = cwasmtypes.WithSmartContractRecipient(ctx, recipient.String())
 	}
 
+	if def.MaxSupply != nil {
+		supply := k.bankKeeper.GetSupply(ctx, def.Denom)
+		if supply.Amount.Add(amount).GT(*def.MaxSupply) {
+			return sdkerrors.Wrapf(
+				types.ErrMaxSupplyExceeded,
+				"minting %s%s exceeds max supply %s, current supply: %s",
+				amount.String(), def.Denom, def.MaxSupply.String(), supply.Amount.String(),
+			)
+		}
+	}
+
 	if err := k.validateCoinReceivable(ctx, recipient, def, amount); err != nil {
 		return sdkerrors.Wrapf(err, "coins are not receivable")
 	}
@@ -970,6 +1240,10 @@ func (k Keeper) validateCoinSpendable(
 		return nil
 	}
 
+	if err := k.validateNotDenylisted(ctx, addr, def); err != nil {
+		return err
+	}
+
 	if def.IsFeatureEnabled(types.Feature_block_smart_contracts) &&
 		!def.HasAdminPrivileges(addr) &&
 		cwasmtypes.IsTriggeredBySmartContract(ctx) {
@@ -1039,6 +1313,10 @@ func (k Keeper) validateCoinReceivable(
 		return nil
 	}
 
+	if err := k.validateNotDenylisted(ctx, addr, def); err != nil {
+		return err
+	}
+
 	if def.IsFeatureEnabled(types.Feature_whitelisting) && !def.HasAdminPrivileges(addr) {
 		if err := k.validateWhitelistedBalance(ctx, addr, sdk.NewCoin(def.Denom, amount)); err != nil {
 			return err
@@ -1129,23 +1407,32 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (
 		return types.Token{}, err
 	}
 
+	globalFreezeExpiresAt, err := k.GetGlobalFreezeExpiration(ctx, definition.Denom)
+	if err != nil {
+		return types.Token{}, err
+	}
+
 	return types.Token{
-		Denom:              definition.Denom,
-		Issuer:             definition.Issuer,
-		Symbol:             metadata.Symbol,
-		Precision:          uint32(precision),
-		Subunit:            subunit,
-		Description:        metadata.Description,
-		Features:           definition.Features,
-		BurnRate:           definition.BurnRate,
-		SendCommissionRate: definition.SendCommissionRate,
-		GloballyFrozen:     isGloballyFrozen,
-		Version:            definition.Version,
-		URI:                definition.URI,
-		URIHash:            definition.URIHash,
-		Admin:              definition.Admin,
-		ExtensionCWAddress: definition.ExtensionCWAddress,
-		DEXSettings:        dexSettings,
+		Denom:                 definition.Denom,
+		Issuer:                definition.Issuer,
+		Symbol:                metadata.Symbol,
+		Precision:             uint32(precision),
+		Subunit:               subunit,
+		Description:           metadata.Description,
+		Features:              definition.Features,
+		BurnRate:              definition.BurnRate,
+		SendCommissionRate:    definition.SendCommissionRate,
+		GloballyFrozen:        isGloballyFrozen,
+		Version:               definition.Version,
+		URI:                   definition.URI,
+		URIHash:               definition.URIHash,
+		Admin:                 definition.Admin,
+		ExtensionCWAddress:    definition.ExtensionCWAddress,
+		DEXSettings:           dexSettings,
+		RoleHolders:           definition.RoleHolders,
+		MaxSupply:             definition.MaxSupply,
+		VelocityLimit:         definition.VelocityLimit,
+		GlobalFreezeExpiresAt: globalFreezeExpiresAt,
 	}, nil
 }
 
@@ -1301,3 +1588,17 @@ func (k Keeper) checkIssueFeeIsLimitedToCore(ctx sdk.Context, params types.Param
 
 	return nil
 }
+
+func emitRolesRevokedEvents(ctx sdk.Context, denom string, roleHolders []types.RoleHolder) error {
+	for _, holder := range roleHolders {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRoleRevoked{
+			Denom:   denom,
+			Role:    holder.Role,
+			Account: holder.Address,
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRoleRevoked event: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+)
+
+// AddToDenylist blocks the account from sending and receiving the token. This function is idempotent.
+func (k Keeper) AddToDenylist(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	return k.setDenylisted(ctx, sender, addr, denom, true)
+}
+
+// RemoveFromDenylist unblocks the account denylisted previously. This function is idempotent.
+func (k Keeper) RemoveFromDenylist(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	return k.setDenylisted(ctx, sender, addr, denom, false)
+}
+
+// IsDenylisted returns true if the account is denylisted for the denom.
+func (k Keeper) IsDenylisted(ctx sdk.Context, addr sdk.AccAddress, denom string) (bool, error) {
+	return k.storeService.OpenKVStore(ctx).Has(types.CreateDenylistKey(addr, denom))
+}
+
+// GetDenylistedDenoms returns the denoms the account is denylisted for.
+func (k Keeper) GetDenylistedDenoms(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]string, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.CreateDenylistPrefix(addr))
+	denoms := make([]string, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		denoms = append(denoms, string(key))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return denoms, pageRes, nil
+}
+
+// ImportDenylist imports the denylisted accounts.
+func (k Keeper) ImportDenylist(ctx sdk.Context, entries []types.DenylistEntry) error {
+	store := k.storeService.OpenKVStore(ctx)
+	for _, entry := range entries {
+		addr, err := sdk.AccAddressFromBech32(entry.Account)
+		if err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account %s", entry.Account)
+		}
+		if err := store.Set(types.CreateDenylistKey(addr, entry.Denom), types.StoreTrue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportDenylist exports the denylisted accounts.
+func (k Keeper) ExportDenylist(ctx sdk.Context) ([]types.DenylistEntry, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.DenylistKeyPrefix)
+	entries := make([]types.DenylistEntry, 0)
+	_, err := query.Paginate(store, &query.PageRequest{Limit: query.PaginationMaxLimit}, func(key, _ []byte) error {
+		addr, err := types.AddressFromBalancesStore(key)
+		if err != nil {
+			return err
+		}
+
+		entries = append(entries, types.DenylistEntry{
+			Account: addr.String(),
+			Denom:   string(key[len(addr)+1:]),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return entries, nil
+}
+
+func (k Keeper) setDenylisted(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, denylisted bool) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if def.HasAdminPrivileges(addr) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "admin can't be denylisted")
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_denylisting); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.CreateDenylistKey(addr, denom)
+	if denylisted {
+		err = store.Set(key, types.StoreTrue)
+	} else {
+		err = store.Delete(key)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventDenylistChanged{
+		Account:    addr.String(),
+		Denom:      denom,
+		Denylisted: denylisted,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDenylistChanged event: %s", err)
+	}
+
+	return nil
+}
+
+// validateNotDenylisted returns an error if the account is denylisted for the token, the admin isn't affected.
+func (k Keeper) validateNotDenylisted(ctx sdk.Context, addr sdk.AccAddress, def types.Definition) error {
+	if !def.IsFeatureEnabled(types.Feature_denylisting) || def.HasAdminPrivileges(addr) {
+		return nil
+	}
+
+	denylisted, err := k.IsDenylisted(ctx, addr, def.Denom)
+	if err != nil {
+		return err
+	}
+	if denylisted {
+		return sdkerrors.Wrapf(types.ErrDenylisted, "%s is denylisted for %s", addr.String(), def.Denom)
+	}
+
+	return nil
+}
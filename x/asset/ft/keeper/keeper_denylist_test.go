@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+)
+
+func TestKeeper_Denylist(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_denylisting,
+		},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))))
+
+	// try to denylist the account by non-admin
+	err = ftKeeper.AddToDenylist(ctx, account, recipient, denom)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// try to denylist the admin
+	err = ftKeeper.AddToDenylist(ctx, issuer, issuer, denom)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// denylist the account
+	requireT.NoError(ftKeeper.AddToDenylist(ctx, issuer, account, denom))
+	denylisted, err := ftKeeper.IsDenylisted(ctx, account, denom)
+	requireT.NoError(err)
+	requireT.True(denylisted)
+
+	denoms, _, err := ftKeeper.GetDenylistedDenoms(ctx, account, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]string{denom}, denoms)
+
+	// try to send from the denylisted account
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10))))
+	requireT.ErrorIs(err, types.ErrDenylisted)
+
+	// try to send to the denylisted account
+	err = bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10))))
+	requireT.ErrorIs(err, types.ErrDenylisted)
+
+	// remove the account from the denylist
+	requireT.NoError(ftKeeper.RemoveFromDenylist(ctx, issuer, account, denom))
+	denylisted, err = ftKeeper.IsDenylisted(ctx, account, denom)
+	requireT.NoError(err)
+	requireT.False(denylisted)
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10)))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10)))))
+
+	// the account can't be denylisted for the tokens without the feature
+	settings.Symbol = "GHI"
+	settings.Subunit = "ghi"
+	settings.Features = nil
+	unrestrictedDenom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	err = ftKeeper.AddToDenylist(ctx, issuer, account, unrestrictedDenom)
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+}
@@ -32,15 +32,20 @@ type sudoExtensionPlaceOrderMsg struct {
 // adjusting locked balances, and updating expected to receive balances. It performs necessary
 // validations and updates the state accordingly based on the provided actions.
 func (k Keeper) DEXExecuteActions(ctx sdk.Context, actions types.DEXActions) error {
+	// the limits released by the replaced order are available for the new order
 	if err := k.DEXCheckOrderAmounts(
 		ctx,
 		actions.Order,
-		actions.CreatorExpectedToSpend,
-		actions.CreatorExpectedToReceive,
+		subReleasedAmount(actions.CreatorExpectedToSpend, actions.CreatorReleasedLocked),
+		subReleasedAmount(actions.CreatorExpectedToReceive, sdk.Coins{actions.CreatorReleasedExpectedToReceive}),
 	); err != nil {
 		return err
 	}
 
+	if err := k.dexConsumeVelocityAllowance(ctx, actions); err != nil {
+		return err
+	}
+
 	for _, lock := range actions.IncreaseLocked {
 		if err := k.DEXIncreaseLocked(ctx, lock.Address, lock.Coin); err != nil {
 			return err
@@ -89,18 +94,26 @@ func (k Keeper) DEXExecuteActions(ctx sdk.Context, actions types.DEXActions) err
 	)
 }
 
-// DEXDecreaseLimits decreases the DEX limits.
+// DEXDecreaseLimits decreases the DEX limits of the order released without the execution, and restores the velocity
+// allowance consumed by the released locked coins within the current window.
 func (k Keeper) DEXDecreaseLimits(
 	ctx sdk.Context,
 	addr sdk.AccAddress,
 	lockedCoins sdk.Coins, expectedToReceiveCoin sdk.Coin,
 ) error {
 	for _, coin := range lockedCoins {
+		if err := k.dexRestoreVelocityAllowance(ctx, addr, coin); err != nil {
+			return err
+		}
 		if err := k.DEXDecreaseLocked(ctx, addr, coin); err != nil {
 			return err
 		}
 	}
 
+	if expectedToReceiveCoin.IsNil() || expectedToReceiveCoin.IsZero() {
+		return nil
+	}
+
 	return k.DEXDecreaseExpectedToReceive(ctx, addr, expectedToReceiveCoin)
 }
 
@@ -206,7 +219,7 @@ func (k Keeper) DEXIncreaseExpectedToReceive(ctx sdk.Context, addr sdk.AccAddres
 		)
 	}
 
-	shouldRecord, err := k.shouldRecordDEXExpectedToReceiveBalance(ctx, coin.Denom)
+	shouldRecord, err := k.ShouldRecordDEXExpectedToReceiveBalance(ctx, coin.Denom)
 	if err != nil {
 		return err
 	}
@@ -240,7 +253,7 @@ func (k Keeper) DEXDecreaseExpectedToReceive(ctx sdk.Context, addr sdk.AccAddres
 		)
 	}
 
-	shouldRecord, err := k.shouldRecordDEXExpectedToReceiveBalance(ctx, coin.Denom)
+	shouldRecord, err := k.ShouldRecordDEXExpectedToReceiveBalance(ctx, coin.Denom)
 	if err != nil {
 		return err
 	}
@@ -404,6 +417,27 @@ func (k Keeper) ValidateDEXCancelOrdersByDenomIsAllowed(ctx sdk.Context, addr sd
 	return nil
 }
 
+// ValidateDEXOrderBookHaltIsAllowed validates whether the halt of the order books with the denom is allowed.
+func (k Keeper) ValidateDEXOrderBookHaltIsAllowed(ctx sdk.Context, addr sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if !def.HasAdminPrivileges(addr) {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "only admin is able to halt order books with denom %s", denom)
+	}
+	if !def.IsFeatureEnabled(types.Feature_dex_order_book_halt) {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized,
+			"order book halt is not allowed by denom %s, feature %s is disabled",
+			denom, types.Feature_dex_order_book_halt,
+		)
+	}
+
+	return nil
+}
+
 func (k Keeper) dexCheckExpectedToSpend(
 	ctx sdk.Context,
 	order types.DEXOrder,
@@ -434,6 +468,100 @@ func (k Keeper) dexCheckExpectedToSpend(
 	return nil
 }
 
+// dexConsumeVelocityAllowance consumes the velocity allowance of the order creator by the amount the order is
+// expected to spend, the part locked by the order is restored when the order is released. The allowance consumed by
+// the replaced order is restored first, so it's reused by the new order.
+func (k Keeper) dexConsumeVelocityAllowance(ctx sdk.Context, actions types.DEXActions) error {
+	for _, coin := range actions.CreatorReleasedLocked {
+		if err := k.dexRestoreVelocityAllowance(ctx, actions.Order.Creator, coin); err != nil {
+			return err
+		}
+	}
+
+	expectedToSpend := actions.CreatorExpectedToSpend
+	spendDef, err := k.getDefinitionOrNil(ctx, expectedToSpend.Denom)
+	if err != nil {
+		return err
+	}
+	if spendDef != nil {
+		if err := k.consumeVelocityAllowance(
+			ctx, actions.Order.Creator, *spendDef, expectedToSpend.Amount,
+		); err != nil {
+			return sdkerrors.Wrapf(types.ErrDEXInsufficientSpendableBalance, "err: %s", err)
+		}
+	}
+
+	return k.dexLockVelocityAllowance(ctx, actions)
+}
+
+// dexLockVelocityAllowance tracks the balances locked and unlocked by the actions within the velocity window. The
+// balance released by the replaced order of the creator is netted with the creator locked balance by the actions, so
+// it's added back since its allowance is already restored.
+func (k Keeper) dexLockVelocityAllowance(ctx sdk.Context, actions types.DEXActions) error {
+	type accountDenom struct {
+		address string
+		denom   string
+	}
+	keys := make([]accountDenom, 0)
+	diffs := make(map[accountDenom]sdkmath.Int)
+	addDiff := func(addr sdk.AccAddress, coin sdk.Coin) {
+		key := accountDenom{address: addr.String(), denom: coin.Denom}
+		diff, ok := diffs[key]
+		if !ok {
+			keys = append(keys, key)
+			diff = sdkmath.ZeroInt()
+		}
+		diffs[key] = diff.Add(coin.Amount)
+	}
+	for _, lock := range actions.IncreaseLocked {
+		addDiff(lock.Address, lock.Coin)
+	}
+	for _, unlock := range actions.DecreaseLocked {
+		addDiff(unlock.Address, sdk.Coin{Denom: unlock.Coin.Denom, Amount: unlock.Coin.Amount.Neg()})
+	}
+	for _, coin := range actions.CreatorReleasedLocked {
+		addDiff(actions.Order.Creator, coin)
+	}
+
+	for _, key := range keys {
+		diff := diffs[key]
+		if diff.IsZero() {
+			continue
+		}
+		def, err := k.getDefinitionOrNil(ctx, key.denom)
+		if err != nil {
+			return err
+		}
+		if def == nil {
+			continue
+		}
+		addr := sdk.MustAccAddressFromBech32(key.address)
+		if diff.IsPositive() {
+			err = k.lockDEXVelocityAllowance(ctx, addr, *def, diff)
+		} else {
+			err = k.unlockDEXVelocityAllowance(ctx, addr, *def, diff.Neg())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dexRestoreVelocityAllowance restores the velocity allowance of the account consumed by the released DEX locked coin.
+func (k Keeper) dexRestoreVelocityAllowance(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	def, err := k.getDefinitionOrNil(ctx, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if def == nil {
+		return nil
+	}
+
+	return k.restoreDEXVelocityAllowance(ctx, addr, *def, coin.Amount)
+}
+
 func (k Keeper) dexCheckExpectedToReceive(
 	ctx sdk.Context,
 	order types.DEXOrder,
@@ -583,6 +711,10 @@ func (k Keeper) dexChecksForDefinition(ctx sdk.Context, acc sdk.AccAddress, def
 		}
 	}
 
+	if err := k.validateNotDenylisted(ctx, acc, def); err != nil {
+		return sdkerrors.Wrapf(err, "usage of %s for DEX is blocked", def.Denom)
+	}
+
 	return nil
 }
 
@@ -620,8 +752,10 @@ func (k Keeper) updateDEXSettings(
 	// the gov can update any DEX setting even if the features are disabled
 	if k.authority != sender.String() { //nolint:nestif // the ifs are for the error checks mostly
 		if def != nil {
-			if !def.IsAdmin(sender) {
-				return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin and gov can update DEX settings")
+			if !def.IsAdmin(sender) && !def.HasRole(sender, types.Role_dex_settings_manager) {
+				return sdkerrors.Wrap(
+					cosmoserrors.ErrUnauthorized, "only admin, DEX settings manager and gov can update DEX settings",
+				)
 			}
 			if err := types.ValidateDEXSettingsAccess(newSettings, *def); err != nil {
 				return err
@@ -681,7 +815,9 @@ func (k Keeper) dexExpectedToReceiveAccountBalanceStore(ctx sdk.Context, addr sd
 	return newBalanceStore(k.cdc, runtime.KVStoreAdapter(store), types.CreateDEXExpectedToReceiveBalancesKey(addr))
 }
 
-func (k Keeper) shouldRecordDEXExpectedToReceiveBalance(ctx sdk.Context, denom string) (bool, error) {
+// ShouldRecordDEXExpectedToReceiveBalance returns true if the DEX expected to receive balance is recorded for
+// the denom.
+func (k Keeper) ShouldRecordDEXExpectedToReceiveBalance(ctx sdk.Context, denom string) (bool, error) {
 	def, err := k.getDefinitionOrNil(ctx, denom)
 	if err != nil {
 		return false, err
@@ -717,3 +853,18 @@ func (k Keeper) getDEXSettingsOrNil(ctx sdk.Context, denom string) (*types.DEXSe
 
 	return &dexSettings, nil
 }
+
+// subReleasedAmount subtracts the released amount of the coin denom from the coin floored at zero.
+func subReleasedAmount(coin sdk.Coin, released sdk.Coins) sdk.Coin {
+	if coin.IsNil() {
+		return coin
+	}
+	releasedAmount := sdkmath.ZeroInt()
+	for _, releasedCoin := range released {
+		if !releasedCoin.IsNil() && releasedCoin.Denom == coin.Denom {
+			releasedAmount = releasedAmount.Add(releasedCoin.Amount)
+		}
+	}
+
+	return sdk.NewCoin(coin.Denom, sdkmath.MaxInt(coin.Amount.Sub(releasedAmount), sdkmath.ZeroInt()))
+}
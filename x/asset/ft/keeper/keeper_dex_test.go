@@ -267,7 +267,7 @@ func TestKeeper_DEXLocked(t *testing.T) {
 	requireT.Equal(sdk.NewInt64Coin(denom, 400).String(), spendableBalance.String())
 
 	// freeze locked balance
-	requireT.NoError(ftKeeper.Freeze(ctx, issuer, acc, coinToSend))
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, acc, coinToSend, nil))
 	// 1050 - total, 600 locked by dex, 50 locked by bank, 1000 frozen
 	spendableBalance, err = ftKeeper.GetSpendableBalance(ctx, acc, denom)
 	requireT.NoError(err)
@@ -311,7 +311,7 @@ func TestKeeper_DEXLocked(t *testing.T) {
 	requireT.ErrorContains(err, "available 350")
 
 	// try to use with global freezing
-	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom))
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom, nil))
 	requireT.ErrorContains(
 		ftKeeper.DEXCheckOrderAmounts(
 			ctx,
@@ -336,7 +336,7 @@ func TestKeeper_DEXLocked(t *testing.T) {
 	)
 	requireT.NoError(ftKeeper.DEXIncreaseLocked(ctx, acc, sdk.NewInt64Coin(denom, 350)))
 	// freeze more than balance
-	requireT.NoError(ftKeeper.Freeze(ctx, issuer, acc, sdk.NewInt64Coin(denom, 1_000_000)))
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, acc, sdk.NewInt64Coin(denom, 1_000_000), nil))
 }
 
 func TestKeeper_DEXBlockSmartContracts(t *testing.T) {
@@ -1058,7 +1058,7 @@ func TestKeeper_DEXExtensions(t *testing.T) {
 	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, acc, sdk.NewCoins(coinWithExtension)))
 
 	// freeze locked balance, to check that we check freezing for extension as well
-	requireT.NoError(ftKeeper.Freeze(ctx, issuer, acc, coinWithExtension))
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, acc, coinWithExtension, nil))
 	requireT.ErrorContains(
 		ftKeeper.DEXCheckOrderAmounts(
 			simapp.CopyContextWithMultiStore(ctx),
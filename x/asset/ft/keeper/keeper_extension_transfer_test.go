@@ -328,7 +328,7 @@ func TestKeeper_Extension_FreezeUnfreeze(t *testing.T) {
 	requireT.NoError(err)
 
 	// freeze
-	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient1, sdk.NewCoin(denom, sdkmath.NewInt(120))))
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient1, sdk.NewCoin(denom, sdkmath.NewInt(120)), nil))
 
 	// try to send more than available
 	coinsToSend := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(80)))
@@ -539,11 +539,11 @@ func TestKeeper_Extension_Burn(t *testing.T) {
 	requireT.Equal(sdkmath.NewInt(575), totalSupply.Supply.AmountOf(burnableDenom))
 
 	// try to freeze the issuer (issuer can't be frozen)
-	err = ftKeeper.Freeze(ctx, issuer, issuer, sdk.NewCoin(burnableDenom, sdkmath.NewInt(600)))
+	err = ftKeeper.Freeze(ctx, issuer, issuer, sdk.NewCoin(burnableDenom, sdkmath.NewInt(600)), nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to burn non-issuer frozen coins
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(burnableDenom, AmountBurningTrigger))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(burnableDenom, AmountBurningTrigger), nil)
 	requireT.NoError(err)
 	err = bankKeeper.SendCoins(ctx, recipient, issuer, sdk.NewCoins(
 		sdk.NewCoin(burnableDenom, AmountBurningTrigger)),
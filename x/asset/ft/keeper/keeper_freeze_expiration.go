@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+)
+
+// GetFreezeExpiration returns the time the frozen balance of the account is automatically unfrozen at,
+// nil is returned if the frozen balance doesn't expire.
+func (k Keeper) GetFreezeExpiration(ctx sdk.Context, addr sdk.AccAddress, denom string) (*time.Time, error) {
+	return k.getExpiration(ctx, types.CreateFreezeExpirationKey(addr, denom))
+}
+
+// GetFrozenBalanceExpiration returns the expiration time of the balance returned by GetFrozenBalance,
+// which is the expiration time of the global freeze if the token is globally frozen.
+func (k Keeper) GetFrozenBalanceExpiration(ctx sdk.Context, addr sdk.AccAddress, denom string) (*time.Time, error) {
+	isGloballyFrozen, err := k.isGloballyFrozen(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	def, err := k.getDefinitionOrNil(ctx, denom)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if def != nil && def.HasAdminPrivileges(addr) {
+		return nil, nil //nolint:nilnil // the admin's balance can't be frozen
+	}
+
+	if isGloballyFrozen {
+		return k.GetGlobalFreezeExpiration(ctx, denom)
+	}
+	return k.GetFreezeExpiration(ctx, addr, denom)
+}
+
+// GetFreezeExpirations returns the expiration times of the frozen balances of the account which expire.
+func (k Keeper) GetFreezeExpirations(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	coins sdk.Coins,
+) ([]types.FreezeExpiration, error) {
+	expirations := make([]types.FreezeExpiration, 0)
+	for _, coin := range coins {
+		expiresAt, err := k.GetFreezeExpiration(ctx, addr, coin.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if expiresAt == nil {
+			continue
+		}
+		expirations = append(expirations, types.FreezeExpiration{
+			Account:   addr.String(),
+			Denom:     coin.Denom,
+			ExpiresAt: *expiresAt,
+		})
+	}
+
+	return expirations, nil
+}
+
+// GetGlobalFreezeExpiration returns the time the global freeze of the token is automatically removed at,
+// nil is returned if the global freeze doesn't expire.
+func (k Keeper) GetGlobalFreezeExpiration(ctx sdk.Context, denom string) (*time.Time, error) {
+	return k.getExpiration(ctx, types.CreateGlobalFreezeExpirationKey(denom))
+}
+
+// ImportFreezeExpirations saves the expiration times of the frozen balances, the delayed unfreezing is imported
+// by the delay module.
+func (k Keeper) ImportFreezeExpirations(ctx sdk.Context, expirations []types.FreezeExpiration) error {
+	for _, expiration := range expirations {
+		addr, err := sdk.AccAddressFromBech32(expiration.Account)
+		if err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account %s", expiration.Account)
+		}
+		if err := k.setExpiration(
+			ctx, types.CreateFreezeExpirationKey(addr, expiration.Denom), expiration.ExpiresAt,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportFreezeExpirations exports the expiration times of the frozen balances.
+func (k Keeper) ExportFreezeExpirations(ctx sdk.Context) ([]types.FreezeExpiration, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.FreezeExpirationsKeyPrefix)
+	expirations := make([]types.FreezeExpiration, 0)
+	_, err := query.Paginate(store, &query.PageRequest{Limit: query.PaginationMaxLimit}, func(key, value []byte) error {
+		addr, err := types.AddressFromBalancesStore(key)
+		if err != nil {
+			return err
+		}
+		expiresAt, err := sdk.ParseTimeBytes(value)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to parse freeze expiration: %s", err)
+		}
+
+		expirations = append(expirations, types.FreezeExpiration{
+			Account:   addr.String(),
+			Denom:     string(key[len(addr)+1:]),
+			ExpiresAt: expiresAt,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return expirations, nil
+}
+
+// ImportGlobalFreezeExpiration saves the expiration time of the global freeze, the delayed global unfreezing is
+// imported by the delay module.
+func (k Keeper) ImportGlobalFreezeExpiration(ctx sdk.Context, denom string, expiresAt time.Time) error {
+	return k.setExpiration(ctx, types.CreateGlobalFreezeExpirationKey(denom), expiresAt)
+}
+
+// UnfreezeExpired unfreezes the frozen balance of the account once it expires.
+func (k Keeper) UnfreezeExpired(ctx sdk.Context, data *types.DelayedUnfreeze) error {
+	addr, err := sdk.AccAddressFromBech32(data.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account %s", data.Account)
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateFreezeExpirationKey(addr, data.Denom)); err != nil {
+		return err
+	}
+
+	frozenStore := k.frozenAccountBalanceStore(ctx, addr)
+	frozenBalance := frozenStore.Balance(data.Denom)
+	if frozenBalance.IsZero() {
+		return nil
+	}
+	frozenStore.SetBalance(sdk.NewCoin(data.Denom, sdkmath.ZeroInt()))
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFrozenAmountChanged{
+		Account:        data.Account,
+		Denom:          data.Denom,
+		PreviousAmount: frozenBalance.Amount,
+		CurrentAmount:  sdkmath.ZeroInt(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventFrozenAmountChanged event: %s", err)
+	}
+
+	return nil
+}
+
+// GloballyUnfreezeExpired removes the global freeze of the token once it expires.
+func (k Keeper) GloballyUnfreezeExpired(ctx sdk.Context, data *types.DelayedGloballyUnfreeze) error {
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateGlobalFreezeExpirationKey(data.Denom)); err != nil {
+		return err
+	}
+
+	return k.SetGlobalFreeze(ctx, data.Denom, false)
+}
+
+// setFreezeExpiration replaces the pending unfreezing of the account frozen balance, the nil expiration time
+// only removes it.
+func (k Keeper) setFreezeExpiration(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	denom string,
+	expiresAt *time.Time,
+) error {
+	id := freezeExpirationID(addr, denom)
+	key := types.CreateFreezeExpirationKey(addr, denom)
+	if err := k.removeExpiration(ctx, id, key); err != nil {
+		return err
+	}
+	if expiresAt == nil {
+		return nil
+	}
+
+	if err := k.setExpiration(ctx, key, *expiresAt); err != nil {
+		return err
+	}
+	if err := k.delayKeeper.ExecuteAfter(ctx, id, &types.DelayedUnfreeze{
+		Account: addr.String(),
+		Denom:   denom,
+	}, *expiresAt); err != nil {
+		return sdkerrors.Wrap(err, "failed to create delayed unfreezing")
+	}
+
+	return nil
+}
+
+// setGlobalFreezeExpiration replaces the pending removal of the global freeze, the nil expiration time only
+// removes it.
+func (k Keeper) setGlobalFreezeExpiration(ctx sdk.Context, denom string, expiresAt *time.Time) error {
+	id := globalFreezeExpirationID(denom)
+	key := types.CreateGlobalFreezeExpirationKey(denom)
+	if err := k.removeExpiration(ctx, id, key); err != nil {
+		return err
+	}
+	if expiresAt == nil {
+		return nil
+	}
+
+	if err := k.setExpiration(ctx, key, *expiresAt); err != nil {
+		return err
+	}
+	if err := k.delayKeeper.ExecuteAfter(ctx, id, &types.DelayedGloballyUnfreeze{
+		Denom: denom,
+	}, *expiresAt); err != nil {
+		return sdkerrors.Wrap(err, "failed to create delayed global unfreezing")
+	}
+
+	return nil
+}
+
+func (k Keeper) removeExpiration(ctx sdk.Context, id string, key []byte) error {
+	expiresAt, err := k.getExpiration(ctx, key)
+	if err != nil {
+		return err
+	}
+	if expiresAt == nil {
+		return nil
+	}
+
+	if err := k.delayKeeper.RemoveExecuteAfter(ctx, id, *expiresAt); err != nil {
+		return sdkerrors.Wrap(err, "failed to remove delayed unfreezing")
+	}
+
+	return k.storeService.OpenKVStore(ctx).Delete(key)
+}
+
+func (k Keeper) getExpiration(ctx sdk.Context, key []byte) (*time.Time, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil // nil is returned if the expiration isn't set
+	}
+
+	expiresAt, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to parse freeze expiration: %s", err)
+	}
+
+	return &expiresAt, nil
+}
+
+func (k Keeper) setExpiration(ctx sdk.Context, key []byte, expiresAt time.Time) error {
+	return k.storeService.OpenKVStore(ctx).Set(key, sdk.FormatTimeBytes(expiresAt))
+}
+
+func validateFreezeExpiration(ctx sdk.Context, expiresAt *time.Time) error {
+	if expiresAt != nil && !expiresAt.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"freeze expiration time %s must be after the block time %s",
+			expiresAt.String(),
+			ctx.BlockTime().String(),
+		)
+	}
+
+	return nil
+}
+
+func freezeExpirationID(addr sdk.AccAddress, denom string) string {
+	return fmt.Sprintf("%s-unfreeze-%s-%s", types.ModuleName, addr, denom)
+}
+
+func globalFreezeExpirationID(denom string) string {
+	return fmt.Sprintf("%s-global-unfreeze-%s", types.ModuleName, denom)
+}
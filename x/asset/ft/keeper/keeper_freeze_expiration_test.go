@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+)
+
+func TestKeeper_FreezeExpiration(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Now().UTC().Truncate(time.Second)
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time:   blockTime,
+		Height: 1,
+	})
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_freezing,
+		},
+	})
+	requireT.NoError(err)
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coin := sdk.NewCoin(denom, sdkmath.NewInt(100))
+
+	// try to freeze with the expiration time in the past
+	err = ftKeeper.Freeze(ctx, issuer, recipient, coin, lo.ToPtr(blockTime))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to freeze with the expiration time by non-admin
+	err = ftKeeper.Freeze(ctx, recipient, recipient, coin, lo.ToPtr(blockTime.Add(time.Hour)))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// freeze with the expiration time
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient, coin, lo.ToPtr(blockTime.Add(time.Hour))))
+	expiresAt, err := ftKeeper.GetFreezeExpiration(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.NotNil(expiresAt)
+	requireT.True(blockTime.Add(time.Hour).Equal(*expiresAt))
+
+	// renew the freeze, the previous expiration is replaced
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient, coin, lo.ToPtr(blockTime.Add(2*time.Hour))))
+	expirations, err := ftKeeper.GetFreezeExpirations(ctx, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.OneInt())))
+	requireT.NoError(err)
+	requireT.Len(expirations, 1)
+	requireT.True(blockTime.Add(2 * time.Hour).Equal(expirations[0].ExpiresAt))
+
+	// the balance is still frozen after the replaced expiration time
+	ctx = runDelayedItems(t, testApp, blockTime.Add(time.Hour), 2)
+	frozenBalance, err := ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(200).String(), frozenBalance.Amount.String())
+
+	// the whole balance is unfrozen once it expires
+	ctx = runDelayedItems(t, testApp, blockTime.Add(2*time.Hour), 3)
+	frozenBalance, err = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.True(frozenBalance.IsZero())
+	expiresAt, err = ftKeeper.GetFreezeExpiration(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Nil(expiresAt)
+
+	// the freeze without the expiration time is rejected for the expiring frozen balance, the set frozen without the
+	// expiration time removes the pending unfreezing
+	blockTime = ctx.BlockTime()
+	requireT.NoError(ftKeeper.SetFrozen(ctx, issuer, recipient, coin, lo.ToPtr(blockTime.Add(time.Hour))))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, coin, nil)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	expiresAt, err = ftKeeper.GetFreezeExpiration(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.NotNil(expiresAt)
+	requireT.NoError(ftKeeper.SetFrozen(ctx, issuer, recipient, coin.Add(coin), nil))
+	expiresAt, err = ftKeeper.GetFreezeExpiration(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Nil(expiresAt)
+	ctx = runDelayedItems(t, testApp, blockTime.Add(time.Hour), 4)
+	frozenBalance, err = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(200).String(), frozenBalance.Amount.String())
+
+	// the freeze with the expiration time is rejected for the non-expiring frozen balance, so the balance frozen
+	// without the expiration time is never unfrozen by the expiration
+	blockTime = ctx.BlockTime()
+	err = ftKeeper.Freeze(ctx, issuer, recipient, coin, lo.ToPtr(blockTime.Add(time.Hour)))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	expiresAt, err = ftKeeper.GetFreezeExpiration(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Nil(expiresAt)
+	frozenBalance, err = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(200).String(), frozenBalance.Amount.String())
+
+	// the set frozen with the expiration time makes the frozen balance expire, and the renewal with the earlier
+	// expiration time shortens the hold
+	requireT.NoError(ftKeeper.SetFrozen(ctx, issuer, recipient, frozenBalance, lo.ToPtr(blockTime.Add(2*time.Hour))))
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient, coin, lo.ToPtr(blockTime.Add(time.Hour))))
+	expiresAt, err = ftKeeper.GetFreezeExpiration(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.NotNil(expiresAt)
+	requireT.True(blockTime.Add(time.Hour).Equal(*expiresAt))
+	ctx = runDelayedItems(t, testApp, blockTime.Add(time.Hour), 5)
+	frozenBalance, err = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.True(frozenBalance.IsZero())
+
+	// the global freeze is removed once it expires
+	blockTime = ctx.BlockTime()
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom, lo.ToPtr(blockTime.Add(time.Hour))))
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.True(token.GloballyFrozen)
+	requireT.NotNil(token.GlobalFreezeExpiresAt)
+	requireT.True(blockTime.Add(time.Hour).Equal(*token.GlobalFreezeExpiresAt))
+	frozenBalanceExpiresAt, err := ftKeeper.GetFrozenBalanceExpiration(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.NotNil(frozenBalanceExpiresAt)
+	requireT.True(blockTime.Add(time.Hour).Equal(*frozenBalanceExpiresAt))
+
+	ctx = runDelayedItems(t, testApp, blockTime.Add(time.Hour), 6)
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.False(token.GloballyFrozen)
+	requireT.Nil(token.GlobalFreezeExpiresAt)
+
+	// the global freezing with and without the expiration time can't be mixed
+	blockTime = ctx.BlockTime()
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom, lo.ToPtr(blockTime.Add(time.Hour))))
+	requireT.ErrorIs(ftKeeper.GloballyFreeze(ctx, issuer, denom, nil), types.ErrInvalidInput)
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.NotNil(token.GlobalFreezeExpiresAt)
+	requireT.True(blockTime.Add(time.Hour).Equal(*token.GlobalFreezeExpiresAt))
+
+	// the global unfreeze removes the pending global unfreezing
+	requireT.NoError(ftKeeper.GloballyUnfreeze(ctx, issuer, denom))
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom, nil))
+	requireT.ErrorIs(
+		ftKeeper.GloballyFreeze(ctx, issuer, denom, lo.ToPtr(blockTime.Add(time.Hour))), types.ErrInvalidInput,
+	)
+	ctx = runDelayedItems(t, testApp, blockTime.Add(time.Hour), 7)
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.True(token.GloballyFrozen)
+	requireT.Nil(token.GlobalFreezeExpiresAt)
+}
+
+func runDelayedItems(t *testing.T, testApp *simapp.App, blockTime time.Time, height int64) sdk.Context {
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time:   blockTime,
+		Height: height,
+	})
+	_, err := testApp.BeginBlocker(ctx)
+	require.NoError(t, err)
+
+	return ctx
+}
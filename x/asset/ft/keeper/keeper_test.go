@@ -575,6 +575,85 @@ func TestKeeper_Mint(t *testing.T) {
 	requireT.Equal(sdkmath.NewInt(977), totalSupply.Supply.AmountOf(mintableDenom))
 }
 
+func TestKeeper_MaxSupply(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// try to issue a token with the initial amount greater than the max supply
+	settings := types.IssueSettings{
+		Issuer:        addr,
+		Symbol:        "capped",
+		Subunit:       "capped",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1001),
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+		MaxSupply: lo.ToPtr(sdkmath.NewInt(1000)),
+	}
+	_, err := ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	settings.InitialAmount = sdkmath.NewInt(700)
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(1000).String(), token.MaxSupply.String())
+
+	// mint up to the max supply
+	requireT.NoError(ftKeeper.Mint(ctx, addr, addr, sdk.NewCoin(denom, sdkmath.NewInt(200))))
+	err = ftKeeper.Mint(ctx, addr, addr, sdk.NewCoin(denom, sdkmath.NewInt(101)))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+	requireT.NoError(ftKeeper.Mint(ctx, addr, addr, sdk.NewCoin(denom, sdkmath.NewInt(100))))
+	requireT.Equal(sdkmath.NewInt(1000).String(), bankKeeper.GetSupply(ctx, denom).Amount.String())
+
+	// burn to have some space below the cap
+	requireT.NoError(ftKeeper.Burn(ctx, addr, sdk.NewCoin(denom, sdkmath.NewInt(300))))
+
+	// try to update the max supply as non-admin
+	err = ftKeeper.UpdateMaxSupply(ctx, randomAddr, denom, sdkmath.NewInt(900))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// try to raise the max supply
+	err = ftKeeper.UpdateMaxSupply(ctx, addr, denom, sdkmath.NewInt(1001))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to lower the max supply below the current supply
+	err = ftKeeper.UpdateMaxSupply(ctx, addr, denom, sdkmath.NewInt(699))
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// lower the max supply
+	requireT.NoError(ftKeeper.UpdateMaxSupply(ctx, addr, denom, sdkmath.NewInt(800)))
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(800).String(), token.MaxSupply.String())
+
+	err = ftKeeper.Mint(ctx, addr, addr, sdk.NewCoin(denom, sdkmath.NewInt(101)))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+	requireT.NoError(ftKeeper.Mint(ctx, addr, addr, sdk.NewCoin(denom, sdkmath.NewInt(100))))
+
+	// the token without the max supply can be capped later
+	settings.Symbol = "uncapped"
+	settings.Subunit = "uncapped"
+	settings.MaxSupply = nil
+	uncappedDenom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.Mint(ctx, addr, addr, sdk.NewCoin(uncappedDenom, sdkmath.NewInt(500))))
+	requireT.NoError(ftKeeper.UpdateMaxSupply(ctx, addr, uncappedDenom, sdkmath.NewInt(1200)))
+	err = ftKeeper.Mint(ctx, addr, addr, sdk.NewCoin(uncappedDenom, sdkmath.OneInt()))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+}
+
 func TestKeeper_Burn(t *testing.T) {
 	requireT := require.New(t)
 
@@ -652,11 +731,11 @@ func TestKeeper_Burn(t *testing.T) {
 	requireT.Equal(sdkmath.NewInt(577), totalSupply.Supply.AmountOf(burnableDenom))
 
 	// try to freeze the issuer (issuer can't be frozen)
-	err = ftKeeper.Freeze(ctx, issuer, issuer, sdk.NewCoin(burnableDenom, sdkmath.NewInt(600)))
+	err = ftKeeper.Freeze(ctx, issuer, issuer, sdk.NewCoin(burnableDenom, sdkmath.NewInt(600)), nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to burn non-issuer frozen coins
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(burnableDenom, sdkmath.NewInt(100)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(burnableDenom, sdkmath.NewInt(100)), nil)
 	requireT.NoError(err)
 	err = ftKeeper.Burn(ctx, recipient, sdk.NewCoin(burnableDenom, sdkmath.NewInt(100)))
 	requireT.ErrorIs(err, cosmoserrors.ErrInsufficientFunds)
@@ -1118,28 +1197,28 @@ func TestKeeper_FreezeUnfreeze(t *testing.T) {
 
 	// try to freeze non-existent denom
 	nonExistentDenom := types.BuildDenom("nonexist", issuer)
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(nonExistentDenom, sdkmath.NewInt(10)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(nonExistentDenom, sdkmath.NewInt(10)), nil)
 	assertT.True(sdkerrors.IsOf(err, types.ErrTokenNotFound))
 
 	// try to freeze unfreezable Token
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(unfreezableDenom, sdkmath.NewInt(10)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(unfreezableDenom, sdkmath.NewInt(10)), nil)
 	requireT.ErrorIs(err, types.ErrFeatureDisabled)
 
 	// try to freeze from non issuer address
 	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = ftKeeper.Freeze(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	err = ftKeeper.Freeze(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdkmath.NewInt(10)), nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to freeze 0 balance
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(0)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(0)), nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrInvalidCoins)
 
 	// try to unfreeze 0 balance
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(0)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(0)), nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrInvalidCoins)
 
 	// try to freeze more than balance
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(110)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(110)), nil)
 	requireT.NoError(err)
 	frozenBalance, err := ftKeeper.GetFrozenBalance(ctx, recipient, denom)
 	requireT.NoError(err)
@@ -1160,7 +1239,7 @@ func TestKeeper_FreezeUnfreeze(t *testing.T) {
 	assertT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(0)).String(), frozenBalance.String())
 
 	// freeze, query frozen
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(40)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(40)), nil)
 	requireT.NoError(err)
 	frozenBalance, err = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
 	requireT.NoError(err)
@@ -1175,7 +1254,7 @@ func TestKeeper_FreezeUnfreeze(t *testing.T) {
 	requireT.Equal(sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(40))).String(), allBalances[0].Coins.String())
 
 	// increase frozen and query
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(40)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(40)), nil)
 	requireT.NoError(err)
 	frozenBalance, err = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
 	requireT.NoError(err)
@@ -1217,7 +1296,7 @@ func TestKeeper_FreezeUnfreeze(t *testing.T) {
 	assertT.True(sdkerrors.IsOf(err, cosmoserrors.ErrUnauthorized))
 
 	// set absolute frozen amount
-	err = ftKeeper.SetFrozen(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(100)))
+	err = ftKeeper.SetFrozen(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(100)), nil)
 	requireT.NoError(err)
 	frozenBalance, err = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
 	requireT.NoError(err)
@@ -1297,22 +1376,22 @@ func TestKeeper_GlobalFreezeUnfreeze(t *testing.T) {
 
 	// try to global-freeze non-existent
 	nonExistentDenom := types.BuildDenom("nonexist", issuer)
-	err = ftKeeper.GloballyFreeze(ctx, issuer, nonExistentDenom)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, nonExistentDenom, nil)
 	assertT.True(sdkerrors.IsOf(err, types.ErrTokenNotFound))
 
 	// try to global-freeze unfreezable Token
-	err = ftKeeper.GloballyFreeze(ctx, issuer, unfreezableDenom)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, unfreezableDenom, nil)
 	requireT.ErrorIs(err, types.ErrFeatureDisabled)
 
 	// try to global-freeze from non issuer address
 	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = ftKeeper.GloballyFreeze(ctx, randomAddr, freezableDenom)
+	err = ftKeeper.GloballyFreeze(ctx, randomAddr, freezableDenom, nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// freeze twice to check global-freeze idempotence
-	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom, nil)
 	requireT.NoError(err)
-	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom, nil)
 	requireT.NoError(err)
 	frozenToken, err := ftKeeper.GetToken(ctx, freezableDenom)
 	requireT.NoError(err)
@@ -1332,7 +1411,7 @@ func TestKeeper_GlobalFreezeUnfreeze(t *testing.T) {
 	assertT.False(unfrozenToken.GloballyFrozen)
 
 	// freeze, try to send & verify balance
-	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom, nil)
 	requireT.NoError(err)
 	coinsToSend := sdk.NewCoins(sdk.NewCoin(freezableDenom, sdkmath.NewInt(10)))
 	// send
@@ -1464,7 +1543,7 @@ func TestKeeper_Clawback(t *testing.T) {
 	requireT.Equal(accountBalanceBefore.Sub(sdk.NewCoin(denom, sdkmath.NewInt(40))), accountBalanceAfter)
 
 	// clawback frozen token, query balance
-	err = ftKeeper.Freeze(ctx, issuer, from, sdk.NewCoin(denom, sdkmath.NewInt(60)))
+	err = ftKeeper.Freeze(ctx, issuer, from, sdk.NewCoin(denom, sdkmath.NewInt(60)), nil)
 	requireT.NoError(err)
 	err = ftKeeper.Clawback(ctx, issuer, from, sdk.NewCoin(denom, sdkmath.NewInt(60)))
 	requireT.NoError(err)
@@ -1640,7 +1719,7 @@ func TestKeeper_FreezeWhitelistMultiSend(t *testing.T) {
 	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
 	// freeze denom1 partially on the recipient1
-	err = ftKeeper.Freeze(ctx, issuer1, recipient1, sdk.NewCoin(denom1, sdkmath.NewInt(10)))
+	err = ftKeeper.Freeze(ctx, issuer1, recipient1, sdk.NewCoin(denom1, sdkmath.NewInt(10)), nil)
 	requireT.NoError(err)
 
 	// whitelist denom2 partially on the recipient2
@@ -1810,7 +1889,7 @@ func TestKeeper_AllInOne(t *testing.T) {
 	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
 	// freeze denom1 partially on the recipient1
-	err = ftKeeper.Freeze(ctx, issuer, recipient1, sdk.NewCoin(denom1, sdkmath.NewInt(10)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient1, sdk.NewCoin(denom1, sdkmath.NewInt(10)), nil)
 	requireT.NoError(err)
 
 	// whitelist recipients
@@ -2153,15 +2232,15 @@ func TestKeeper_TransferAdmin_Burn(t *testing.T) {
 	requireT.Equal(sdkmath.NewInt(677), totalSupply.Supply.AmountOf(burnableDenom))
 
 	// try to freeze the original issuer
-	err = ftKeeper.Freeze(ctx, originalIssuer, admin, sdk.NewCoin(burnableDenom, sdkmath.NewInt(600)))
+	err = ftKeeper.Freeze(ctx, originalIssuer, admin, sdk.NewCoin(burnableDenom, sdkmath.NewInt(600)), nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to freeze the admin (admin can't be frozen)
-	err = ftKeeper.Freeze(ctx, admin, admin, sdk.NewCoin(burnableDenom, sdkmath.NewInt(600)))
+	err = ftKeeper.Freeze(ctx, admin, admin, sdk.NewCoin(burnableDenom, sdkmath.NewInt(600)), nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to burn non-admin frozen coins
-	err = ftKeeper.Freeze(ctx, admin, recipient, sdk.NewCoin(burnableDenom, sdkmath.NewInt(100)))
+	err = ftKeeper.Freeze(ctx, admin, recipient, sdk.NewCoin(burnableDenom, sdkmath.NewInt(100)), nil)
 	requireT.NoError(err)
 	err = ftKeeper.Burn(ctx, recipient, sdk.NewCoin(burnableDenom, sdkmath.NewInt(100)))
 	requireT.ErrorIs(err, cosmoserrors.ErrInsufficientFunds)
@@ -2201,11 +2280,11 @@ func TestKeeper_TransferAdmin_FreezeUnfreeze(t *testing.T) {
 	requireT.NoError(err)
 
 	// try to freeze from issuer address which is non admin anymore
-	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(10)), nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// freeze, query frozen
-	err = ftKeeper.Freeze(ctx, admin, recipient, sdk.NewCoin(denom, sdkmath.NewInt(40)))
+	err = ftKeeper.Freeze(ctx, admin, recipient, sdk.NewCoin(denom, sdkmath.NewInt(40)), nil)
 	requireT.NoError(err)
 	frozenBalance, err := ftKeeper.GetFrozenBalance(ctx, recipient, denom)
 	requireT.NoError(err)
@@ -2224,7 +2303,7 @@ func TestKeeper_TransferAdmin_FreezeUnfreeze(t *testing.T) {
 	assertT.True(sdkerrors.IsOf(err, cosmoserrors.ErrUnauthorized))
 
 	// set absolute frozen amount
-	err = ftKeeper.SetFrozen(ctx, admin, recipient, sdk.NewCoin(denom, sdkmath.NewInt(100)))
+	err = ftKeeper.SetFrozen(ctx, admin, recipient, sdk.NewCoin(denom, sdkmath.NewInt(100)), nil)
 	requireT.NoError(err)
 	frozenBalance, err = ftKeeper.GetFrozenBalance(ctx, recipient, denom)
 	requireT.NoError(err)
@@ -2311,26 +2390,26 @@ func TestKeeper_TransferAdmin_GlobalFreezeUnfreeze(t *testing.T) {
 
 	// try to global-freeze non-existent
 	nonExistentDenom := types.BuildDenom("nonexist", admin)
-	err = ftKeeper.GloballyFreeze(ctx, admin, nonExistentDenom)
+	err = ftKeeper.GloballyFreeze(ctx, admin, nonExistentDenom, nil)
 	assertT.True(sdkerrors.IsOf(err, types.ErrTokenNotFound))
 
 	// try to global-freeze unfreezable Token
-	err = ftKeeper.GloballyFreeze(ctx, admin, unfreezableDenom)
+	err = ftKeeper.GloballyFreeze(ctx, admin, unfreezableDenom, nil)
 	requireT.ErrorIs(err, types.ErrFeatureDisabled)
 
 	// try to global-freeze from original issuer address which is not admin anymore
-	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, freezableDenom, nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// try to global-freeze from non admin address
 	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
-	err = ftKeeper.GloballyFreeze(ctx, randomAddr, freezableDenom)
+	err = ftKeeper.GloballyFreeze(ctx, randomAddr, freezableDenom, nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// freeze twice to check global-freeze idempotence
-	err = ftKeeper.GloballyFreeze(ctx, admin, freezableDenom)
+	err = ftKeeper.GloballyFreeze(ctx, admin, freezableDenom, nil)
 	requireT.NoError(err)
-	err = ftKeeper.GloballyFreeze(ctx, admin, freezableDenom)
+	err = ftKeeper.GloballyFreeze(ctx, admin, freezableDenom, nil)
 	requireT.NoError(err)
 	frozenToken, err := ftKeeper.GetToken(ctx, freezableDenom)
 	requireT.NoError(err)
@@ -2354,7 +2433,7 @@ func TestKeeper_TransferAdmin_GlobalFreezeUnfreeze(t *testing.T) {
 	assertT.False(unfrozenToken.GloballyFrozen)
 
 	// freeze, try to send & verify balance
-	err = ftKeeper.GloballyFreeze(ctx, admin, freezableDenom)
+	err = ftKeeper.GloballyFreeze(ctx, admin, freezableDenom, nil)
 	requireT.NoError(err)
 	coinsToSend := sdk.NewCoins(sdk.NewCoin(freezableDenom, sdkmath.NewInt(10)))
 	// send
@@ -2497,7 +2576,7 @@ func TestKeeper_TransferAdmin_Clawback(t *testing.T) {
 	requireT.Equal(accountBalanceBefore.Sub(sdk.NewCoin(denom, sdkmath.NewInt(40))), accountBalanceAfter)
 
 	// clawback frozen token, query balance
-	err = ftKeeper.Freeze(ctx, admin, account, sdk.NewCoin(denom, sdkmath.NewInt(60)))
+	err = ftKeeper.Freeze(ctx, admin, account, sdk.NewCoin(denom, sdkmath.NewInt(60)), nil)
 	requireT.NoError(err)
 	err = ftKeeper.Clawback(ctx, admin, account, sdk.NewCoin(denom, sdkmath.NewInt(60)))
 	requireT.NoError(err)
@@ -2788,11 +2867,11 @@ func TestKeeper_TransferAdmin_AllInOne(t *testing.T) {
 	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 
 	// freeze denom1 partially on the recipient1 as original issuer which is not admin anymore
-	err = ftKeeper.Freeze(ctx, issuer, recipient1, sdk.NewCoin(denom1, sdkmath.NewInt(10)))
+	err = ftKeeper.Freeze(ctx, issuer, recipient1, sdk.NewCoin(denom1, sdkmath.NewInt(10)), nil)
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 
 	// freeze denom1 partially on the recipient1
-	err = ftKeeper.Freeze(ctx, admin, recipient1, sdk.NewCoin(denom1, sdkmath.NewInt(10)))
+	err = ftKeeper.Freeze(ctx, admin, recipient1, sdk.NewCoin(denom1, sdkmath.NewInt(10)), nil)
 	requireT.NoError(err)
 
 	// whitelist recipients as original issuer which is not admin anymore
@@ -2884,3 +2963,116 @@ func TestKeeper_ClearAdmin(t *testing.T) {
 	err = bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))))
 	requireT.NoError(err)
 }
+
+func TestKeeper_Roles(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{})
+
+	bankKeeper := testApp.BankKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	minter := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	freezer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	clawbackManager := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	whitelistManager := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	dexSettingsManager := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        admin,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdkmath.NewInt(666),
+		Features: []types.Feature{
+			types.Feature_minting,
+			types.Feature_freezing,
+			types.Feature_clawback,
+			types.Feature_whitelisting,
+			types.Feature_dex_unified_ref_amount_change,
+		},
+	}
+
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	roleHolders := []types.RoleHolder{
+		{Role: types.Role_minter, Address: minter.String()},
+		{Role: types.Role_freezer, Address: freezer.String()},
+		{Role: types.Role_clawback_manager, Address: clawbackManager.String()},
+		{Role: types.Role_whitelist_manager, Address: whitelistManager.String()},
+		{Role: types.Role_dex_settings_manager, Address: dexSettingsManager.String()},
+	}
+
+	// try to grant the role from non admin address
+	requireT.ErrorIs(
+		ftKeeper.GrantRole(ctx, minter, minter, denom, types.Role_minter),
+		cosmoserrors.ErrUnauthorized,
+	)
+
+	for _, holder := range roleHolders {
+		holderAddr := sdk.MustAccAddressFromBech32(holder.Address)
+		requireT.NoError(ftKeeper.GrantRole(ctx, admin, holderAddr, denom, holder.Role))
+	}
+	// try to grant the held role
+	requireT.ErrorIs(ftKeeper.GrantRole(ctx, admin, minter, denom, types.Role_minter), types.ErrInvalidInput)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(roleHolders, token.RoleHolders)
+
+	// the role holders can use only the features of their roles
+	coin := sdk.NewCoin(denom, sdkmath.NewInt(100))
+	requireT.ErrorIs(ftKeeper.SetWhitelistedBalance(ctx, minter, recipient, coin), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, whitelistManager, recipient, coin))
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, whitelistManager, clawbackManager, coin))
+
+	requireT.ErrorIs(ftKeeper.Mint(ctx, freezer, recipient, coin), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(ftKeeper.Mint(ctx, minter, recipient, coin))
+	requireT.Equal(coin.String(), bankKeeper.GetBalance(ctx, recipient, denom).String())
+
+	clawbackCoin := sdk.NewCoin(denom, sdkmath.NewInt(10))
+	requireT.ErrorIs(ftKeeper.Clawback(ctx, minter, recipient, clawbackCoin), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(ftKeeper.Clawback(ctx, clawbackManager, recipient, clawbackCoin))
+	requireT.Equal(clawbackCoin.String(), bankKeeper.GetBalance(ctx, clawbackManager, denom).String())
+
+	frozenCoin := sdk.NewCoin(denom, sdkmath.NewInt(20))
+	requireT.ErrorIs(ftKeeper.Freeze(ctx, minter, recipient, frozenCoin, nil), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(ftKeeper.Freeze(ctx, freezer, recipient, frozenCoin, nil))
+	frozenBalance, err := ftKeeper.GetFrozenBalance(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Equal(frozenCoin.String(), frozenBalance.String())
+
+	unifiedRefAmount := sdkmath.LegacyMustNewDecFromStr("1000")
+	requireT.ErrorIs(
+		ftKeeper.UpdateDEXUnifiedRefAmount(ctx, minter, denom, unifiedRefAmount),
+		cosmoserrors.ErrUnauthorized,
+	)
+	requireT.NoError(ftKeeper.UpdateDEXUnifiedRefAmount(ctx, dexSettingsManager, denom, unifiedRefAmount))
+
+	// revoke the role
+	requireT.ErrorIs(ftKeeper.RevokeRole(ctx, minter, minter, denom, types.Role_minter), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(ftKeeper.RevokeRole(ctx, admin, minter, denom, types.Role_minter))
+	requireT.ErrorIs(ftKeeper.RevokeRole(ctx, admin, minter, denom, types.Role_minter), types.ErrInvalidInput)
+	requireT.ErrorIs(ftKeeper.Mint(ctx, minter, recipient, coin), cosmoserrors.ErrUnauthorized)
+
+	// the roles are revoked when the administration is transferred
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, admin, recipient, denom))
+	def, err := ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	requireT.Empty(def.RoleHolders)
+	requireT.ErrorIs(ftKeeper.Freeze(ctx, freezer, recipient, frozenCoin, nil), cosmoserrors.ErrUnauthorized)
+	requireT.ErrorIs(ftKeeper.GrantRole(ctx, admin, freezer, denom, types.Role_freezer), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(ftKeeper.GrantRole(ctx, recipient, freezer, denom, types.Role_freezer))
+
+	// the roles are revoked together with the administration
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, recipient, denom))
+	def, err = ftKeeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	requireT.Empty(def.RoleHolders)
+	requireT.ErrorIs(ftKeeper.Freeze(ctx, freezer, recipient, frozenCoin, nil), cosmoserrors.ErrUnauthorized)
+}
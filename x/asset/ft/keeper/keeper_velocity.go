@@ -0,0 +1,400 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	wibctransfertypes "github.com/CoreumFoundation/coreum/v6/x/wibctransfer/types"
+)
+
+// velocityWindowBuckets is the number of buckets the velocity window is split into.
+const velocityWindowBuckets = 24
+
+// SetVelocityLimitOverride overrides the velocity limit amount of the token for the account,
+// zero amount removes the override.
+func (k Keeper) SetVelocityLimitOverride(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error {
+	if coin.IsNil() || coin.IsNegative() {
+		return sdkerrors.Wrap(
+			cosmoserrors.ErrInvalidCoins, "velocity limit override amount should be greater than or equal to 0",
+		)
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	if err = def.CheckFeatureAllowed(sender, types.Feature_velocity_limiting); err != nil {
+		return err
+	}
+
+	overridesStore := k.velocityLimitOverridesAccountStore(ctx, addr)
+	previousOverride := overridesStore.Balance(coin.Denom)
+	overridesStore.SetBalance(coin)
+
+	if err = ctx.EventManager().EmitTypedEvent(&types.EventVelocityLimitOverrideChanged{
+		Account:        addr.String(),
+		Denom:          coin.Denom,
+		PreviousAmount: previousOverride.Amount,
+		CurrentAmount:  coin.Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventVelocityLimitOverrideChanged event: %s", err)
+	}
+
+	return nil
+}
+
+// GetVelocityLimitOverride returns the velocity limit override of a denom and account.
+func (k Keeper) GetVelocityLimitOverride(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return k.velocityLimitOverridesAccountStore(ctx, addr).Balance(denom)
+}
+
+// GetAccountsVelocityLimitOverrides returns the velocity limit overrides of all the accounts.
+func (k Keeper) GetAccountsVelocityLimitOverrides(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.Balance, *query.PageResponse, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	return collectBalances(
+		k.cdc, prefix.NewStore(runtime.KVStoreAdapter(store), types.VelocityLimitOverridesKeyPrefix), pagination)
+}
+
+// SetVelocityLimitOverrides sets the velocity limit overrides of a specified account.
+func (k Keeper) SetVelocityLimitOverrides(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) {
+	overridesStore := k.velocityLimitOverridesAccountStore(ctx, addr)
+	for _, coin := range coins {
+		overridesStore.SetBalance(coin)
+	}
+}
+
+// GetVelocityAllowance returns the amount of the denom the account is allowed to send taking into account
+// the outflow accumulated within the trailing window.
+func (k Keeper) GetVelocityAllowance(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsFeatureEnabled(types.Feature_velocity_limiting) || def.VelocityLimit == nil {
+		return sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrFeatureDisabled, "feature %s is disabled", types.Feature_velocity_limiting.String(),
+		)
+	}
+
+	usage, err := k.getCurrentVelocityUsage(ctx, addr, def)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	allowance := k.getVelocityLimitAmount(ctx, addr, def).Sub(velocityUsageAmount(usage))
+	// the allowance might be negative if the override was lowered within the window
+	if allowance.IsNegative() {
+		allowance = sdkmath.ZeroInt()
+	}
+
+	return sdk.NewCoin(denom, allowance), nil
+}
+
+// ImportVelocityUsages imports the velocity usages.
+func (k Keeper) ImportVelocityUsages(ctx sdk.Context, usages []types.AccountVelocityUsage) error {
+	for _, usage := range usages {
+		addr, err := sdk.AccAddressFromBech32(usage.Account)
+		if err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account %s", usage.Account)
+		}
+		if err := k.setVelocityUsage(ctx, addr, usage.Denom, usage.Usage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExportVelocityUsages exports the velocity usages.
+func (k Keeper) ExportVelocityUsages(ctx sdk.Context) ([]types.AccountVelocityUsage, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.VelocityUsagesKeyPrefix)
+	usages := make([]types.AccountVelocityUsage, 0)
+	_, err := query.Paginate(store, &query.PageRequest{Limit: query.PaginationMaxLimit}, func(key, value []byte) error {
+		addr, err := types.AddressFromBalancesStore(key)
+		if err != nil {
+			return err
+		}
+		var usage types.VelocityUsage
+		k.cdc.MustUnmarshal(value, &usage)
+
+		usages = append(usages, types.AccountVelocityUsage{
+			Account: addr.String(),
+			Denom:   string(key[len(addr)+1:]),
+			Usage:   usage,
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return usages, nil
+}
+
+// consumeVelocityAllowance adds the amount to the outflow of the account accumulated within the current bucket
+// of the window and returns an error if the velocity limit is exceeded.
+func (k Keeper) consumeVelocityAllowance(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	def types.Definition,
+	amount sdkmath.Int,
+) error {
+	// the incoming IBC transfers are sent from the escrow address, which isn't the account the limit is defined for
+	if !k.isVelocityLimited(ctx, addr, def) || wibctransfertypes.IsPurposeIn(ctx) {
+		return nil
+	}
+
+	usage, err := k.getCurrentVelocityUsage(ctx, addr, def)
+	if err != nil {
+		return err
+	}
+
+	limit := k.getVelocityLimitAmount(ctx, addr, def)
+	usedAmount := velocityUsageAmount(usage)
+	if usedAmount.Add(amount).GT(limit) {
+		available := limit.Sub(usedAmount)
+		if available.IsNegative() {
+			available = sdkmath.ZeroInt()
+		}
+		return sdkerrors.Wrapf(
+			types.ErrVelocityLimitExceeded,
+			"%s%s exceeds the velocity limit, available %s%s",
+			amount.String(), def.Denom, available.String(), def.Denom,
+		)
+	}
+
+	bucket := currentVelocityBucket(ctx, def, &usage)
+	bucket.Amount = bucket.Amount.Add(amount)
+	return k.setVelocityUsage(ctx, addr, def.Denom, usage)
+}
+
+// lockDEXVelocityAllowance marks the amount of the outflow accumulated within the current bucket as locked by the
+// DEX orders, so it can be restored once the orders are cancelled.
+func (k Keeper) lockDEXVelocityAllowance(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	def types.Definition,
+	amount sdkmath.Int,
+) error {
+	if !k.isVelocityLimited(ctx, addr, def) {
+		return nil
+	}
+
+	usage, err := k.getCurrentVelocityUsage(ctx, addr, def)
+	if err != nil {
+		return err
+	}
+
+	bucket := currentVelocityBucket(ctx, def, &usage)
+	// the locked amount can't exceed the outflow accumulated within the bucket
+	bucket.DexLocked = sdkmath.MinInt(velocityBucketDEXLocked(*bucket).Add(amount), bucket.Amount)
+	return k.setVelocityUsage(ctx, addr, def.Denom, usage)
+}
+
+// unlockDEXVelocityAllowance unmarks the amount locked by the executed DEX orders, so it isn't restored.
+// The executed amount is attributed to the orders placed within the window first, so the amount isn't restored by
+// the orders placed before the window.
+func (k Keeper) unlockDEXVelocityAllowance(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	def types.Definition,
+	amount sdkmath.Int,
+) error {
+	if !k.isVelocityLimited(ctx, addr, def) {
+		return nil
+	}
+
+	usage, err := k.getCurrentVelocityUsage(ctx, addr, def)
+	if err != nil {
+		return err
+	}
+	if !releaseVelocityDEXLocked(&usage, amount, false) {
+		return nil
+	}
+
+	return k.setVelocityUsage(ctx, addr, def.Denom, usage)
+}
+
+// restoreDEXVelocityAllowance subtracts the amount released by the cancelled DEX orders from the outflow of the
+// account. The released amount is attributed to the orders placed before the window first, since the allowance
+// consumed by them is already released, so only the amount exceeding the locked balance of such orders is restored.
+// Must be called before the DEX locked balance is decreased.
+func (k Keeper) restoreDEXVelocityAllowance(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	def types.Definition,
+	amount sdkmath.Int,
+) error {
+	if !k.isVelocityLimited(ctx, addr, def) {
+		return nil
+	}
+
+	usage, err := k.getCurrentVelocityUsage(ctx, addr, def)
+	if err != nil {
+		return err
+	}
+
+	lockedBeforeWindow := sdkmath.MaxInt(
+		k.GetDEXLockedBalance(ctx, addr, def.Denom).Amount.Sub(velocityUsageDEXLocked(usage)), sdkmath.ZeroInt(),
+	)
+	if !releaseVelocityDEXLocked(&usage, amount.Sub(lockedBeforeWindow), true) {
+		return nil
+	}
+
+	return k.setVelocityUsage(ctx, addr, def.Denom, usage)
+}
+
+// getCurrentVelocityUsage returns the usage of the trailing window, the buckets released by the time of the block
+// are removed.
+func (k Keeper) getCurrentVelocityUsage(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	def types.Definition,
+) (types.VelocityUsage, error) {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(types.CreateVelocityUsageKey(addr, def.Denom))
+	if err != nil {
+		return types.VelocityUsage{}, err
+	}
+	if bz == nil {
+		return types.VelocityUsage{}, nil
+	}
+
+	var usage types.VelocityUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+
+	// the outflow of the bucket is released once the whole window passes after the end of the bucket, so the
+	// outflow within any window never exceeds the limit
+	releasedAt := ctx.BlockTime().Add(-def.VelocityLimit.Window - velocityBucketDuration(*def.VelocityLimit))
+	firstIndex := 0
+	for firstIndex < len(usage.Buckets) && !usage.Buckets[firstIndex].Start.After(releasedAt) {
+		firstIndex++
+	}
+	usage.Buckets = usage.Buckets[firstIndex:]
+
+	return usage, nil
+}
+
+// isVelocityLimited returns true if the outflow of the account is limited by the velocity limit of the token.
+// The admin and the module accounts, paying out the funds they hold on behalf of the others, aren't limited.
+func (k Keeper) isVelocityLimited(ctx sdk.Context, addr sdk.AccAddress, def types.Definition) bool {
+	if !def.IsFeatureEnabled(types.Feature_velocity_limiting) || def.VelocityLimit == nil {
+		return false
+	}
+	if def.HasAdminPrivileges(addr) {
+		return false
+	}
+	_, isModuleAccount := k.accountKeeper.GetAccount(ctx, addr).(*authtypes.ModuleAccount)
+
+	return !isModuleAccount
+}
+
+func (k Keeper) setVelocityUsage(ctx sdk.Context, addr sdk.AccAddress, denom string, usage types.VelocityUsage) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.CreateVelocityUsageKey(addr, denom), k.cdc.MustMarshal(&usage))
+}
+
+// getVelocityLimitAmount returns the override of the account if it's set, or the token velocity limit amount.
+func (k Keeper) getVelocityLimitAmount(ctx sdk.Context, addr sdk.AccAddress, def types.Definition) sdkmath.Int {
+	override := k.GetVelocityLimitOverride(ctx, addr, def.Denom)
+	if override.IsPositive() {
+		return override.Amount
+	}
+
+	return def.VelocityLimit.Amount
+}
+
+// velocityLimitOverridesAccountStore gets the store for the velocity limit overrides of an account.
+func (k Keeper) velocityLimitOverridesAccountStore(ctx sdk.Context, addr sdk.AccAddress) balanceStore {
+	store := k.storeService.OpenKVStore(ctx)
+	return newBalanceStore(k.cdc, runtime.KVStoreAdapter(store), types.CreateVelocityLimitOverridesKey(addr))
+}
+
+// velocityBucketDuration returns the duration of the bucket the outflow is accumulated within.
+func velocityBucketDuration(limit types.VelocityLimit) time.Duration {
+	return max(limit.Window/velocityWindowBuckets, 1)
+}
+
+// velocityUsageAmount returns the outflow accumulated within all the buckets of the usage.
+func velocityUsageAmount(usage types.VelocityUsage) sdkmath.Int {
+	amount := sdkmath.ZeroInt()
+	for _, bucket := range usage.Buckets {
+		amount = amount.Add(bucket.Amount)
+	}
+
+	return amount
+}
+
+// velocityUsageDEXLocked returns the outflow locked by the DEX orders within all the buckets of the usage.
+func velocityUsageDEXLocked(usage types.VelocityUsage) sdkmath.Int {
+	amount := sdkmath.ZeroInt()
+	for _, bucket := range usage.Buckets {
+		amount = amount.Add(velocityBucketDEXLocked(bucket))
+	}
+
+	return amount
+}
+
+// velocityBucketDEXLocked returns the outflow locked by the DEX orders within the bucket.
+func velocityBucketDEXLocked(bucket types.VelocityUsageBucket) sdkmath.Int {
+	if bucket.DexLocked.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+
+	return bucket.DexLocked
+}
+
+// currentVelocityBucket returns the bucket of the block time, the bucket is appended if it doesn't exist.
+func currentVelocityBucket(
+	ctx sdk.Context,
+	def types.Definition,
+	usage *types.VelocityUsage,
+) *types.VelocityUsageBucket {
+	bucketStart := ctx.BlockTime().Truncate(velocityBucketDuration(*def.VelocityLimit))
+	lastIndex := len(usage.Buckets) - 1
+	if lastIndex < 0 || !usage.Buckets[lastIndex].Start.Equal(bucketStart) {
+		usage.Buckets = append(usage.Buckets, types.VelocityUsageBucket{
+			Start:     bucketStart,
+			Amount:    sdkmath.ZeroInt(),
+			DexLocked: sdkmath.ZeroInt(),
+		})
+		lastIndex++
+	}
+
+	return &usage.Buckets[lastIndex]
+}
+
+// releaseVelocityDEXLocked releases up to the amount locked by the DEX orders starting from the latest bucket, the
+// released amount is subtracted from the outflow too if restore is true. Returns false if nothing is released.
+func releaseVelocityDEXLocked(usage *types.VelocityUsage, amount sdkmath.Int, restore bool) bool {
+	released := false
+	for i := len(usage.Buckets) - 1; i >= 0 && amount.IsPositive(); i-- {
+		bucket := &usage.Buckets[i]
+		releasedAmount := sdkmath.MinInt(velocityBucketDEXLocked(*bucket), amount)
+		if !releasedAmount.IsPositive() {
+			continue
+		}
+		bucket.DexLocked = velocityBucketDEXLocked(*bucket).Sub(releasedAmount)
+		if restore {
+			bucket.Amount = bucket.Amount.Sub(releasedAmount)
+		}
+		amount = amount.Sub(releasedAmount)
+		released = true
+	}
+
+	return released
+}
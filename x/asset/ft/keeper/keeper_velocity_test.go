@@ -0,0 +1,248 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	wibctransfertypes "github.com/CoreumFoundation/coreum/v6/x/wibctransfer/types"
+)
+
+func TestKeeper_VelocityLimit(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_velocity_limiting,
+		},
+	}
+
+	// try to issue the token without the velocity limit
+	_, err := ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	window := 24 * time.Hour
+	settings.VelocityLimit = &types.VelocityLimit{
+		Window: window,
+		Amount: sdkmath.NewInt(100),
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(window, token.VelocityLimit.Window)
+	requireT.Equal(sdkmath.NewInt(100).String(), token.VelocityLimit.Amount.String())
+
+	// the admin isn't limited
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, from, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(500)))))
+
+	// send within the limit
+	requireT.NoError(bankKeeper.SendCoins(ctx, from, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(60)))))
+	allowance, err := ftKeeper.GetVelocityAllowance(ctx, from, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(40).String(), allowance.Amount.String())
+
+	// try to exceed the limit
+	err = bankKeeper.SendCoins(ctx, from, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(41))))
+	requireT.ErrorIs(err, types.ErrVelocityLimitExceeded)
+
+	// try to override the limit by non-admin
+	err = ftKeeper.SetVelocityLimitOverride(ctx, from, from, sdk.NewCoin(denom, sdkmath.NewInt(200)))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// override the limit
+	requireT.NoError(ftKeeper.SetVelocityLimitOverride(ctx, issuer, from, sdk.NewCoin(denom, sdkmath.NewInt(200))))
+	allowance, err = ftKeeper.GetVelocityAllowance(ctx, from, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(140).String(), allowance.Amount.String())
+	requireT.NoError(bankKeeper.SendCoins(ctx, from, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(140)))))
+	err = bankKeeper.SendCoins(ctx, from, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(1))))
+	requireT.ErrorIs(err, types.ErrVelocityLimitExceeded)
+
+	// the recipient has its own allowance
+	allowance, err = ftKeeper.GetVelocityAllowance(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(100).String(), allowance.Amount.String())
+
+	// remove the override, the allowance can't be negative
+	requireT.NoError(ftKeeper.SetVelocityLimitOverride(ctx, issuer, from, sdk.NewCoin(denom, sdkmath.ZeroInt())))
+	allowance, err = ftKeeper.GetVelocityAllowance(ctx, from, denom)
+	requireT.NoError(err)
+	requireT.True(allowance.Amount.IsZero())
+
+	// the outflow is released once the whole window passes after the end of its bucket
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(window + window/24))
+	allowance, err = ftKeeper.GetVelocityAllowance(ctx, from, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(100).String(), allowance.Amount.String())
+	requireT.NoError(bankKeeper.SendCoins(ctx, from, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))))
+
+	// the velocity allowance isn't available for the tokens without the feature
+	settings.Symbol = "GHI"
+	settings.Subunit = "ghi"
+	settings.Features = nil
+	settings.VelocityLimit = nil
+	unlimitedDenom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	_, err = ftKeeper.GetVelocityAllowance(ctx, from, unlimitedDenom)
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+	err = ftKeeper.SetVelocityLimitOverride(ctx, issuer, from, sdk.NewCoin(unlimitedDenom, sdkmath.NewInt(200)))
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+}
+
+func TestKeeper_VelocityLimitRollingWindow(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	startTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time: startTime,
+	})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_velocity_limiting,
+		},
+		VelocityLimit: &types.VelocityLimit{
+			Window: 24 * time.Hour,
+			Amount: sdkmath.NewInt(100),
+		},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, from, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(500)))))
+
+	requireAllowance := func(ctx sdk.Context, expected int64) {
+		allowance, err := ftKeeper.GetVelocityAllowance(ctx, from, denom)
+		requireT.NoError(err)
+		requireT.Equal(sdkmath.NewInt(expected).String(), allowance.Amount.String())
+	}
+	send := func(ctx sdk.Context, amount int64) error {
+		return bankKeeper.SendCoins(ctx, from, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(amount))))
+	}
+
+	// use the whole limit at the start and at the end of the window
+	requireT.NoError(send(ctx, 10))
+	ctx = ctx.WithBlockTime(startTime.Add(23*time.Hour + 59*time.Minute))
+	requireT.NoError(send(ctx, 90))
+	requireAllowance(ctx, 0)
+
+	// the limit can't be exceeded right after the window of the first outflow is over
+	ctx = ctx.WithBlockTime(startTime.Add(24 * time.Hour))
+	requireAllowance(ctx, 0)
+	requireT.ErrorIs(send(ctx, 1), types.ErrVelocityLimitExceeded)
+
+	// the first outflow is released once the window passes after the end of its bucket
+	ctx = ctx.WithBlockTime(startTime.Add(25*time.Hour - time.Nanosecond))
+	requireAllowance(ctx, 0)
+	ctx = ctx.WithBlockTime(startTime.Add(25 * time.Hour))
+	requireAllowance(ctx, 10)
+	requireT.NoError(send(ctx, 10))
+	requireAllowance(ctx, 0)
+
+	// the second outflow is released while the third one is still within the window
+	ctx = ctx.WithBlockTime(startTime.Add(48 * time.Hour))
+	requireAllowance(ctx, 90)
+	requireT.ErrorIs(send(ctx, 91), types.ErrVelocityLimitExceeded)
+	requireT.NoError(send(ctx, 90))
+	requireAllowance(ctx, 0)
+}
+
+func TestKeeper_VelocityLimitExemptions(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time: time.Now(),
+	})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_velocity_limiting,
+		},
+		VelocityLimit: &types.VelocityLimit{
+			Window: 24 * time.Hour,
+			Amount: sdkmath.NewInt(100),
+		},
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	coinsOverLimit := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(200)))
+
+	// the module accounts paying out the funds they hold aren't limited
+	requireT.NoError(bankKeeper.SendCoinsFromAccountToModule(ctx, issuer, distrtypes.ModuleName, coinsOverLimit))
+	requireT.NoError(bankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, recipient, coinsOverLimit))
+
+	// the escrow address is limited for the regular transfers
+	escrowAddress := ibctransfertypes.GetEscrowAddress(ibctransfertypes.PortID, "channel-0")
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, escrowAddress, coinsOverLimit.Add(coinsOverLimit...)))
+	err = bankKeeper.SendCoins(ctx, escrowAddress, recipient, coinsOverLimit)
+	requireT.ErrorIs(err, types.ErrVelocityLimitExceeded)
+
+	// the refunds of the rejected and timed out IBC transfers aren't limited
+	for _, purpose := range []wibctransfertypes.Purpose{
+		wibctransfertypes.PurposeAck,
+		wibctransfertypes.PurposeTimeout,
+	} {
+		ibcCtx := sdk.UnwrapSDKContext(wibctransfertypes.WithPurpose(ctx, purpose))
+		requireT.NoError(bankKeeper.SendCoins(ibcCtx, escrowAddress, recipient, coinsOverLimit))
+	}
+	requireT.Equal(
+		sdkmath.NewInt(600).String(), bankKeeper.GetBalance(ctx, recipient, denom).Amount.String(),
+	)
+
+	// the recipient allowance isn't consumed by the received funds
+	allowance, err := ftKeeper.GetVelocityAllowance(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(100).String(), allowance.Amount.String())
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -20,15 +21,21 @@ type MsgKeeper interface {
 	Issue(ctx sdk.Context, settings types.IssueSettings) (string, error)
 	Mint(ctx sdk.Context, sender, recipient sdk.AccAddress, coin sdk.Coin) error
 	Burn(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error
-	Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin, expiresAt *time.Time) error
 	Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
-	SetFrozen(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
-	GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
+	SetFrozen(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin, expiresAt *time.Time) error
+	GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string, expiresAt *time.Time) error
 	GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 	Clawback(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
 	SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	SetVelocityLimitOverride(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	AddToDenylist(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	RemoveFromDenylist(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
 	TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
 	ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error
+	GrantRole(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, role types.Role) error
+	RevokeRole(ctx sdk.Context, sender, addr sdk.AccAddress, denom string, role types.Role) error
+	UpdateMaxSupply(ctx sdk.Context, sender sdk.AccAddress, denom string, maxSupply sdkmath.Int) error
 	AddDelayedTokenUpgradeV1(ctx sdk.Context, sender sdk.AccAddress, denom string, ibcEnabled bool) error
 	UpdateParams(ctx sdk.Context, authority string, params types.Params) error
 	UpdateDEXUnifiedRefAmount(
@@ -77,6 +84,8 @@ func (ms MsgServer) Issue(ctx context.Context, req *types.MsgIssue) (*types.Empt
 		URIHash:            req.URIHash,
 		ExtensionSettings:  req.ExtensionSettings,
 		DEXSettings:        req.DEXSettings,
+		MaxSupply:          req.MaxSupply,
+		VelocityLimit:      req.VelocityLimit,
 	})
 	if err != nil {
 		return nil, err
@@ -138,7 +147,7 @@ func (ms MsgServer) Freeze(goCtx context.Context, req *types.MsgFreeze) (*types.
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	err = ms.keeper.Freeze(ctx, sender, account, req.Coin)
+	err = ms.keeper.Freeze(ctx, sender, account, req.Coin, req.ExpiresAt)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +189,7 @@ func (ms MsgServer) SetFrozen(goCtx context.Context, req *types.MsgSetFrozen) (*
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	err = ms.keeper.SetFrozen(ctx, sender, account, req.Coin)
+	err = ms.keeper.SetFrozen(ctx, sender, account, req.Coin, req.ExpiresAt)
 	if err != nil {
 		return nil, err
 	}
@@ -196,7 +205,7 @@ func (ms MsgServer) GloballyFreeze(goCtx context.Context, req *types.MsgGlobally
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
 	}
 
-	if err := ms.keeper.GloballyFreeze(ctx, sender, req.Denom); err != nil {
+	if err := ms.keeper.GloballyFreeze(ctx, sender, req.Denom, req.ExpiresAt); err != nil {
 		return nil, err
 	}
 
@@ -266,6 +275,76 @@ func (ms MsgServer) SetWhitelistedLimit(
 	return &types.EmptyResponse{}, nil
 }
 
+// SetVelocityLimitOverride overrides the velocity limit amount of the token for a specific account.
+func (ms MsgServer) SetVelocityLimitOverride(
+	goCtx context.Context,
+	req *types.MsgSetVelocityLimitOverride,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.SetVelocityLimitOverride(ctx, sender, account, req.Coin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// AddToDenylist blocks the account from sending and receiving the fungible token.
+func (ms MsgServer) AddToDenylist(
+	goCtx context.Context,
+	req *types.MsgAddToDenylist,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.AddToDenylist(ctx, sender, account, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveFromDenylist unblocks the account denylisted previously.
+func (ms MsgServer) RemoveFromDenylist(
+	goCtx context.Context,
+	req *types.MsgRemoveFromDenylist,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ms.keeper.RemoveFromDenylist(ctx, sender, account, req.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // TransferAdmin changes admin of a fungible token.
 func (ms MsgServer) TransferAdmin(goCtx context.Context, req *types.MsgTransferAdmin) (*types.EmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -303,6 +382,66 @@ func (ms MsgServer) ClearAdmin(goCtx context.Context, req *types.MsgClearAdmin)
 	return &types.EmptyResponse{}, nil
 }
 
+// GrantRole grants the role of a fungible token to the account.
+func (ms MsgServer) GrantRole(goCtx context.Context, req *types.MsgGrantRole) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.GrantRole(ctx, sender, account, req.Denom, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RevokeRole revokes the role of a fungible token from the account.
+func (ms MsgServer) RevokeRole(goCtx context.Context, req *types.MsgRevokeRole) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.RevokeRole(ctx, sender, account, req.Denom, req.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateMaxSupply lowers the max supply of a fungible token.
+func (ms MsgServer) UpdateMaxSupply(
+	goCtx context.Context,
+	req *types.MsgUpdateMaxSupply,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.UpdateMaxSupply(ctx, sender, req.Denom, req.MaxSupply); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // UpdateParams is a governance operation that sets parameters of the module.
 func (ms MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.EmptyResponse, error) {
 	if err := ms.keeper.UpdateParams(sdk.UnwrapSDKContext(goCtx), req.Authority, req.Params); err != nil {
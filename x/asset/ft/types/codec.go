@@ -22,10 +22,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgClawback{},
 		&MsgTransferAdmin{},
 		&MsgClearAdmin{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgUpdateMaxSupply{},
 		&MsgSetWhitelistedLimit{},
+		&MsgSetVelocityLimitOverride{},
+		&MsgAddToDenylist{},
+		&MsgRemoveFromDenylist{},
 	)
 	registry.RegisterImplementations((*proto.Message)(nil),
 		&DelayedTokenUpgradeV1{},
+		&DelayedUnfreeze{},
+		&DelayedGloballyUnfreeze{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
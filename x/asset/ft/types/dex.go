@@ -43,6 +43,10 @@ type DEXActions struct {
 	IncreaseExpectedToReceive []AccountToCoin
 	DecreaseExpectedToReceive []AccountToCoin
 	Send                      []CoinToSend
+	// CreatorReleasedLocked and CreatorReleasedExpectedToReceive are the limits of the replaced order of the creator,
+	// which are netted with the limits of the new order.
+	CreatorReleasedLocked            sdk.Coins
+	CreatorReleasedExpectedToReceive sdk.Coin
 }
 
 // NewDEXActions returns new instance of DEXActions.
@@ -99,6 +103,36 @@ func (da *DEXActions) AddDecreaseExpectedToReceive(address sdk.AccAddress, coin
 	)
 }
 
+// ReleaseCreatorLimits nets the limits of the replaced order of the creator with the limits increased by the actions,
+// so only the difference is locked or unlocked.
+func (da *DEXActions) ReleaseCreatorLimits(lockedCoins sdk.Coins, expectedToReceiveCoin sdk.Coin) {
+	creator := da.Order.Creator
+	da.CreatorReleasedLocked = da.CreatorReleasedLocked.Add(lockedCoins...)
+
+	var remainingLockedCoins sdk.Coins
+	da.IncreaseLocked, remainingLockedCoins = offsetAccountsToCoin(da.IncreaseLocked, creator, lockedCoins)
+	for _, coin := range remainingLockedCoins {
+		da.AddDecreaseLocked(creator, coin)
+	}
+
+	if expectedToReceiveCoin.IsNil() || expectedToReceiveCoin.IsZero() {
+		return
+	}
+	if da.CreatorReleasedExpectedToReceive.IsNil() {
+		da.CreatorReleasedExpectedToReceive = expectedToReceiveCoin
+	} else {
+		da.CreatorReleasedExpectedToReceive = da.CreatorReleasedExpectedToReceive.Add(expectedToReceiveCoin)
+	}
+
+	var remainingExpectedToReceiveCoins sdk.Coins
+	da.IncreaseExpectedToReceive, remainingExpectedToReceiveCoins = offsetAccountsToCoin(
+		da.IncreaseExpectedToReceive, creator, sdk.NewCoins(expectedToReceiveCoin),
+	)
+	for _, coin := range remainingExpectedToReceiveCoins {
+		da.AddDecreaseExpectedToReceive(creator, coin)
+	}
+}
+
 // AddSend appends a new CoinToSend to the Send list with the specified fromAddr, toAddr, and coin.
 func (da *DEXActions) AddSend(fromAddr, toAddr sdk.AccAddress, coin sdk.Coin) {
 	for i, send := range da.Send {
@@ -124,3 +158,28 @@ func appendOrAddToAccountsToCoin(accountsToCoin []AccountToCoin, accountToCoin A
 	accountsToCoin = append(accountsToCoin, accountToCoin)
 	return accountsToCoin
 }
+
+// offsetAccountsToCoin subtracts the coins from the items of the address and returns the updated items and the
+// coins left.
+func offsetAccountsToCoin(
+	accountsToCoin []AccountToCoin,
+	address sdk.AccAddress,
+	coins sdk.Coins,
+) ([]AccountToCoin, sdk.Coins) {
+	result := make([]AccountToCoin, 0, len(accountsToCoin))
+	for _, item := range accountsToCoin {
+		if item.Address.String() == address.String() {
+			offset := sdkmath.MinInt(item.Coin.Amount, coins.AmountOf(item.Coin.Denom))
+			if offset.IsPositive() {
+				item.Coin = item.Coin.SubAmount(offset)
+				coins = coins.Sub(sdk.NewCoin(item.Coin.Denom, offset))
+			}
+			if item.Coin.IsZero() {
+				continue
+			}
+		}
+		result = append(result, item)
+	}
+
+	return result, coins
+}
@@ -29,4 +29,10 @@ var (
 	ErrDEXInsufficientSpendableBalance = sdkerrors.Register(
 		ModuleName, 11, "DEX insufficient spendable balance",
 	)
+	// ErrMaxSupplyExceeded is returned when the minted amount exceeds the max supply of the token.
+	ErrMaxSupplyExceeded = sdkerrors.Register(ModuleName, 12, "max supply exceeded")
+	// ErrVelocityLimitExceeded is returned when the outflow of the account exceeds the velocity limit of the token.
+	ErrVelocityLimitExceeded = sdkerrors.Register(ModuleName, 13, "velocity limit exceeded")
+	// ErrDenylisted is returned when the account is denylisted for the token.
+	ErrDenylisted = sdkerrors.Register(ModuleName, 14, "account is denylisted")
 )
@@ -40,6 +40,8 @@ type EventIssued struct {
 	URIHash            string                      `protobuf:"bytes,12,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	Admin              string                      `protobuf:"bytes,13,opt,name=admin,proto3" json:"admin,omitempty"`
 	DEXSettings        *DEXSettings                `protobuf:"bytes,14,opt,name=dex_settings,json=dexSettings,proto3" json:"dex_settings,omitempty"`
+	MaxSupply          *cosmossdk_io_math.Int      `protobuf:"bytes,15,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply,omitempty"`
+	VelocityLimit      *VelocityLimit              `protobuf:"bytes,16,opt,name=velocity_limit,json=velocityLimit,proto3" json:"velocity_limit,omitempty"`
 }
 
 func (m *EventIssued) Reset()         { *m = EventIssued{} }
@@ -152,6 +154,13 @@ func (m *EventIssued) GetDEXSettings() *DEXSettings {
 	return nil
 }
 
+func (m *EventIssued) GetVelocityLimit() *VelocityLimit {
+	if m != nil {
+		return m.VelocityLimit
+	}
+	return nil
+}
+
 type EventFrozenAmountChanged struct {
 	Account        string                `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	Denom          string                `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -535,6 +544,286 @@ func (m *EventAdminCleared) GetPreviousAdmin() string {
 	return ""
 }
 
+type EventRoleGranted struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role    Role   `protobuf:"varint,2,opt,name=role,proto3,enum=coreum.asset.ft.v1.Role" json:"role,omitempty"`
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *EventRoleGranted) Reset()         { *m = EventRoleGranted{} }
+func (m *EventRoleGranted) String() string { return proto.CompactTextString(m) }
+func (*EventRoleGranted) ProtoMessage()    {}
+func (*EventRoleGranted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{8}
+}
+func (m *EventRoleGranted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRoleGranted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRoleGranted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRoleGranted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRoleGranted.Merge(m, src)
+}
+func (m *EventRoleGranted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRoleGranted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRoleGranted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRoleGranted proto.InternalMessageInfo
+
+func (m *EventRoleGranted) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventRoleGranted) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_role_unspecified
+}
+
+func (m *EventRoleGranted) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type EventRoleRevoked struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role    Role   `protobuf:"varint,2,opt,name=role,proto3,enum=coreum.asset.ft.v1.Role" json:"role,omitempty"`
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *EventRoleRevoked) Reset()         { *m = EventRoleRevoked{} }
+func (m *EventRoleRevoked) String() string { return proto.CompactTextString(m) }
+func (*EventRoleRevoked) ProtoMessage()    {}
+func (*EventRoleRevoked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{9}
+}
+func (m *EventRoleRevoked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRoleRevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRoleRevoked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRoleRevoked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRoleRevoked.Merge(m, src)
+}
+func (m *EventRoleRevoked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRoleRevoked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRoleRevoked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRoleRevoked proto.InternalMessageInfo
+
+func (m *EventRoleRevoked) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventRoleRevoked) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_role_unspecified
+}
+
+func (m *EventRoleRevoked) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type EventVelocityLimitOverrideChanged struct {
+	Account        string                `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom          string                `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	PreviousAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=previous_amount,json=previousAmount,proto3,customtype=cosmossdk.io/math.Int" json:"previous_amount"`
+	CurrentAmount  cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=current_amount,json=currentAmount,proto3,customtype=cosmossdk.io/math.Int" json:"current_amount"`
+}
+
+func (m *EventVelocityLimitOverrideChanged) Reset()         { *m = EventVelocityLimitOverrideChanged{} }
+func (m *EventVelocityLimitOverrideChanged) String() string { return proto.CompactTextString(m) }
+func (*EventVelocityLimitOverrideChanged) ProtoMessage()    {}
+func (*EventVelocityLimitOverrideChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{10}
+}
+func (m *EventVelocityLimitOverrideChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventVelocityLimitOverrideChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventVelocityLimitOverrideChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventVelocityLimitOverrideChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventVelocityLimitOverrideChanged.Merge(m, src)
+}
+func (m *EventVelocityLimitOverrideChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventVelocityLimitOverrideChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventVelocityLimitOverrideChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventVelocityLimitOverrideChanged proto.InternalMessageInfo
+
+func (m *EventVelocityLimitOverrideChanged) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventVelocityLimitOverrideChanged) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type EventDenylistChanged struct {
+	Account    string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Denylisted bool   `protobuf:"varint,3,opt,name=denylisted,proto3" json:"denylisted,omitempty"`
+}
+
+func (m *EventDenylistChanged) Reset()         { *m = EventDenylistChanged{} }
+func (m *EventDenylistChanged) String() string { return proto.CompactTextString(m) }
+func (*EventDenylistChanged) ProtoMessage()    {}
+func (*EventDenylistChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{11}
+}
+func (m *EventDenylistChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDenylistChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDenylistChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDenylistChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDenylistChanged.Merge(m, src)
+}
+func (m *EventDenylistChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDenylistChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDenylistChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDenylistChanged proto.InternalMessageInfo
+
+func (m *EventDenylistChanged) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *EventDenylistChanged) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *EventDenylistChanged) GetDenylisted() bool {
+	if m != nil {
+		return m.Denylisted
+	}
+	return false
+}
+
+type EventMaxSupplyUpdated struct {
+	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PreviousMaxSupply *cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=previous_max_supply,json=previousMaxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"previous_max_supply,omitempty"`
+	CurrentMaxSupply  cosmossdk_io_math.Int  `protobuf:"bytes,3,opt,name=current_max_supply,json=currentMaxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"current_max_supply"`
+}
+
+func (m *EventMaxSupplyUpdated) Reset()         { *m = EventMaxSupplyUpdated{} }
+func (m *EventMaxSupplyUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventMaxSupplyUpdated) ProtoMessage()    {}
+func (*EventMaxSupplyUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bdf87682d70b967f, []int{12}
+}
+func (m *EventMaxSupplyUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMaxSupplyUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMaxSupplyUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMaxSupplyUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMaxSupplyUpdated.Merge(m, src)
+}
+func (m *EventMaxSupplyUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMaxSupplyUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMaxSupplyUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMaxSupplyUpdated proto.InternalMessageInfo
+
+func (m *EventMaxSupplyUpdated) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type EventDEXSettingsChanged struct {
 	PreviousSettings *DEXSettings `protobuf:"bytes,1,opt,name=previous_settings,json=previousSettings,proto3" json:"previous_settings,omitempty"`
 	NewSettings      DEXSettings  `protobuf:"bytes,2,opt,name=new_settings,json=newSettings,proto3" json:"new_settings"`
@@ -544,7 +833,7 @@ func (m *EventDEXSettingsChanged) Reset()         { *m = EventDEXSettingsChanged
 func (m *EventDEXSettingsChanged) String() string { return proto.CompactTextString(m) }
 func (*EventDEXSettingsChanged) ProtoMessage()    {}
 func (*EventDEXSettingsChanged) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bdf87682d70b967f, []int{8}
+	return fileDescriptor_bdf87682d70b967f, []int{13}
 }
 func (m *EventDEXSettingsChanged) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,62 +885,79 @@ func init() {
 	proto.RegisterType((*EventDEXExpectedToReceiveAmountChanged)(nil), "coreum.asset.ft.v1.EventDEXExpectedToReceiveAmountChanged")
 	proto.RegisterType((*EventAdminTransferred)(nil), "coreum.asset.ft.v1.EventAdminTransferred")
 	proto.RegisterType((*EventAdminCleared)(nil), "coreum.asset.ft.v1.EventAdminCleared")
+	proto.RegisterType((*EventRoleGranted)(nil), "coreum.asset.ft.v1.EventRoleGranted")
+	proto.RegisterType((*EventRoleRevoked)(nil), "coreum.asset.ft.v1.EventRoleRevoked")
+	proto.RegisterType((*EventVelocityLimitOverrideChanged)(nil), "coreum.asset.ft.v1.EventVelocityLimitOverrideChanged")
+	proto.RegisterType((*EventDenylistChanged)(nil), "coreum.asset.ft.v1.EventDenylistChanged")
+	proto.RegisterType((*EventMaxSupplyUpdated)(nil), "coreum.asset.ft.v1.EventMaxSupplyUpdated")
 	proto.RegisterType((*EventDEXSettingsChanged)(nil), "coreum.asset.ft.v1.EventDEXSettingsChanged")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/event.proto", fileDescriptor_bdf87682d70b967f) }
 
 var fileDescriptor_bdf87682d70b967f = []byte{
-	// 782 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6e, 0xe3, 0x36,
-	0x10, 0xb6, 0x62, 0xaf, 0x7f, 0xe8, 0x9f, 0xed, 0x0a, 0xd9, 0x56, 0xdd, 0x74, 0x6d, 0xc3, 0x8b,
-	0x2e, 0x7c, 0x92, 0xb0, 0x29, 0x9a, 0x5e, 0xdb, 0xf8, 0x07, 0x31, 0x90, 0x43, 0xa0, 0x24, 0x68,
-	0xd0, 0x8b, 0x41, 0x4b, 0x63, 0x9b, 0xb0, 0x45, 0x1a, 0x24, 0xe5, 0xd8, 0x3d, 0xf4, 0x19, 0xfa,
-	0x2a, 0x7d, 0x8b, 0x1c, 0x73, 0x0c, 0x5a, 0xd4, 0x28, 0x1c, 0xa0, 0x2f, 0xd0, 0x17, 0x28, 0x48,
-	0x49, 0x76, 0x80, 0xa4, 0x80, 0x83, 0xde, 0x72, 0xd3, 0x7c, 0x9c, 0xf9, 0x38, 0x1f, 0x67, 0x34,
-	0x24, 0xaa, 0x7a, 0x8c, 0x43, 0x18, 0x38, 0x58, 0x08, 0x90, 0xce, 0x50, 0x3a, 0xf3, 0x4f, 0x0e,
-	0xcc, 0x81, 0x4a, 0x7b, 0xc6, 0x99, 0x64, 0xa6, 0x19, 0xad, 0xdb, 0x7a, 0xdd, 0x1e, 0x4a, 0x7b,
-	0xfe, 0xe9, 0xdd, 0x53, 0x31, 0x92, 0x4d, 0x80, 0x46, 0x31, 0xef, 0xf6, 0x47, 0x6c, 0xc4, 0xf4,
-	0xa7, 0xa3, 0xbe, 0x22, 0xb4, 0xf1, 0x4f, 0x06, 0x15, 0x3b, 0x8a, 0xb9, 0x27, 0x44, 0x08, 0xbe,
-	0xb9, 0x8f, 0x5e, 0xf9, 0x40, 0x59, 0x60, 0x19, 0x75, 0xa3, 0x59, 0x70, 0x23, 0xc3, 0xfc, 0x1c,
-	0x65, 0x89, 0x5a, 0xe7, 0xd6, 0x9e, 0x86, 0x63, 0x4b, 0xe1, 0x62, 0x19, 0x0c, 0xd8, 0xd4, 0x4a,
-	0x47, 0x78, 0x64, 0x99, 0x16, 0xca, 0x89, 0x70, 0x10, 0x52, 0x22, 0xad, 0x8c, 0x5e, 0x48, 0x4c,
-	0xf3, 0x2b, 0x54, 0x98, 0x71, 0xf0, 0x88, 0x20, 0x8c, 0x5a, 0xaf, 0xea, 0x46, 0xb3, 0xec, 0x6e,
-	0x01, 0xb3, 0x8d, 0x2a, 0x84, 0x12, 0x49, 0xf0, 0xb4, 0x8f, 0x03, 0x16, 0x52, 0x69, 0x65, 0x55,
-	0xf8, 0xf1, 0xfb, 0x9b, 0x55, 0x2d, 0xf5, 0xfb, 0xaa, 0xf6, 0xd6, 0x63, 0x22, 0x60, 0x42, 0xf8,
-	0x13, 0x9b, 0x30, 0x27, 0xc0, 0x72, 0x6c, 0xf7, 0xa8, 0x74, 0xcb, 0x71, 0xd0, 0x0f, 0x3a, 0xc6,
-	0xac, 0xa3, 0xa2, 0x0f, 0xc2, 0xe3, 0x64, 0x26, 0xd5, 0x2e, 0x39, 0x9d, 0xc1, 0x43, 0xc8, 0xfc,
-	0x0e, 0xe5, 0x87, 0x80, 0x65, 0xc8, 0x41, 0x58, 0xf9, 0x7a, 0xba, 0x59, 0x39, 0x3c, 0xb0, 0x1f,
-	0x1f, 0xa9, 0xdd, 0x8d, 0x7c, 0xdc, 0x8d, 0xb3, 0xf9, 0x3d, 0x2a, 0x0c, 0x42, 0x4e, 0xfb, 0x1c,
-	0x4b, 0xb0, 0x0a, 0x3a, 0xb7, 0x0f, 0x71, 0x6e, 0x07, 0x8f, 0x73, 0x3b, 0x85, 0x11, 0xf6, 0x96,
-	0x6d, 0xf0, 0xdc, 0xbc, 0x8a, 0x72, 0xb1, 0x04, 0xf3, 0x12, 0xed, 0x0b, 0xa0, 0x7e, 0xdf, 0x63,
-	0x41, 0x40, 0x84, 0x52, 0x1d, 0x91, 0xa1, 0xdd, 0xc9, 0x4c, 0x45, 0xd0, 0xda, 0xc4, 0x6b, 0xda,
-	0x2f, 0x51, 0x3a, 0xe4, 0xc4, 0x2a, 0x6a, 0x96, 0xdc, 0x7a, 0x55, 0x4b, 0x5f, 0xba, 0x3d, 0x57,
-	0x61, 0xe6, 0x47, 0x94, 0x0f, 0x39, 0xe9, 0x8f, 0xb1, 0x18, 0x5b, 0x25, 0xbd, 0x5e, 0x5c, 0xaf,
-	0x6a, 0xb9, 0x4b, 0xb7, 0x77, 0x82, 0xc5, 0xd8, 0xcd, 0x85, 0x9c, 0xa8, 0x0f, 0x55, 0x7a, 0xec,
-	0x07, 0x84, 0x5a, 0xe5, 0xa8, 0xf4, 0xda, 0x30, 0xcf, 0x51, 0xc9, 0x87, 0x45, 0x5f, 0x80, 0x94,
-	0x84, 0x8e, 0x84, 0x55, 0xa9, 0x1b, 0xcd, 0xe2, 0x61, 0xed, 0xa9, 0xe3, 0x6a, 0x77, 0xae, 0xce,
-	0x63, 0xb7, 0xe3, 0xd7, 0xeb, 0x55, 0xad, 0xf8, 0x00, 0x50, 0xe7, 0xbf, 0x48, 0x8c, 0xc6, 0x9d,
-	0x81, 0x2c, 0xdd, 0x75, 0x5d, 0xce, 0x7e, 0x06, 0x1a, 0xd5, 0xad, 0x35, 0xc6, 0x74, 0x04, 0xbe,
-	0x6a, 0x1e, 0xec, 0x79, 0xba, 0xfa, 0x51, 0x13, 0x26, 0xe6, 0xb6, 0x39, 0xf7, 0x1e, 0x36, 0x67,
-	0x17, 0xbd, 0x9e, 0x71, 0x98, 0x13, 0x16, 0x8a, 0xa4, 0x6b, 0xd2, 0xbb, 0x74, 0x4d, 0x25, 0x89,
-	0x8a, 0xdb, 0xa6, 0x8d, 0x2a, 0x5e, 0xc8, 0x39, 0x50, 0x99, 0xd0, 0x64, 0x76, 0x6a, 0xbe, 0x38,
-	0x28, 0x62, 0x69, 0xfc, 0x82, 0xde, 0x6a, 0x65, 0xb1, 0xa6, 0x29, 0xbe, 0x06, 0xff, 0x18, 0x7b,
-	0x93, 0x67, 0xcb, 0xfa, 0x16, 0x65, 0x9f, 0xa3, 0x26, 0x76, 0x6e, 0xfc, 0x69, 0xa0, 0xf7, 0x3a,
-	0x81, 0x1f, 0xc7, 0x44, 0xc2, 0x94, 0x08, 0x09, 0xfe, 0x4b, 0x3a, 0xdf, 0x3f, 0x0c, 0x74, 0xa0,
-	0xf5, 0xb5, 0x3b, 0x57, 0xa7, 0xcc, 0x9b, 0xbc, 0x2c, 0x75, 0x7f, 0x1b, 0xe8, 0x63, 0xa2, 0xae,
-	0xb3, 0x98, 0x81, 0x27, 0xc1, 0xbf, 0x60, 0x2e, 0x78, 0x40, 0xe6, 0xf0, 0x92, 0x84, 0x2e, 0x93,
-	0xdf, 0x44, 0x0d, 0x99, 0x0b, 0x8e, 0xa9, 0x18, 0x02, 0xe7, 0xff, 0x79, 0x01, 0x7d, 0x8d, 0x2a,
-	0xdb, 0xe4, 0xf5, 0x90, 0x8a, 0xb4, 0x95, 0x37, 0xc9, 0xe9, 0x61, 0xf5, 0x01, 0x95, 0x37, 0xb9,
-	0x69, 0xaf, 0xe8, 0x5a, 0x2a, 0x25, 0x7b, 0x2b, 0xac, 0x71, 0x86, 0xde, 0x6c, 0xb7, 0x6e, 0x4d,
-	0x01, 0xff, 0xdf, 0x6d, 0x1b, 0xbf, 0x19, 0xe8, 0x8b, 0xa4, 0x6a, 0xc9, 0x8c, 0x4b, 0xca, 0x74,
-	0x8a, 0xde, 0x6c, 0x28, 0x36, 0x43, 0xd4, 0xd8, 0x69, 0x88, 0xba, 0x9f, 0x25, 0x91, 0x09, 0x62,
-	0x9e, 0xa0, 0x12, 0x85, 0xeb, 0x2d, 0xd1, 0xde, 0x6e, 0xd3, 0x38, 0xa3, 0x6a, 0xe3, 0x16, 0x29,
-	0x5c, 0x6f, 0xa0, 0xb3, 0x9b, 0x75, 0xd5, 0xb8, 0x5d, 0x57, 0x8d, 0xbf, 0xd6, 0x55, 0xe3, 0xd7,
-	0xfb, 0x6a, 0xea, 0xf6, 0xbe, 0x9a, 0xba, 0xbb, 0xaf, 0xa6, 0x7e, 0x3a, 0x1a, 0x11, 0x39, 0x0e,
-	0x07, 0xb6, 0xc7, 0x02, 0xa7, 0xa5, 0x79, 0xbb, 0x2c, 0xa4, 0x3e, 0x56, 0x37, 0xa7, 0x13, 0x3f,
-	0x32, 0xe6, 0x47, 0xce, 0x62, 0xfb, 0xd2, 0x90, 0xcb, 0x19, 0x88, 0x41, 0x56, 0xbf, 0x28, 0xbe,
-	0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0x3a, 0xf1, 0xb5, 0x8d, 0xbd, 0x08, 0x00, 0x00,
+	// 974 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x5f, 0x6f, 0x1b, 0x45,
+	0x10, 0xcf, 0xc5, 0xf9, 0x3b, 0x8e, 0x9d, 0xf4, 0x48, 0xe1, 0xda, 0x50, 0x3b, 0x75, 0x45, 0x95,
+	0x07, 0x64, 0xab, 0x41, 0x14, 0x1e, 0x21, 0xff, 0x48, 0x44, 0x10, 0xd5, 0xa5, 0x81, 0x8a, 0x17,
+	0x6b, 0x73, 0x37, 0xb1, 0x57, 0xbe, 0xdb, 0x3d, 0xed, 0xee, 0x5d, 0x6c, 0x1e, 0xf8, 0x0c, 0x7c,
+	0x15, 0x3e, 0x04, 0x52, 0x1f, 0xfb, 0x58, 0x81, 0xb0, 0xc0, 0x91, 0xf8, 0x0a, 0xbc, 0xa2, 0xdd,
+	0xfb, 0x63, 0x47, 0x4d, 0x84, 0x0b, 0xe2, 0x25, 0x6f, 0x3b, 0xb3, 0x33, 0xbf, 0x99, 0xdf, 0xec,
+	0xec, 0xee, 0x40, 0xcd, 0xe3, 0x02, 0xe3, 0xb0, 0x45, 0xa4, 0x44, 0xd5, 0x3a, 0x57, 0xad, 0xe4,
+	0x49, 0x0b, 0x13, 0x64, 0xaa, 0x19, 0x09, 0xae, 0xb8, 0x6d, 0xa7, 0xfb, 0x4d, 0xb3, 0xdf, 0x3c,
+	0x57, 0xcd, 0xe4, 0xc9, 0xfd, 0xeb, 0x7c, 0x14, 0xef, 0x21, 0x4b, 0x7d, 0xee, 0xaf, 0x77, 0x78,
+	0x87, 0x9b, 0x65, 0x4b, 0xaf, 0x52, 0x6d, 0xe3, 0xaf, 0x79, 0x28, 0xef, 0x6b, 0xe4, 0x23, 0x29,
+	0x63, 0xf4, 0xed, 0x75, 0x98, 0xf7, 0x91, 0xf1, 0xd0, 0xb1, 0x36, 0xad, 0xad, 0x65, 0x37, 0x15,
+	0xec, 0x77, 0x61, 0x81, 0xea, 0x7d, 0xe1, 0xcc, 0x1a, 0x75, 0x26, 0x69, 0xbd, 0x1c, 0x84, 0x67,
+	0x3c, 0x70, 0x4a, 0xa9, 0x3e, 0x95, 0x6c, 0x07, 0x16, 0x65, 0x7c, 0x16, 0x33, 0xaa, 0x9c, 0x39,
+	0xb3, 0x91, 0x8b, 0xf6, 0xfb, 0xb0, 0x1c, 0x09, 0xf4, 0xa8, 0xa4, 0x9c, 0x39, 0xf3, 0x9b, 0xd6,
+	0x56, 0xc5, 0x1d, 0x2b, 0xec, 0x3d, 0xa8, 0x52, 0x46, 0x15, 0x25, 0x41, 0x9b, 0x84, 0x3c, 0x66,
+	0xca, 0x59, 0xd0, 0xee, 0x3b, 0x0f, 0x5e, 0x0e, 0xeb, 0x33, 0xbf, 0x0c, 0xeb, 0x77, 0x3d, 0x2e,
+	0x43, 0x2e, 0xa5, 0xdf, 0x6b, 0x52, 0xde, 0x0a, 0x89, 0xea, 0x36, 0x8f, 0x98, 0x72, 0x2b, 0x99,
+	0xd3, 0xe7, 0xc6, 0xc7, 0xde, 0x84, 0xb2, 0x8f, 0xd2, 0x13, 0x34, 0x52, 0x3a, 0xca, 0xa2, 0xc9,
+	0x60, 0x52, 0x65, 0x7f, 0x02, 0x4b, 0xe7, 0x48, 0x54, 0x2c, 0x50, 0x3a, 0x4b, 0x9b, 0xa5, 0xad,
+	0xea, 0xf6, 0x46, 0xf3, 0xcd, 0x92, 0x36, 0x0f, 0x52, 0x1b, 0xb7, 0x30, 0xb6, 0x3f, 0x83, 0xe5,
+	0xb3, 0x58, 0xb0, 0xb6, 0x20, 0x0a, 0x9d, 0x65, 0x93, 0xdb, 0xa3, 0x2c, 0xb7, 0x8d, 0x37, 0x73,
+	0x3b, 0xc6, 0x0e, 0xf1, 0x06, 0x7b, 0xe8, 0xb9, 0x4b, 0xda, 0xcb, 0x25, 0x0a, 0xed, 0x53, 0x58,
+	0x97, 0xc8, 0xfc, 0xb6, 0xc7, 0xc3, 0x90, 0x4a, 0xcd, 0x3a, 0x05, 0x83, 0xe9, 0xc1, 0x6c, 0x0d,
+	0xb0, 0x5b, 0xf8, 0x1b, 0xd8, 0x7b, 0x50, 0x8a, 0x05, 0x75, 0xca, 0x06, 0x65, 0x71, 0x34, 0xac,
+	0x97, 0x4e, 0xdd, 0x23, 0x57, 0xeb, 0xec, 0xc7, 0xb0, 0x14, 0x0b, 0xda, 0xee, 0x12, 0xd9, 0x75,
+	0x56, 0xcc, 0x7e, 0x79, 0x34, 0xac, 0x2f, 0x9e, 0xba, 0x47, 0x87, 0x44, 0x76, 0xdd, 0xc5, 0x58,
+	0x50, 0xbd, 0xd0, 0x47, 0x4f, 0xfc, 0x90, 0x32, 0xa7, 0x92, 0x1e, 0xbd, 0x11, 0xec, 0x13, 0x58,
+	0xf1, 0xb1, 0xdf, 0x96, 0xa8, 0x14, 0x65, 0x1d, 0xe9, 0x54, 0x37, 0xad, 0xad, 0xf2, 0x76, 0xfd,
+	0xba, 0x72, 0xed, 0xed, 0xbf, 0x38, 0xc9, 0xcc, 0x76, 0x56, 0x47, 0xc3, 0x7a, 0x79, 0x42, 0xa1,
+	0xeb, 0xdf, 0xcf, 0x05, 0xfb, 0x53, 0x80, 0x90, 0xf4, 0xdb, 0x32, 0x8e, 0xa2, 0x60, 0xe0, 0xac,
+	0x9a, 0xa4, 0xee, 0xdd, 0x7c, 0xbe, 0xcb, 0x21, 0xe9, 0x9f, 0x18, 0x5b, 0xfb, 0x10, 0xaa, 0x09,
+	0x06, 0xdc, 0xa3, 0x6a, 0xd0, 0x0e, 0x68, 0x48, 0x95, 0xb3, 0x66, 0x12, 0x7a, 0x78, 0x5d, 0x42,
+	0xdf, 0x64, 0x96, 0xc7, 0xda, 0xd0, 0xad, 0x24, 0x93, 0x62, 0xe3, 0xb5, 0x05, 0x8e, 0xe9, 0xfc,
+	0x03, 0xc1, 0xbf, 0x47, 0x96, 0xf6, 0xce, 0x6e, 0x97, 0xb0, 0x0e, 0xfa, 0xba, 0x81, 0x89, 0xe7,
+	0x99, 0x0e, 0x4c, 0x2f, 0x42, 0x2e, 0x8e, 0x2f, 0xc8, 0xec, 0xe4, 0x05, 0x39, 0x80, 0xd5, 0x48,
+	0x60, 0x42, 0x79, 0x2c, 0xf3, 0xce, 0x2d, 0x4d, 0xd3, 0xb9, 0xd5, 0xdc, 0x2b, 0x6b, 0xdd, 0x3d,
+	0xa8, 0x7a, 0xb1, 0x10, 0xc8, 0x54, 0x0e, 0x33, 0x37, 0xd5, 0x05, 0xc8, 0x9c, 0x52, 0x94, 0xc6,
+	0x0f, 0x70, 0xd7, 0x30, 0xcb, 0x38, 0x05, 0xe4, 0x02, 0xfd, 0x1d, 0xe2, 0xf5, 0xde, 0x9a, 0xd6,
+	0xc7, 0xb0, 0xf0, 0x36, 0x6c, 0x32, 0xe3, 0xc6, 0x6f, 0x16, 0x3c, 0x30, 0x09, 0x7c, 0xdb, 0xa5,
+	0x0a, 0x03, 0x2a, 0x15, 0xfa, 0xb7, 0xa9, 0xbe, 0xbf, 0x5a, 0xb0, 0x61, 0xf8, 0xed, 0xed, 0xbf,
+	0x38, 0xe6, 0x5e, 0xef, 0x76, 0xb1, 0xfb, 0xd3, 0x82, 0xc7, 0x39, 0xbb, 0xfd, 0x7e, 0x84, 0x9e,
+	0x42, 0xff, 0x39, 0x77, 0xd1, 0x43, 0x9a, 0xe0, 0x6d, 0x22, 0x3a, 0xc8, 0xaf, 0x89, 0x7e, 0xe8,
+	0x9e, 0x0b, 0xc2, 0xe4, 0x39, 0x0a, 0x71, 0xe3, 0x27, 0xf8, 0x01, 0x54, 0xc7, 0xc9, 0x9b, 0x87,
+	0x32, 0xe5, 0x56, 0x29, 0x92, 0x33, 0x0f, 0xe6, 0x23, 0xa8, 0x14, 0xb9, 0x19, 0xab, 0xf4, 0x6b,
+	0x5c, 0xc9, 0x63, 0x6b, 0x5d, 0xe3, 0x19, 0xdc, 0x19, 0x87, 0xde, 0x0d, 0x90, 0xfc, 0xd7, 0xb0,
+	0x8d, 0x08, 0xd6, 0x0c, 0xa2, 0xcb, 0x03, 0xfc, 0x42, 0x10, 0xa6, 0x6e, 0x04, 0xfc, 0x10, 0xe6,
+	0x04, 0x0f, 0xd0, 0xc0, 0x54, 0xb7, 0x9d, 0xeb, 0x1e, 0x4e, 0x0d, 0xe2, 0x1a, 0xab, 0xc9, 0x23,
+	0x2e, 0x5d, 0x39, 0xe2, 0x2b, 0x11, 0x5d, 0x4c, 0x78, 0xef, 0x7f, 0x8f, 0xf8, 0x87, 0x05, 0x0f,
+	0x4d, 0xc8, 0x2b, 0x0f, 0xfb, 0xd7, 0x09, 0x0a, 0x41, 0x7d, 0xbc, 0x1d, 0x4d, 0x79, 0x0e, 0xeb,
+	0xe9, 0xe5, 0x43, 0x36, 0xd0, 0x2f, 0xe7, 0xbf, 0x65, 0x55, 0x03, 0xf0, 0x33, 0x08, 0xf4, 0x0d,
+	0xa1, 0x25, 0x77, 0x42, 0xd3, 0xf8, 0xd9, 0xca, 0xba, 0xff, 0xab, 0xfc, 0x6f, 0x3d, 0x8d, 0x7c,
+	0x72, 0x73, 0xd7, 0x1c, 0xc1, 0x3b, 0x45, 0x95, 0x26, 0xfe, 0xee, 0xd9, 0x7f, 0xfa, 0xbb, 0xef,
+	0xe4, 0x5e, 0x45, 0x1c, 0xfb, 0x4b, 0xb0, 0xf3, 0x42, 0x4d, 0x20, 0x4d, 0x55, 0xf3, 0xb5, 0xcc,
+	0xb1, 0x00, 0x6b, 0xfc, 0x64, 0xc1, 0x7b, 0xf9, 0x6b, 0x95, 0xcf, 0x17, 0x79, 0xcd, 0x8e, 0xa1,
+	0x88, 0x3e, 0x1e, 0x60, 0xac, 0xa9, 0x06, 0x18, 0x77, 0x2d, 0xf7, 0x2c, 0x86, 0x96, 0x43, 0x58,
+	0x61, 0x78, 0x31, 0x06, 0x9a, 0x9d, 0x6e, 0x12, 0x9a, 0xd3, 0x8c, 0xdc, 0x32, 0xc3, 0x8b, 0x42,
+	0xf5, 0xec, 0xe5, 0xa8, 0x66, 0xbd, 0x1a, 0xd5, 0xac, 0xdf, 0x47, 0x35, 0xeb, 0xc7, 0xcb, 0xda,
+	0xcc, 0xab, 0xcb, 0xda, 0xcc, 0xeb, 0xcb, 0xda, 0xcc, 0x77, 0x4f, 0x3b, 0x54, 0x75, 0xe3, 0xb3,
+	0xa6, 0xc7, 0xc3, 0xd6, 0xae, 0xc1, 0x3d, 0xe0, 0x31, 0xf3, 0x89, 0x9e, 0x5a, 0x5b, 0xd9, 0x80,
+	0x9f, 0x3c, 0x6d, 0xf5, 0xc7, 0x53, 0xbe, 0x1a, 0x44, 0x28, 0xcf, 0x16, 0xcc, 0x34, 0xff, 0xd1,
+	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xbd, 0xb5, 0x83, 0xf3, 0x39, 0x0c, 0x00, 0x00,
 }
 
 func (m *EventIssued) Marshal() (dAtA []byte, err error) {
@@ -674,6 +980,32 @@ func (m *EventIssued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VelocityLimit != nil {
+		{
+			size, err := m.VelocityLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.DEXSettings != nil {
 		{
 			size, err := m.DEXSettings.MarshalToSizedBuffer(dAtA[:i])
@@ -728,20 +1060,20 @@ func (m *EventIssued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x4a
 	if len(m.Features) > 0 {
-		dAtA3 := make([]byte, len(m.Features)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.Features)*10)
+		var j3 int
 		for _, num := range m.Features {
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintEvent(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvent(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -1154,7 +1486,7 @@ func (m *EventAdminCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventDEXSettingsChanged) Marshal() (dAtA []byte, err error) {
+func (m *EventRoleGranted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1164,60 +1496,300 @@ func (m *EventDEXSettingsChanged) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventDEXSettingsChanged) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventRoleGranted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventDEXSettingsChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventRoleGranted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.NewSettings.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.PreviousSettings != nil {
-		{
-			size, err := m.PreviousSettings.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if m.Role != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventRoleRevoked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventIssued) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
+
+func (m *EventRoleRevoked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRoleRevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Role != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventVelocityLimitOverrideChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventVelocityLimitOverrideChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventVelocityLimitOverrideChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentAmount.Size()
+		i -= size
+		if _, err := m.CurrentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PreviousAmount.Size()
+		i -= size
+		if _, err := m.PreviousAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDenylistChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDenylistChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDenylistChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Denylisted {
+		i--
+		if m.Denylisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMaxSupplyUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMaxSupplyUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMaxSupplyUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentMaxSupply.Size()
+		i -= size
+		if _, err := m.CurrentMaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PreviousMaxSupply != nil {
+		{
+			size := m.PreviousMaxSupply.Size()
+			i -= size
+			if _, err := m.PreviousMaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDEXSettingsChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDEXSettingsChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDEXSettingsChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewSettings.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PreviousSettings != nil {
+		{
+			size, err := m.PreviousSettings.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventIssued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
 	l = len(m.Issuer)
@@ -1268,6 +1840,14 @@ func (m *EventIssued) Size() (n int) {
 		l = m.DEXSettings.Size()
 		n += 1 + l + sovEvent(uint64(l))
 	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.VelocityLimit != nil {
+		l = m.VelocityLimit.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
 	return n
 }
 
@@ -1412,34 +1992,134 @@ func (m *EventAdminCleared) Size() (n int) {
 	return n
 }
 
-func (m *EventDEXSettingsChanged) Size() (n int) {
+func (m *EventRoleGranted) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.PreviousSettings != nil {
-		l = m.PreviousSettings.Size()
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovEvent(uint64(m.Role))
+	}
+	l = len(m.Account)
+	if l > 0 {
 		n += 1 + l + sovEvent(uint64(l))
 	}
-	l = m.NewSettings.Size()
-	n += 1 + l + sovEvent(uint64(l))
 	return n
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *EventIssued) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+func (m *EventRoleRevoked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovEvent(uint64(m.Role))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventVelocityLimitOverrideChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.PreviousAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CurrentAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventDenylistChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Denylisted {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventMaxSupplyUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PreviousMaxSupply != nil {
+		l = m.PreviousMaxSupply.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.CurrentMaxSupply.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventDEXSettingsChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousSettings != nil {
+		l = m.PreviousSettings.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.NewSettings.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventIssued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowEvent
 			}
@@ -1558,9 +2238,763 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			}
 			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subunit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subunit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType == 0 {
+				var v Feature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Feature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvent
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvent
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]Feature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Feature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Feature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DEXSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DEXSettings == nil {
+				m.DEXSettings = &DEXSettings{}
+			}
+			if err := m.DEXSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VelocityLimit == nil {
+				m.VelocityLimit = &VelocityLimit{}
+			}
+			if err := m.VelocityLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFrozenAmountChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFrozenAmountChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFrozenAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAmountClawedBack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAmountClawedBack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAmountClawedBack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subunit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1588,30 +3022,63 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subunit = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Precision = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Precision |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
 			}
-		case 6:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWhitelistedAmountChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWhitelistedAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1639,13 +3106,11 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1673,80 +3138,11 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType == 0 {
-				var v Feature
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Feature(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Features = append(m.Features, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvent
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvent
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				if elementCount != 0 && len(m.Features) == 0 {
-					m.Features = make([]Feature, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v Feature
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Feature(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Features = append(m.Features, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
-			}
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1774,13 +3170,13 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PreviousAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1808,13 +3204,63 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDEXLockedAmountChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDEXLockedAmountChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDEXLockedAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1842,11 +3288,11 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1874,11 +3320,11 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1906,13 +3352,15 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if err := m.PreviousAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 14:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DEXSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1922,25 +3370,23 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DEXSettings == nil {
-				m.DEXSettings = &DEXSettings{}
-			}
-			if err := m.DEXSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1965,7 +3411,7 @@ func (m *EventIssued) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFrozenAmountChanged) Unmarshal(dAtA []byte) error {
+func (m *EventDEXExpectedToReceiveAmountChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1988,10 +3434,10 @@ func (m *EventFrozenAmountChanged) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFrozenAmountChanged: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDEXExpectedToReceiveAmountChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFrozenAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDEXExpectedToReceiveAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2147,7 +3593,7 @@ func (m *EventFrozenAmountChanged) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAmountClawedBack) Unmarshal(dAtA []byte) error {
+func (m *EventAdminTransferred) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2170,15 +3616,15 @@ func (m *EventAmountClawedBack) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAmountClawedBack: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAdminTransferred: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAmountClawedBack: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAdminTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2206,11 +3652,11 @@ func (m *EventAmountClawedBack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2238,11 +3684,11 @@ func (m *EventAmountClawedBack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.PreviousAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2270,9 +3716,7 @@ func (m *EventAmountClawedBack) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CurrentAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2295,7 +3739,7 @@ func (m *EventAmountClawedBack) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
+func (m *EventAdminCleared) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2318,15 +3762,15 @@ func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWhitelistedAmountChanged: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAdminCleared: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWhitelistedAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAdminCleared: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2354,11 +3798,11 @@ func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2386,11 +3830,61 @@ func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.PreviousAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRoleGranted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRoleGranted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRoleGranted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2418,13 +3912,30 @@ func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PreviousAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2452,9 +3963,7 @@ func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2477,7 +3986,7 @@ func (m *EventWhitelistedAmountChanged) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDEXLockedAmountChanged) Unmarshal(dAtA []byte) error {
+func (m *EventRoleRevoked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2500,45 +4009,13 @@ func (m *EventDEXLockedAmountChanged) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDEXLockedAmountChanged: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventRoleRevoked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDEXLockedAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventRoleRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -2570,11 +4047,11 @@ func (m *EventDEXLockedAmountChanged) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAmount", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var stringLen uint64
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2584,29 +4061,14 @@ func (m *EventDEXLockedAmountChanged) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Role |= Role(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.PreviousAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2634,9 +4096,7 @@ func (m *EventDEXLockedAmountChanged) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2659,7 +4119,7 @@ func (m *EventDEXLockedAmountChanged) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDEXExpectedToReceiveAmountChanged) Unmarshal(dAtA []byte) error {
+func (m *EventVelocityLimitOverrideChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2682,10 +4142,10 @@ func (m *EventDEXExpectedToReceiveAmountChanged) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDEXExpectedToReceiveAmountChanged: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventVelocityLimitOverrideChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDEXExpectedToReceiveAmountChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventVelocityLimitOverrideChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2841,7 +4301,7 @@ func (m *EventDEXExpectedToReceiveAmountChanged) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAdminTransferred) Unmarshal(dAtA []byte) error {
+func (m *EventDenylistChanged) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2864,15 +4324,15 @@ func (m *EventAdminTransferred) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAdminTransferred: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDenylistChanged: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAdminTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDenylistChanged: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2900,11 +4360,11 @@ func (m *EventAdminTransferred) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2932,13 +4392,13 @@ func (m *EventAdminTransferred) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousAdmin = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAdmin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylisted", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2948,24 +4408,12 @@ func (m *EventAdminTransferred) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CurrentAdmin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Denylisted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2987,7 +4435,7 @@ func (m *EventAdminTransferred) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAdminCleared) Unmarshal(dAtA []byte) error {
+func (m *EventMaxSupplyUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3010,10 +4458,10 @@ func (m *EventAdminCleared) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAdminCleared: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMaxSupplyUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAdminCleared: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMaxSupplyUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3050,7 +4498,7 @@ func (m *EventAdminCleared) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousMaxSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3078,7 +4526,45 @@ func (m *EventAdminCleared) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousAdmin = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.Int
+			m.PreviousMaxSupply = &v
+			if err := m.PreviousMaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentMaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
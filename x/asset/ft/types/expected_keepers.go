@@ -39,11 +39,14 @@ type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	HasSupply(ctx context.Context, denom string) bool
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 }
 
 // DelayKeeper defines methods required from the delay keeper.
 type DelayKeeper interface {
 	DelayExecution(ctx sdk.Context, id string, data proto.Message, delay time.Duration) error
+	ExecuteAfter(ctx sdk.Context, id string, data proto.Message, time time.Time) error
+	RemoveExecuteAfter(ctx sdk.Context, id string, time time.Time) error
 }
 
 // StakingKeeper defines the expected staking interface.
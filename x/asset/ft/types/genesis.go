@@ -1,5 +1,11 @@
 package types
 
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // DefaultGenesis returns the default Token genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -51,6 +57,47 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, balance := range gs.VelocityLimitOverrides {
+		if err := ValidateAssetCoins(balance.Coins); err != nil {
+			return err
+		}
+	}
+
+	for _, usage := range gs.VelocityUsages {
+		if _, err := sdk.AccAddressFromBech32(usage.Account); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account %s", usage.Account)
+		}
+		if _, _, err := DeconstructDenom(usage.Denom); err != nil {
+			return err
+		}
+		for _, bucket := range usage.Usage.Buckets {
+			if bucket.Amount.IsNil() || bucket.Amount.IsNegative() {
+				return sdkerrors.Wrapf(ErrInvalidInput, "invalid velocity usage amount %s", bucket.Amount)
+			}
+			if bucket.DexLocked.IsNil() || bucket.DexLocked.IsNegative() || bucket.DexLocked.GT(bucket.Amount) {
+				return sdkerrors.Wrapf(ErrInvalidInput, "invalid velocity usage DEX locked amount %s", bucket.DexLocked)
+			}
+		}
+	}
+
+	for _, entry := range gs.Denylist {
+		if _, err := sdk.AccAddressFromBech32(entry.Account); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account %s", entry.Account)
+		}
+		if _, _, err := DeconstructDenom(entry.Denom); err != nil {
+			return err
+		}
+	}
+
+	for _, expiration := range gs.FreezeExpirations {
+		if _, err := sdk.AccAddressFromBech32(expiration.Account); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account %s", expiration.Account)
+		}
+		if _, _, err := DeconstructDenom(expiration.Denom); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
@@ -77,5 +124,19 @@ func (token Token) Validate() error {
 		return err
 	}
 
+	if err := ValidateRoleHolders(token.RoleHolders); err != nil {
+		return err
+	}
+
+	if token.MaxSupply != nil {
+		if err := ValidateMaxSupply(*token.MaxSupply); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateVelocityLimitSettings(token.Features, token.VelocityLimit); err != nil {
+		return err
+	}
+
 	return ValidateBurnRate(token.BurnRate)
 }
@@ -41,6 +41,14 @@ type GenesisState struct {
 	DEXLockedBalances            []Balance              `protobuf:"bytes,6,rep,name=dex_locked_balances,json=dexLockedBalances,proto3" json:"dex_locked_balances"`
 	DEXExpectedToReceiveBalances []Balance              `protobuf:"bytes,7,rep,name=dex_expected_to_receive_balances,json=dexExpectedToReceiveBalances,proto3" json:"dex_expected_to_receive_balances"`
 	DEXSettings                  []DEXSettingsWithDenom `protobuf:"bytes,8,rep,name=dex_settings,json=dexSettings,proto3" json:"dex_settings"`
+	// velocity_limit_overrides contains the velocity limit overrides on all of the accounts
+	VelocityLimitOverrides []Balance `protobuf:"bytes,9,rep,name=velocity_limit_overrides,json=velocityLimitOverrides,proto3" json:"velocity_limit_overrides"`
+	// velocity_usages contains the outflow accumulated by the accounts within the trailing windows
+	VelocityUsages []AccountVelocityUsage `protobuf:"bytes,10,rep,name=velocity_usages,json=velocityUsages,proto3" json:"velocity_usages"`
+	// freeze_expirations contains the expiration times of the frozen balances on all of the accounts
+	FreezeExpirations []FreezeExpiration `protobuf:"bytes,11,rep,name=freeze_expirations,json=freezeExpirations,proto3" json:"freeze_expirations"`
+	// denylist contains the denylisted accounts of all of the tokens
+	Denylist []DenylistEntry `protobuf:"bytes,12,rep,name=denylist,proto3" json:"denylist"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -132,6 +140,34 @@ func (m *GenesisState) GetDEXSettings() []DEXSettingsWithDenom {
 	return nil
 }
 
+func (m *GenesisState) GetVelocityLimitOverrides() []Balance {
+	if m != nil {
+		return m.VelocityLimitOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVelocityUsages() []AccountVelocityUsage {
+	if m != nil {
+		return m.VelocityUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFreezeExpirations() []FreezeExpiration {
+	if m != nil {
+		return m.FreezeExpirations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenylist() []DenylistEntry {
+	if m != nil {
+		return m.Denylist
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used module genesis genesis state.
 type Balance struct {
 	// address is the address of the balance holder.
@@ -292,56 +328,181 @@ func (m *DEXSettingsWithDenom) GetDEXSettings() DEXSettings {
 	return DEXSettings{}
 }
 
+type AccountVelocityUsage struct {
+	Account string        `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Usage   VelocityUsage `protobuf:"bytes,3,opt,name=usage,proto3" json:"usage"`
+}
+
+func (m *AccountVelocityUsage) Reset()         { *m = AccountVelocityUsage{} }
+func (m *AccountVelocityUsage) String() string { return proto.CompactTextString(m) }
+func (*AccountVelocityUsage) ProtoMessage()    {}
+func (*AccountVelocityUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d281657d6c91cb92, []int{4}
+}
+func (m *AccountVelocityUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountVelocityUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountVelocityUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountVelocityUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountVelocityUsage.Merge(m, src)
+}
+func (m *AccountVelocityUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountVelocityUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountVelocityUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountVelocityUsage proto.InternalMessageInfo
+
+func (m *AccountVelocityUsage) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *AccountVelocityUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AccountVelocityUsage) GetUsage() VelocityUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return VelocityUsage{}
+}
+
+// DenylistEntry defines the account denylisted for the denom.
+type DenylistEntry struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *DenylistEntry) Reset()         { *m = DenylistEntry{} }
+func (m *DenylistEntry) String() string { return proto.CompactTextString(m) }
+func (*DenylistEntry) ProtoMessage()    {}
+func (*DenylistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d281657d6c91cb92, []int{5}
+}
+func (m *DenylistEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenylistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenylistEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenylistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenylistEntry.Merge(m, src)
+}
+func (m *DenylistEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenylistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenylistEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenylistEntry proto.InternalMessageInfo
+
+func (m *DenylistEntry) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *DenylistEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.asset.ft.v1.GenesisState")
 	proto.RegisterType((*Balance)(nil), "coreum.asset.ft.v1.Balance")
 	proto.RegisterType((*PendingTokenUpgrade)(nil), "coreum.asset.ft.v1.PendingTokenUpgrade")
 	proto.RegisterType((*DEXSettingsWithDenom)(nil), "coreum.asset.ft.v1.DEXSettingsWithDenom")
+	proto.RegisterType((*AccountVelocityUsage)(nil), "coreum.asset.ft.v1.AccountVelocityUsage")
+	proto.RegisterType((*DenylistEntry)(nil), "coreum.asset.ft.v1.DenylistEntry")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/genesis.proto", fileDescriptor_d281657d6c91cb92) }
 
 var fileDescriptor_d281657d6c91cb92 = []byte{
-	// 612 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x6f, 0xb6, 0xb5, 0x03, 0x77, 0x80, 0x96, 0x56, 0x28, 0x1b, 0x53, 0x5a, 0x55, 0x48, 0xf4,
-	0x42, 0x42, 0x87, 0x34, 0x38, 0x77, 0x2d, 0x48, 0x88, 0xc3, 0x94, 0x0d, 0x6d, 0xe2, 0x52, 0xd2,
-	0xf8, 0x35, 0xb3, 0xb6, 0xda, 0x51, 0xec, 0x96, 0xc2, 0x1d, 0x24, 0x6e, 0x7c, 0x0e, 0x3e, 0xc9,
-	0x8e, 0x3b, 0x72, 0x1a, 0xa8, 0xfd, 0x22, 0xc8, 0x7f, 0xb2, 0x16, 0x96, 0xa9, 0x9c, 0x12, 0xfb,
-	0xfd, 0xfe, 0xf9, 0xc9, 0xcf, 0xa8, 0x1e, 0xb1, 0x14, 0x46, 0x43, 0x3f, 0xe4, 0x1c, 0x84, 0x3f,
-	0x10, 0xfe, 0xb8, 0xe5, 0xc7, 0x40, 0x81, 0x13, 0xee, 0x25, 0x29, 0x13, 0xcc, 0xb6, 0x35, 0xc2,
-	0x53, 0x08, 0x6f, 0x20, 0xbc, 0x71, 0x6b, 0xbb, 0x96, 0xc3, 0x4a, 0xc2, 0x34, 0x1c, 0x1a, 0xd2,
-	0xb6, 0x9b, 0x03, 0x10, 0xec, 0x0c, 0xe8, 0xbc, 0xce, 0x87, 0x8c, 0xfb, 0xfd, 0x90, 0x83, 0x3f,
-	0x6e, 0xf5, 0x41, 0x84, 0x2d, 0x3f, 0x62, 0x24, 0xab, 0x57, 0x63, 0x16, 0x33, 0xf5, 0xeb, 0xcb,
-	0x3f, 0xbd, 0xdb, 0xb8, 0x2a, 0xa2, 0x8d, 0xd7, 0x3a, 0xdc, 0xa1, 0x08, 0x05, 0xd8, 0x2f, 0x51,
-	0x49, 0xdb, 0x3a, 0x56, 0xdd, 0x6a, 0x96, 0x77, 0xb7, 0xbd, 0x9b, 0x61, 0xbd, 0x03, 0x85, 0x68,
-	0xaf, 0x5d, 0x5c, 0xd5, 0x0a, 0x81, 0xc1, 0xdb, 0x2f, 0x50, 0x49, 0xe5, 0xe1, 0xce, 0x4a, 0x7d,
-	0xb5, 0x59, 0xde, 0xdd, 0xca, 0x63, 0x1e, 0x49, 0x44, 0x46, 0xd4, 0x70, 0xfb, 0x0d, 0x7a, 0x30,
-	0x48, 0xd9, 0x67, 0xa0, 0xbd, 0x7e, 0x78, 0x1e, 0xd2, 0x08, 0xb8, 0xb3, 0xaa, 0x14, 0x1e, 0xe5,
-	0x29, 0xb4, 0x35, 0xc6, 0x68, 0xdc, 0xd7, 0x4c, 0xb3, 0xc9, 0xed, 0x23, 0x54, 0xfd, 0x78, 0x4a,
-	0x04, 0x9c, 0x13, 0x2e, 0x00, 0xcf, 0x05, 0xd7, 0xfe, 0x57, 0xb0, 0xb2, 0x40, 0xbf, 0x56, 0x8d,
-	0xd0, 0xc3, 0x04, 0x28, 0x26, 0x34, 0xee, 0xa9, 0xcc, 0xbd, 0x51, 0x12, 0xa7, 0x21, 0x06, 0xee,
-	0x14, 0x95, 0xee, 0x93, 0xdc, 0x26, 0x69, 0x86, 0x3a, 0xf1, 0x3b, 0x8d, 0x37, 0x1e, 0xd5, 0xe4,
-	0x66, 0x89, 0xdb, 0x03, 0x54, 0xc1, 0x30, 0xe9, 0x9d, 0xb3, 0xe8, 0x6c, 0x31, 0x79, 0x69, 0x79,
-	0xf2, 0x2d, 0xa9, 0x3a, 0xbd, 0xaa, 0x6d, 0x76, 0xba, 0x27, 0x6f, 0x15, 0x3d, 0x4b, 0x1e, 0x6c,
-	0x62, 0x98, 0xfc, 0xbd, 0x65, 0x7f, 0xb3, 0x50, 0x5d, 0x1a, 0xc1, 0x24, 0x81, 0x48, 0x36, 0x49,
-	0xb0, 0x5e, 0x0a, 0x11, 0x90, 0x31, 0xcc, 0x5d, 0xd7, 0x97, 0xbb, 0x3e, 0x36, 0xae, 0x3b, 0x9d,
-	0xee, 0x49, 0xd7, 0x68, 0x1d, 0xb1, 0x40, 0x2b, 0x5d, 0x07, 0xd8, 0xc1, 0x30, 0xb9, 0xb5, 0x6a,
-	0x7f, 0x40, 0x1b, 0x32, 0x0a, 0x07, 0x21, 0x08, 0x8d, 0xb9, 0x73, 0x47, 0xd9, 0x36, 0xf3, 0x6c,
-	0x3b, 0xdd, 0x93, 0x43, 0x03, 0x3b, 0x26, 0xe2, 0xb4, 0x03, 0x94, 0x0d, 0xdb, 0x15, 0x93, 0xa1,
-	0xbc, 0x50, 0x0d, 0xca, 0x18, 0x26, 0xd9, 0xa2, 0xf1, 0xd5, 0x42, 0xeb, 0xc6, 0xce, 0x76, 0xd0,
-	0x7a, 0x88, 0x71, 0x0a, 0x5c, 0x5f, 0xee, 0xbb, 0x41, 0xb6, 0xb4, 0x43, 0x54, 0x94, 0xa3, 0xb2,
-	0x78, 0x75, 0xe5, 0x30, 0x79, 0x72, 0x98, 0x3c, 0x33, 0x4c, 0xde, 0x3e, 0x23, 0xb4, 0xfd, 0x4c,
-	0x3a, 0xfe, 0xf8, 0x55, 0x6b, 0xc6, 0x44, 0x9c, 0x8e, 0xfa, 0x5e, 0xc4, 0x86, 0xbe, 0x99, 0x3c,
-	0xfd, 0x79, 0xca, 0xf1, 0x99, 0x2f, 0x3e, 0x25, 0xc0, 0x15, 0x81, 0x07, 0x5a, 0xb9, 0xd1, 0x45,
-	0x95, 0x9c, 0x1b, 0x61, 0x57, 0x51, 0x11, 0xcb, 0xa3, 0x98, 0x44, 0x7a, 0x21, 0x93, 0x8e, 0x21,
-	0xe5, 0x84, 0x51, 0x67, 0xa5, 0x6e, 0x35, 0xef, 0x05, 0xd9, 0xb2, 0xf1, 0xc5, 0x42, 0xd5, 0xbc,
-	0x56, 0xdc, 0x22, 0x74, 0xfc, 0x4f, 0x83, 0x57, 0xd4, 0x50, 0xd7, 0x96, 0x34, 0x78, 0x79, 0x5f,
-	0xdb, 0x07, 0x17, 0x53, 0xd7, 0xba, 0x9c, 0xba, 0xd6, 0xef, 0xa9, 0x6b, 0x7d, 0x9f, 0xb9, 0x85,
-	0xcb, 0x99, 0x5b, 0xf8, 0x39, 0x73, 0x0b, 0xef, 0xf7, 0x16, 0x3a, 0xb3, 0xaf, 0x6c, 0x5e, 0xb1,
-	0x11, 0xc5, 0xa1, 0x20, 0x8c, 0xfa, 0xe6, 0x11, 0x1b, 0xef, 0xf9, 0x93, 0xf9, 0x4b, 0xa6, 0xba,
-	0xd5, 0x2f, 0xa9, 0x17, 0xe9, 0xf9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x84, 0x6b, 0xff,
-	0x40, 0x05, 0x00, 0x00,
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x4f, 0x32, 0x47,
+	0x18, 0x66, 0x51, 0x50, 0x07, 0xad, 0x71, 0x20, 0x66, 0xb5, 0x06, 0x28, 0x31, 0x29, 0x97, 0xee,
+	0x16, 0x9b, 0xd8, 0x5e, 0x9a, 0xa6, 0x08, 0x36, 0x69, 0x4c, 0x6a, 0x50, 0xab, 0x6d, 0x0f, 0x74,
+	0xd9, 0x7d, 0x59, 0x27, 0xc2, 0x0e, 0xd9, 0x19, 0xb6, 0xe0, 0xbd, 0x4d, 0x7a, 0xeb, 0xdf, 0xd1,
+	0x73, 0xff, 0x08, 0x8f, 0x1e, 0x7b, 0xf2, 0xfb, 0x82, 0xff, 0xc8, 0x97, 0xf9, 0xb1, 0x80, 0xba,
+	0x06, 0xbf, 0x13, 0x3b, 0x33, 0xcf, 0xf3, 0xbc, 0xcf, 0xbe, 0xf3, 0xf0, 0x2e, 0x2a, 0xbb, 0x34,
+	0x84, 0x61, 0xdf, 0x76, 0x18, 0x03, 0x6e, 0x77, 0xb9, 0x1d, 0xd5, 0x6c, 0x1f, 0x02, 0x60, 0x84,
+	0x59, 0x83, 0x90, 0x72, 0x8a, 0xb1, 0x42, 0x58, 0x12, 0x61, 0x75, 0xb9, 0x15, 0xd5, 0x76, 0x4b,
+	0x09, 0xac, 0x81, 0x13, 0x3a, 0x7d, 0x4d, 0xda, 0x2d, 0x26, 0x00, 0x38, 0xbd, 0x81, 0x60, 0x76,
+	0xce, 0xfa, 0x94, 0xd9, 0x1d, 0x87, 0x81, 0x1d, 0xd5, 0x3a, 0xc0, 0x9d, 0x9a, 0xed, 0x52, 0x12,
+	0x9f, 0x17, 0x7c, 0xea, 0x53, 0xf9, 0x68, 0x8b, 0x27, 0xb5, 0x5b, 0xf9, 0x6f, 0x15, 0xad, 0xff,
+	0xa0, 0xcc, 0x9d, 0x71, 0x87, 0x03, 0xfe, 0x06, 0x65, 0x55, 0x59, 0xd3, 0x28, 0x1b, 0xd5, 0xdc,
+	0xc1, 0xae, 0xf5, 0xd2, 0xac, 0x75, 0x2a, 0x11, 0xf5, 0xe5, 0xbb, 0x87, 0x52, 0xaa, 0xa5, 0xf1,
+	0xf8, 0x6b, 0x94, 0x95, 0x7e, 0x98, 0x99, 0x2e, 0x2f, 0x55, 0x73, 0x07, 0x3b, 0x49, 0xcc, 0x73,
+	0x81, 0x88, 0x89, 0x0a, 0x8e, 0x7f, 0x44, 0x9b, 0xdd, 0x90, 0xde, 0x42, 0xd0, 0xee, 0x38, 0x3d,
+	0x27, 0x70, 0x81, 0x99, 0x4b, 0x52, 0xe1, 0xd3, 0x24, 0x85, 0xba, 0xc2, 0x68, 0x8d, 0x4f, 0x14,
+	0x53, 0x6f, 0x32, 0x7c, 0x8e, 0x0a, 0x7f, 0x5c, 0x13, 0x0e, 0x3d, 0xc2, 0x38, 0x78, 0x33, 0xc1,
+	0xe5, 0xb7, 0x0a, 0xe6, 0xe7, 0xe8, 0x53, 0x55, 0x17, 0x6d, 0x0f, 0x20, 0xf0, 0x48, 0xe0, 0xb7,
+	0xa5, 0xe7, 0xf6, 0x70, 0xe0, 0x87, 0x8e, 0x07, 0xcc, 0xcc, 0x48, 0xdd, 0xcf, 0x13, 0x9b, 0xa4,
+	0x18, 0xf2, 0x8d, 0x2f, 0x14, 0x5e, 0xd7, 0x28, 0x0c, 0x5e, 0x1e, 0x31, 0xdc, 0x45, 0x79, 0x0f,
+	0x46, 0xed, 0x1e, 0x75, 0x6f, 0xe6, 0x9d, 0x67, 0x17, 0x3b, 0xdf, 0x11, 0xaa, 0x93, 0x87, 0xd2,
+	0x56, 0xa3, 0x79, 0x75, 0x22, 0xe9, 0xb1, 0xf3, 0xd6, 0x96, 0x07, 0xa3, 0xa7, 0x5b, 0xf8, 0x6f,
+	0x03, 0x95, 0x45, 0x21, 0x18, 0x0d, 0xc0, 0x15, 0x4d, 0xe2, 0xb4, 0x1d, 0x82, 0x0b, 0x24, 0x82,
+	0x59, 0xd5, 0x95, 0xc5, 0x55, 0xf7, 0x75, 0xd5, 0xbd, 0x46, 0xf3, 0xaa, 0xa9, 0xb5, 0xce, 0x69,
+	0x4b, 0x29, 0x4d, 0x0d, 0xec, 0x79, 0x30, 0x7a, 0xf5, 0x14, 0xff, 0x8e, 0xd6, 0x85, 0x15, 0x06,
+	0x9c, 0x93, 0xc0, 0x67, 0xe6, 0xaa, 0x2c, 0x5b, 0x4d, 0x2a, 0xdb, 0x68, 0x5e, 0x9d, 0x69, 0xd8,
+	0x25, 0xe1, 0xd7, 0x0d, 0x08, 0x68, 0xbf, 0x9e, 0xd7, 0x1e, 0x72, 0x73, 0xa7, 0xad, 0x9c, 0x07,
+	0xa3, 0x78, 0x81, 0x7f, 0x43, 0x66, 0x04, 0x3d, 0xea, 0x12, 0x3e, 0x6e, 0xf7, 0x48, 0x9f, 0xf0,
+	0x36, 0x8d, 0x20, 0x0c, 0x89, 0xb8, 0xbc, 0xb5, 0xb7, 0x86, 0x62, 0x3b, 0x96, 0x38, 0x11, 0x0a,
+	0x3f, 0xc5, 0x02, 0xf8, 0x12, 0x6d, 0x4e, 0xc5, 0x87, 0xcc, 0xf1, 0x81, 0x99, 0xe8, 0xf5, 0x37,
+	0xf8, 0xde, 0x75, 0xe9, 0x30, 0xe0, 0x3f, 0x6b, 0xc6, 0x85, 0x20, 0xc4, 0x31, 0x8e, 0xe6, 0x37,
+	0x19, 0xfe, 0x05, 0xe1, 0x6e, 0x08, 0x70, 0x0b, 0xe2, 0x96, 0x48, 0xe8, 0x70, 0x42, 0x03, 0x66,
+	0xe6, 0xa4, 0xf6, 0x7e, 0x92, 0xf6, 0xb1, 0x44, 0x37, 0xa7, 0x60, 0xad, 0xbb, 0xd5, 0x7d, 0xb6,
+	0xcf, 0xf0, 0x11, 0x5a, 0xf5, 0x20, 0x18, 0x8b, 0x84, 0x9b, 0xeb, 0x52, 0xf0, 0xb3, 0xc4, 0x76,
+	0x6b, 0x4c, 0x33, 0xe0, 0xe1, 0x58, 0xab, 0x4d, 0x89, 0x95, 0xbf, 0x0c, 0xb4, 0xa2, 0x5b, 0x84,
+	0x4d, 0xb4, 0xe2, 0x78, 0x5e, 0x08, 0x4c, 0x8d, 0x8c, 0xb5, 0x56, 0xbc, 0xc4, 0x0e, 0xca, 0x88,
+	0x01, 0x34, 0x3f, 0x10, 0xc4, 0x88, 0xb2, 0xc4, 0x88, 0xb2, 0xf4, 0x88, 0xb2, 0x8e, 0x28, 0x09,
+	0xea, 0x5f, 0x0a, 0xfd, 0x7f, 0xdf, 0x95, 0xaa, 0x3e, 0xe1, 0xd7, 0xc3, 0x8e, 0xe5, 0xd2, 0xbe,
+	0xad, 0xe7, 0x99, 0xfa, 0xf9, 0x82, 0x79, 0x37, 0x36, 0x1f, 0x0f, 0x80, 0x49, 0x02, 0x6b, 0x29,
+	0xe5, 0x4a, 0x13, 0xe5, 0x13, 0xfe, 0x67, 0xb8, 0x80, 0x32, 0x9e, 0x08, 0x88, 0x76, 0xa4, 0x16,
+	0xc2, 0x69, 0x04, 0x21, 0x23, 0x34, 0x30, 0xd3, 0x65, 0xa3, 0xba, 0xd1, 0x8a, 0x97, 0x95, 0x3f,
+	0x0d, 0x54, 0x48, 0x0a, 0xd8, 0x2b, 0x42, 0x97, 0xcf, 0x62, 0x9b, 0x96, 0xa3, 0xb2, 0xb4, 0x20,
+	0xb6, 0x8b, 0xd3, 0x2a, 0x7d, 0x24, 0xc5, 0x44, 0x36, 0x59, 0xed, 0x4f, 0x9b, 0xac, 0x96, 0x33,
+	0x87, 0xe9, 0x79, 0x87, 0xdf, 0xa2, 0x8c, 0x0c, 0xa4, 0xb9, 0x24, 0xad, 0x25, 0x5e, 0x71, 0x52,
+	0x10, 0x15, 0xab, 0xf2, 0x1d, 0xda, 0x78, 0x12, 0x80, 0x8f, 0xad, 0x5f, 0x3f, 0xbd, 0x9b, 0x14,
+	0x8d, 0xfb, 0x49, 0xd1, 0x78, 0x3f, 0x29, 0x1a, 0xff, 0x3c, 0x16, 0x53, 0xf7, 0x8f, 0xc5, 0xd4,
+	0xff, 0x8f, 0xc5, 0xd4, 0xaf, 0x87, 0x73, 0x57, 0x7c, 0x24, 0x4d, 0x1d, 0xd3, 0x61, 0xe0, 0xc9,
+	0x74, 0xda, 0xfa, 0x1b, 0x17, 0x1d, 0xda, 0xa3, 0xd9, 0x87, 0x4e, 0x5e, 0x7b, 0x27, 0x2b, 0x3f,
+	0x58, 0x5f, 0x7d, 0x08, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xbc, 0x1f, 0x9b, 0x5f, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -364,6 +525,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denylist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.FreezeExpirations) > 0 {
+		for iNdEx := len(m.FreezeExpirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreezeExpirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VelocityUsages) > 0 {
+		for iNdEx := len(m.VelocityUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VelocityUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VelocityLimitOverrides) > 0 {
+		for iNdEx := len(m.VelocityLimitOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VelocityLimitOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.DEXSettings) > 0 {
 		for iNdEx := len(m.DEXSettings) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -594,6 +811,90 @@ func (m *DEXSettingsWithDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AccountVelocityUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountVelocityUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountVelocityUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenylistEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenylistEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenylistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -655,6 +956,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VelocityLimitOverrides) > 0 {
+		for _, e := range m.VelocityLimitOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VelocityUsages) > 0 {
+		for _, e := range m.VelocityUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FreezeExpirations) > 0 {
+		for _, e := range m.FreezeExpirations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, e := range m.Denylist {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -708,6 +1033,42 @@ func (m *DEXSettingsWithDenom) Size() (n int) {
 	return n
 }
 
+func (m *AccountVelocityUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Usage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *DenylistEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1014,21 +1375,157 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityLimitOverrides", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VelocityLimitOverrides = append(m.VelocityLimitOverrides, Balance{})
+			if err := m.VelocityLimitOverrides[len(m.VelocityLimitOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VelocityUsages = append(m.VelocityUsages, AccountVelocityUsage{})
+			if err := m.VelocityUsages[len(m.VelocityUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeExpirations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreezeExpirations = append(m.FreezeExpirations, FreezeExpiration{})
+			if err := m.FreezeExpirations[len(m.FreezeExpirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, DenylistEntry{})
+			if err := m.Denylist[len(m.Denylist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -1367,6 +1864,267 @@ func (m *DEXSettingsWithDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AccountVelocityUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountVelocityUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountVelocityUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenylistEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenylistEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenylistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
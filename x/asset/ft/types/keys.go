@@ -41,6 +41,16 @@ var (
 	DEXExpectedToReceiveBalancesKeyPrefix = []byte{0x10}
 	// DEXSettingsKeyPrefix defines the key prefix for the DEX settings.
 	DEXSettingsKeyPrefix = []byte{0x11}
+	// VelocityLimitOverridesKeyPrefix defines the key prefix to track velocity limit overrides.
+	VelocityLimitOverridesKeyPrefix = []byte{0x12}
+	// VelocityUsagesKeyPrefix defines the key prefix to track the outflow accumulated within the velocity window.
+	VelocityUsagesKeyPrefix = []byte{0x13}
+	// FreezeExpirationsKeyPrefix defines the key prefix to track the expiration times of the frozen balances.
+	FreezeExpirationsKeyPrefix = []byte{0x14}
+	// GlobalFreezeExpirationsKeyPrefix defines the key prefix to track the expiration times of the global freezes.
+	GlobalFreezeExpirationsKeyPrefix = []byte{0x15}
+	// DenylistKeyPrefix defines the key prefix to track the denylisted accounts.
+	DenylistKeyPrefix = []byte{0x16}
 )
 
 // StoreTrue keeps a value used by stores to indicate that key is present.
@@ -101,6 +111,36 @@ func CreateDEXSettingsKey(denom string) []byte {
 	return store.JoinKeys(DEXSettingsKeyPrefix, []byte(denom))
 }
 
+// CreateVelocityLimitOverridesKey creates the key for an account's velocity limit overrides.
+func CreateVelocityLimitOverridesKey(addr []byte) []byte {
+	return store.JoinKeys(VelocityLimitOverridesKeyPrefix, address.MustLengthPrefix(addr))
+}
+
+// CreateVelocityUsageKey creates the key for an account's velocity usage of the denom.
+func CreateVelocityUsageKey(addr []byte, denom string) []byte {
+	return store.JoinKeys(store.JoinKeys(VelocityUsagesKeyPrefix, address.MustLengthPrefix(addr)), []byte(denom))
+}
+
+// CreateFreezeExpirationKey creates the key for the expiration time of an account's frozen balance of the denom.
+func CreateFreezeExpirationKey(addr []byte, denom string) []byte {
+	return store.JoinKeys(store.JoinKeys(FreezeExpirationsKeyPrefix, address.MustLengthPrefix(addr)), []byte(denom))
+}
+
+// CreateGlobalFreezeExpirationKey creates the key for the expiration time of the global freeze of the denom.
+func CreateGlobalFreezeExpirationKey(denom string) []byte {
+	return store.JoinKeys(GlobalFreezeExpirationsKeyPrefix, []byte(denom))
+}
+
+// CreateDenylistKey creates the key for the account denylisted for the denom.
+func CreateDenylistKey(addr []byte, denom string) []byte {
+	return store.JoinKeys(CreateDenylistPrefix(addr), []byte(denom))
+}
+
+// CreateDenylistPrefix creates the key prefix for the denoms the account is denylisted for.
+func CreateDenylistPrefix(addr []byte) []byte {
+	return store.JoinKeys(DenylistKeyPrefix, address.MustLengthPrefix(addr))
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the prefix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -35,8 +35,14 @@ var (
 	_ extendedMsg = &MsgGloballyUnfreeze{}
 	_ extendedMsg = &MsgClawback{}
 	_ extendedMsg = &MsgSetWhitelistedLimit{}
+	_ extendedMsg = &MsgSetVelocityLimitOverride{}
+	_ extendedMsg = &MsgAddToDenylist{}
+	_ extendedMsg = &MsgRemoveFromDenylist{}
 	_ extendedMsg = &MsgTransferAdmin{}
 	_ extendedMsg = &MsgClearAdmin{}
+	_ extendedMsg = &MsgGrantRole{}
+	_ extendedMsg = &MsgRevokeRole{}
+	_ extendedMsg = &MsgUpdateMaxSupply{}
 	_ extendedMsg = &MsgUpdateParams{}
 	_ extendedMsg = &MsgUpdateDEXUnifiedRefAmount{}
 	_ extendedMsg = &MsgUpdateDEXWhitelistedDenoms{}
@@ -53,10 +59,16 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgGloballyFreeze{}, ModuleName+"/MsgGloballyFreeze")
 	legacy.RegisterAminoMsg(cdc, &MsgGloballyUnfreeze{}, ModuleName+"/MsgGloballyUnfreeze")
 	legacy.RegisterAminoMsg(cdc, &MsgSetWhitelistedLimit{}, ModuleName+"/MsgSetWhitelistedLimit")
+	legacy.RegisterAminoMsg(cdc, &MsgSetVelocityLimitOverride{}, ModuleName+"/MsgSetVelocityLimitOverride")
+	legacy.RegisterAminoMsg(cdc, &MsgAddToDenylist{}, ModuleName+"/MsgAddToDenylist")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveFromDenylist{}, ModuleName+"/MsgRemoveFromDenylist")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, ModuleName+"/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgClawback{}, ModuleName+"/MsgClawback")
 	legacy.RegisterAminoMsg(cdc, &MsgClearAdmin{}, ModuleName+"/MsgClearAdmin")
 	legacy.RegisterAminoMsg(cdc, &MsgTransferAdmin{}, ModuleName+"/MsgTransferAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgGrantRole{}, ModuleName+"/MsgGrantRole")
+	legacy.RegisterAminoMsg(cdc, &MsgRevokeRole{}, ModuleName+"/MsgRevokeRole")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateMaxSupply{}, ModuleName+"/MsgUpdateMaxSupply")
 	legacy.RegisterAminoMsg(
 		cdc, &MsgUpdateDEXUnifiedRefAmount{}, ModuleName+"/MsgUpdateDEXUnifiedRefAmount",
 	)
@@ -106,6 +118,19 @@ func (m MsgIssue) ValidateBasic() error {
 		}
 	}
 
+	if m.MaxSupply != nil {
+		if err := ValidateMaxSupply(*m.MaxSupply); err != nil {
+			return err
+		}
+		if m.InitialAmount.GT(*m.MaxSupply) {
+			return sdkerrors.Wrapf(ErrInvalidInput, "initial amount is greater than max supply")
+		}
+	}
+
+	if err := ValidateVelocityLimitSettings(m.Features, m.VelocityLimit); err != nil {
+		return err
+	}
+
 	if len(m.Description) > MaxDescriptionLength {
 		return sdkerrors.Wrapf(
 			ErrInvalidInput,
@@ -282,6 +307,34 @@ func (m MsgSetWhitelistedLimit) ValidateBasic() error {
 	return m.Coin.Validate()
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m MsgSetVelocityLimitOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(m.Coin.Denom)
+	if err != nil {
+		return err
+	}
+
+	return m.Coin.Validate()
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgAddToDenylist) ValidateBasic() error {
+	return validateDenylistMsg(m.Sender, m.Account, m.Denom)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRemoveFromDenylist) ValidateBasic() error {
+	return validateDenylistMsg(m.Sender, m.Account, m.Denom)
+}
+
 // ValidateBasic checks that message fields are valid.
 func (m MsgTransferAdmin) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
@@ -314,6 +367,29 @@ func (m MsgClearAdmin) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m MsgGrantRole) ValidateBasic() error {
+	return validateRoleMsg(m.Sender, m.Denom, m.Role, m.Account)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRevokeRole) ValidateBasic() error {
+	return validateRoleMsg(m.Sender, m.Denom, m.Role, m.Account)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgUpdateMaxSupply) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return ValidateMaxSupply(m.MaxSupply)
+}
+
 // ValidateBasic checks that message fields are valid.
 func (m MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
@@ -340,3 +416,35 @@ func (m MsgUpdateDEXWhitelistedDenoms) ValidateBasic() error {
 
 	return ValidateWhitelistedDenoms(m.WhitelistedDenoms)
 }
+
+func validateRoleMsg(sender, denom string, role Role, account string) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(denom); err != nil {
+		return err
+	}
+
+	return ValidateRole(role)
+}
+
+func validateDenylistMsg(sender, account, denom string) error {
+	if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(denom); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -63,6 +64,47 @@ func TestMsgIssue_ValidateBasic(t *testing.T) {
 			},
 			expectedError: cosmoserrors.ErrInvalidAddress,
 		},
+		{
+			name: "valid_velocity_limit",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Features = []types.Feature{types.Feature_velocity_limiting}
+				msg.VelocityLimit = &types.VelocityLimit{
+					Window: time.Hour,
+					Amount: sdkmath.NewInt(100),
+				}
+				return msg
+			},
+		},
+		{
+			name: "invalid_velocity_limit_without_feature",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.VelocityLimit = &types.VelocityLimit{
+					Window: time.Hour,
+					Amount: sdkmath.NewInt(100),
+				}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_velocity_limit_missing",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Features = []types.Feature{types.Feature_velocity_limiting}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_velocity_limit_window",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Features = []types.Feature{types.Feature_velocity_limiting}
+				msg.VelocityLimit = &types.VelocityLimit{
+					Amount: sdkmath.NewInt(100),
+				}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
 		{
 			name: "invalid_missing_symbol",
 			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
@@ -672,6 +714,157 @@ func TestMsgClearAdmin_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgGrantRole_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name                string
+		message             types.MsgGrantRole
+		expectedError       error
+		expectedErrorString string
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_freezer,
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_freezer,
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account address",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_freezer,
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Role:    types.Role_freezer,
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+			},
+			expectedErrorString: "invalid denom",
+		},
+		{
+			name: "invalid role",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role(100),
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "unspecified role",
+			message: types.MsgGrantRole{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Role:    types.Role_role_unspecified,
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.message.ValidateBasic()
+			switch {
+			case tc.expectedError == nil && tc.expectedErrorString == "":
+				requireT.NoError(err)
+			case tc.expectedErrorString != "":
+				requireT.Contains(err.Error(), tc.expectedErrorString)
+			default:
+				requireT.ErrorIs(err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateMaxSupply_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgUpdateMaxSupply{
+		Sender:    "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Denom:     "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		MaxSupply: sdkmath.NewInt(1000),
+	}
+
+	testCases := []struct {
+		name          string
+		messageFunc   func(msg types.MsgUpdateMaxSupply) types.MsgUpdateMaxSupply
+		expectedError error
+	}{
+		{
+			name: "valid",
+			messageFunc: func(msg types.MsgUpdateMaxSupply) types.MsgUpdateMaxSupply {
+				return msg
+			},
+		},
+		{
+			name: "invalid_sender",
+			messageFunc: func(msg types.MsgUpdateMaxSupply) types.MsgUpdateMaxSupply {
+				msg.Sender = "invalid"
+				return msg
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid_denom",
+			messageFunc: func(msg types.MsgUpdateMaxSupply) types.MsgUpdateMaxSupply {
+				msg.Denom = "abc"
+				return msg
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "zero_max_supply",
+			messageFunc: func(msg types.MsgUpdateMaxSupply) types.MsgUpdateMaxSupply {
+				msg.MaxSupply = sdkmath.ZeroInt()
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "negative_max_supply",
+			messageFunc: func(msg types.MsgUpdateMaxSupply) types.MsgUpdateMaxSupply {
+				msg.MaxSupply = sdkmath.NewInt(-1)
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tc.messageFunc(validMessage).ValidateBasic()
+			if tc.expectedError == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
 func TestMsgUpdateDEXUnifiedRefAmount_ValidateBasic(t *testing.T) {
 	validMessage := types.MsgUpdateDEXUnifiedRefAmount{
 		Sender:           sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
@@ -856,6 +1049,33 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"assetft/MsgSetWhitelistedLimit","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgSetVelocityLimitOverride{}),
+			msg: &types.MsgSetVelocityLimitOverride{
+				Sender:  address,
+				Account: address,
+				Coin:    coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgSetVelocityLimitOverride","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgAddToDenylist{}),
+			msg: &types.MsgAddToDenylist{
+				Sender:  address,
+				Account: address,
+				Denom:   coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgAddToDenylist","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgRemoveFromDenylist{}),
+			msg: &types.MsgRemoveFromDenylist{
+				Sender:  address,
+				Account: address,
+				Denom:   coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgRemoveFromDenylist","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
 		{
 			name: sdk.MsgTypeURL(&types.MsgUpdateDEXUnifiedRefAmount{}),
 			msg: &types.MsgUpdateDEXUnifiedRefAmount{
@@ -13,19 +13,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -559,6 +563,8 @@ type QueryFrozenBalancesResponse struct {
 	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	// balances contains the frozen balances on the queried account
 	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+	// expirations contains the expiration times of the returned frozen balances which expire
+	Expirations []FreezeExpiration `protobuf:"bytes,3,rep,name=expirations,proto3" json:"expirations"`
 }
 
 func (m *QueryFrozenBalancesResponse) Reset()         { *m = QueryFrozenBalancesResponse{} }
@@ -608,6 +614,13 @@ func (m *QueryFrozenBalancesResponse) GetBalances() github_com_cosmos_cosmos_sdk
 	return nil
 }
 
+func (m *QueryFrozenBalancesResponse) GetExpirations() []FreezeExpiration {
+	if m != nil {
+		return m.Expirations
+	}
+	return nil
+}
+
 type QueryFrozenBalanceRequest struct {
 	// account specifies the account onto which we query frozen balances
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -665,6 +678,8 @@ func (m *QueryFrozenBalanceRequest) GetDenom() string {
 type QueryFrozenBalanceResponse struct {
 	// balance contains the frozen balance with the queried account and denom
 	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+	// expires_at is the time the frozen balance is automatically unfrozen at, not set if the balance doesn't expire
+	ExpiresAt *time.Time `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
 }
 
 func (m *QueryFrozenBalanceResponse) Reset()         { *m = QueryFrozenBalanceResponse{} }
@@ -707,6 +722,13 @@ func (m *QueryFrozenBalanceResponse) GetBalance() types.Coin {
 	return types.Coin{}
 }
 
+func (m *QueryFrozenBalanceResponse) GetExpiresAt() *time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return nil
+}
+
 type QueryWhitelistedBalancesRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -1004,6 +1026,312 @@ func (m *QueryDEXSettingsResponse) GetDEXSettings() DEXSettings {
 	return DEXSettings{}
 }
 
+type QueryVelocityAllowanceRequest struct {
+	// account specifies the account onto which we query the velocity allowance
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// denom specifies the denom of the velocity allowance
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryVelocityAllowanceRequest) Reset()         { *m = QueryVelocityAllowanceRequest{} }
+func (m *QueryVelocityAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVelocityAllowanceRequest) ProtoMessage()    {}
+func (*QueryVelocityAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{20}
+}
+func (m *QueryVelocityAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVelocityAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVelocityAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVelocityAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVelocityAllowanceRequest.Merge(m, src)
+}
+func (m *QueryVelocityAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVelocityAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVelocityAllowanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVelocityAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryVelocityAllowanceRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryVelocityAllowanceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryVelocityAllowanceResponse struct {
+	// allowance is the amount the account is allowed to send within the trailing window
+	Allowance types.Coin `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance"`
+}
+
+func (m *QueryVelocityAllowanceResponse) Reset()         { *m = QueryVelocityAllowanceResponse{} }
+func (m *QueryVelocityAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVelocityAllowanceResponse) ProtoMessage()    {}
+func (*QueryVelocityAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{21}
+}
+func (m *QueryVelocityAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVelocityAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVelocityAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVelocityAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVelocityAllowanceResponse.Merge(m, src)
+}
+func (m *QueryVelocityAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVelocityAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVelocityAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVelocityAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryVelocityAllowanceResponse) GetAllowance() types.Coin {
+	if m != nil {
+		return m.Allowance
+	}
+	return types.Coin{}
+}
+
+type QueryDenylistedDenomsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// account specifies the account onto which we query the denylisted denoms
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *QueryDenylistedDenomsRequest) Reset()         { *m = QueryDenylistedDenomsRequest{} }
+func (m *QueryDenylistedDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenylistedDenomsRequest) ProtoMessage()    {}
+func (*QueryDenylistedDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{22}
+}
+func (m *QueryDenylistedDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenylistedDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenylistedDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenylistedDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenylistedDenomsRequest.Merge(m, src)
+}
+func (m *QueryDenylistedDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenylistedDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenylistedDenomsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenylistedDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryDenylistedDenomsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryDenylistedDenomsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+type QueryDenylistedDenomsResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denoms contains the denoms the queried account is denylisted for
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *QueryDenylistedDenomsResponse) Reset()         { *m = QueryDenylistedDenomsResponse{} }
+func (m *QueryDenylistedDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenylistedDenomsResponse) ProtoMessage()    {}
+func (*QueryDenylistedDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{23}
+}
+func (m *QueryDenylistedDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenylistedDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenylistedDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenylistedDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenylistedDenomsResponse.Merge(m, src)
+}
+func (m *QueryDenylistedDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenylistedDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenylistedDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenylistedDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryDenylistedDenomsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryDenylistedDenomsResponse) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+type QueryDenylistedRequest struct {
+	// account specifies the account onto which we query the denylisting
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// denom specifies the denom of the denylisting
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryDenylistedRequest) Reset()         { *m = QueryDenylistedRequest{} }
+func (m *QueryDenylistedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenylistedRequest) ProtoMessage()    {}
+func (*QueryDenylistedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{24}
+}
+func (m *QueryDenylistedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenylistedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenylistedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenylistedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenylistedRequest.Merge(m, src)
+}
+func (m *QueryDenylistedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenylistedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenylistedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenylistedRequest proto.InternalMessageInfo
+
+func (m *QueryDenylistedRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *QueryDenylistedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryDenylistedResponse struct {
+	// denylisted is true if the account is denylisted for the denom
+	Denylisted bool `protobuf:"varint,1,opt,name=denylisted,proto3" json:"denylisted,omitempty"`
+}
+
+func (m *QueryDenylistedResponse) Reset()         { *m = QueryDenylistedResponse{} }
+func (m *QueryDenylistedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenylistedResponse) ProtoMessage()    {}
+func (*QueryDenylistedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{25}
+}
+func (m *QueryDenylistedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenylistedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenylistedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenylistedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenylistedResponse.Merge(m, src)
+}
+func (m *QueryDenylistedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenylistedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenylistedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenylistedResponse proto.InternalMessageInfo
+
+func (m *QueryDenylistedResponse) GetDenylisted() bool {
+	if m != nil {
+		return m.Denylisted
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.asset.ft.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.asset.ft.v1.QueryParamsResponse")
@@ -1025,88 +1353,111 @@ func init() {
 	proto.RegisterType((*QueryWhitelistedBalanceResponse)(nil), "coreum.asset.ft.v1.QueryWhitelistedBalanceResponse")
 	proto.RegisterType((*QueryDEXSettingsRequest)(nil), "coreum.asset.ft.v1.QueryDEXSettingsRequest")
 	proto.RegisterType((*QueryDEXSettingsResponse)(nil), "coreum.asset.ft.v1.QueryDEXSettingsResponse")
+	proto.RegisterType((*QueryVelocityAllowanceRequest)(nil), "coreum.asset.ft.v1.QueryVelocityAllowanceRequest")
+	proto.RegisterType((*QueryVelocityAllowanceResponse)(nil), "coreum.asset.ft.v1.QueryVelocityAllowanceResponse")
+	proto.RegisterType((*QueryDenylistedDenomsRequest)(nil), "coreum.asset.ft.v1.QueryDenylistedDenomsRequest")
+	proto.RegisterType((*QueryDenylistedDenomsResponse)(nil), "coreum.asset.ft.v1.QueryDenylistedDenomsResponse")
+	proto.RegisterType((*QueryDenylistedRequest)(nil), "coreum.asset.ft.v1.QueryDenylistedRequest")
+	proto.RegisterType((*QueryDenylistedResponse)(nil), "coreum.asset.ft.v1.QueryDenylistedResponse")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/query.proto", fileDescriptor_e9fe336d9bdb8f05) }
 
 var fileDescriptor_e9fe336d9bdb8f05 = []byte{
-	// 1214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0xa6, 0x89, 0xd3, 0xdf, 0xe4, 0x17, 0x50, 0x27, 0x21, 0xb8, 0xdb, 0x62, 0x87, 0x15,
-	0x24, 0xa1, 0x34, 0x3b, 0x24, 0x69, 0x3e, 0x10, 0x1f, 0x2d, 0xce, 0x07, 0x84, 0x54, 0xc2, 0xb8,
-	0x85, 0x54, 0x08, 0x29, 0x5a, 0x7b, 0x27, 0xce, 0x2a, 0xf1, 0x8e, 0xeb, 0x19, 0x1b, 0x87, 0xaa,
-	0x1c, 0xca, 0x01, 0x8e, 0x95, 0x38, 0xf0, 0x1f, 0x70, 0xe8, 0x89, 0x13, 0x17, 0xce, 0x48, 0x15,
-	0x97, 0x54, 0x82, 0x03, 0xe2, 0x10, 0x50, 0x82, 0xc4, 0xbf, 0x81, 0x76, 0xe6, 0x5d, 0xef, 0x1a,
-	0xaf, 0xed, 0x75, 0x88, 0x90, 0x38, 0x79, 0x77, 0xf6, 0x7d, 0x9e, 0xf7, 0x79, 0x66, 0xde, 0x99,
-	0x79, 0x65, 0x94, 0x2a, 0xb0, 0x0a, 0xad, 0x96, 0x88, 0xc5, 0x39, 0x15, 0x64, 0x47, 0x90, 0xda,
-	0x2c, 0xb9, 0x5b, 0xa5, 0x95, 0x03, 0xb3, 0x5c, 0x61, 0x82, 0x61, 0xac, 0xbe, 0x9b, 0xf2, 0xbb,
-	0xb9, 0x23, 0xcc, 0xda, 0xac, 0x9e, 0x8e, 0xc0, 0x94, 0xad, 0x8a, 0x55, 0xe2, 0x0a, 0xa4, 0x47,
-	0x91, 0x0a, 0xb6, 0x47, 0x5d, 0xf8, 0x7e, 0xa5, 0xc0, 0x78, 0x89, 0x71, 0x92, 0xb7, 0x38, 0x55,
-	0xd9, 0x48, 0x6d, 0x36, 0x4f, 0x85, 0xe5, 0xf1, 0x14, 0x1d, 0xd7, 0x12, 0x0e, 0x73, 0x03, 0xae,
-	0x20, 0xd6, 0x8f, 0x2a, 0x30, 0xc7, 0xff, 0x7e, 0x09, 0xbe, 0xfb, 0x34, 0x61, 0xf5, 0xfa, 0x58,
-	0x91, 0x15, 0x99, 0x7c, 0x24, 0xde, 0x13, 0x8c, 0x5e, 0x2e, 0x32, 0x56, 0xdc, 0xa7, 0xc4, 0x2a,
-	0x3b, 0xc4, 0x72, 0x5d, 0x26, 0x64, 0x3e, 0x10, 0x6f, 0x8c, 0x21, 0xfc, 0xbe, 0x47, 0x91, 0x95,
-	0x8e, 0x72, 0xf4, 0x6e, 0x95, 0x72, 0x61, 0xbc, 0x87, 0x46, 0x9b, 0x46, 0x79, 0x99, 0xb9, 0x9c,
-	0xe2, 0x65, 0x94, 0x50, 0xce, 0x93, 0xda, 0x84, 0x36, 0x3d, 0x3c, 0xa7, 0x9b, 0xad, 0xf3, 0x65,
-	0x2a, 0x4c, 0x66, 0xe0, 0xf1, 0x51, 0xba, 0x2f, 0x07, 0xf1, 0xc6, 0x4b, 0xe8, 0x82, 0x24, 0xbc,
-	0xed, 0xcd, 0x0b, 0x64, 0xc1, 0x63, 0x68, 0xd0, 0xa6, 0x2e, 0x2b, 0x49, 0xb6, 0xff, 0xe5, 0xd4,
-	0x8b, 0xb1, 0x09, 0x8a, 0x20, 0x14, 0x52, 0x2f, 0xa0, 0x41, 0x39, 0xa7, 0x90, 0xf9, 0x62, 0x54,
-	0x66, 0x89, 0x80, 0xc4, 0x2a, 0xda, 0x58, 0x46, 0x13, 0x01, 0xd9, 0x07, 0xe5, 0x62, 0xc5, 0xb2,
-	0xe9, 0x2d, 0x61, 0x89, 0x2a, 0xa7, 0xbc, 0xb3, 0x0c, 0x86, 0x9e, 0xef, 0x80, 0x04, 0x55, 0xef,
-	0xa2, 0xf3, 0x1c, 0xc6, 0x40, 0xd8, 0x74, 0x5b, 0x61, 0x7f, 0xe3, 0x00, 0x9d, 0x0d, 0xbc, 0x21,
-	0xc2, 0xbe, 0x1b, 0xe2, 0xd6, 0x11, 0x0a, 0x8a, 0x04, 0x72, 0x4c, 0x9a, 0xaa, 0x0a, 0x4c, 0xaf,
-	0x4a, 0x4c, 0x55, 0x01, 0x50, 0x2b, 0x66, 0xd6, 0x2a, 0x52, 0xc0, 0xe6, 0x42, 0x48, 0x3c, 0x8e,
-	0x12, 0x0e, 0xe7, 0x55, 0x5a, 0x49, 0xf6, 0x4b, 0x97, 0xf0, 0x66, 0x7c, 0xad, 0xc1, 0x52, 0xfb,
-	0x69, 0xc1, 0xd9, 0xdb, 0x11, 0x79, 0xa7, 0xba, 0xe6, 0x55, 0xe0, 0xa6, 0xc4, 0x4b, 0x28, 0x21,
-	0x97, 0x82, 0x27, 0xfb, 0x27, 0xce, 0xc5, 0x59, 0x39, 0x08, 0x37, 0xd6, 0x40, 0x58, 0xc6, 0xda,
-	0xb7, 0xdc, 0x82, 0x6f, 0x0a, 0x27, 0xd1, 0x90, 0x55, 0x28, 0xb0, 0xaa, 0x2b, 0x60, 0xbd, 0xfc,
-	0xd7, 0x60, 0x1d, 0xfb, 0xc3, 0xeb, 0xf8, 0x70, 0x00, 0x8d, 0x35, 0xf3, 0x80, 0xc3, 0x25, 0x34,
-	0x94, 0x57, 0x43, 0x8a, 0x28, 0xf3, 0x9c, 0x97, 0xfe, 0xd7, 0xa3, 0xf4, 0x33, 0xca, 0x25, 0xb7,
-	0xf7, 0x4c, 0x87, 0x91, 0x92, 0x25, 0x76, 0xcd, 0x0d, 0x57, 0xe4, 0xfc, 0x68, 0x7c, 0x1d, 0x0d,
-	0x7f, 0xb2, 0xeb, 0x08, 0xba, 0xef, 0x70, 0x41, 0x6d, 0x95, 0xad, 0x1b, 0x38, 0x8c, 0xc0, 0x0b,
-	0x28, 0xb1, 0x53, 0x61, 0x9f, 0x52, 0x37, 0x79, 0x2e, 0x0e, 0x16, 0x82, 0x3d, 0xd8, 0x3e, 0x2b,
-	0xec, 0x51, 0x3b, 0x39, 0x10, 0x0b, 0xa6, 0x82, 0xf1, 0x06, 0xba, 0xa0, 0x9e, 0xb6, 0x1d, 0x77,
-	0xbb, 0x46, 0xb9, 0x70, 0xdc, 0x62, 0x72, 0x30, 0x0e, 0xc3, 0xd3, 0x0a, 0xb7, 0xe1, 0x7e, 0xa8,
-	0x50, 0x38, 0x8b, 0x46, 0x02, 0x2a, 0x9b, 0xd6, 0x93, 0x09, 0x49, 0x73, 0xb5, 0x23, 0xcd, 0xf1,
-	0x51, 0x7a, 0xf8, 0x26, 0x10, 0xad, 0xae, 0xdd, 0xc9, 0x0d, 0xfb, 0xac, 0xab, 0xb4, 0x8e, 0x39,
-	0xd2, 0x69, 0xbd, 0x4c, 0x0b, 0x82, 0xda, 0xdb, 0x82, 0x6d, 0x57, 0x68, 0x81, 0x3a, 0x35, 0xea,
-	0xd3, 0x0f, 0x49, 0xfa, 0xa5, 0x6e, 0xf4, 0xe3, 0x6b, 0x40, 0x71, 0x9b, 0xe5, 0x14, 0x81, 0xca,
-	0x34, 0x4e, 0x23, 0xc6, 0x69, 0xdd, 0xf8, 0x0c, 0xe9, 0xb2, 0x22, 0xd6, 0xe5, 0xbc, 0x42, 0x5d,
-	0x9c, 0xf9, 0x8e, 0x0b, 0x15, 0x6a, 0x7f, 0x53, 0xa1, 0x1a, 0x87, 0x1a, 0xba, 0x14, 0x29, 0xe0,
-	0xac, 0xf7, 0x5e, 0x11, 0x9d, 0x87, 0xa2, 0x0d, 0xef, 0xbe, 0x80, 0xc6, 0x27, 0x58, 0x61, 0x8e,
-	0x9b, 0x79, 0xc5, 0x9b, 0xe6, 0x47, 0xbf, 0xa5, 0xa7, 0x8b, 0x8e, 0xd8, 0xad, 0xe6, 0xcd, 0x02,
-	0x2b, 0x11, 0xb8, 0x6d, 0xd4, 0xcf, 0x0c, 0xb7, 0xf7, 0x88, 0x38, 0x28, 0x53, 0x2e, 0x01, 0x3c,
-	0xd7, 0x20, 0x37, 0x36, 0xd1, 0xc5, 0x56, 0x43, 0xa7, 0xdd, 0xb1, 0x5b, 0x51, 0xcb, 0xd3, 0x98,
-	0x9c, 0x57, 0x9b, 0xb7, 0x6d, 0x47, 0x4b, 0xea, 0x40, 0xf1, 0xe3, 0x8d, 0xcf, 0x35, 0x94, 0x96,
-	0xcc, 0x5b, 0xc1, 0x66, 0xfc, 0xf7, 0x57, 0xff, 0x67, 0x0d, 0xee, 0xa4, 0x48, 0x15, 0xff, 0xd9,
-	0x12, 0xc8, 0xa2, 0x54, 0x1b, 0x57, 0xa7, 0xad, 0x83, 0x8f, 0xdb, 0xae, 0xd6, 0x59, 0x14, 0x03,
-	0x41, 0xcf, 0x4a, 0xf6, 0xd5, 0xb5, 0x3b, 0xb7, 0xa8, 0xf0, 0x8e, 0xb7, 0x2e, 0x0d, 0x01, 0x47,
-	0xc9, 0x56, 0x00, 0xe8, 0xd8, 0x42, 0xff, 0xb7, 0x69, 0x7d, 0x9b, 0xc3, 0x38, 0x88, 0x49, 0x47,
-	0x5d, 0x75, 0x21, 0x78, 0x66, 0xd4, 0x93, 0xe4, 0x9d, 0x8f, 0x61, 0xce, 0x61, 0x9b, 0xd6, 0xfd,
-	0x97, 0xb9, 0xc3, 0x11, 0x34, 0x28, 0xb3, 0xe2, 0x07, 0x1a, 0x4a, 0xa8, 0xd6, 0x0a, 0x4f, 0x46,
-	0xf1, 0xb6, 0x76, 0x71, 0xfa, 0x54, 0xd7, 0x38, 0x25, 0xdf, 0x98, 0xfa, 0xf2, 0xcf, 0x6f, 0xaf,
-	0x68, 0x0f, 0x7e, 0xfa, 0xe3, 0xab, 0xfe, 0xcb, 0x58, 0x27, 0x6d, 0x1b, 0x5e, 0x29, 0x42, 0x35,
-	0x0a, 0x1d, 0x44, 0x34, 0x35, 0x30, 0x1d, 0x44, 0x34, 0x77, 0x1c, 0x31, 0x44, 0xa8, 0xc6, 0x00,
-	0x7f, 0xa1, 0xa1, 0x41, 0x89, 0xc5, 0x2f, 0x76, 0xe6, 0xf6, 0x25, 0x4c, 0x76, 0x0b, 0x03, 0x05,
-	0x24, 0x50, 0xf0, 0x02, 0x36, 0xda, 0x2b, 0x20, 0xf7, 0x64, 0x45, 0xdc, 0xc7, 0x3f, 0x68, 0x68,
-	0x2c, 0xaa, 0xb7, 0xc3, 0xd7, 0x3a, 0x67, 0x8c, 0x6e, 0x44, 0xf5, 0x85, 0x1e, 0x51, 0x20, 0xfb,
-	0x46, 0x20, 0x7b, 0x01, 0xcf, 0x77, 0x97, 0x4d, 0xaa, 0x8a, 0x68, 0xc6, 0x6f, 0x3d, 0xf1, 0x23,
-	0x0d, 0x0d, 0xc1, 0xd6, 0xc2, 0xed, 0xd7, 0xab, 0x79, 0x3b, 0xeb, 0xd3, 0xdd, 0x03, 0x41, 0xe0,
-	0xcd, 0x40, 0xe0, 0x5b, 0xf8, 0x7a, 0x94, 0x40, 0x38, 0x08, 0x38, 0xb9, 0x07, 0x4f, 0xf7, 0x89,
-	0x7f, 0xb0, 0x10, 0x5e, 0x2d, 0x95, 0xac, 0xca, 0x41, 0x63, 0xd2, 0xbf, 0xd3, 0xd0, 0x53, 0xcd,
-	0x17, 0x27, 0x36, 0xdb, 0x4a, 0x89, 0xbc, 0xe2, 0x75, 0x12, 0x3b, 0x1e, 0x1c, 0xac, 0x04, 0x0e,
-	0x96, 0xf1, 0x62, 0xaf, 0x0e, 0xa0, 0x7f, 0xfb, 0x5e, 0x43, 0x23, 0x4d, 0xfc, 0x78, 0x26, 0x9e,
-	0x0e, 0x5f, 0xb6, 0x19, 0x37, 0x1c, 0x54, 0x6f, 0x06, 0xaa, 0x6f, 0xe0, 0x37, 0x4f, 0xa7, 0xba,
-	0x31, 0xed, 0x3f, 0x6a, 0x68, 0x34, 0xe2, 0xc6, 0xc2, 0xf3, 0x6d, 0x45, 0xb5, 0xbf, 0x65, 0xf5,
-	0x6b, 0xbd, 0x81, 0xc0, 0xcf, 0x3b, 0x81, 0x9f, 0x37, 0xf0, 0x6b, 0xbd, 0xfa, 0x09, 0x77, 0xe0,
-	0x87, 0x1a, 0xc2, 0xad, 0x99, 0xf0, 0x5c, 0x0f, 0xb2, 0x7c, 0x2b, 0xf3, 0x3d, 0x61, 0xc0, 0x49,
-	0x36, 0x70, 0xb2, 0x86, 0x57, 0xfe, 0x81, 0x93, 0xc6, 0xf2, 0x7c, 0xa3, 0xa1, 0xf0, 0x2d, 0x82,
-	0x5f, 0x6e, 0x2b, 0xab, 0xf5, 0xc2, 0xd3, 0xaf, 0xc6, 0x0b, 0x06, 0xf1, 0xaf, 0x07, 0xe2, 0x67,
-	0x31, 0x89, 0x71, 0xde, 0xd8, 0xb4, 0x3e, 0xe3, 0x5f, 0x8d, 0x99, 0xec, 0xe3, 0xe3, 0x94, 0xf6,
-	0xe4, 0x38, 0xa5, 0xfd, 0x7e, 0x9c, 0xd2, 0x1e, 0x9e, 0xa4, 0xfa, 0x9e, 0x9c, 0xa4, 0xfa, 0x7e,
-	0x39, 0x49, 0xf5, 0x7d, 0xb4, 0x18, 0xea, 0x3a, 0x56, 0x24, 0xe9, 0x3a, 0xab, 0xba, 0xb6, 0xec,
-	0x63, 0xfc, 0x2c, 0xb5, 0x45, 0x52, 0x0f, 0x52, 0xc9, 0x4e, 0x24, 0x9f, 0x90, 0xff, 0x64, 0xcc,
-	0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0x2f, 0x4a, 0x4a, 0x27, 0xdd, 0x11, 0x00, 0x00,
+	// 1488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcd, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0x86, 0xc4, 0x21, 0x2f, 0xa5, 0x2d, 0x43, 0x9a, 0x9a, 0x05, 0x6c, 0xba, 0xa2, 0x90,
+	0x02, 0xd9, 0x25, 0x09, 0xe1, 0x43, 0x94, 0x2f, 0xe7, 0xa3, 0x50, 0x90, 0x48, 0x0d, 0x05, 0x54,
+	0x55, 0xb2, 0x36, 0xf6, 0xc4, 0xac, 0x62, 0xef, 0x18, 0xcf, 0xd8, 0x38, 0x20, 0x2a, 0x44, 0x0f,
+	0xf4, 0x88, 0xd4, 0x43, 0x7b, 0xe9, 0xb5, 0x07, 0xa4, 0x4a, 0x3d, 0xb5, 0x87, 0x9e, 0x2b, 0xa1,
+	0xf6, 0x50, 0xa4, 0xf6, 0x50, 0xf5, 0x00, 0x55, 0xa8, 0xd4, 0x7f, 0xa3, 0xf2, 0xcc, 0x5b, 0xef,
+	0x1a, 0xaf, 0xed, 0x75, 0x88, 0x90, 0x7a, 0xb2, 0x77, 0xf6, 0xfd, 0x7e, 0xef, 0xf7, 0x3e, 0x66,
+	0xe7, 0x0d, 0x24, 0xb2, 0xac, 0x4c, 0x2b, 0x45, 0xcb, 0xe6, 0x9c, 0x0a, 0x6b, 0x59, 0x58, 0xd5,
+	0x49, 0xeb, 0x66, 0x85, 0x96, 0x57, 0xcd, 0x52, 0x99, 0x09, 0x46, 0x88, 0x7a, 0x6f, 0xca, 0xf7,
+	0xe6, 0xb2, 0x30, 0xab, 0x93, 0x7a, 0x32, 0x04, 0x53, 0xb2, 0xcb, 0x76, 0x91, 0x2b, 0x90, 0x1e,
+	0x46, 0x2a, 0xd8, 0x0a, 0x75, 0xf1, 0xfd, 0xfe, 0x2c, 0xe3, 0x45, 0xc6, 0xad, 0x25, 0x9b, 0x53,
+	0xe5, 0xcd, 0xaa, 0x4e, 0x2e, 0x51, 0x61, 0xd7, 0x79, 0xf2, 0x8e, 0x6b, 0x0b, 0x87, 0xb9, 0x3e,
+	0x97, 0x6f, 0xeb, 0x59, 0x65, 0x99, 0xe3, 0xbd, 0xdf, 0x81, 0xef, 0x3d, 0x9a, 0xa0, 0x7a, 0x7d,
+	0x34, 0xcf, 0xf2, 0x4c, 0xfe, 0xb5, 0xea, 0xff, 0x70, 0x75, 0x67, 0x9e, 0xb1, 0x7c, 0x81, 0x5a,
+	0x76, 0xc9, 0xb1, 0x6c, 0xd7, 0x65, 0x42, 0xfa, 0xf3, 0xc4, 0x27, 0xf1, 0xad, 0x7c, 0x5a, 0xaa,
+	0x2c, 0x5b, 0xc2, 0x29, 0x52, 0x2e, 0xec, 0x62, 0x49, 0x19, 0x18, 0xa3, 0x40, 0x3e, 0xaa, 0xfb,
+	0x58, 0x94, 0x21, 0xa7, 0xe9, 0xcd, 0x0a, 0xe5, 0xc2, 0xb8, 0x04, 0xdb, 0x9a, 0x56, 0x79, 0x89,
+	0xb9, 0x9c, 0x92, 0x63, 0x10, 0x53, 0xa9, 0x89, 0x6b, 0xbb, 0xb5, 0xf1, 0x91, 0x29, 0xdd, 0x6c,
+	0x4d, 0xa8, 0xa9, 0x30, 0xa9, 0x81, 0xc7, 0x4f, 0x93, 0x7d, 0x69, 0xb4, 0x37, 0xde, 0x83, 0xad,
+	0x92, 0xf0, 0x4a, 0x3d, 0x71, 0xe8, 0x85, 0x8c, 0xc2, 0x60, 0x8e, 0xba, 0xac, 0x28, 0xd9, 0x86,
+	0xd3, 0xea, 0xc1, 0xb8, 0x80, 0x8a, 0xd0, 0x14, 0x5d, 0xcf, 0xc0, 0xa0, 0x4c, 0x3a, 0x7a, 0xde,
+	0x1e, 0xe6, 0x59, 0x22, 0xd0, 0xb1, 0xb2, 0x36, 0x8e, 0xc1, 0x6e, 0x9f, 0xec, 0xe3, 0x52, 0xbe,
+	0x6c, 0xe7, 0xe8, 0x65, 0x61, 0x8b, 0x0a, 0xa7, 0xbc, 0xb3, 0x0c, 0x06, 0xef, 0x74, 0x40, 0xa2,
+	0xaa, 0x0f, 0x61, 0x33, 0xc7, 0x35, 0x14, 0x36, 0xde, 0x56, 0xd8, 0x0b, 0x1c, 0xa8, 0xb3, 0x81,
+	0x37, 0x44, 0x30, 0xee, 0x86, 0xb8, 0x05, 0x00, 0xbf, 0x8b, 0xd0, 0xc7, 0x5e, 0x53, 0xb5, 0x89,
+	0x59, 0x6f, 0x23, 0x53, 0xb5, 0x08, 0x36, 0x93, 0xb9, 0x68, 0xe7, 0x29, 0x62, 0xd3, 0x01, 0x24,
+	0x19, 0x83, 0x98, 0xc3, 0x79, 0x85, 0x96, 0xe3, 0xfd, 0x32, 0x4a, 0x7c, 0x32, 0xbe, 0xd2, 0xb0,
+	0xd4, 0x9e, 0x5b, 0x8c, 0xec, 0x83, 0x10, 0xbf, 0xfb, 0xba, 0xfa, 0x55, 0xe0, 0x26, 0xc7, 0x47,
+	0x21, 0x26, 0x4b, 0xc1, 0xe3, 0xfd, 0xbb, 0x37, 0x45, 0xa9, 0x1c, 0x9a, 0x1b, 0xf3, 0x28, 0x2c,
+	0x65, 0x17, 0x6c, 0x37, 0xeb, 0x05, 0x45, 0xe2, 0x30, 0x64, 0x67, 0xb3, 0xac, 0xe2, 0x0a, 0xac,
+	0x97, 0xf7, 0xe8, 0xd7, 0xb1, 0x3f, 0x58, 0xc7, 0x87, 0x03, 0x30, 0xda, 0xcc, 0x83, 0x11, 0x1e,
+	0x85, 0xa1, 0x25, 0xb5, 0xa4, 0x88, 0x52, 0xbb, 0xea, 0xee, 0xff, 0x7a, 0x9a, 0x7c, 0x4b, 0x45,
+	0xc9, 0x73, 0x2b, 0xa6, 0xc3, 0xac, 0xa2, 0x2d, 0x6e, 0x98, 0xe7, 0x5d, 0x91, 0xf6, 0xac, 0xc9,
+	0x69, 0x18, 0xb9, 0x75, 0xc3, 0x11, 0xb4, 0xe0, 0x70, 0x41, 0x73, 0xca, 0x5b, 0x37, 0x70, 0x10,
+	0x41, 0x66, 0x20, 0xb6, 0x5c, 0x66, 0xb7, 0xa9, 0x1b, 0xdf, 0x14, 0x05, 0x8b, 0xc6, 0x75, 0x58,
+	0x81, 0x65, 0x57, 0x68, 0x2e, 0x3e, 0x10, 0x09, 0xa6, 0x8c, 0xc9, 0x79, 0xd8, 0xaa, 0xfe, 0x65,
+	0x1c, 0x37, 0x53, 0xa5, 0x5c, 0x38, 0x6e, 0x3e, 0x3e, 0x18, 0x85, 0xe1, 0x0d, 0x85, 0x3b, 0xef,
+	0x5e, 0x55, 0x28, 0xb2, 0x08, 0x5b, 0x7c, 0xaa, 0x1c, 0xad, 0xc5, 0x63, 0x92, 0xe6, 0x60, 0x47,
+	0x9a, 0xb5, 0xa7, 0xc9, 0x91, 0x8b, 0x48, 0x34, 0x37, 0x7f, 0x3d, 0x3d, 0xe2, 0xb1, 0xce, 0xd1,
+	0x1a, 0xe1, 0xa0, 0xd3, 0x5a, 0x89, 0x66, 0x05, 0xcd, 0x65, 0x04, 0xcb, 0x94, 0x69, 0x96, 0x3a,
+	0x55, 0xea, 0xd1, 0x0f, 0x49, 0xfa, 0xa3, 0xdd, 0xe8, 0xc7, 0xe6, 0x91, 0xe2, 0x0a, 0x4b, 0x2b,
+	0x02, 0xe5, 0x69, 0x8c, 0x86, 0xac, 0xd3, 0x9a, 0xf1, 0x19, 0xe8, 0xb2, 0x23, 0x16, 0x64, 0x5e,
+	0xb1, 0x2f, 0x36, 0x7c, 0xc7, 0x05, 0x1a, 0xb5, 0xbf, 0xa9, 0x51, 0x8d, 0x6f, 0xfa, 0x61, 0x47,
+	0xa8, 0x80, 0x8d, 0xde, 0x7b, 0x79, 0xd8, 0x8c, 0x4d, 0x1b, 0xdc, 0x7d, 0x3e, 0x8d, 0x47, 0x30,
+	0xcb, 0x1c, 0x37, 0x75, 0xa8, 0x9e, 0xe6, 0x47, 0xcf, 0x92, 0xe3, 0x79, 0x47, 0xdc, 0xa8, 0x2c,
+	0x99, 0x59, 0x56, 0xb4, 0xf0, 0x38, 0x52, 0x3f, 0x13, 0x3c, 0xb7, 0x62, 0x89, 0xd5, 0x12, 0xe5,
+	0x12, 0xc0, 0xd3, 0x0d, 0x72, 0x72, 0x11, 0x46, 0x68, 0xad, 0xe4, 0x94, 0xd5, 0xd9, 0x13, 0xdf,
+	0x24, 0x7d, 0xed, 0x09, 0xdb, 0xe9, 0x0b, 0x65, 0x4a, 0x6f, 0xd3, 0xf9, 0x86, 0x31, 0x6e, 0xfa,
+	0x20, 0xdc, 0xb8, 0x00, 0xdb, 0x5b, 0xd3, 0xb3, 0xde, 0xfd, 0xff, 0xb5, 0x16, 0x56, 0xed, 0x46,
+	0xae, 0x8f, 0x37, 0x7f, 0x05, 0x3a, 0x66, 0x48, 0x49, 0x0d, 0x7c, 0x07, 0x40, 0xaa, 0xa6, 0x3c,
+	0x63, 0xab, 0x1a, 0xd7, 0x4f, 0x44, 0x75, 0xe0, 0x9a, 0xde, 0x81, 0x6b, 0x5e, 0xf1, 0x0e, 0xdc,
+	0xd4, 0xc0, 0xc3, 0x67, 0x49, 0x2d, 0x3d, 0x8c, 0x98, 0xb3, 0xc2, 0xf8, 0x5c, 0x83, 0xa4, 0x94,
+	0x76, 0xcd, 0xff, 0x38, 0xbc, 0xfa, 0x6e, 0xfc, 0x43, 0xc3, 0x33, 0x32, 0x54, 0xc5, 0xff, 0xb5,
+	0x25, 0x8d, 0x45, 0x48, 0xb4, 0x89, 0x6a, 0xbd, 0x9d, 0xf4, 0x69, 0xdb, 0x6a, 0x6d, 0x40, 0x37,
+	0x19, 0x16, 0xbc, 0x2d, 0xd9, 0xe7, 0xe6, 0xaf, 0x5f, 0xa6, 0xa2, 0xfe, 0xb9, 0xed, 0x32, 0xa0,
+	0x70, 0x88, 0xb7, 0x02, 0x50, 0xc7, 0x35, 0x78, 0x2d, 0x47, 0x6b, 0x19, 0x8e, 0xeb, 0x28, 0x26,
+	0x19, 0xb6, 0x21, 0x03, 0xf0, 0xd4, 0xb6, 0xba, 0xa4, 0xfa, 0xf7, 0x3a, 0xc8, 0x39, 0x92, 0xa3,
+	0x35, 0xef, 0xc1, 0xb8, 0x04, 0xbb, 0xa4, 0xd3, 0xab, 0xb4, 0xc0, 0xb2, 0x8e, 0x58, 0x3d, 0x5b,
+	0x28, 0xb0, 0x5b, 0x2f, 0x93, 0xd4, 0x0c, 0x96, 0x29, 0x84, 0x10, 0x63, 0x39, 0x09, 0xc3, 0xb6,
+	0xb7, 0x18, 0x35, 0xab, 0x3e, 0xc2, 0xb8, 0xa7, 0xc1, 0x4e, 0x95, 0x27, 0xea, 0xae, 0xaa, 0xaa,
+	0xcd, 0xd5, 0x3d, 0xbf, 0xc2, 0x1d, 0x76, 0x4f, 0xc3, 0xac, 0xb5, 0x4a, 0xd8, 0xe8, 0xed, 0x35,
+	0x06, 0x31, 0x99, 0x57, 0xb5, 0xb9, 0x86, 0xd3, 0xf8, 0x64, 0x9c, 0x83, 0xb1, 0x17, 0x14, 0xac,
+	0xb7, 0x60, 0xc7, 0xbd, 0x3e, 0x0d, 0x30, 0x61, 0x14, 0x09, 0x80, 0x5c, 0x63, 0x55, 0xb2, 0x6d,
+	0x4e, 0x07, 0x56, 0xa6, 0x1e, 0x10, 0x18, 0x94, 0x58, 0x72, 0x5f, 0x83, 0x98, 0xba, 0x27, 0x90,
+	0xbd, 0x61, 0x4d, 0xd9, 0x7a, 0x25, 0xd1, 0xf7, 0x75, 0xb5, 0x53, 0x2a, 0x8c, 0x7d, 0x5f, 0xfc,
+	0xfb, 0xfd, 0x7e, 0xed, 0xfe, 0xef, 0xff, 0x7c, 0xd9, 0xbf, 0x93, 0xe8, 0x56, 0xdb, 0xeb, 0x9d,
+	0x14, 0xa1, 0xa6, 0xde, 0x0e, 0x22, 0x9a, 0xa6, 0xf1, 0x0e, 0x22, 0x9a, 0xc7, 0xe7, 0x08, 0x22,
+	0xd4, 0x94, 0x4b, 0x1e, 0x68, 0x30, 0x28, 0xb1, 0xe4, 0xdd, 0xce, 0xdc, 0x9e, 0x84, 0xbd, 0xdd,
+	0xcc, 0x50, 0x81, 0xe5, 0x2b, 0xd8, 0x43, 0x8c, 0xf6, 0x0a, 0xac, 0x3b, 0xb2, 0xae, 0x77, 0xc9,
+	0xcf, 0x1a, 0x8c, 0x86, 0x5d, 0x54, 0xc8, 0xe1, 0xce, 0x1e, 0xc3, 0x6f, 0x55, 0xfa, 0x4c, 0x8f,
+	0x28, 0x94, 0x7d, 0xc6, 0x97, 0x3d, 0x43, 0xa6, 0xbb, 0xcb, 0xb6, 0x2a, 0x8a, 0x68, 0xc2, 0xbb,
+	0x47, 0x91, 0x47, 0x1a, 0x0c, 0xe1, 0x77, 0x99, 0xb4, 0xaf, 0x57, 0xf3, 0x59, 0xa0, 0x8f, 0x77,
+	0x37, 0x44, 0x81, 0x17, 0x7d, 0x81, 0x67, 0xc9, 0xe9, 0x30, 0x81, 0xb8, 0x7f, 0xb8, 0x75, 0x07,
+	0xff, 0xdd, 0xb5, 0xbc, 0x53, 0xc9, 0xe2, 0x95, 0x62, 0xd1, 0x2e, 0xaf, 0x36, 0x92, 0xfe, 0x83,
+	0x06, 0xaf, 0x37, 0x4f, 0x81, 0xc4, 0x6c, 0x2b, 0x25, 0x74, 0x5e, 0xd5, 0xad, 0xc8, 0xf6, 0x18,
+	0xc1, 0xac, 0x1f, 0xc1, 0x31, 0x72, 0xa4, 0xd7, 0x08, 0xf0, 0x32, 0xf2, 0x93, 0x06, 0x5b, 0x9a,
+	0xf8, 0xc9, 0x44, 0x34, 0x1d, 0x9e, 0x6c, 0x33, 0xaa, 0x39, 0xaa, 0xbe, 0xe0, 0xab, 0x3e, 0x43,
+	0x4e, 0xad, 0x4f, 0x75, 0x23, 0xed, 0xbf, 0x68, 0xb0, 0x2d, 0x64, 0xdc, 0x21, 0xd3, 0x6d, 0x45,
+	0xb5, 0x1f, 0xd1, 0xf4, 0xc3, 0xbd, 0x81, 0x30, 0x9e, 0x73, 0x7e, 0x3c, 0x27, 0xc9, 0x89, 0x5e,
+	0xe3, 0x09, 0x5e, 0x27, 0x7f, 0xd3, 0x80, 0xb4, 0x7a, 0x22, 0x53, 0x3d, 0xc8, 0xf2, 0x42, 0x99,
+	0xee, 0x09, 0x83, 0x91, 0x2c, 0xfa, 0x91, 0xcc, 0x93, 0xd9, 0x97, 0x88, 0xa4, 0x51, 0x9e, 0x6f,
+	0x35, 0x08, 0x8e, 0x20, 0xe4, 0x40, 0x5b, 0x59, 0xad, 0xd3, 0x92, 0x7e, 0x30, 0x9a, 0x31, 0x8a,
+	0x7f, 0xdf, 0x17, 0x3f, 0x49, 0xac, 0x08, 0xdf, 0x9b, 0x1c, 0xad, 0x4d, 0x78, 0x73, 0x15, 0xf9,
+	0x55, 0x83, 0xad, 0x2d, 0x93, 0x0b, 0x99, 0x6c, 0xab, 0xa0, 0xdd, 0xd8, 0xa4, 0x4f, 0xf5, 0x02,
+	0x41, 0xe9, 0x97, 0x7c, 0xe9, 0x73, 0x24, 0x15, 0x31, 0xef, 0x55, 0xa4, 0x9b, 0x68, 0x4c, 0x48,
+	0xfe, 0x09, 0xf0, 0xa3, 0x06, 0x6f, 0xbe, 0x38, 0xa2, 0x90, 0x43, 0xed, 0xd3, 0x19, 0x3e, 0x50,
+	0xe9, 0x93, 0x3d, 0x20, 0x30, 0x94, 0x53, 0x7e, 0x28, 0xd3, 0x64, 0x32, 0x62, 0x28, 0xfe, 0x64,
+	0x41, 0xbe, 0xd3, 0x00, 0x7c, 0x72, 0xb2, 0x3f, 0x82, 0x02, 0x4f, 0xed, 0x81, 0x48, 0xb6, 0xa8,
+	0x73, 0xc1, 0xd7, 0x79, 0x82, 0x1c, 0xef, 0x59, 0xa7, 0x97, 0xe9, 0xd4, 0xe2, 0xe3, 0xb5, 0x84,
+	0xf6, 0x64, 0x2d, 0xa1, 0xfd, 0xbd, 0x96, 0xd0, 0x1e, 0x3e, 0x4f, 0xf4, 0x3d, 0x79, 0x9e, 0xe8,
+	0xfb, 0xf3, 0x79, 0xa2, 0xef, 0x93, 0x23, 0x81, 0xab, 0xce, 0xac, 0xa4, 0x5f, 0x60, 0x15, 0x37,
+	0x27, 0xa7, 0x3b, 0xcf, 0x5f, 0xf5, 0x88, 0x55, 0xf3, 0x9d, 0xca, 0xeb, 0xcf, 0x52, 0x4c, 0x5e,
+	0x38, 0xa7, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xaf, 0x08, 0xc2, 0x03, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1141,6 +1492,12 @@ type QueryClient interface {
 	WhitelistedBalance(ctx context.Context, in *QueryWhitelistedBalanceRequest, opts ...grpc.CallOption) (*QueryWhitelistedBalanceResponse, error)
 	// DEXSettings returns DEX settings of the denom.
 	DEXSettings(ctx context.Context, in *QueryDEXSettingsRequest, opts ...grpc.CallOption) (*QueryDEXSettingsResponse, error)
+	// VelocityAllowance returns the amount of the denom the account is allowed to send within the trailing window.
+	VelocityAllowance(ctx context.Context, in *QueryVelocityAllowanceRequest, opts ...grpc.CallOption) (*QueryVelocityAllowanceResponse, error)
+	// DenylistedDenoms returns all the denoms the account is denylisted for.
+	DenylistedDenoms(ctx context.Context, in *QueryDenylistedDenomsRequest, opts ...grpc.CallOption) (*QueryDenylistedDenomsResponse, error)
+	// Denylisted returns whether the account is denylisted for the denom.
+	Denylisted(ctx context.Context, in *QueryDenylistedRequest, opts ...grpc.CallOption) (*QueryDenylistedResponse, error)
 }
 
 type queryClient struct {
@@ -1241,6 +1598,33 @@ func (c *queryClient) DEXSettings(ctx context.Context, in *QueryDEXSettingsReque
 	return out, nil
 }
 
+func (c *queryClient) VelocityAllowance(ctx context.Context, in *QueryVelocityAllowanceRequest, opts ...grpc.CallOption) (*QueryVelocityAllowanceResponse, error) {
+	out := new(QueryVelocityAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/VelocityAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenylistedDenoms(ctx context.Context, in *QueryDenylistedDenomsRequest, opts ...grpc.CallOption) (*QueryDenylistedDenomsResponse, error) {
+	out := new(QueryDenylistedDenomsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/DenylistedDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Denylisted(ctx context.Context, in *QueryDenylistedRequest, opts ...grpc.CallOption) (*QueryDenylistedResponse, error) {
+	out := new(QueryDenylistedResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/Denylisted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/asset/ft module.
@@ -1263,6 +1647,12 @@ type QueryServer interface {
 	WhitelistedBalance(context.Context, *QueryWhitelistedBalanceRequest) (*QueryWhitelistedBalanceResponse, error)
 	// DEXSettings returns DEX settings of the denom.
 	DEXSettings(context.Context, *QueryDEXSettingsRequest) (*QueryDEXSettingsResponse, error)
+	// VelocityAllowance returns the amount of the denom the account is allowed to send within the trailing window.
+	VelocityAllowance(context.Context, *QueryVelocityAllowanceRequest) (*QueryVelocityAllowanceResponse, error)
+	// DenylistedDenoms returns all the denoms the account is denylisted for.
+	DenylistedDenoms(context.Context, *QueryDenylistedDenomsRequest) (*QueryDenylistedDenomsResponse, error)
+	// Denylisted returns whether the account is denylisted for the denom.
+	Denylisted(context.Context, *QueryDenylistedRequest) (*QueryDenylistedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1299,6 +1689,15 @@ func (*UnimplementedQueryServer) WhitelistedBalance(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) DEXSettings(ctx context.Context, req *QueryDEXSettingsRequest) (*QueryDEXSettingsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DEXSettings not implemented")
 }
+func (*UnimplementedQueryServer) VelocityAllowance(ctx context.Context, req *QueryVelocityAllowanceRequest) (*QueryVelocityAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VelocityAllowance not implemented")
+}
+func (*UnimplementedQueryServer) DenylistedDenoms(ctx context.Context, req *QueryDenylistedDenomsRequest) (*QueryDenylistedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenylistedDenoms not implemented")
+}
+func (*UnimplementedQueryServer) Denylisted(ctx context.Context, req *QueryDenylistedRequest) (*QueryDenylistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Denylisted not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1484,40 +1883,94 @@ func _Query_DEXSettings_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "coreum.asset.ft.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Tokens",
-			Handler:    _Query_Tokens_Handler,
-		},
-		{
-			MethodName: "Token",
-			Handler:    _Query_Token_Handler,
-		},
-		{
-			MethodName: "TokenUpgradeStatuses",
-			Handler:    _Query_TokenUpgradeStatuses_Handler,
-		},
-		{
-			MethodName: "Balance",
-			Handler:    _Query_Balance_Handler,
-		},
-		{
-			MethodName: "FrozenBalances",
-			Handler:    _Query_FrozenBalances_Handler,
-		},
-		{
-			MethodName: "FrozenBalance",
-			Handler:    _Query_FrozenBalance_Handler,
-		},
-		{
-			MethodName: "WhitelistedBalances",
+func _Query_VelocityAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVelocityAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VelocityAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/VelocityAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VelocityAllowance(ctx, req.(*QueryVelocityAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenylistedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenylistedDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenylistedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/DenylistedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenylistedDenoms(ctx, req.(*QueryDenylistedDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Denylisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenylistedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Denylisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/Denylisted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Denylisted(ctx, req.(*QueryDenylistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.asset.ft.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Tokens",
+			Handler:    _Query_Tokens_Handler,
+		},
+		{
+			MethodName: "Token",
+			Handler:    _Query_Token_Handler,
+		},
+		{
+			MethodName: "TokenUpgradeStatuses",
+			Handler:    _Query_TokenUpgradeStatuses_Handler,
+		},
+		{
+			MethodName: "Balance",
+			Handler:    _Query_Balance_Handler,
+		},
+		{
+			MethodName: "FrozenBalances",
+			Handler:    _Query_FrozenBalances_Handler,
+		},
+		{
+			MethodName: "FrozenBalance",
+			Handler:    _Query_FrozenBalance_Handler,
+		},
+		{
+			MethodName: "WhitelistedBalances",
 			Handler:    _Query_WhitelistedBalances_Handler,
 		},
 		{
@@ -1528,6 +1981,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DEXSettings",
 			Handler:    _Query_DEXSettings_Handler,
 		},
+		{
+			MethodName: "VelocityAllowance",
+			Handler:    _Query_VelocityAllowance_Handler,
+		},
+		{
+			MethodName: "DenylistedDenoms",
+			Handler:    _Query_DenylistedDenoms_Handler,
+		},
+		{
+			MethodName: "Denylisted",
+			Handler:    _Query_Denylisted_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "coreum/asset/ft/v1/query.proto",
@@ -1998,6 +2463,20 @@ func (m *QueryFrozenBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.Expirations) > 0 {
+		for iNdEx := len(m.Expirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Balances) > 0 {
 		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2084,6 +2563,16 @@ func (m *QueryFrozenBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2321,127 +2810,353 @@ func (m *QueryDEXSettingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVelocityAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryVelocityAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVelocityAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryVelocityAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Token.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTokenUpgradeStatusesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryVelocityAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVelocityAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokenUpgradeStatusesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenylistedDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Statuses.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryDenylistedDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenylistedDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryDenylistedDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenylistedDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenylistedDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenylistedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenylistedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenylistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenylistedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenylistedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenylistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Denylisted {
+		i--
+		if m.Denylisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Token.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenUpgradeStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenUpgradeStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Statuses.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = len(m.Account)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -2509,6 +3224,12 @@ func (m *QueryFrozenBalancesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Expirations) > 0 {
+		for _, e := range m.Expirations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2537,6 +3258,10 @@ func (m *QueryFrozenBalanceResponse) Size() (n int) {
 	_ = l
 	l = m.Balance.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -2628,41 +3353,665 @@ func (m *QueryDEXSettingsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryVelocityAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryVelocityAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Allowance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+
+func (m *QueryDenylistedDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenylistedDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenylistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenylistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Denylisted {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenUpgradeStatusesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenUpgradeStatusesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenUpgradeStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenUpgradeStatusesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenUpgradeStatusesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenUpgradeStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Statuses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2684,7 +4033,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokensResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2707,15 +4056,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokensResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2742,7 +4091,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, Token{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2767,7 +4153,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2790,13 +4176,45 @@ func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -2849,7 +4267,7 @@ func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2872,17 +4290,17 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2892,78 +4310,131 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Whitelisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTokenUpgradeStatusesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Frozen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenUpgradeStatusesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenUpgradeStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedInVesting", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2991,63 +4462,49 @@ func (m *QueryTokenUpgradeStatusesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LockedInVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedInDEX", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTokenUpgradeStatusesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.LockedInDEX.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenUpgradeStatusesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenUpgradeStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedToReceiveInDEX", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3057,22 +4514,23 @@ func (m *QueryTokenUpgradeStatusesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Statuses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpectedToReceiveInDEX.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3097,7 +4555,7 @@ func (m *QueryTokenUpgradeStatusesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokensRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenBalancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3120,10 +4578,10 @@ func (m *QueryTokensRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokensRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenBalancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3164,7 +4622,7 @@ func (m *QueryTokensRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3192,7 +4650,7 @@ func (m *QueryTokensRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3215,7 +4673,7 @@ func (m *QueryTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenBalancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3238,10 +4696,10 @@ func (m *QueryTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenBalancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3282,7 +4740,7 @@ func (m *QueryTokensResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3309,8 +4767,42 @@ func (m *QueryTokensResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, Token{})
-			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expirations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expirations = append(m.Expirations, FreezeExpiration{})
+			if err := m.Expirations[len(m.Expirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3335,7 +4827,7 @@ func (m *QueryTokensResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3358,10 +4850,10 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3449,7 +4941,7 @@ func (m *QueryBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3462,95 +4954,27 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBalanceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Whitelisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3560,31 +4984,30 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Frozen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3594,65 +5017,83 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedInVesting", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistedBalancesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedInVesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistedBalancesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistedBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedInDEX", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3662,29 +5103,31 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedInDEX.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedToReceiveInDEX", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3712,9 +5155,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExpectedToReceiveInDEX.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3737,7 +5178,7 @@ func (m *QueryBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFrozenBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWhitelistedBalancesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3760,10 +5201,10 @@ func (m *QueryFrozenBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFrozenBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedBalancesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFrozenBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3796,7 +5237,7 @@ func (m *QueryFrozenBalancesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3804,9 +5245,9 @@ func (m *QueryFrozenBalancesRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3816,23 +5257,25 @@ func (m *QueryFrozenBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Balances = append(m.Balances, types.Coin{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3855,7 +5298,7 @@ func (m *QueryFrozenBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFrozenBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWhitelistedBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3878,17 +5321,17 @@ func (m *QueryFrozenBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFrozenBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFrozenBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3898,31 +5341,109 @@ func (m *QueryFrozenBalancesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistedBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistedBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistedBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3949,8 +5470,7 @@ func (m *QueryFrozenBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, types.Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3975,7 +5495,7 @@ func (m *QueryFrozenBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFrozenBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDEXSettingsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3998,45 +5518,13 @@ func (m *QueryFrozenBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFrozenBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDEXSettingsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFrozenBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDEXSettingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -4089,7 +5577,7 @@ func (m *QueryFrozenBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFrozenBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDEXSettingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4112,15 +5600,15 @@ func (m *QueryFrozenBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFrozenBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDEXSettingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFrozenBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDEXSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DEXSettings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4147,7 +5635,7 @@ func (m *QueryFrozenBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DEXSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4172,7 +5660,7 @@ func (m *QueryFrozenBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVelocityAllowanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4195,17 +5683,17 @@ func (m *QueryWhitelistedBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVelocityAllowanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVelocityAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4215,31 +5703,27 @@ func (m *QueryWhitelistedBalancesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4267,7 +5751,7 @@ func (m *QueryWhitelistedBalancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4290,7 +5774,7 @@ func (m *QueryWhitelistedBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedBalancesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVelocityAllowanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4313,51 +5797,15 @@ func (m *QueryWhitelistedBalancesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedBalancesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVelocityAllowanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedBalancesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVelocityAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4384,8 +5832,7 @@ func (m *QueryWhitelistedBalancesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, types.Coin{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4410,7 +5857,7 @@ func (m *QueryWhitelistedBalancesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenylistedDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4433,17 +5880,17 @@ func (m *QueryWhitelistedBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenylistedDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenylistedDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4453,27 +5900,31 @@ func (m *QueryWhitelistedBalanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4501,7 +5952,7 @@ func (m *QueryWhitelistedBalanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4524,7 +5975,7 @@ func (m *QueryWhitelistedBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenylistedDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4547,15 +5998,15 @@ func (m *QueryWhitelistedBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenylistedDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenylistedDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4582,10 +6033,45 @@ func (m *QueryWhitelistedBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4607,7 +6093,7 @@ func (m *QueryWhitelistedBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDEXSettingsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenylistedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4630,13 +6116,45 @@ func (m *QueryDEXSettingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDEXSettingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenylistedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDEXSettingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenylistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -4689,7 +6207,7 @@ func (m *QueryDEXSettingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDEXSettingsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenylistedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4712,17 +6230,17 @@ func (m *QueryDEXSettingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDEXSettingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenylistedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDEXSettingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenylistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DEXSettings", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylisted", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4732,25 +6250,12 @@ func (m *QueryDEXSettingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DEXSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Denylisted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -44,6 +44,15 @@ var MaxMintableAmount = sdkmath.NewIntFromBigInt(big.NewInt(0).Exp(big.NewInt(10
 // MaxUnifiedRefAmount is the maximum value for unified ref amount.
 var MaxUnifiedRefAmount = sdkmath.LegacyNewDecFromBigInt(big.NewInt(0).Exp(big.NewInt(10), big.NewInt(50), nil))
 
+// featureRoles defines the roles allowed to use the features in addition to the admin.
+var featureRoles = map[Feature]Role{
+	Feature_minting:      Role_minter,
+	Feature_freezing:     Role_freezer,
+	Feature_clawback:     Role_clawback_manager,
+	Feature_whitelisting: Role_whitelist_manager,
+	Feature_denylisting:  Role_denylist_manager,
+}
+
 func init() {
 	subunitRegex = regexp.MustCompile(subunitRegexStr)
 	symbolRegex = regexp.MustCompile(symbolRegexStr)
@@ -64,6 +73,8 @@ type IssueSettings struct {
 	SendCommissionRate sdkmath.LegacyDec
 	ExtensionSettings  *ExtensionIssueSettings
 	DEXSettings        *DEXSettings
+	MaxSupply          *sdkmath.Int
+	VelocityLimit      *VelocityLimit
 }
 
 // BuildDenom builds the denom string from the symbol and issuer address.
@@ -194,6 +205,11 @@ func (def Definition) IsFeatureAllowed(addr sdk.Address, feature Feature) bool {
 		return featureEnabled || feature == Feature_burning
 	}
 
+	// the role holder can use the enabled feature of the role
+	if role, ok := featureRoles[feature]; ok && def.HasRole(addr, role) {
+		return featureEnabled
+	}
+
 	// non-issuer can use only burning and only if it is enabled
 	return featureEnabled && feature == Feature_burning
 }
@@ -213,6 +229,46 @@ func (def Definition) HasAdminPrivileges(addr sdk.Address) bool {
 	return def.Admin == addr.String() || def.ExtensionCWAddress == addr.String()
 }
 
+// HasRole returns true if the addr holds the role.
+func (def Definition) HasRole(addr sdk.Address, role Role) bool {
+	return lo.ContainsBy(def.RoleHolders, func(holder RoleHolder) bool {
+		return holder.Role == role && holder.Address == addr.String()
+	})
+}
+
+// ValidateRole verifies that provided role belongs to the defined set.
+func ValidateRole(role Role) error {
+	if _, exists := Role_name[int32(role)]; !exists {
+		return sdkerrors.Wrapf(ErrInvalidInput, "non-existing role provided: %d", role)
+	}
+	if role == Role_role_unspecified {
+		return sdkerrors.Wrap(ErrInvalidInput, "role must be specified")
+	}
+
+	return nil
+}
+
+// ValidateRoleHolders verifies that the role holders are valid and not duplicated.
+func ValidateRoleHolders(holders []RoleHolder) error {
+	present := map[RoleHolder]struct{}{}
+	for _, holder := range holders {
+		if err := ValidateRole(holder.Role); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(holder.Address); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid role holder address: %s", err)
+		}
+		if _, exists := present[holder]; exists {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicated role holder: %s %s", holder.Role.String(), holder.Address,
+			)
+		}
+		present[holder] = struct{}{}
+	}
+
+	return nil
+}
+
 // ValidateFeatures verifies that provided features belong to the defined set.
 func ValidateFeatures(features []Feature) error {
 	present := map[Feature]struct{}{}
@@ -241,7 +297,8 @@ func ValidateFeatures(features []Feature) error {
 		// if dex is blocked those features make not sense
 		if hasDEXBlock && (feature == Feature_dex_whitelisted_denoms ||
 			feature == Feature_dex_order_cancellation ||
-			feature == Feature_dex_unified_ref_amount_change) {
+			feature == Feature_dex_unified_ref_amount_change ||
+			feature == Feature_dex_order_book_halt) {
 			return sdkerrors.Wrapf(
 				ErrInvalidInput,
 				"%s is not allowed in combination with %s", Feature_dex_block.String(), feature.String(),
@@ -268,6 +325,46 @@ func ValidateSendCommissionRate(sendCommissionRate sdkmath.LegacyDec) error {
 	return nil
 }
 
+// ValidateMaxSupply checks that provided max supply is valid.
+func ValidateMaxSupply(maxSupply sdkmath.Int) error {
+	if maxSupply.IsNil() || !maxSupply.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid max supply %s, must be positive", maxSupply.String())
+	}
+	return nil
+}
+
+// ValidateVelocityLimit checks that provided velocity limit is valid.
+func ValidateVelocityLimit(limit VelocityLimit) error {
+	if limit.Window <= 0 {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid velocity window %s, must be positive", limit.Window)
+	}
+	if limit.Amount.IsNil() || !limit.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid velocity limit amount %s, must be positive", limit.Amount)
+	}
+
+	return nil
+}
+
+// ValidateVelocityLimitSettings checks that the velocity limit is provided only if the feature is enabled.
+func ValidateVelocityLimitSettings(features []Feature, limit *VelocityLimit) error {
+	if !lo.Contains(features, Feature_velocity_limiting) {
+		if limit != nil {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "velocity limit can be set only if the %s feature is enabled", Feature_velocity_limiting,
+			)
+		}
+		return nil
+	}
+
+	if limit == nil {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "velocity limit must be set if the %s feature is enabled", Feature_velocity_limiting,
+		)
+	}
+
+	return ValidateVelocityLimit(*limit)
+}
+
 func validateRate(rate sdkmath.LegacyDec) error {
 	const maxRatePrecisionAllowed = 4
 
@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -44,6 +45,9 @@ const (
 	Feature_dex_whitelisted_denoms        Feature = 9
 	Feature_dex_order_cancellation        Feature = 10
 	Feature_dex_unified_ref_amount_change Feature = 11
+	Feature_dex_order_book_halt           Feature = 12
+	Feature_velocity_limiting             Feature = 13
+	Feature_denylisting                   Feature = 14
 )
 
 var Feature_name = map[int32]string{
@@ -59,6 +63,9 @@ var Feature_name = map[int32]string{
 	9:  "dex_whitelisted_denoms",
 	10: "dex_order_cancellation",
 	11: "dex_unified_ref_amount_change",
+	12: "dex_order_book_halt",
+	13: "velocity_limiting",
+	14: "denylisting",
 }
 
 var Feature_value = map[string]int32{
@@ -74,6 +81,9 @@ var Feature_value = map[string]int32{
 	"dex_whitelisted_denoms":        9,
 	"dex_order_cancellation":        10,
 	"dex_unified_ref_amount_change": 11,
+	"dex_order_book_halt":           12,
+	"velocity_limiting":             13,
+	"denylisting":                   14,
 }
 
 func (x Feature) String() string {
@@ -84,6 +94,100 @@ func (Feature) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fe80c7a2c55589e7, []int{0}
 }
 
+// Role defines possible roles the admin of fungible token can grant to other accounts.
+type Role int32
+
+const (
+	Role_role_unspecified     Role = 0
+	Role_minter               Role = 1
+	Role_freezer              Role = 2
+	Role_clawback_manager     Role = 3
+	Role_whitelist_manager    Role = 4
+	Role_dex_settings_manager Role = 5
+	Role_denylist_manager     Role = 6
+)
+
+var Role_name = map[int32]string{
+	0: "role_unspecified",
+	1: "minter",
+	2: "freezer",
+	3: "clawback_manager",
+	4: "whitelist_manager",
+	5: "dex_settings_manager",
+	6: "denylist_manager",
+}
+
+var Role_value = map[string]int32{
+	"role_unspecified":     0,
+	"minter":               1,
+	"freezer":              2,
+	"clawback_manager":     3,
+	"whitelist_manager":    4,
+	"dex_settings_manager": 5,
+	"denylist_manager":     6,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{1}
+}
+
+// RoleHolder defines the account holding the role of the fungible token.
+type RoleHolder struct {
+	Role    Role   `protobuf:"varint,1,opt,name=role,proto3,enum=coreum.asset.ft.v1.Role" json:"role,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *RoleHolder) Reset()         { *m = RoleHolder{} }
+func (m *RoleHolder) String() string { return proto.CompactTextString(m) }
+func (*RoleHolder) ProtoMessage()    {}
+func (*RoleHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{0}
+}
+func (m *RoleHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleHolder.Merge(m, src)
+}
+func (m *RoleHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleHolder proto.InternalMessageInfo
+
+func (m *RoleHolder) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_role_unspecified
+}
+
+func (m *RoleHolder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 // Definition defines the fungible token settings to store.
 type Definition struct {
 	Denom    string    `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -100,13 +204,19 @@ type Definition struct {
 	URIHash            string                      `protobuf:"bytes,8,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
 	ExtensionCWAddress string                      `protobuf:"bytes,9,opt,name=extension_cw_address,json=extensionCwAddress,proto3" json:"extension_cw_address,omitempty"`
 	Admin              string                      `protobuf:"bytes,10,opt,name=admin,proto3" json:"admin,omitempty"`
+	// role_holders are the accounts holding the roles granted by the admin.
+	RoleHolders []RoleHolder `protobuf:"bytes,11,rep,name=role_holders,json=roleHolders,proto3" json:"role_holders"`
+	// max_supply is the cap of the token supply which can't be exceeded by minting, the supply isn't capped if not set.
+	MaxSupply *cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply,omitempty"`
+	// velocity_limit is the limit of the token outflow per account within the window.
+	VelocityLimit *VelocityLimit `protobuf:"bytes,13,opt,name=velocity_limit,json=velocityLimit,proto3" json:"velocity_limit,omitempty"`
 }
 
 func (m *Definition) Reset()         { *m = Definition{} }
 func (m *Definition) String() string { return proto.CompactTextString(m) }
 func (*Definition) ProtoMessage()    {}
 func (*Definition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe80c7a2c55589e7, []int{0}
+	return fileDescriptor_fe80c7a2c55589e7, []int{1}
 }
 func (m *Definition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -157,13 +267,22 @@ type Token struct {
 	ExtensionCWAddress string                      `protobuf:"bytes,14,opt,name=extension_cw_address,json=extensionCwAddress,proto3" json:"extension_cw_address,omitempty"`
 	Admin              string                      `protobuf:"bytes,15,opt,name=admin,proto3" json:"admin,omitempty"`
 	DEXSettings        *DEXSettings                `protobuf:"bytes,16,opt,name=dex_settings,json=dexSettings,proto3" json:"dex_settings,omitempty"`
+	// role_holders are the accounts holding the roles granted by the admin.
+	RoleHolders []RoleHolder `protobuf:"bytes,17,rep,name=role_holders,json=roleHolders,proto3" json:"role_holders"`
+	// max_supply is the cap of the token supply which can't be exceeded by minting, the supply isn't capped if not set.
+	MaxSupply *cosmossdk_io_math.Int `protobuf:"bytes,18,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply,omitempty"`
+	// velocity_limit is the limit of the token outflow per account within the window.
+	VelocityLimit *VelocityLimit `protobuf:"bytes,19,opt,name=velocity_limit,json=velocityLimit,proto3" json:"velocity_limit,omitempty"`
+	// global_freeze_expires_at is the time the global freeze is automatically removed at, the global freeze
+	// lasts until it's removed explicitly if not set.
+	GlobalFreezeExpiresAt *time.Time `protobuf:"bytes,20,opt,name=global_freeze_expires_at,json=globalFreezeExpiresAt,proto3,stdtime" json:"global_freeze_expires_at,omitempty"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
 func (m *Token) String() string { return proto.CompactTextString(m) }
 func (*Token) ProtoMessage()    {}
 func (*Token) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe80c7a2c55589e7, []int{1}
+	return fileDescriptor_fe80c7a2c55589e7, []int{2}
 }
 func (m *Token) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -201,7 +320,7 @@ func (m *DelayedTokenUpgradeV1) Reset()         { *m = DelayedTokenUpgradeV1{} }
 func (m *DelayedTokenUpgradeV1) String() string { return proto.CompactTextString(m) }
 func (*DelayedTokenUpgradeV1) ProtoMessage()    {}
 func (*DelayedTokenUpgradeV1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe80c7a2c55589e7, []int{2}
+	return fileDescriptor_fe80c7a2c55589e7, []int{3}
 }
 func (m *DelayedTokenUpgradeV1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -237,6 +356,165 @@ func (m *DelayedTokenUpgradeV1) GetDenom() string {
 	return ""
 }
 
+// DelayedUnfreeze is executed by the delay module when the frozen balance of the account expires.
+type DelayedUnfreeze struct {
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *DelayedUnfreeze) Reset()         { *m = DelayedUnfreeze{} }
+func (m *DelayedUnfreeze) String() string { return proto.CompactTextString(m) }
+func (*DelayedUnfreeze) ProtoMessage()    {}
+func (*DelayedUnfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{4}
+}
+func (m *DelayedUnfreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedUnfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedUnfreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelayedUnfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedUnfreeze.Merge(m, src)
+}
+func (m *DelayedUnfreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedUnfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedUnfreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedUnfreeze proto.InternalMessageInfo
+
+func (m *DelayedUnfreeze) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *DelayedUnfreeze) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DelayedGloballyUnfreeze is executed by the delay module when the global freeze of the token expires.
+type DelayedGloballyUnfreeze struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *DelayedGloballyUnfreeze) Reset()         { *m = DelayedGloballyUnfreeze{} }
+func (m *DelayedGloballyUnfreeze) String() string { return proto.CompactTextString(m) }
+func (*DelayedGloballyUnfreeze) ProtoMessage()    {}
+func (*DelayedGloballyUnfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{5}
+}
+func (m *DelayedGloballyUnfreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedGloballyUnfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedGloballyUnfreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelayedGloballyUnfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedGloballyUnfreeze.Merge(m, src)
+}
+func (m *DelayedGloballyUnfreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedGloballyUnfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedGloballyUnfreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedGloballyUnfreeze proto.InternalMessageInfo
+
+func (m *DelayedGloballyUnfreeze) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// FreezeExpiration defines the time the frozen balance of the account is automatically unfrozen at.
+type FreezeExpiration struct {
+	Account   string    `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom     string    `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ExpiresAt time.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+}
+
+func (m *FreezeExpiration) Reset()         { *m = FreezeExpiration{} }
+func (m *FreezeExpiration) String() string { return proto.CompactTextString(m) }
+func (*FreezeExpiration) ProtoMessage()    {}
+func (*FreezeExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{6}
+}
+func (m *FreezeExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreezeExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FreezeExpiration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FreezeExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreezeExpiration.Merge(m, src)
+}
+func (m *FreezeExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreezeExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreezeExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreezeExpiration proto.InternalMessageInfo
+
+func (m *FreezeExpiration) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *FreezeExpiration) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FreezeExpiration) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
 // TokenUpgradeV1Status defines the current status of the v1 token migration.
 type TokenUpgradeV1Status struct {
 	IbcEnabled bool      `protobuf:"varint,1,opt,name=ibc_enabled,json=ibcEnabled,proto3" json:"ibc_enabled,omitempty"`
@@ -248,7 +526,7 @@ func (m *TokenUpgradeV1Status) Reset()         { *m = TokenUpgradeV1Status{} }
 func (m *TokenUpgradeV1Status) String() string { return proto.CompactTextString(m) }
 func (*TokenUpgradeV1Status) ProtoMessage()    {}
 func (*TokenUpgradeV1Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe80c7a2c55589e7, []int{3}
+	return fileDescriptor_fe80c7a2c55589e7, []int{7}
 }
 func (m *TokenUpgradeV1Status) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,7 +585,7 @@ func (m *TokenUpgradeStatuses) Reset()         { *m = TokenUpgradeStatuses{} }
 func (m *TokenUpgradeStatuses) String() string { return proto.CompactTextString(m) }
 func (*TokenUpgradeStatuses) ProtoMessage()    {}
 func (*TokenUpgradeStatuses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe80c7a2c55589e7, []int{4}
+	return fileDescriptor_fe80c7a2c55589e7, []int{8}
 }
 func (m *TokenUpgradeStatuses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +633,7 @@ func (m *DEXSettings) Reset()         { *m = DEXSettings{} }
 func (m *DEXSettings) String() string { return proto.CompactTextString(m) }
 func (*DEXSettings) ProtoMessage()    {}
 func (*DEXSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe80c7a2c55589e7, []int{5}
+	return fileDescriptor_fe80c7a2c55589e7, []int{9}
 }
 func (m *DEXSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,83 +669,267 @@ func (m *DEXSettings) GetWhitelistedDenoms() []string {
 	return nil
 }
 
+// VelocityLimit defines the max amount of the token an account is allowed to send within the window.
+type VelocityLimit struct {
+	// window is the period within which the outflow of the account is accumulated.
+	Window time.Duration `protobuf:"bytes,1,opt,name=window,proto3,stdduration" json:"window"`
+	// amount is the max amount an account is allowed to send within the window.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *VelocityLimit) Reset()         { *m = VelocityLimit{} }
+func (m *VelocityLimit) String() string { return proto.CompactTextString(m) }
+func (*VelocityLimit) ProtoMessage()    {}
+func (*VelocityLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{10}
+}
+func (m *VelocityLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VelocityLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VelocityLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VelocityLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VelocityLimit.Merge(m, src)
+}
+func (m *VelocityLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *VelocityLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_VelocityLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VelocityLimit proto.InternalMessageInfo
+
+func (m *VelocityLimit) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// VelocityUsage defines the outflow of the account accumulated within the trailing window.
+type VelocityUsage struct {
+	// buckets are the outflows accumulated per bucket of the window, ordered by the bucket start time.
+	Buckets []VelocityUsageBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *VelocityUsage) Reset()         { *m = VelocityUsage{} }
+func (m *VelocityUsage) String() string { return proto.CompactTextString(m) }
+func (*VelocityUsage) ProtoMessage()    {}
+func (*VelocityUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{11}
+}
+func (m *VelocityUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VelocityUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VelocityUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VelocityUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VelocityUsage.Merge(m, src)
+}
+func (m *VelocityUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *VelocityUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_VelocityUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VelocityUsage proto.InternalMessageInfo
+
+func (m *VelocityUsage) GetBuckets() []VelocityUsageBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// VelocityUsageBucket defines the outflow of the account accumulated within the bucket, the window is split into
+// the buckets and the outflow of the bucket is released once the whole window passes after the end of the bucket.
+type VelocityUsageBucket struct {
+	// start is the start time of the bucket.
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// amount is the outflow accumulated within the bucket.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// dex_locked is the part of the outflow locked by the DEX orders placed within the bucket, which is restored once
+	// the orders are cancelled.
+	DexLocked cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=dex_locked,json=dexLocked,proto3,customtype=cosmossdk.io/math.Int" json:"dex_locked"`
+}
+
+func (m *VelocityUsageBucket) Reset()         { *m = VelocityUsageBucket{} }
+func (m *VelocityUsageBucket) String() string { return proto.CompactTextString(m) }
+func (*VelocityUsageBucket) ProtoMessage()    {}
+func (*VelocityUsageBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{12}
+}
+func (m *VelocityUsageBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VelocityUsageBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VelocityUsageBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VelocityUsageBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VelocityUsageBucket.Merge(m, src)
+}
+func (m *VelocityUsageBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *VelocityUsageBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_VelocityUsageBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VelocityUsageBucket proto.InternalMessageInfo
+
+func (m *VelocityUsageBucket) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterEnum("coreum.asset.ft.v1.Feature", Feature_name, Feature_value)
+	proto.RegisterEnum("coreum.asset.ft.v1.Role", Role_name, Role_value)
+	proto.RegisterType((*RoleHolder)(nil), "coreum.asset.ft.v1.RoleHolder")
 	proto.RegisterType((*Definition)(nil), "coreum.asset.ft.v1.Definition")
 	proto.RegisterType((*Token)(nil), "coreum.asset.ft.v1.Token")
 	proto.RegisterType((*DelayedTokenUpgradeV1)(nil), "coreum.asset.ft.v1.DelayedTokenUpgradeV1")
+	proto.RegisterType((*DelayedUnfreeze)(nil), "coreum.asset.ft.v1.DelayedUnfreeze")
+	proto.RegisterType((*DelayedGloballyUnfreeze)(nil), "coreum.asset.ft.v1.DelayedGloballyUnfreeze")
+	proto.RegisterType((*FreezeExpiration)(nil), "coreum.asset.ft.v1.FreezeExpiration")
 	proto.RegisterType((*TokenUpgradeV1Status)(nil), "coreum.asset.ft.v1.TokenUpgradeV1Status")
 	proto.RegisterType((*TokenUpgradeStatuses)(nil), "coreum.asset.ft.v1.TokenUpgradeStatuses")
 	proto.RegisterType((*DEXSettings)(nil), "coreum.asset.ft.v1.DEXSettings")
+	proto.RegisterType((*VelocityLimit)(nil), "coreum.asset.ft.v1.VelocityLimit")
+	proto.RegisterType((*VelocityUsage)(nil), "coreum.asset.ft.v1.VelocityUsage")
+	proto.RegisterType((*VelocityUsageBucket)(nil), "coreum.asset.ft.v1.VelocityUsageBucket")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/token.proto", fileDescriptor_fe80c7a2c55589e7) }
 
 var fileDescriptor_fe80c7a2c55589e7 = []byte{
-	// 960 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x17, 0x15, 0xad, 0xc8, 0xa2, 0xae, 0x9c, 0x98, 0xdf, 0xc0, 0x31, 0x18, 0xe7, 0xab, 0xa8, 0xba,
-	0x40, 0x2b, 0x14, 0x08, 0x09, 0xbb, 0x40, 0x5a, 0x74, 0xd3, 0xc6, 0x7f, 0x48, 0x80, 0x16, 0x08,
-	0xe8, 0xb8, 0x2d, 0xba, 0x21, 0x86, 0xe4, 0x95, 0x34, 0x30, 0xc9, 0x11, 0x66, 0x86, 0xb2, 0x9d,
-	0x27, 0x28, 0xd0, 0x4d, 0x1e, 0x21, 0x2f, 0xd2, 0xae, 0xb3, 0x0c, 0xd0, 0x4d, 0xd1, 0x85, 0x5a,
-	0xc8, 0x9b, 0xae, 0xfa, 0x0c, 0xc5, 0x0c, 0x25, 0x47, 0x86, 0x0d, 0xa4, 0x31, 0xb2, 0xe3, 0x39,
-	0x67, 0xee, 0xc1, 0xe5, 0xbd, 0x87, 0x43, 0xe8, 0x24, 0x5c, 0x60, 0x99, 0x07, 0x54, 0x4a, 0x54,
-	0x41, 0x5f, 0x05, 0xe3, 0xad, 0x40, 0xf1, 0x63, 0x2c, 0xfc, 0x91, 0xe0, 0x8a, 0x13, 0x52, 0xe9,
-	0xbe, 0xd1, 0xfd, 0xbe, 0xf2, 0xc7, 0x5b, 0x1b, 0x6b, 0x03, 0x3e, 0xe0, 0x46, 0x0e, 0xf4, 0x53,
-	0x75, 0x72, 0xc3, 0x1b, 0x70, 0x3e, 0xc8, 0x30, 0x30, 0x28, 0x2e, 0xfb, 0x81, 0x62, 0x39, 0x4a,
-	0x45, 0xf3, 0x51, 0x75, 0x60, 0xf3, 0xb7, 0x3a, 0xc0, 0x1e, 0xf6, 0x59, 0xc1, 0x14, 0xe3, 0x05,
-	0x59, 0x83, 0x46, 0x8a, 0x05, 0xcf, 0x5d, 0xab, 0x6b, 0xf5, 0x5a, 0x61, 0x05, 0xc8, 0x3a, 0x2c,
-	0x33, 0x29, 0x4b, 0x14, 0xee, 0x92, 0xa1, 0x67, 0x88, 0x7c, 0x0e, 0x76, 0x1f, 0xa9, 0x2a, 0x05,
-	0x4a, 0xb7, 0xde, 0xad, 0xf7, 0xee, 0x6c, 0xdf, 0xf7, 0xaf, 0xb6, 0xe6, 0x1f, 0x54, 0x67, 0xc2,
-	0x8b, 0xc3, 0xe4, 0x6b, 0x68, 0xc5, 0xa5, 0x28, 0x22, 0x41, 0x15, 0xba, 0xb7, 0xb4, 0xe7, 0xce,
-	0x47, 0xaf, 0x26, 0x5e, 0xed, 0x8f, 0x89, 0x77, 0x3f, 0xe1, 0x32, 0xe7, 0x52, 0xa6, 0xc7, 0x3e,
-	0xe3, 0x41, 0x4e, 0xd5, 0xd0, 0xff, 0x06, 0x07, 0x34, 0x39, 0xdb, 0xc3, 0x24, 0xb4, 0x75, 0x55,
-	0x48, 0x15, 0x92, 0x23, 0x58, 0x93, 0x58, 0xa4, 0x51, 0xc2, 0xf3, 0x9c, 0x49, 0xc9, 0xf8, 0xcc,
-	0xac, 0xf1, 0xdf, 0xcd, 0x88, 0x36, 0xd8, 0xbd, 0xa8, 0x37, 0xb6, 0x2e, 0x34, 0xc7, 0x28, 0x34,
-	0x74, 0x97, 0xbb, 0x56, 0xef, 0x76, 0x38, 0x87, 0xe4, 0x1e, 0xd4, 0x4b, 0xc1, 0xdc, 0xa6, 0xf1,
-	0x6f, 0x4e, 0x27, 0x5e, 0xfd, 0x28, 0x7c, 0x12, 0x6a, 0x8e, 0x7c, 0x0c, 0x76, 0x29, 0x58, 0x34,
-	0xa4, 0x72, 0xe8, 0xda, 0x46, 0x6f, 0x4f, 0x27, 0x5e, 0xf3, 0x28, 0x7c, 0xf2, 0x98, 0xca, 0x61,
-	0xd8, 0x2c, 0x05, 0xd3, 0x0f, 0xe4, 0x31, 0xac, 0xe1, 0xa9, 0xc2, 0xc2, 0x74, 0x9b, 0x9c, 0x44,
-	0x34, 0x4d, 0x05, 0x4a, 0xe9, 0xb6, 0x4c, 0xcd, 0xfa, 0x74, 0xe2, 0x91, 0xfd, 0xb9, 0xbe, 0xfb,
-	0xfd, 0xa3, 0x4a, 0x0d, 0xc9, 0x45, 0xcd, 0xee, 0xc9, 0x8c, 0xd3, 0x6b, 0xa2, 0x69, 0xce, 0x0a,
-	0x17, 0xaa, 0x35, 0x19, 0xf0, 0xa5, 0xfd, 0xd3, 0x4b, 0xaf, 0xf6, 0xf7, 0x4b, 0xaf, 0xb6, 0xf9,
-	0x6b, 0x03, 0x1a, 0xcf, 0x74, 0x60, 0xde, 0x71, 0xa1, 0xeb, 0xb0, 0x2c, 0xcf, 0xf2, 0x98, 0x67,
-	0x6e, 0xbd, 0xe2, 0x2b, 0xa4, 0xc7, 0x22, 0xcb, 0xb8, 0x2c, 0x98, 0xaa, 0xb6, 0x15, 0xce, 0x21,
-	0xf9, 0x3f, 0xb4, 0x46, 0x02, 0x13, 0x66, 0x46, 0xd6, 0x30, 0x23, 0x7b, 0x43, 0x90, 0x2e, 0xb4,
-	0x53, 0x94, 0x89, 0x60, 0x23, 0x35, 0x1f, 0x69, 0x2b, 0x5c, 0xa4, 0xc8, 0x27, 0xb0, 0x3a, 0xc8,
-	0x78, 0x4c, 0xb3, 0xec, 0x2c, 0xea, 0x0b, 0xfe, 0x1c, 0x0b, 0x33, 0x62, 0x3b, 0xbc, 0x33, 0xa7,
-	0x0f, 0x0c, 0x7b, 0x29, 0x6b, 0xf6, 0x8d, 0xb3, 0xd6, 0x7a, 0x9f, 0x59, 0x83, 0xf7, 0x96, 0xb5,
-	0xf6, 0xb5, 0x59, 0x5b, 0x79, 0x4b, 0xd6, 0x6e, 0xdf, 0x20, 0x6b, 0x77, 0x6e, 0x9e, 0xb5, 0xd5,
-	0x85, 0xac, 0x91, 0x43, 0x58, 0x49, 0xf1, 0x34, 0x92, 0xa8, 0x14, 0x2b, 0x06, 0xd2, 0x75, 0xba,
-	0x56, 0xaf, 0xbd, 0xed, 0x5d, 0xb7, 0x92, 0xbd, 0xfd, 0x1f, 0x0e, 0x67, 0xc7, 0x76, 0x56, 0xa7,
-	0x13, 0xaf, 0xbd, 0x40, 0xe8, 0x30, 0x9c, 0xce, 0xc1, 0x42, 0x80, 0x1f, 0xc0, 0xdd, 0x3d, 0xcc,
-	0xe8, 0x19, 0xa6, 0x26, 0xc6, 0x47, 0xa3, 0x81, 0xa0, 0x29, 0x7e, 0xb7, 0x75, 0x7d, 0x9e, 0x37,
-	0x7f, 0xb1, 0x60, 0xed, 0xf2, 0xc1, 0x43, 0x45, 0x55, 0x29, 0x89, 0x07, 0x6d, 0x16, 0x27, 0x11,
-	0x16, 0x34, 0xce, 0x30, 0x35, 0x45, 0x76, 0x08, 0x2c, 0x4e, 0xf6, 0x2b, 0x86, 0xec, 0x02, 0x48,
-	0x45, 0x85, 0x8a, 0xf4, 0xc5, 0x68, 0xbe, 0x86, 0xf6, 0xf6, 0x86, 0x5f, 0xdd, 0x9a, 0xfe, 0xfc,
-	0xd6, 0xf4, 0x9f, 0xcd, 0x6f, 0xcd, 0x1d, 0x5b, 0x6f, 0xfb, 0xc5, 0x9f, 0x9e, 0x15, 0xb6, 0x4c,
-	0x9d, 0x56, 0xc8, 0x57, 0x60, 0xeb, 0x7c, 0x18, 0x8b, 0xfa, 0x3b, 0x58, 0x34, 0xb1, 0x48, 0x35,
-	0xbf, 0xf9, 0xf4, 0x72, 0xfb, 0x55, 0xf3, 0x28, 0xc9, 0x17, 0xb0, 0x34, 0xde, 0x32, 0x5d, 0xb7,
-	0xb7, 0x7b, 0xd7, 0xcd, 0xf6, 0xba, 0x97, 0x0e, 0x97, 0xc6, 0x5b, 0x9b, 0x3f, 0x5b, 0xb0, 0x38,
-	0x67, 0xf2, 0x2d, 0x90, 0xb2, 0x60, 0x7d, 0x86, 0x69, 0x24, 0xb0, 0x1f, 0xd1, 0x9c, 0x97, 0x85,
-	0xaa, 0x86, 0xb8, 0xe3, 0xbd, 0x2d, 0xbd, 0xce, 0xac, 0x34, 0xc4, 0xfe, 0x23, 0x53, 0x48, 0x1e,
-	0x00, 0x39, 0x19, 0x32, 0x85, 0x19, 0x93, 0x0a, 0xd3, 0xc8, 0x6c, 0x41, 0xba, 0x4b, 0xdd, 0x7a,
-	0xaf, 0x15, 0xfe, 0x6f, 0x41, 0xd9, 0x33, 0xc2, 0xa7, 0xff, 0x58, 0xd0, 0x9c, 0x7d, 0x99, 0xa4,
-	0x0d, 0xcd, 0x9c, 0x15, 0xba, 0x2b, 0xa7, 0xa6, 0x81, 0xfe, 0xcc, 0x34, 0xb0, 0xc8, 0x0a, 0xd8,
-	0x7d, 0x81, 0xf8, 0x5c, 0xa3, 0x25, 0xe2, 0xc0, 0xca, 0x85, 0x91, 0x66, 0xea, 0xa4, 0x09, 0x75,
-	0x16, 0x27, 0xce, 0x2d, 0x72, 0x0f, 0xee, 0xc6, 0x19, 0x4f, 0x8e, 0x23, 0x99, 0xeb, 0xd5, 0x25,
-	0xbc, 0x50, 0x82, 0x26, 0x4a, 0x3a, 0x0d, 0xed, 0x91, 0x64, 0xf4, 0x24, 0xa6, 0xc9, 0xb1, 0xb3,
-	0x4c, 0x6e, 0x43, 0xeb, 0x22, 0xd1, 0x4e, 0x53, 0x43, 0x1d, 0x5a, 0x53, 0xeb, 0xd8, 0x64, 0x03,
-	0xd6, 0x35, 0xbc, 0xfa, 0x22, 0x4e, 0x6b, 0xae, 0x71, 0x91, 0xa2, 0x88, 0x12, 0x5a, 0x24, 0x98,
-	0x65, 0x54, 0xdf, 0x58, 0x0e, 0x90, 0x0f, 0xe1, 0x03, 0xad, 0x5d, 0x9d, 0x67, 0x94, 0x0c, 0x69,
-	0x31, 0x40, 0xa7, 0xbd, 0xf3, 0xf4, 0xd5, 0xb4, 0x63, 0xbd, 0x9e, 0x76, 0xac, 0xbf, 0xa6, 0x1d,
-	0xeb, 0xc5, 0x79, 0xa7, 0xf6, 0xfa, 0xbc, 0x53, 0xfb, 0xfd, 0xbc, 0x53, 0xfb, 0xf1, 0xe1, 0x80,
-	0xa9, 0x61, 0x19, 0xfb, 0x09, 0xcf, 0x83, 0x5d, 0xb3, 0xd0, 0x03, 0x5e, 0x16, 0xa9, 0xf1, 0x0e,
-	0x66, 0xff, 0xfd, 0xf1, 0xc3, 0xe0, 0xf4, 0xcd, 0xcf, 0x5f, 0x9d, 0x8d, 0x50, 0xc6, 0xcb, 0x26,
-	0x49, 0x9f, 0xfd, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x05, 0x45, 0xfd, 0x9d, 0x1c, 0x08, 0x00, 0x00,
+	// 1434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4b, 0x6f, 0xdb, 0xc6,
+	0x16, 0x16, 0x2d, 0xeb, 0x75, 0xe4, 0x07, 0x33, 0x91, 0x1d, 0xda, 0xb9, 0x91, 0x1c, 0x5d, 0xe0,
+	0x46, 0x08, 0x6e, 0x24, 0xd8, 0x17, 0x37, 0xf7, 0x22, 0x2d, 0xd0, 0x5a, 0x7e, 0xc4, 0x01, 0x52,
+	0x20, 0xa0, 0xe3, 0x34, 0xed, 0x86, 0x18, 0x92, 0x23, 0x69, 0x60, 0x92, 0x23, 0x70, 0x86, 0xb2,
+	0x94, 0x6d, 0x17, 0x2d, 0xd0, 0x4d, 0xd0, 0x55, 0x97, 0xf9, 0x07, 0xfd, 0x05, 0xdd, 0x16, 0x59,
+	0x66, 0x59, 0x64, 0xe1, 0x16, 0x0e, 0x50, 0xf4, 0x67, 0x14, 0x33, 0x24, 0x65, 0xb9, 0x56, 0xea,
+	0xc4, 0xcd, 0x4e, 0xe7, 0x39, 0xe7, 0x9c, 0xf9, 0xbe, 0x39, 0x14, 0x54, 0x1d, 0x16, 0x92, 0xc8,
+	0x6f, 0x61, 0xce, 0x89, 0x68, 0x75, 0x44, 0x6b, 0xb0, 0xde, 0x12, 0xec, 0x90, 0x04, 0xcd, 0x7e,
+	0xc8, 0x04, 0x43, 0x28, 0xb6, 0x37, 0x95, 0xbd, 0xd9, 0x11, 0xcd, 0xc1, 0xfa, 0x6a, 0xa5, 0xcb,
+	0xba, 0x4c, 0x99, 0x5b, 0xf2, 0x57, 0xec, 0xb9, 0x5a, 0xed, 0x32, 0xd6, 0xf5, 0x48, 0x4b, 0x49,
+	0x76, 0xd4, 0x69, 0xb9, 0x51, 0x88, 0x05, 0x65, 0x49, 0xa6, 0xd5, 0xda, 0x9f, 0xed, 0x82, 0xfa,
+	0x84, 0x0b, 0xec, 0xf7, 0x63, 0x87, 0xfa, 0x63, 0x00, 0x93, 0x79, 0x64, 0x8f, 0x79, 0x2e, 0x09,
+	0xd1, 0xbf, 0x61, 0x36, 0x64, 0x1e, 0x31, 0xb4, 0x35, 0xad, 0xb1, 0xb0, 0x61, 0x34, 0xcf, 0xd7,
+	0xd1, 0x94, 0xde, 0xa6, 0xf2, 0x42, 0x06, 0x14, 0xb0, 0xeb, 0x86, 0x84, 0x73, 0x63, 0x66, 0x4d,
+	0x6b, 0x94, 0xcc, 0x54, 0xac, 0xbf, 0xc8, 0x01, 0x6c, 0x93, 0x0e, 0x0d, 0xa8, 0xac, 0x05, 0x55,
+	0x20, 0xe7, 0x92, 0x80, 0xf9, 0x2a, 0x6f, 0xc9, 0x8c, 0x05, 0xb4, 0x0c, 0x79, 0xca, 0x79, 0x44,
+	0xc2, 0x24, 0x3a, 0x91, 0xd0, 0xff, 0xa0, 0xd8, 0x21, 0x58, 0x44, 0x21, 0xe1, 0x46, 0x76, 0x2d,
+	0xdb, 0x58, 0xd8, 0xb8, 0x3e, 0xad, 0x90, 0xdd, 0xd8, 0xc7, 0x1c, 0x3b, 0xa3, 0x4f, 0xa1, 0x64,
+	0x47, 0x61, 0x60, 0x85, 0x58, 0x10, 0x63, 0x56, 0xe6, 0x6c, 0xff, 0xf3, 0xe5, 0x71, 0x2d, 0xf3,
+	0xfa, 0xb8, 0x76, 0xdd, 0x61, 0xdc, 0x67, 0x9c, 0xbb, 0x87, 0x4d, 0xca, 0x5a, 0x3e, 0x16, 0xbd,
+	0xe6, 0x43, 0xd2, 0xc5, 0xce, 0x68, 0x9b, 0x38, 0x66, 0x51, 0x46, 0x99, 0x58, 0x10, 0x74, 0x00,
+	0x15, 0x4e, 0x02, 0xd7, 0x72, 0x98, 0xef, 0x53, 0xce, 0x29, 0x4b, 0x92, 0xe5, 0xde, 0x3d, 0x19,
+	0x92, 0x09, 0xb6, 0xc6, 0xf1, 0x2a, 0xad, 0x01, 0x85, 0x01, 0x09, 0xa5, 0x68, 0xe4, 0xd7, 0xb4,
+	0xc6, 0xbc, 0x99, 0x8a, 0x68, 0x05, 0xb2, 0x51, 0x48, 0x8d, 0x82, 0xca, 0x5f, 0x38, 0x39, 0xae,
+	0x65, 0x0f, 0xcc, 0x07, 0xa6, 0xd4, 0xa1, 0x7f, 0x41, 0x31, 0x0a, 0xa9, 0xd5, 0xc3, 0xbc, 0x67,
+	0x14, 0x95, 0xbd, 0x7c, 0x72, 0x5c, 0x2b, 0x1c, 0x98, 0x0f, 0xf6, 0x30, 0xef, 0x99, 0x85, 0x28,
+	0xa4, 0xf2, 0x07, 0xda, 0x83, 0x0a, 0x19, 0x0a, 0x12, 0xa8, 0x6a, 0x9d, 0x23, 0x2b, 0xbd, 0x92,
+	0x92, 0x8a, 0x59, 0x3e, 0x39, 0xae, 0xa1, 0x9d, 0xd4, 0xbe, 0xf5, 0xf9, 0x66, 0x6c, 0x35, 0xd1,
+	0x38, 0x66, 0xeb, 0x28, 0xd1, 0xc9, 0x6b, 0xc2, 0xae, 0x4f, 0x03, 0x03, 0xe2, 0x6b, 0x52, 0x02,
+	0xba, 0x0f, 0x73, 0xf2, 0xb6, 0xad, 0x9e, 0x82, 0x08, 0x37, 0xca, 0x6b, 0xd9, 0x46, 0x79, 0xa3,
+	0xfa, 0x36, 0x6c, 0xc4, 0x48, 0x6a, 0xcf, 0xca, 0x59, 0x99, 0xe5, 0x70, 0xac, 0xe1, 0xe8, 0xff,
+	0x00, 0x3e, 0x1e, 0x5a, 0x3c, 0xea, 0xf7, 0xbd, 0x91, 0x31, 0xa7, 0xca, 0x5b, 0x79, 0x7d, 0x5c,
+	0x5b, 0x3a, 0x3f, 0xce, 0x07, 0x81, 0x30, 0x4b, 0x3e, 0x1e, 0xee, 0x2b, 0x5f, 0xb4, 0x07, 0x0b,
+	0x03, 0xe2, 0x31, 0x87, 0x8a, 0x91, 0xe5, 0x51, 0x9f, 0x0a, 0x63, 0x7e, 0x4d, 0x6b, 0x94, 0x37,
+	0x6e, 0x4e, 0x2b, 0xe2, 0x49, 0xe2, 0xf9, 0x50, 0x3a, 0x9a, 0xf3, 0x83, 0x49, 0xf1, 0x5e, 0xf1,
+	0x9b, 0x17, 0xb5, 0xcc, 0xef, 0x2f, 0x6a, 0x99, 0xfa, 0x6f, 0x05, 0xc8, 0x3d, 0x96, 0x9c, 0x7b,
+	0x4f, 0x74, 0x2e, 0x43, 0x9e, 0x8f, 0x7c, 0x9b, 0x79, 0x46, 0x36, 0xd6, 0xc7, 0x92, 0xbc, 0x63,
+	0x1e, 0xd9, 0x51, 0x40, 0x45, 0x0c, 0x3d, 0x33, 0x15, 0xd1, 0x3f, 0xa0, 0xd4, 0x0f, 0x89, 0x43,
+	0xd5, 0xfd, 0xe7, 0xd4, 0xfd, 0x9f, 0x2a, 0xd0, 0x1a, 0x94, 0x5d, 0xc2, 0x9d, 0x90, 0xf6, 0x45,
+	0x8a, 0x8f, 0x92, 0x39, 0xa9, 0x42, 0xb7, 0x60, 0xb1, 0xeb, 0x31, 0x1b, 0x7b, 0xde, 0xc8, 0xea,
+	0x84, 0xec, 0x19, 0x09, 0x14, 0x5e, 0x8a, 0xe6, 0x42, 0xaa, 0xde, 0x55, 0xda, 0x33, 0xc4, 0x29,
+	0x5e, 0x9a, 0x38, 0xa5, 0x0f, 0x49, 0x1c, 0xf8, 0x60, 0xc4, 0x29, 0x4f, 0x25, 0xce, 0xdc, 0x05,
+	0xc4, 0x99, 0xbf, 0x04, 0x71, 0x16, 0x2e, 0x4f, 0x9c, 0xc5, 0x49, 0xe2, 0xec, 0xc3, 0x9c, 0x4b,
+	0x86, 0x16, 0x27, 0x42, 0xd0, 0xa0, 0xcb, 0x0d, 0x5d, 0x61, 0xb6, 0x36, 0xed, 0x4a, 0xb6, 0x77,
+	0x9e, 0xee, 0x27, 0x6e, 0xed, 0xc5, 0x93, 0xe3, 0x5a, 0x79, 0x42, 0x21, 0xc1, 0x30, 0x4c, 0x85,
+	0x73, 0x6c, 0xbc, 0xf2, 0x61, 0xd8, 0x88, 0xfe, 0x16, 0x1b, 0xaf, 0x5e, 0x8e, 0x8d, 0xe8, 0x0b,
+	0x30, 0x62, 0x08, 0x5b, 0x9d, 0x90, 0x90, 0x67, 0xc4, 0x22, 0xc3, 0x3e, 0x0d, 0x09, 0xb7, 0xb0,
+	0x30, 0x2a, 0x2a, 0xe7, 0x6a, 0x33, 0x5e, 0x60, 0xcd, 0x74, 0x81, 0x35, 0x1f, 0xa7, 0x0b, 0xac,
+	0x3d, 0xfb, 0xfc, 0x97, 0x9a, 0x66, 0x2e, 0xc5, 0x19, 0x76, 0x55, 0x82, 0x9d, 0x38, 0x7e, 0x73,
+	0x92, 0xe8, 0x77, 0x60, 0x69, 0x9b, 0x78, 0x78, 0x44, 0x5c, 0x45, 0xf7, 0x83, 0x7e, 0x37, 0xc4,
+	0x2e, 0x79, 0xb2, 0x3e, 0x9d, 0xf7, 0xf5, 0x4d, 0x58, 0x4c, 0xdc, 0x0f, 0x82, 0xb8, 0x2a, 0xb5,
+	0xe7, 0x1c, 0x87, 0x45, 0x81, 0x48, 0x5c, 0x53, 0xf1, 0x34, 0xc5, 0xcc, 0x64, 0x8a, 0x16, 0x5c,
+	0x4b, 0x52, 0xdc, 0x4f, 0x08, 0x3a, 0x4e, 0x35, 0xfd, 0xcc, 0xaf, 0x35, 0xd0, 0x27, 0x1a, 0x50,
+	0x0b, 0xfc, 0x7d, 0x4f, 0x45, 0x5b, 0x00, 0x13, 0xe3, 0xcb, 0x5e, 0x38, 0xbe, 0xa2, 0xc4, 0x84,
+	0x1a, 0x61, 0x89, 0xa4, 0x63, 0xab, 0xff, 0xa8, 0x41, 0xe5, 0xec, 0x98, 0xf6, 0x05, 0x16, 0x11,
+	0x47, 0x35, 0x28, 0x53, 0xdb, 0xb1, 0x48, 0x80, 0x6d, 0x8f, 0xb8, 0xaa, 0xa2, 0xa2, 0x09, 0xd4,
+	0x76, 0x76, 0x62, 0x8d, 0x3c, 0x9e, 0x0b, 0x1c, 0x0a, 0x4b, 0x7e, 0x61, 0xa8, 0xca, 0xde, 0xf9,
+	0x78, 0x15, 0x27, 0x2d, 0xe8, 0x13, 0x28, 0xca, 0x57, 0x44, 0xa5, 0x78, 0x9f, 0x0e, 0x0a, 0x24,
+	0x70, 0xa5, 0xbe, 0xfe, 0xe8, 0x6c, 0xf9, 0x71, 0xf1, 0x44, 0xa2, 0x7d, 0x66, 0xb0, 0xae, 0xaa,
+	0x2e, 0x6f, 0x34, 0xa6, 0xe1, 0x74, 0x5a, 0xd3, 0xe6, 0xcc, 0x60, 0xbd, 0xfe, 0xad, 0x06, 0x93,
+	0x6c, 0x44, 0x9f, 0x01, 0x8a, 0x02, 0xda, 0xa1, 0xc4, 0xb5, 0x42, 0xd2, 0xb1, 0xb0, 0x7f, 0x7a,
+	0x43, 0xed, 0xda, 0x45, 0x6f, 0x9c, 0x9e, 0x84, 0x9a, 0xa4, 0xb3, 0xa9, 0x02, 0xd1, 0x1d, 0x40,
+	0x47, 0x3d, 0x2a, 0x88, 0x47, 0xb9, 0x20, 0xae, 0xa5, 0xae, 0x52, 0x7e, 0x4e, 0x65, 0x1b, 0x25,
+	0xf3, 0xca, 0x84, 0x65, 0x5b, 0x19, 0xea, 0x5f, 0x69, 0x30, 0x7f, 0x86, 0x52, 0xe8, 0x23, 0xc8,
+	0x1f, 0xd1, 0xc0, 0x65, 0x47, 0x49, 0x77, 0x2b, 0xe7, 0x06, 0xb6, 0x9d, 0x7c, 0x12, 0xc6, 0xf3,
+	0xfa, 0x5e, 0xce, 0x2b, 0x09, 0x41, 0xff, 0x85, 0x7c, 0xd2, 0x80, 0x82, 0x52, 0xfb, 0x46, 0xf2,
+	0x50, 0xbf, 0xe5, 0x11, 0x48, 0x9c, 0xeb, 0x4f, 0x4f, 0x8b, 0x38, 0xe0, 0xb8, 0x4b, 0xd0, 0x7d,
+	0x28, 0xd8, 0x91, 0x73, 0x48, 0x04, 0x37, 0x34, 0xf5, 0x20, 0xdd, 0xfa, 0xab, 0xb7, 0x40, 0xc5,
+	0xb4, 0x95, 0x7f, 0xf2, 0x32, 0xa5, 0xd1, 0xf5, 0x9f, 0x34, 0xb8, 0x3a, 0xc5, 0x0d, 0xdd, 0x83,
+	0x9c, 0x42, 0x49, 0xd2, 0xe4, 0xbb, 0xa1, 0x22, 0x0e, 0xb9, 0x64, 0x93, 0xe8, 0x63, 0x00, 0xf9,
+	0x7c, 0x7b, 0xcc, 0x39, 0x24, 0x6e, 0xbc, 0xec, 0x2f, 0x0a, 0x2d, 0xb9, 0x64, 0xf8, 0x50, 0xf9,
+	0xdf, 0xfe, 0x61, 0x06, 0x0a, 0xc9, 0xa2, 0x45, 0x65, 0x28, 0xf8, 0x34, 0x90, 0xf0, 0xd1, 0x33,
+	0x52, 0x90, 0x5b, 0x53, 0x0a, 0x1a, 0x9a, 0x83, 0xa2, 0x7a, 0x18, 0xa4, 0x34, 0x83, 0x74, 0x98,
+	0x1b, 0xdf, 0xb8, 0xd4, 0x64, 0x51, 0x01, 0xb2, 0xd4, 0x76, 0xf4, 0x59, 0xb4, 0x02, 0x4b, 0xb6,
+	0xac, 0xc4, 0xe2, 0xbe, 0xe4, 0x98, 0xc3, 0x02, 0x11, 0x62, 0x47, 0x70, 0x3d, 0x27, 0x73, 0x38,
+	0x1e, 0x3e, 0xb2, 0xb1, 0x73, 0xa8, 0xe7, 0xd1, 0x3c, 0x94, 0xc6, 0x0b, 0x4a, 0x2f, 0x48, 0x51,
+	0x36, 0xa1, 0x62, 0xf5, 0x22, 0x5a, 0x85, 0x65, 0x29, 0x9e, 0x47, 0x9c, 0x5e, 0x4a, 0x6d, 0x2c,
+	0x74, 0x49, 0x68, 0x39, 0x38, 0x70, 0x88, 0xe7, 0x29, 0xdc, 0xe8, 0x80, 0x6e, 0xc2, 0x0d, 0x69,
+	0x3b, 0x0f, 0x7c, 0xcb, 0xe9, 0xe1, 0xa0, 0x4b, 0xf4, 0x32, 0xba, 0x06, 0x57, 0x4f, 0xc3, 0x6d,
+	0xc6, 0x0e, 0xad, 0x1e, 0xf6, 0x84, 0x3e, 0x87, 0x96, 0xe0, 0xca, 0xd9, 0x75, 0x21, 0x5b, 0x9b,
+	0x47, 0x8b, 0xf2, 0xbb, 0x27, 0x18, 0xa5, 0xbd, 0x2e, 0xdc, 0xfe, 0x4e, 0x83, 0x59, 0xb9, 0xb2,
+	0x50, 0x05, 0x74, 0xb5, 0xe2, 0xa2, 0x80, 0xf7, 0x89, 0xa3, 0x4e, 0xd4, 0x33, 0x08, 0x20, 0x2f,
+	0x87, 0x48, 0x42, 0x5d, 0x93, 0x33, 0x8c, 0xdf, 0xd3, 0x50, 0x9f, 0x91, 0xee, 0x69, 0xff, 0x96,
+	0x8f, 0x03, 0xdc, 0x25, 0xa1, 0x9e, 0x95, 0xa7, 0x8e, 0xbb, 0x1c, 0xab, 0x67, 0x91, 0x01, 0x95,
+	0xc9, 0x9d, 0x3c, 0xb6, 0xe4, 0x64, 0x9a, 0xb4, 0x9e, 0xb1, 0x36, 0xdf, 0x7e, 0xf4, 0xf2, 0xa4,
+	0xaa, 0xbd, 0x3a, 0xa9, 0x6a, 0xbf, 0x9e, 0x54, 0xb5, 0xe7, 0x6f, 0xaa, 0x99, 0x57, 0x6f, 0xaa,
+	0x99, 0x9f, 0xdf, 0x54, 0x33, 0x5f, 0xde, 0xed, 0x52, 0xd1, 0x8b, 0xec, 0xa6, 0xc3, 0xfc, 0xd6,
+	0x96, 0xc2, 0xfa, 0x2e, 0x8b, 0x02, 0x57, 0x4d, 0xac, 0x95, 0xfc, 0xbf, 0x1b, 0xdc, 0x6d, 0x0d,
+	0x4f, 0xff, 0xe4, 0x89, 0x51, 0x9f, 0x70, 0x3b, 0xaf, 0x20, 0xfb, 0x9f, 0x3f, 0x02, 0x00, 0x00,
+	0xff, 0xff, 0x88, 0x04, 0xe6, 0xd7, 0x04, 0x0e, 0x00, 0x00,
 }
 
-func (m *Definition) Marshal() (dAtA []byte, err error) {
+func (m *RoleHolder) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -477,42 +939,115 @@ func (m *Definition) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Definition) MarshalTo(dAtA []byte) (int, error) {
+func (m *RoleHolder) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Definition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RoleHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.ExtensionCWAddress) > 0 {
-		i -= len(m.ExtensionCWAddress)
-		copy(dAtA[i:], m.ExtensionCWAddress)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.ExtensionCWAddress)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x12
 	}
-	if len(m.URIHash) > 0 {
-		i -= len(m.URIHash)
-		copy(dAtA[i:], m.URIHash)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+	if m.Role != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Role))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x8
 	}
-	if len(m.URI) > 0 {
-		i -= len(m.URI)
-		copy(dAtA[i:], m.URI)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
-		i--
+	return len(dAtA) - i, nil
+}
+
+func (m *Definition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Definition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Definition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VelocityLimit != nil {
+		{
+			size, err := m.VelocityLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.RoleHolders) > 0 {
+		for iNdEx := len(m.RoleHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ExtensionCWAddress) > 0 {
+		i -= len(m.ExtensionCWAddress)
+		copy(dAtA[i:], m.ExtensionCWAddress)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.ExtensionCWAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
+		i--
 		dAtA[i] = 0x3a
 	}
 	if m.Version != 0 {
@@ -541,20 +1076,20 @@ func (m *Definition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x22
 	if len(m.Features) > 0 {
-		dAtA2 := make([]byte, len(m.Features)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.Features)*10)
+		var j2 int
 		for _, num := range m.Features {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintToken(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintToken(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -595,6 +1130,62 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GlobalFreezeExpiresAt != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.GlobalFreezeExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GlobalFreezeExpiresAt):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintToken(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.VelocityLimit != nil {
+		{
+			size, err := m.VelocityLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RoleHolders) > 0 {
+		for iNdEx := len(m.RoleHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if m.DEXSettings != nil {
 		{
 			size, err := m.DEXSettings.MarshalToSizedBuffer(dAtA[:i])
@@ -663,20 +1254,20 @@ func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x4a
 	if len(m.Features) > 0 {
-		dAtA5 := make([]byte, len(m.Features)*10)
-		var j4 int
+		dAtA8 := make([]byte, len(m.Features)*10)
+		var j7 int
 		for _, num := range m.Features {
 			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j4++
+				j7++
 			}
-			dAtA5[j4] = uint8(num)
-			j4++
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintToken(dAtA, i, uint64(j4))
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintToken(dAtA, i, uint64(j7))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -763,6 +1354,118 @@ func (m *DelayedTokenUpgradeV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelayedUnfreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedUnfreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedUnfreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedGloballyUnfreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedGloballyUnfreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedGloballyUnfreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FreezeExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreezeExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreezeExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintToken(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TokenUpgradeV1Status) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -783,20 +1486,20 @@ func (m *TokenUpgradeV1Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err6 != nil {
-		return 0, err6
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n6
-	i = encodeVarintToken(dAtA, i, uint64(n6))
+	i -= n10
+	i = encodeVarintToken(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x1a
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err7 != nil {
-		return 0, err7
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n7
-	i = encodeVarintToken(dAtA, i, uint64(n7))
+	i -= n11
+	i = encodeVarintToken(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	if m.IbcEnabled {
@@ -891,61 +1594,220 @@ func (m *DEXSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
-	offset -= sovToken(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *VelocityLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Definition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *VelocityLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VelocityLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	if len(m.Features) > 0 {
-		l = 0
-		for _, e := range m.Features {
-			l += sovToken(uint64(e))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
-		n += 1 + sovToken(uint64(l)) + l
-	}
-	l = m.BurnRate.Size()
-	n += 1 + l + sovToken(uint64(l))
-	l = m.SendCommissionRate.Size()
-	n += 1 + l + sovToken(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovToken(uint64(m.Version))
-	}
-	l = len(m.URI)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+		i = encodeVarintToken(dAtA, i, uint64(size))
 	}
-	l = len(m.URIHash)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window):])
+	if err13 != nil {
+		return 0, err13
 	}
-	l = len(m.ExtensionCWAddress)
-	if l > 0 {
+	i -= n13
+	i = encodeVarintToken(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VelocityUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VelocityUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VelocityUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VelocityUsageBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VelocityUsageBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VelocityUsageBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DexLocked.Size()
+		i -= size
+		if _, err := m.DexLocked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintToken(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
+	offset -= sovToken(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RoleHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovToken(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *Definition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovToken(uint64(e))
+		}
+		n += 1 + sovToken(uint64(l)) + l
+	}
+	l = m.BurnRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.SendCommissionRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovToken(uint64(m.Version))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.ExtensionCWAddress)
+	if l > 0 {
 		n += 1 + l + sovToken(uint64(l))
 	}
 	l = len(m.Admin)
 	if l > 0 {
 		n += 1 + l + sovToken(uint64(l))
 	}
+	if len(m.RoleHolders) > 0 {
+		for _, e := range m.RoleHolders {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.VelocityLimit != nil {
+		l = m.VelocityLimit.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
 	return n
 }
 
@@ -1015,6 +1877,24 @@ func (m *Token) Size() (n int) {
 		l = m.DEXSettings.Size()
 		n += 2 + l + sovToken(uint64(l))
 	}
+	if len(m.RoleHolders) > 0 {
+		for _, e := range m.RoleHolders {
+			l = e.Size()
+			n += 2 + l + sovToken(uint64(l))
+		}
+	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 2 + l + sovToken(uint64(l))
+	}
+	if m.VelocityLimit != nil {
+		l = m.VelocityLimit.Size()
+		n += 2 + l + sovToken(uint64(l))
+	}
+	if m.GlobalFreezeExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GlobalFreezeExpiresAt)
+		n += 2 + l + sovToken(uint64(l))
+	}
 	return n
 }
 
@@ -1031,6 +1911,55 @@ func (m *DelayedTokenUpgradeV1) Size() (n int) {
 	return n
 }
 
+func (m *DelayedUnfreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *DelayedGloballyUnfreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *FreezeExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
 func (m *TokenUpgradeV1Status) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1079,13 +2008,56 @@ func (m *DEXSettings) Size() (n int) {
 	return n
 }
 
+func (m *VelocityLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovToken(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *VelocityUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VelocityUsageBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovToken(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.DexLocked.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
 func sovToken(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozToken(x uint64) (n int) {
 	return sovToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Definition) Unmarshal(dAtA []byte) error {
+func (m *RoleHolder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1108,17 +2080,17 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Definition: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleHolder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Definition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleHolder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var stringLen uint64
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1128,27 +2100,14 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Role |= Role(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1176,23 +2135,137 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType == 0 {
-				var v Feature
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowToken
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Feature(b&0x7F) << shift
-					if b < 0x80 {
-						break
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Definition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Definition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Definition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Feature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Feature(b&0x7F) << shift
+					if b < 0x80 {
+						break
 					}
 				}
 				m.Features = append(m.Features, v)
@@ -1462,6 +2535,112 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleHolders = append(m.RoleHolders, RoleHolder{})
+			if err := m.RoleHolders[len(m.RoleHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VelocityLimit == nil {
+				m.VelocityLimit = &VelocityLimit{}
+			}
+			if err := m.VelocityLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -1903,7 +3082,756 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionCWAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionCWAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DEXSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DEXSettings == nil {
+				m.DEXSettings = &DEXSettings{}
+			}
+			if err := m.DEXSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleHolders = append(m.RoleHolders, RoleHolder{})
+			if err := m.RoleHolders[len(m.RoleHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VelocityLimit == nil {
+				m.VelocityLimit = &VelocityLimit{}
+			}
+			if err := m.VelocityLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalFreezeExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GlobalFreezeExpiresAt == nil {
+				m.GlobalFreezeExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.GlobalFreezeExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedTokenUpgradeV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedTokenUpgradeV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedUnfreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedUnfreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedGloballyUnfreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedGloballyUnfreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedGloballyUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreezeExpiration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreezeExpiration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreezeExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenUpgradeV1Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenUpgradeV1Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcEnabled", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1913,29 +3841,17 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 14:
+			m.IbcEnabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionCWAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1945,29 +3861,30 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtensionCWAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1977,27 +3894,78 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenUpgradeStatuses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenUpgradeStatuses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenUpgradeStatuses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DEXSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field V1", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2024,10 +3992,10 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DEXSettings == nil {
-				m.DEXSettings = &DEXSettings{}
+			if m.V1 == nil {
+				m.V1 = &TokenUpgradeV1Status{}
 			}
-			if err := m.DEXSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.V1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2052,7 +4020,7 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
+func (m *DEXSettings) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2075,15 +4043,15 @@ func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelayedTokenUpgradeV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: DEXSettings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelayedTokenUpgradeV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DEXSettings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnifiedRefAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2111,7 +4079,43 @@ func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.LegacyDec
+			m.UnifiedRefAmount = &v
+			if err := m.UnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistedDenoms = append(m.WhitelistedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2134,7 +4138,7 @@ func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
+func (m *VelocityLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2157,35 +4161,15 @@ func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenUpgradeV1Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: VelocityLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenUpgradeV1Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VelocityLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowToken
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IbcEnabled = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2212,15 +4196,15 @@ func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -2230,22 +4214,23 @@ func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2270,7 +4255,7 @@ func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenUpgradeStatuses) Unmarshal(dAtA []byte) error {
+func (m *VelocityUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2293,15 +4278,15 @@ func (m *TokenUpgradeStatuses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenUpgradeStatuses: wiretype end group for non-group")
+			return fmt.Errorf("proto: VelocityUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenUpgradeStatuses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VelocityUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2328,10 +4313,8 @@ func (m *TokenUpgradeStatuses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.V1 == nil {
-				m.V1 = &TokenUpgradeV1Status{}
-			}
-			if err := m.V1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Buckets = append(m.Buckets, VelocityUsageBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2356,7 +4339,7 @@ func (m *TokenUpgradeStatuses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DEXSettings) Unmarshal(dAtA []byte) error {
+func (m *VelocityUsageBucket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2379,15 +4362,48 @@ func (m *DEXSettings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DEXSettings: wiretype end group for non-group")
+			return fmt.Errorf("proto: VelocityUsageBucket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DEXSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VelocityUsageBucket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnifiedRefAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2415,15 +4431,13 @@ func (m *DEXSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.UnifiedRefAmount = &v
-			if err := m.UnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DexLocked", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2451,7 +4465,9 @@ func (m *DEXSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WhitelistedDenoms = append(m.WhitelistedDenoms, string(dAtA[iNdEx:postIndex]))
+			if err := m.DexLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
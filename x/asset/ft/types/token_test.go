@@ -324,6 +324,14 @@ func TestValidateFeatures(t *testing.T) {
 			},
 			Ok: false,
 		},
+		{
+			Name: "dex_block_order_book_halt",
+			Features: []types.Feature{
+				types.Feature_dex_block,
+				types.Feature_dex_order_book_halt,
+			},
+			Ok: false,
+		},
 		{
 			Name: "all_dex_features_except_block",
 			Features: []types.Feature{
@@ -16,18 +16,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -56,6 +60,11 @@ type MsgIssue struct {
 	ExtensionSettings *ExtensionIssueSettings `protobuf:"bytes,12,opt,name=extension_settings,json=extensionSettings,proto3" json:"extension_settings,omitempty"`
 	// dex_settings allowed to be customized by issuer
 	DEXSettings *DEXSettings `protobuf:"bytes,13,opt,name=dex_settings,json=dexSettings,proto3" json:"dex_settings,omitempty"`
+	// max_supply is the cap of the token supply which can't be exceeded by minting, the supply isn't capped if not set.
+	MaxSupply *cosmossdk_io_math.Int `protobuf:"bytes,14,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply,omitempty"`
+	// velocity_limit is the limit of the token outflow per account within the window, it must be set only if
+	// the velocity_limiting feature is enabled.
+	VelocityLimit *VelocityLimit `protobuf:"bytes,15,opt,name=velocity_limit,json=velocityLimit,proto3" json:"velocity_limit,omitempty"`
 }
 
 func (m *MsgIssue) Reset()         { *m = MsgIssue{} }
@@ -218,6 +227,9 @@ type MsgFreeze struct {
 	Sender  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Account string     `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	Coin    types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+	// expires_at is the time the frozen balance of the account is automatically unfrozen at, the frozen balance
+	// stays frozen until it's unfrozen explicitly if not set.
+	ExpiresAt *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
 }
 
 func (m *MsgFreeze) Reset()         { *m = MsgFreeze{} }
@@ -296,6 +308,9 @@ type MsgSetFrozen struct {
 	Sender  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Account string     `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	Coin    types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+	// expires_at is the time the frozen balance of the account is automatically unfrozen at, the frozen balance
+	// stays frozen until it's unfrozen explicitly if not set.
+	ExpiresAt *time.Time `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
 }
 
 func (m *MsgSetFrozen) Reset()         { *m = MsgSetFrozen{} }
@@ -334,6 +349,9 @@ var xxx_messageInfo_MsgSetFrozen proto.InternalMessageInfo
 type MsgGloballyFreeze struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// expires_at is the time the global freeze is automatically removed at, the global freeze lasts until it's
+	// removed explicitly if not set.
+	ExpiresAt *time.Time `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at,omitempty"`
 }
 
 func (m *MsgGloballyFreeze) Reset()         { *m = MsgGloballyFreeze{} }
@@ -485,6 +503,124 @@ func (m *MsgSetWhitelistedLimit) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetWhitelistedLimit proto.InternalMessageInfo
 
+type MsgSetVelocityLimitOverride struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// coin is the amount the account is allowed to send within the window, zero amount removes the override.
+	Coin types.Coin `protobuf:"bytes,3,opt,name=coin,proto3" json:"coin"`
+}
+
+func (m *MsgSetVelocityLimitOverride) Reset()         { *m = MsgSetVelocityLimitOverride{} }
+func (m *MsgSetVelocityLimitOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgSetVelocityLimitOverride) ProtoMessage()    {}
+func (*MsgSetVelocityLimitOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{11}
+}
+func (m *MsgSetVelocityLimitOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetVelocityLimitOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetVelocityLimitOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetVelocityLimitOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetVelocityLimitOverride.Merge(m, src)
+}
+func (m *MsgSetVelocityLimitOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetVelocityLimitOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetVelocityLimitOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetVelocityLimitOverride proto.InternalMessageInfo
+
+type MsgAddToDenylist struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgAddToDenylist) Reset()         { *m = MsgAddToDenylist{} }
+func (m *MsgAddToDenylist) String() string { return proto.CompactTextString(m) }
+func (*MsgAddToDenylist) ProtoMessage()    {}
+func (*MsgAddToDenylist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{12}
+}
+func (m *MsgAddToDenylist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddToDenylist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddToDenylist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddToDenylist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddToDenylist.Merge(m, src)
+}
+func (m *MsgAddToDenylist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddToDenylist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddToDenylist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddToDenylist proto.InternalMessageInfo
+
+type MsgRemoveFromDenylist struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Denom   string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveFromDenylist) Reset()         { *m = MsgRemoveFromDenylist{} }
+func (m *MsgRemoveFromDenylist) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFromDenylist) ProtoMessage()    {}
+func (*MsgRemoveFromDenylist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{13}
+}
+func (m *MsgRemoveFromDenylist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFromDenylist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFromDenylist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFromDenylist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFromDenylist.Merge(m, src)
+}
+func (m *MsgRemoveFromDenylist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFromDenylist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFromDenylist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFromDenylist proto.InternalMessageInfo
+
 type MsgTransferAdmin struct {
 	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
@@ -495,7 +631,7 @@ func (m *MsgTransferAdmin) Reset()         { *m = MsgTransferAdmin{} }
 func (m *MsgTransferAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgTransferAdmin) ProtoMessage()    {}
 func (*MsgTransferAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e54b0962ccfc4ca0, []int{11}
+	return fileDescriptor_e54b0962ccfc4ca0, []int{14}
 }
 func (m *MsgTransferAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -533,7 +669,7 @@ func (m *MsgClearAdmin) Reset()         { *m = MsgClearAdmin{} }
 func (m *MsgClearAdmin) String() string { return proto.CompactTextString(m) }
 func (*MsgClearAdmin) ProtoMessage()    {}
 func (*MsgClearAdmin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e54b0962ccfc4ca0, []int{12}
+	return fileDescriptor_e54b0962ccfc4ca0, []int{15}
 }
 func (m *MsgClearAdmin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,6 +698,126 @@ func (m *MsgClearAdmin) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClearAdmin proto.InternalMessageInfo
 
+type MsgGrantRole struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role    Role   `protobuf:"varint,3,opt,name=role,proto3,enum=coreum.asset.ft.v1.Role" json:"role,omitempty"`
+	Account string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *MsgGrantRole) Reset()         { *m = MsgGrantRole{} }
+func (m *MsgGrantRole) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantRole) ProtoMessage()    {}
+func (*MsgGrantRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{16}
+}
+func (m *MsgGrantRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantRole.Merge(m, src)
+}
+func (m *MsgGrantRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantRole proto.InternalMessageInfo
+
+type MsgRevokeRole struct {
+	Sender  string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Role    Role   `protobuf:"varint,3,opt,name=role,proto3,enum=coreum.asset.ft.v1.Role" json:"role,omitempty"`
+	Account string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *MsgRevokeRole) Reset()         { *m = MsgRevokeRole{} }
+func (m *MsgRevokeRole) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeRole) ProtoMessage()    {}
+func (*MsgRevokeRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{17}
+}
+func (m *MsgRevokeRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeRole.Merge(m, src)
+}
+func (m *MsgRevokeRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeRole proto.InternalMessageInfo
+
+type MsgUpdateMaxSupply struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// max_supply is the new cap of the token supply, it must be lower than the current one.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+}
+
+func (m *MsgUpdateMaxSupply) Reset()         { *m = MsgUpdateMaxSupply{} }
+func (m *MsgUpdateMaxSupply) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMaxSupply) ProtoMessage()    {}
+func (*MsgUpdateMaxSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e54b0962ccfc4ca0, []int{18}
+}
+func (m *MsgUpdateMaxSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMaxSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMaxSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMaxSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMaxSupply.Merge(m, src)
+}
+func (m *MsgUpdateMaxSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMaxSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMaxSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMaxSupply proto.InternalMessageInfo
+
 type MsgUpdateParams struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Params    Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
@@ -571,7 +827,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e54b0962ccfc4ca0, []int{13}
+	return fileDescriptor_e54b0962ccfc4ca0, []int{19}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +867,7 @@ func (m *MsgUpdateDEXUnifiedRefAmount) Reset()         { *m = MsgUpdateDEXUnifie
 func (m *MsgUpdateDEXUnifiedRefAmount) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDEXUnifiedRefAmount) ProtoMessage()    {}
 func (*MsgUpdateDEXUnifiedRefAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e54b0962ccfc4ca0, []int{14}
+	return fileDescriptor_e54b0962ccfc4ca0, []int{20}
 }
 func (m *MsgUpdateDEXUnifiedRefAmount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -651,7 +907,7 @@ func (m *MsgUpdateDEXWhitelistedDenoms) Reset()         { *m = MsgUpdateDEXWhite
 func (m *MsgUpdateDEXWhitelistedDenoms) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDEXWhitelistedDenoms) ProtoMessage()    {}
 func (*MsgUpdateDEXWhitelistedDenoms) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e54b0962ccfc4ca0, []int{15}
+	return fileDescriptor_e54b0962ccfc4ca0, []int{21}
 }
 func (m *MsgUpdateDEXWhitelistedDenoms) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,7 +943,7 @@ func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
 func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
 func (*EmptyResponse) ProtoMessage()    {}
 func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e54b0962ccfc4ca0, []int{16}
+	return fileDescriptor_e54b0962ccfc4ca0, []int{22}
 }
 func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -728,8 +984,14 @@ func init() {
 	proto.RegisterType((*MsgGloballyUnfreeze)(nil), "coreum.asset.ft.v1.MsgGloballyUnfreeze")
 	proto.RegisterType((*MsgClawback)(nil), "coreum.asset.ft.v1.MsgClawback")
 	proto.RegisterType((*MsgSetWhitelistedLimit)(nil), "coreum.asset.ft.v1.MsgSetWhitelistedLimit")
+	proto.RegisterType((*MsgSetVelocityLimitOverride)(nil), "coreum.asset.ft.v1.MsgSetVelocityLimitOverride")
+	proto.RegisterType((*MsgAddToDenylist)(nil), "coreum.asset.ft.v1.MsgAddToDenylist")
+	proto.RegisterType((*MsgRemoveFromDenylist)(nil), "coreum.asset.ft.v1.MsgRemoveFromDenylist")
 	proto.RegisterType((*MsgTransferAdmin)(nil), "coreum.asset.ft.v1.MsgTransferAdmin")
 	proto.RegisterType((*MsgClearAdmin)(nil), "coreum.asset.ft.v1.MsgClearAdmin")
+	proto.RegisterType((*MsgGrantRole)(nil), "coreum.asset.ft.v1.MsgGrantRole")
+	proto.RegisterType((*MsgRevokeRole)(nil), "coreum.asset.ft.v1.MsgRevokeRole")
+	proto.RegisterType((*MsgUpdateMaxSupply)(nil), "coreum.asset.ft.v1.MsgUpdateMaxSupply")
 	proto.RegisterType((*MsgUpdateParams)(nil), "coreum.asset.ft.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateDEXUnifiedRefAmount)(nil), "coreum.asset.ft.v1.MsgUpdateDEXUnifiedRefAmount")
 	proto.RegisterType((*MsgUpdateDEXWhitelistedDenoms)(nil), "coreum.asset.ft.v1.MsgUpdateDEXWhitelistedDenoms")
@@ -739,102 +1001,124 @@ func init() {
 func init() { proto.RegisterFile("coreum/asset/ft/v1/tx.proto", fileDescriptor_e54b0962ccfc4ca0) }
 
 var fileDescriptor_e54b0962ccfc4ca0 = []byte{
-	// 1508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xd6, 0x49, 0x1c, 0x8f, 0x93, 0x26, 0xd9, 0xa6, 0xed, 0x26, 0x69, 0x6d, 0x77, 0xdb,
-	0x7e, 0xbf, 0x21, 0xa8, 0x5e, 0x92, 0xd2, 0x22, 0x22, 0x21, 0xd1, 0xfc, 0xa2, 0x41, 0x75, 0x55,
-	0x36, 0x0d, 0x2d, 0x3d, 0x60, 0x8d, 0x77, 0xc7, 0xeb, 0x21, 0xde, 0x59, 0x6b, 0x67, 0x36, 0x3f,
-	0x7a, 0x40, 0x88, 0x03, 0x87, 0x9e, 0xe0, 0xca, 0x01, 0x89, 0x1b, 0xe2, 0x42, 0x54, 0xfa, 0x47,
-	0x94, 0x5b, 0x05, 0x17, 0x04, 0x52, 0x80, 0xf4, 0x90, 0x23, 0x77, 0x4e, 0x68, 0x66, 0x77, 0xed,
-	0xb5, 0xb3, 0x4e, 0xb7, 0x69, 0x25, 0x7a, 0xb1, 0x77, 0xe6, 0xbd, 0xf7, 0x79, 0x9f, 0x79, 0xf3,
-	0x66, 0xde, 0xdb, 0x05, 0x93, 0x86, 0xe3, 0x22, 0xcf, 0xd6, 0x20, 0xa5, 0x88, 0x69, 0x55, 0xa6,
-	0x6d, 0xcc, 0x68, 0x6c, 0xab, 0xd8, 0x70, 0x1d, 0xe6, 0xc8, 0xb2, 0x2f, 0x2c, 0x0a, 0x61, 0xb1,
-	0xca, 0x8a, 0x1b, 0x33, 0x13, 0xa3, 0xd0, 0xc6, 0xc4, 0xd1, 0xc4, 0xaf, 0xaf, 0x36, 0x91, 0x8f,
-	0xc1, 0x68, 0x40, 0x17, 0xda, 0x34, 0x50, 0xc8, 0xc5, 0x39, 0x71, 0xd6, 0x11, 0x69, 0xc9, 0xa9,
-	0xed, 0x50, 0xad, 0x02, 0x29, 0xd2, 0x36, 0x66, 0x2a, 0x88, 0xc1, 0x19, 0xcd, 0x70, 0x70, 0x28,
-	0x3f, 0x1d, 0xc8, 0x6d, 0x6a, 0x71, 0x53, 0x9b, 0x5a, 0x81, 0x60, 0xdc, 0x17, 0x94, 0xc5, 0x48,
-	0xf3, 0x07, 0x81, 0x68, 0xcc, 0x72, 0x2c, 0xc7, 0x9f, 0xe7, 0x4f, 0xfe, 0xac, 0xfa, 0x7b, 0x1f,
-	0x18, 0x28, 0x51, 0x6b, 0x85, 0x52, 0x0f, 0xc9, 0x6f, 0x80, 0x7e, 0xcc, 0x1f, 0x5c, 0x45, 0x2a,
-	0x48, 0x53, 0x99, 0x79, 0xe5, 0xe7, 0x47, 0x97, 0xc6, 0x02, 0x90, 0x6b, 0xa6, 0xe9, 0x22, 0x4a,
-	0x57, 0x99, 0x8b, 0x89, 0xa5, 0x07, 0x7a, 0xf2, 0x29, 0xd0, 0x4f, 0xb7, 0xed, 0x8a, 0x53, 0x57,
-	0x8e, 0x71, 0x0b, 0x3d, 0x18, 0xc9, 0x0a, 0x48, 0x53, 0xaf, 0xe2, 0x11, 0xcc, 0x94, 0x94, 0x10,
-	0x84, 0x43, 0xf9, 0x0c, 0xc8, 0x34, 0x5c, 0x64, 0x60, 0x8a, 0x1d, 0xa2, 0xf4, 0x16, 0xa4, 0xa9,
-	0x21, 0xbd, 0x35, 0x21, 0x2f, 0x82, 0xe3, 0x98, 0x60, 0x86, 0x61, 0xbd, 0x0c, 0x6d, 0xc7, 0x23,
-	0x4c, 0xe9, 0x13, 0x4c, 0xce, 0x3e, 0xde, 0xcd, 0xf7, 0xfc, 0xb6, 0x9b, 0x3f, 0xe9, 0xb3, 0xa1,
-	0xe6, 0x7a, 0x11, 0x3b, 0x9a, 0x0d, 0x59, 0xad, 0xb8, 0x42, 0x98, 0x3e, 0x14, 0x18, 0x5d, 0x13,
-	0x36, 0x72, 0x01, 0x64, 0x4d, 0x44, 0x0d, 0x17, 0x37, 0x18, 0xf7, 0xd2, 0x2f, 0x18, 0x44, 0xa7,
-	0xe4, 0xb7, 0xc0, 0x40, 0x15, 0x41, 0xe6, 0xb9, 0x88, 0x2a, 0xe9, 0x42, 0x6a, 0xea, 0xf8, 0xec,
-	0x64, 0xf1, 0xe0, 0xde, 0x16, 0x97, 0x7d, 0x1d, 0xbd, 0xa9, 0x2c, 0xbf, 0x0b, 0x32, 0x15, 0xcf,
-	0x25, 0x65, 0x17, 0x32, 0xa4, 0x0c, 0x08, 0x6e, 0xe7, 0x03, 0x6e, 0x93, 0x07, 0xb9, 0xdd, 0x40,
-	0x16, 0x34, 0xb6, 0x17, 0x91, 0xa1, 0x0f, 0x70, 0x2b, 0x1d, 0x32, 0x24, 0xaf, 0x81, 0x31, 0x8a,
-	0x88, 0x59, 0x36, 0x1c, 0xdb, 0xc6, 0x94, 0xaf, 0xda, 0x07, 0xcb, 0x24, 0x07, 0x93, 0x39, 0xc0,
-	0x42, 0xd3, 0x5e, 0xc0, 0x8e, 0x83, 0x94, 0xe7, 0x62, 0x05, 0x08, 0x94, 0xf4, 0xde, 0x6e, 0x3e,
-	0xb5, 0xa6, 0xaf, 0xe8, 0x7c, 0x4e, 0xfe, 0x1f, 0x18, 0xf0, 0x5c, 0x5c, 0xae, 0x41, 0x5a, 0x53,
-	0xb2, 0x42, 0x9e, 0xdd, 0xdb, 0xcd, 0xa7, 0xd7, 0xf4, 0x95, 0xeb, 0x90, 0xd6, 0xf4, 0xb4, 0xe7,
-	0x62, 0xfe, 0x20, 0x7f, 0x04, 0x64, 0xb4, 0xc5, 0x10, 0x11, 0x9c, 0x28, 0x62, 0x0c, 0x13, 0x8b,
-	0x2a, 0x83, 0x05, 0x69, 0x2a, 0x3b, 0x3b, 0x1d, 0x17, 0x9e, 0xa5, 0x50, 0x5b, 0xa4, 0xcf, 0x6a,
-	0x60, 0xa1, 0x8f, 0x36, 0x51, 0xc2, 0x29, 0x79, 0x15, 0x0c, 0x9a, 0x68, 0xab, 0x05, 0x3a, 0x24,
-	0x40, 0xf3, 0x71, 0xa0, 0x8b, 0x4b, 0x77, 0x43, 0xb3, 0xf9, 0xe1, 0xbd, 0xdd, 0x7c, 0x36, 0x32,
-	0xc1, 0x37, 0x71, 0x2b, 0x1c, 0xcc, 0x15, 0x3e, 0xdf, 0xdf, 0x99, 0x0e, 0x32, 0xf1, 0xc1, 0xfe,
-	0xce, 0xf4, 0x88, 0x80, 0xa9, 0x32, 0x2d, 0x4c, 0x68, 0xf5, 0xdb, 0x63, 0xe0, 0x54, 0x3c, 0x49,
-	0xf9, 0x34, 0x48, 0x1b, 0x8e, 0x89, 0xca, 0xd8, 0x14, 0xc9, 0xde, 0xab, 0xf7, 0xf3, 0xe1, 0x8a,
-	0x29, 0x8f, 0x81, 0xbe, 0x3a, 0xac, 0xa0, 0x30, 0xa3, 0xfd, 0x81, 0x5c, 0x05, 0x7d, 0x55, 0x8f,
-	0x98, 0x54, 0x49, 0x15, 0x52, 0x53, 0xd9, 0xd9, 0xf1, 0x62, 0x70, 0x2c, 0xf8, 0x09, 0x2d, 0x06,
-	0x27, 0xb4, 0xb8, 0xe0, 0x60, 0x32, 0x7f, 0x85, 0xef, 0xe0, 0xf7, 0x7f, 0xe4, 0xa7, 0x2c, 0xcc,
-	0x6a, 0x5e, 0xa5, 0x68, 0x38, 0x76, 0x70, 0x10, 0x83, 0xbf, 0x4b, 0xd4, 0x5c, 0xd7, 0xd8, 0x76,
-	0x03, 0x51, 0x61, 0x40, 0xbf, 0xdb, 0xdf, 0x99, 0x96, 0x74, 0x1f, 0x5e, 0x6e, 0x80, 0x41, 0xbe,
-	0x20, 0x48, 0x0c, 0x54, 0xb6, 0xa9, 0x25, 0x4e, 0xc8, 0xe0, 0x7c, 0xe9, 0x9f, 0xdd, 0xfc, 0xdb,
-	0x11, 0xbc, 0x05, 0x87, 0xda, 0x77, 0x20, 0xb5, 0xb5, 0x4d, 0x48, 0x6d, 0x53, 0xdb, 0x12, 0xff,
-	0x01, 0xa6, 0x0e, 0x37, 0x17, 0x1c, 0xc2, 0x5c, 0x68, 0xb0, 0x12, 0xa2, 0x14, 0x5a, 0xe8, 0xeb,
-	0xfd, 0x9d, 0xe9, 0x2c, 0x26, 0x75, 0x4c, 0x50, 0xf9, 0x13, 0xea, 0x10, 0x3d, 0x1b, 0xba, 0x28,
-	0x51, 0x4b, 0xfd, 0x41, 0x02, 0xe9, 0x12, 0xb5, 0x4a, 0x98, 0x30, 0x7e, 0x01, 0xf0, 0xd4, 0x4a,
-	0x72, 0x01, 0xf8, 0x7a, 0xf2, 0x65, 0xd0, 0xcb, 0xef, 0x25, 0x11, 0xac, 0x43, 0xc3, 0xd2, 0xcb,
-	0xc3, 0xa2, 0x0b, 0x65, 0x7e, 0x07, 0xf0, 0x13, 0xdf, 0xc0, 0x88, 0x84, 0xf7, 0x43, 0x6b, 0x62,
-	0x2e, 0x2f, 0xb6, 0xd5, 0xc7, 0xe7, 0xdb, 0x3a, 0x1c, 0xd9, 0x56, 0xce, 0x52, 0xfd, 0xca, 0x67,
-	0x3c, 0xef, 0xb9, 0xe4, 0x05, 0x18, 0xa7, 0x9e, 0x83, 0xf1, 0xa1, 0x9c, 0x38, 0x0f, 0x1e, 0xc5,
-	0x4c, 0x89, 0x5a, 0xcb, 0x2e, 0x42, 0xf7, 0xd1, 0x11, 0x58, 0x29, 0x20, 0x0d, 0x0d, 0x43, 0xdc,
-	0x78, 0x7e, 0xde, 0x85, 0xc3, 0xa3, 0xf1, 0x3d, 0xd7, 0xc1, 0x77, 0x34, 0xc2, 0xd7, 0xe7, 0xa8,
-	0xfe, 0x28, 0x81, 0x6c, 0x89, 0x5a, 0x6b, 0xa4, 0xfa, 0x8a, 0x70, 0x3e, 0xdf, 0xc1, 0xf9, 0x44,
-	0x84, 0x73, 0xc8, 0x52, 0x7d, 0x28, 0x81, 0xc1, 0x12, 0xb5, 0x56, 0x11, 0x5b, 0x76, 0x9d, 0xfb,
-	0x88, 0xbc, 0xc2, 0xa1, 0x6e, 0x72, 0x54, 0xbf, 0x90, 0xc0, 0x68, 0x89, 0x5a, 0xef, 0xd5, 0x9d,
-	0x0a, 0xac, 0xd7, 0xb7, 0x8f, 0x9c, 0x24, 0x63, 0xa0, 0xcf, 0x44, 0xc4, 0xb1, 0xc3, 0xab, 0x49,
-	0x0c, 0xe6, 0x5e, 0xeb, 0x20, 0x30, 0x1e, 0x89, 0x5b, 0xbb, 0x4b, 0xf5, 0x81, 0x04, 0x4e, 0x44,
-	0x66, 0x5f, 0x60, 0xef, 0xe3, 0xa9, 0xbc, 0xde, 0x41, 0x65, 0x32, 0x86, 0x4a, 0x73, 0x2b, 0x83,
-	0x04, 0x5c, 0xa8, 0xc3, 0xcd, 0x0a, 0x34, 0xd6, 0x5f, 0xed, 0x04, 0x0c, 0x59, 0xaa, 0x3f, 0x49,
-	0xe0, 0x94, 0x9f, 0x80, 0x77, 0x6a, 0x98, 0xa1, 0x3a, 0xa6, 0x0c, 0x99, 0x37, 0xb0, 0x8d, 0xd9,
-	0x7f, 0xbf, 0x80, 0x62, 0xc7, 0x02, 0x72, 0x91, 0x05, 0xc4, 0x10, 0x56, 0xbf, 0x91, 0xc0, 0x48,
-	0x89, 0x5a, 0xb7, 0x5d, 0x48, 0x68, 0x15, 0xb9, 0xd7, 0x4c, 0x1b, 0xbf, 0xdc, 0x03, 0xd5, 0xcc,
-	0x92, 0x54, 0x34, 0x4b, 0xa6, 0x3a, 0x68, 0x2a, 0x11, 0x9a, 0x6d, 0x5c, 0xd4, 0x4f, 0xc1, 0x90,
-	0x88, 0x3d, 0x82, 0x47, 0x26, 0x17, 0x9f, 0xa8, 0x17, 0x3b, 0x28, 0x9c, 0x6c, 0xdb, 0xea, 0xd0,
-	0x9d, 0xfa, 0x48, 0x02, 0xc3, 0xfc, 0xf6, 0x69, 0x98, 0x90, 0xa1, 0x5b, 0xa2, 0x83, 0x97, 0xaf,
-	0x82, 0x0c, 0xf4, 0x58, 0xcd, 0x71, 0x31, 0xdb, 0x7e, 0x26, 0x8b, 0x96, 0xaa, 0xfc, 0x0e, 0xe8,
-	0xf7, 0xdf, 0x01, 0x82, 0x5a, 0x39, 0x11, 0xd7, 0xfc, 0xf8, 0x3e, 0xe6, 0x33, 0x7c, 0x53, 0xfd,
-	0xbe, 0x20, 0x30, 0x9a, 0x9b, 0xe6, 0x8c, 0x5b, 0x70, 0x9c, 0xf4, 0xe9, 0xe8, 0x05, 0x19, 0xa1,
-	0xa8, 0xfe, 0x2d, 0x81, 0x33, 0xcd, 0xb9, 0xc5, 0xa5, 0xbb, 0x6b, 0x04, 0x57, 0x31, 0x32, 0x75,
-	0x54, 0x0d, 0x1a, 0xe4, 0x97, 0x14, 0x46, 0xf9, 0x03, 0x20, 0x7b, 0x3e, 0x76, 0xd9, 0x45, 0xd5,
-	0xb0, 0x65, 0x4f, 0x25, 0xef, 0x64, 0x47, 0xbc, 0x0e, 0x6a, 0x73, 0x6f, 0x76, 0xec, 0xcc, 0x85,
-	0x03, 0x8b, 0x8c, 0x59, 0x90, 0xfa, 0x8b, 0x04, 0xce, 0x46, 0x15, 0x22, 0xa9, 0xbe, 0xc8, 0x99,
-	0xd2, 0x97, 0xb6, 0xe4, 0xcb, 0x40, 0xde, 0x6c, 0x81, 0x97, 0xc5, 0xa4, 0xdf, 0x15, 0x66, 0x82,
-	0xb3, 0x38, 0xba, 0xd9, 0xe9, 0x7c, 0xee, 0x4a, 0xc7, 0xa2, 0x2e, 0xc6, 0x2d, 0xea, 0x00, 0x67,
-	0x75, 0x18, 0x0c, 0x2d, 0xd9, 0x0d, 0xb6, 0xad, 0x23, 0xda, 0x70, 0x08, 0x45, 0xb3, 0x0f, 0x01,
-	0x48, 0x95, 0xa8, 0x25, 0x5f, 0x07, 0x7d, 0xfe, 0x1b, 0xdb, 0x99, 0xb8, 0x24, 0x0a, 0xdb, 0xdf,
-	0x89, 0x73, 0xb1, 0x4d, 0x7b, 0x14, 0x51, 0x5e, 0x06, 0xbd, 0xa2, 0xf3, 0x9b, 0xec, 0x02, 0xc4,
-	0x85, 0x09, 0x71, 0x44, 0x3f, 0xd6, 0x0d, 0x87, 0x0b, 0x93, 0xe0, 0xbc, 0x0f, 0xfa, 0x83, 0xf2,
-	0x78, 0xb6, 0x0b, 0x92, 0x2f, 0x4e, 0x82, 0x75, 0x13, 0x0c, 0x34, 0x2b, 0x5c, 0xbe, 0x0b, 0x5a,
-	0xa8, 0x90, 0x04, 0xef, 0x16, 0xc8, 0xb4, 0xfa, 0x8e, 0x42, 0x17, 0xc0, 0xa6, 0x46, 0x12, 0xc4,
-	0x7b, 0xe0, 0x78, 0x47, 0x53, 0x70, 0xb1, 0x0b, 0x6c, 0xbb, 0x5a, 0x12, 0xec, 0x8f, 0xc1, 0xc8,
-	0x81, 0x3a, 0xff, 0xff, 0x67, 0xa0, 0x3f, 0x4f, 0x34, 0x6e, 0x82, 0x81, 0x66, 0xe9, 0xee, 0x16,
-	0xdd, 0x50, 0x21, 0x09, 0x9e, 0x09, 0x4e, 0xc4, 0x15, 0xd5, 0xe9, 0xee, 0x71, 0xee, 0xd4, 0x4d,
-	0xe2, 0xe5, 0x2e, 0x18, 0x6a, 0x2f, 0x77, 0x17, 0xba, 0xe0, 0xb7, 0x69, 0x25, 0x41, 0xd6, 0x01,
-	0x88, 0x14, 0xaa, 0x73, 0x5d, 0x23, 0x12, 0xaa, 0x24, 0xc1, 0xfc, 0x10, 0x0c, 0xb6, 0xd5, 0x9e,
-	0xf3, 0xdd, 0xb2, 0x38, 0xa2, 0x94, 0x04, 0xb7, 0x01, 0xc6, 0x0f, 0x29, 0x0e, 0x87, 0x3a, 0x89,
-	0xb1, 0x48, 0xe2, 0xd1, 0x05, 0x13, 0x87, 0x5c, 0xce, 0x33, 0xcf, 0x72, 0x79, 0xc0, 0x24, 0x81,
-	0xcf, 0x89, 0xbe, 0xcf, 0x78, 0x05, 0x9d, 0xbf, 0xfd, 0xf8, 0xaf, 0x5c, 0xcf, 0xe3, 0xbd, 0x9c,
-	0xf4, 0x64, 0x2f, 0x27, 0xfd, 0xb9, 0x97, 0x93, 0xbe, 0x7c, 0x9a, 0xeb, 0x79, 0xf2, 0x34, 0xd7,
-	0xf3, 0xeb, 0xd3, 0x5c, 0xcf, 0xbd, 0xab, 0x6d, 0xaf, 0xd5, 0x1c, 0x71, 0xd9, 0xf1, 0x88, 0x09,
-	0x19, 0x76, 0x88, 0x16, 0x7c, 0xa6, 0xdb, 0xb8, 0xaa, 0x6d, 0xb5, 0xbe, 0xd5, 0x89, 0xd7, 0xec,
-	0x4a, 0xbf, 0xf8, 0x7e, 0x76, 0xf9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5f, 0xbe, 0x12, 0x75,
-	0x30, 0x14, 0x00, 0x00,
+	// 1871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcb, 0x6f, 0x1c, 0x49,
+	0x19, 0x77, 0xef, 0xf8, 0x35, 0x35, 0x7e, 0xc4, 0x1d, 0x27, 0x69, 0xdb, 0xc9, 0x8c, 0xd3, 0x49,
+	0x16, 0xaf, 0x21, 0xd3, 0xd8, 0x61, 0x03, 0x8c, 0x40, 0xe0, 0x47, 0xbc, 0x31, 0xda, 0x59, 0x96,
+	0x76, 0xbc, 0x1b, 0x56, 0x88, 0x51, 0xcd, 0x74, 0x4d, 0xbb, 0xf0, 0x74, 0xd7, 0xa8, 0xab, 0x7a,
+	0x3c, 0xb3, 0x07, 0x84, 0x38, 0xee, 0x69, 0xb9, 0x72, 0x40, 0xe2, 0xb6, 0xe2, 0x42, 0x24, 0xf6,
+	0x4f, 0x40, 0x10, 0x6e, 0x2b, 0xb8, 0x20, 0x0e, 0x0e, 0x38, 0x48, 0x39, 0x82, 0xc4, 0x8d, 0x13,
+	0xaa, 0xea, 0xee, 0x99, 0xee, 0x9e, 0x6e, 0xa7, 0x93, 0xb5, 0x88, 0xb5, 0x17, 0xbb, 0xab, 0xea,
+	0xab, 0xdf, 0xf7, 0xfb, 0xd5, 0xe3, 0xab, 0xaf, 0x6a, 0xc0, 0x52, 0x83, 0x38, 0xc8, 0xb5, 0x34,
+	0x48, 0x29, 0x62, 0x5a, 0x93, 0x69, 0x9d, 0x35, 0x8d, 0x75, 0xcb, 0x6d, 0x87, 0x30, 0x22, 0xcb,
+	0x5e, 0x63, 0x59, 0x34, 0x96, 0x9b, 0xac, 0xdc, 0x59, 0x5b, 0x9c, 0x83, 0x16, 0xb6, 0x89, 0x26,
+	0xfe, 0x7a, 0x66, 0x8b, 0xa5, 0x04, 0x8c, 0x36, 0x74, 0xa0, 0x45, 0x7d, 0x83, 0x62, 0x92, 0x13,
+	0x72, 0x88, 0xec, 0x41, 0x3b, 0xb5, 0x08, 0xd5, 0xea, 0x90, 0x22, 0xad, 0xb3, 0x56, 0x47, 0x0c,
+	0xae, 0x69, 0x0d, 0x82, 0x83, 0xf6, 0x2b, 0x7e, 0xbb, 0x45, 0x4d, 0xde, 0xd5, 0xa2, 0xa6, 0xdf,
+	0xb0, 0xe0, 0x35, 0xd4, 0x44, 0x49, 0xf3, 0x0a, 0x7e, 0xd3, 0xbc, 0x49, 0x4c, 0xe2, 0xd5, 0xf3,
+	0xaf, 0x80, 0xaa, 0x49, 0x88, 0xd9, 0x42, 0x9a, 0x28, 0xd5, 0xdd, 0xa6, 0xc6, 0xb0, 0x85, 0x28,
+	0x83, 0x56, 0xdb, 0x33, 0x50, 0x9f, 0x8c, 0x83, 0xc9, 0x2a, 0x35, 0x77, 0x29, 0x75, 0x91, 0xfc,
+	0x55, 0x30, 0x8e, 0xf9, 0x87, 0xa3, 0x48, 0xcb, 0xd2, 0x4a, 0x7e, 0x53, 0xf9, 0xf3, 0xa7, 0xb7,
+	0xe7, 0x7d, 0x2f, 0x1b, 0x86, 0xe1, 0x20, 0x4a, 0xf7, 0x98, 0x83, 0x6d, 0x53, 0xf7, 0xed, 0xe4,
+	0xcb, 0x60, 0x9c, 0xf6, 0xac, 0x3a, 0x69, 0x29, 0xaf, 0xf1, 0x1e, 0xba, 0x5f, 0x92, 0x15, 0x30,
+	0x41, 0xdd, 0xba, 0x6b, 0x63, 0xa6, 0xe4, 0x44, 0x43, 0x50, 0x94, 0xaf, 0x82, 0x7c, 0xdb, 0x41,
+	0x0d, 0x4c, 0x31, 0xb1, 0x95, 0xd1, 0x65, 0x69, 0x65, 0x5a, 0x1f, 0x54, 0xc8, 0xdb, 0x60, 0x06,
+	0xdb, 0x98, 0x61, 0xd8, 0xaa, 0x41, 0x8b, 0xb8, 0x36, 0x53, 0xc6, 0x04, 0x93, 0x6b, 0x8f, 0x8f,
+	0x4b, 0x23, 0x7f, 0x3b, 0x2e, 0x5d, 0xf2, 0xd8, 0x50, 0xe3, 0xb0, 0x8c, 0x89, 0x66, 0x41, 0x76,
+	0x50, 0xde, 0xb5, 0x99, 0x3e, 0xed, 0x77, 0xda, 0x10, 0x7d, 0xe4, 0x65, 0x50, 0x30, 0x10, 0x6d,
+	0x38, 0xb8, 0xcd, 0xb8, 0x97, 0x71, 0xc1, 0x20, 0x5c, 0x25, 0x7f, 0x1d, 0x4c, 0x36, 0x11, 0x64,
+	0xae, 0x83, 0xa8, 0x32, 0xb1, 0x9c, 0x5b, 0x99, 0x59, 0x5f, 0x2a, 0x0f, 0x4f, 0x7e, 0x79, 0xc7,
+	0xb3, 0xd1, 0xfb, 0xc6, 0xf2, 0x77, 0x41, 0xbe, 0xee, 0x3a, 0x76, 0xcd, 0x81, 0x0c, 0x29, 0x93,
+	0x82, 0xdb, 0x0d, 0x9f, 0xdb, 0xd2, 0x30, 0xb7, 0xb7, 0x91, 0x09, 0x1b, 0xbd, 0x6d, 0xd4, 0xd0,
+	0x27, 0x79, 0x2f, 0x1d, 0x32, 0x24, 0xef, 0x83, 0x79, 0x8a, 0x6c, 0xa3, 0xd6, 0x20, 0x96, 0x85,
+	0x29, 0x57, 0xed, 0x81, 0xe5, 0xb3, 0x83, 0xc9, 0x1c, 0x60, 0xab, 0xdf, 0x5f, 0xc0, 0x2e, 0x80,
+	0x9c, 0xeb, 0x60, 0x05, 0x08, 0x94, 0x89, 0x93, 0xe3, 0x52, 0x6e, 0x5f, 0xdf, 0xd5, 0x79, 0x9d,
+	0xfc, 0x3a, 0x98, 0x74, 0x1d, 0x5c, 0x3b, 0x80, 0xf4, 0x40, 0x29, 0x88, 0xf6, 0xc2, 0xc9, 0x71,
+	0x69, 0x62, 0x5f, 0xdf, 0xbd, 0x0f, 0xe9, 0x81, 0x3e, 0xe1, 0x3a, 0x98, 0x7f, 0xc8, 0x3f, 0x04,
+	0x32, 0xea, 0x32, 0x64, 0x0b, 0x4e, 0x14, 0x31, 0x86, 0x6d, 0x93, 0x2a, 0x53, 0xcb, 0xd2, 0x4a,
+	0x61, 0x7d, 0x35, 0x69, 0x78, 0xee, 0x05, 0xd6, 0x62, 0xf9, 0xec, 0xf9, 0x3d, 0xf4, 0xb9, 0x3e,
+	0x4a, 0x50, 0x25, 0xef, 0x81, 0x29, 0x03, 0x75, 0x07, 0xa0, 0xd3, 0x02, 0xb4, 0x94, 0x04, 0xba,
+	0x7d, 0xef, 0x61, 0xd0, 0x6d, 0x73, 0xf6, 0xe4, 0xb8, 0x54, 0x08, 0x55, 0xf0, 0x49, 0xec, 0xf6,
+	0x41, 0xbf, 0x01, 0x80, 0x05, 0xbb, 0x35, 0xea, 0xb6, 0xdb, 0xad, 0x9e, 0x32, 0x23, 0x94, 0x2d,
+	0xa4, 0x2f, 0x92, 0xbc, 0x05, 0xbb, 0x7b, 0xc2, 0x56, 0xbe, 0x0f, 0x66, 0x3a, 0xa8, 0x45, 0x1a,
+	0x98, 0xf5, 0x6a, 0x2d, 0x6c, 0x61, 0xa6, 0xcc, 0x0a, 0x42, 0xd7, 0x93, 0x08, 0xbd, 0xe7, 0x5b,
+	0xbe, 0xcd, 0x0d, 0xf5, 0xe9, 0x4e, 0xb8, 0x58, 0x59, 0xfe, 0xf9, 0xb3, 0x47, 0xab, 0xfe, 0x6e,
+	0xf8, 0xe8, 0xd9, 0xa3, 0xd5, 0x0b, 0xa2, 0x67, 0x93, 0x69, 0xc1, 0xa6, 0x52, 0x7f, 0xfd, 0x1a,
+	0xb8, 0x9c, 0x3c, 0x50, 0xf2, 0x15, 0x30, 0xd1, 0x20, 0x06, 0xaa, 0x61, 0x43, 0x6c, 0xb8, 0x51,
+	0x7d, 0x9c, 0x17, 0x77, 0x0d, 0x79, 0x1e, 0x8c, 0xb5, 0x60, 0x1d, 0x05, 0xbb, 0xca, 0x2b, 0xc8,
+	0x4d, 0x30, 0xd6, 0x74, 0x6d, 0x83, 0x2a, 0xb9, 0xe5, 0xdc, 0x4a, 0x61, 0x7d, 0xa1, 0xec, 0x6f,
+	0x4d, 0x1e, 0x46, 0xca, 0x7e, 0x18, 0x29, 0x6f, 0x11, 0x6c, 0x6f, 0xbe, 0xc9, 0x57, 0xd1, 0x6f,
+	0x9e, 0x94, 0x56, 0x4c, 0xcc, 0x0e, 0xdc, 0x7a, 0xb9, 0x41, 0x2c, 0x3f, 0x5a, 0xf8, 0xff, 0x6e,
+	0x53, 0xe3, 0x50, 0x63, 0xbd, 0x36, 0xa2, 0xa2, 0x03, 0xfd, 0xe4, 0xd9, 0xa3, 0x55, 0x49, 0xf7,
+	0xe0, 0xe5, 0x36, 0x98, 0xe2, 0x82, 0xa0, 0xdd, 0x40, 0x35, 0x8b, 0x9a, 0x62, 0x97, 0x4e, 0x6d,
+	0x56, 0xff, 0x7b, 0x5c, 0xfa, 0x66, 0x08, 0x6f, 0x8b, 0x50, 0xeb, 0x7d, 0x48, 0x2d, 0xed, 0x08,
+	0x52, 0xcb, 0xd0, 0xba, 0xe2, 0xbf, 0x8f, 0xa9, 0xc3, 0xa3, 0x2d, 0x62, 0x33, 0x07, 0x36, 0x58,
+	0x15, 0x51, 0x0a, 0x4d, 0xf4, 0xcb, 0x67, 0x8f, 0x56, 0x0b, 0xd8, 0x6e, 0x61, 0x1b, 0xd5, 0x7e,
+	0x42, 0x89, 0xad, 0x17, 0x02, 0x17, 0x55, 0x6a, 0xaa, 0xbf, 0x95, 0xc0, 0x44, 0x95, 0x9a, 0x55,
+	0x6c, 0x33, 0x1e, 0x84, 0xf8, 0xf2, 0xce, 0x12, 0x84, 0x3c, 0x3b, 0xf9, 0x0e, 0x18, 0xe5, 0xc1,
+	0x53, 0x0c, 0xd6, 0xa9, 0xc3, 0x32, 0xca, 0x87, 0x45, 0x17, 0xc6, 0x3c, 0x0e, 0xf1, 0xa8, 0xd3,
+	0xc6, 0xc8, 0x0e, 0x62, 0xd4, 0xa0, 0xa2, 0x52, 0x12, 0xd3, 0xea, 0xe1, 0xf3, 0x69, 0x9d, 0x0d,
+	0x4d, 0x2b, 0x67, 0xa9, 0xfe, 0xc2, 0x63, 0xbc, 0xe9, 0x3a, 0xf6, 0xe7, 0x60, 0x9c, 0x7b, 0x01,
+	0xc6, 0xa7, 0x72, 0xe2, 0x3c, 0xd4, 0x7f, 0x4b, 0x20, 0x5f, 0xa5, 0xe6, 0x8e, 0x83, 0xd0, 0x87,
+	0xe8, 0x25, 0x58, 0x29, 0x60, 0x02, 0x36, 0x1a, 0x22, 0xea, 0x7a, 0xeb, 0x2e, 0x28, 0xbe, 0x14,
+	0x5f, 0xf9, 0x3b, 0x00, 0xa0, 0x6e, 0x1b, 0x3b, 0x88, 0xd6, 0x20, 0x13, 0x8b, 0xa8, 0xb0, 0xbe,
+	0x58, 0xf6, 0x0e, 0xa4, 0x72, 0x70, 0x20, 0x95, 0x1f, 0x04, 0x07, 0xd2, 0xe6, 0xe8, 0xc7, 0x4f,
+	0x4a, 0x92, 0x9e, 0xf7, 0xfb, 0x6c, 0xb0, 0xca, 0xf5, 0x98, 0xe0, 0xb9, 0x90, 0x60, 0x4f, 0xa4,
+	0xfa, 0x3b, 0x09, 0x14, 0xaa, 0xd4, 0xdc, 0xb7, 0x9b, 0xe7, 0x43, 0x74, 0xe5, 0x46, 0x8c, 0xf3,
+	0xc5, 0x10, 0xe7, 0x80, 0xa5, 0xfa, 0x1f, 0x09, 0x4c, 0x55, 0xa9, 0xb9, 0x87, 0xd8, 0x8e, 0x43,
+	0x3e, 0x44, 0xf6, 0x17, 0x79, 0xae, 0xfa, 0x22, 0xd5, 0x3f, 0x4a, 0x60, 0xae, 0x4a, 0xcd, 0xb7,
+	0x5a, 0xa4, 0x0e, 0x5b, 0xad, 0xde, 0x4b, 0x2f, 0xd3, 0x79, 0x30, 0x66, 0x20, 0x9b, 0x58, 0x41,
+	0x70, 0x14, 0x85, 0x98, 0x82, 0xdc, 0x8b, 0x2b, 0x78, 0x23, 0xa6, 0x60, 0x21, 0x34, 0x73, 0x51,
+	0xce, 0xea, 0x47, 0x12, 0xb8, 0x18, 0xaa, 0xfd, 0x1c, 0xab, 0x2f, 0x51, 0x4b, 0xe5, 0xcb, 0x31,
+	0x2a, 0x4b, 0x09, 0x54, 0xfa, 0x8b, 0xc9, 0xdf, 0x02, 0x5b, 0x2d, 0x78, 0x54, 0x87, 0x8d, 0xc3,
+	0xf3, 0xbd, 0x05, 0x02, 0x96, 0xea, 0x9f, 0x24, 0x70, 0xd9, 0xdb, 0x02, 0xef, 0x1f, 0x60, 0x86,
+	0x5a, 0x98, 0x32, 0x64, 0x88, 0x23, 0xf5, 0xd5, 0x0b, 0x28, 0xc7, 0x04, 0x14, 0x43, 0x02, 0x12,
+	0x08, 0xab, 0x7f, 0x91, 0xc0, 0x92, 0xd7, 0x14, 0x49, 0x15, 0xbe, 0xdf, 0x41, 0x8e, 0x83, 0x8d,
+	0x73, 0x10, 0x94, 0xee, 0xc4, 0x04, 0xdd, 0x88, 0x0a, 0x4a, 0x64, 0xad, 0xfe, 0x4a, 0x02, 0x17,
+	0xaa, 0xd4, 0xdc, 0x30, 0x8c, 0x07, 0x64, 0x1b, 0xd9, 0x3d, 0xae, 0xf8, 0x4c, 0xa5, 0xf4, 0xd7,
+	0x7e, 0x2e, 0xbc, 0xf6, 0x57, 0x62, 0x5c, 0x95, 0x10, 0xd7, 0x08, 0x17, 0xf5, 0x13, 0x09, 0x5c,
+	0xaa, 0x52, 0x53, 0x47, 0x16, 0xe9, 0xa0, 0x1d, 0x87, 0x58, 0xff, 0x47, 0x96, 0xb7, 0x63, 0x2c,
+	0xaf, 0x85, 0x58, 0x0e, 0x13, 0x0a, 0xc6, 0xf2, 0x81, 0x03, 0x6d, 0xda, 0x44, 0xce, 0x86, 0x61,
+	0x61, 0xfb, 0x15, 0x8f, 0x65, 0x84, 0x8b, 0xfa, 0x53, 0x30, 0x2d, 0x76, 0x27, 0x82, 0x2f, 0x4d,
+	0x2e, 0x39, 0x94, 0xdd, 0x8a, 0x51, 0xb8, 0x14, 0x09, 0x06, 0x81, 0x3b, 0xf5, 0xf7, 0xde, 0x89,
+	0xf8, 0x96, 0x03, 0x6d, 0xa6, 0x93, 0xd6, 0xd9, 0x1d, 0x0b, 0x5f, 0x01, 0xa3, 0x0e, 0x69, 0x21,
+	0x31, 0x2e, 0x33, 0xeb, 0x4a, 0x52, 0x7e, 0xcf, 0xfd, 0xe9, 0xc2, 0x2a, 0x3c, 0xc0, 0xa3, 0x91,
+	0x01, 0xae, 0xdc, 0x8c, 0xe9, 0x98, 0x0f, 0x87, 0xe4, 0x80, 0xb5, 0xfa, 0x07, 0x49, 0x8c, 0xa3,
+	0x8e, 0x3a, 0xe4, 0x10, 0x9d, 0x4b, 0x1d, 0xa7, 0xcd, 0xc7, 0x80, 0x36, 0x17, 0x22, 0xf3, 0x8c,
+	0xa5, 0x6d, 0x40, 0x86, 0xaa, 0xfd, 0x7b, 0xd3, 0x59, 0xa9, 0xf9, 0x56, 0xe4, 0xe6, 0x96, 0xcb,
+	0x72, 0xc5, 0x1f, 0xdc, 0xde, 0x2a, 0xab, 0x31, 0x0d, 0x8b, 0xe1, 0x1c, 0x2b, 0xca, 0x58, 0xfd,
+	0x54, 0x02, 0xb3, 0xfd, 0xea, 0x77, 0xc5, 0x23, 0x8d, 0x7c, 0x17, 0xe4, 0xa1, 0xcb, 0x0e, 0x88,
+	0x83, 0x59, 0xef, 0xb9, 0x42, 0x06, 0xa6, 0xf2, 0xb7, 0xc1, 0xb8, 0xf7, 0xcc, 0xe3, 0xdf, 0x34,
+	0x16, 0x93, 0x66, 0xc1, 0xf3, 0xb1, 0x99, 0xe7, 0x6a, 0xbc, 0x5b, 0x95, 0xdf, 0xc9, 0xa3, 0x3d,
+	0x80, 0xe3, 0xcc, 0xaf, 0x0c, 0x31, 0xf7, 0xba, 0xab, 0xff, 0x92, 0xc0, 0xd5, 0x7e, 0xdd, 0xf6,
+	0xbd, 0x87, 0xfb, 0x36, 0x6e, 0x62, 0x64, 0xe8, 0xa8, 0xe9, 0x3f, 0x71, 0x9c, 0xd5, 0x4c, 0xfc,
+	0x00, 0xc8, 0xae, 0x87, 0x5d, 0x73, 0x50, 0x33, 0x78, 0x74, 0xc9, 0x65, 0x7f, 0x8b, 0xb8, 0xe0,
+	0xc6, 0xa8, 0x55, 0xbe, 0x16, 0x9b, 0x9e, 0x9b, 0x43, 0x22, 0x13, 0x04, 0xf1, 0x43, 0xf4, 0x5a,
+	0xd8, 0x20, 0x74, 0xca, 0x6e, 0x73, 0xa6, 0xf4, 0xcc, 0x24, 0xdf, 0x01, 0xf2, 0xd1, 0x00, 0xbc,
+	0x26, 0x2a, 0xbd, 0x3b, 0x75, 0xde, 0x3f, 0x35, 0xe7, 0x8e, 0xe2, 0xce, 0x2b, 0x6f, 0xc6, 0x44,
+	0xdd, 0x4a, 0x12, 0x35, 0xc4, 0x59, 0x9d, 0x05, 0xd3, 0xf7, 0xac, 0x36, 0xeb, 0xe9, 0x88, 0xb6,
+	0x89, 0x4d, 0xd1, 0xfa, 0x3f, 0x67, 0x40, 0xae, 0x4a, 0x4d, 0xf9, 0x3e, 0x18, 0xf3, 0xde, 0xdc,
+	0xae, 0x26, 0x2d, 0xa2, 0xe0, 0xf1, 0x60, 0x31, 0xf1, 0x41, 0x22, 0x82, 0x28, 0xef, 0x80, 0x51,
+	0x71, 0x6f, 0x5e, 0x4a, 0x01, 0xe2, 0x8d, 0x19, 0x71, 0xc4, 0x6d, 0x36, 0x0d, 0x87, 0x37, 0x66,
+	0xc1, 0xf9, 0x1e, 0x18, 0xf7, 0x53, 0xfb, 0x6b, 0x29, 0x48, 0x5e, 0x73, 0x16, 0xac, 0x77, 0xc0,
+	0x64, 0x3f, 0xb9, 0x2e, 0xa5, 0xa0, 0x05, 0x06, 0x59, 0xf0, 0xde, 0x05, 0xf9, 0xc1, 0xa5, 0x6b,
+	0x39, 0x05, 0xb0, 0x6f, 0x91, 0x05, 0xf1, 0x03, 0x30, 0x13, 0xbb, 0xd0, 0xdc, 0x4a, 0x81, 0x8d,
+	0x9a, 0x65, 0xc1, 0xfe, 0x31, 0xb8, 0x30, 0x74, 0xc5, 0xf8, 0xd2, 0x73, 0xd0, 0x5f, 0x64, 0x34,
+	0xde, 0x01, 0x93, 0xfd, 0x5b, 0x43, 0xda, 0xe8, 0x06, 0x06, 0x59, 0xf0, 0x0c, 0x70, 0x31, 0x29,
+	0x9f, 0x5f, 0x4d, 0x1f, 0xe7, 0xb8, 0x6d, 0x16, 0x2f, 0x36, 0x50, 0x52, 0x33, 0x6d, 0x2d, 0xdd,
+	0x55, 0x62, 0x87, 0x2c, 0xfe, 0x1e, 0x82, 0xe9, 0x68, 0x0e, 0x7c, 0x33, 0xc5, 0x49, 0xc4, 0x2a,
+	0x0b, 0x72, 0x1d, 0xc8, 0x09, 0xc9, 0xeb, 0x1b, 0x29, 0xf0, 0xc3, 0xa6, 0x19, 0xd9, 0x47, 0xb3,
+	0xce, 0x34, 0xf6, 0x11, 0xab, 0x2c, 0xc8, 0x3a, 0x00, 0xa1, 0x7c, 0xf1, 0x7a, 0xea, 0xfa, 0x09,
+	0x4c, 0x32, 0xee, 0xcf, 0x41, 0x0a, 0x98, 0xb6, 0x3f, 0xfb, 0x16, 0x19, 0x59, 0x86, 0xb2, 0xb1,
+	0xeb, 0xa9, 0x63, 0x1b, 0x98, 0x64, 0xc1, 0xfc, 0x11, 0x98, 0x8d, 0x27, 0x46, 0xaf, 0xa7, 0x05,
+	0xa7, 0xa8, 0x5d, 0x16, 0xf4, 0xf7, 0xc0, 0x54, 0x24, 0x5b, 0xb9, 0x71, 0x2a, 0xb4, 0x67, 0x94,
+	0x05, 0xb7, 0x0d, 0x16, 0x4e, 0x49, 0x27, 0x4e, 0x75, 0x92, 0xd0, 0x23, 0x8b, 0x47, 0x07, 0x2c,
+	0x9e, 0x72, 0x9c, 0xaf, 0x3d, 0xcf, 0xe5, 0x50, 0x97, 0x0c, 0x3e, 0x17, 0xc7, 0x7e, 0xc6, 0x73,
+	0xae, 0xcd, 0x07, 0x8f, 0xff, 0x51, 0x1c, 0x79, 0x7c, 0x52, 0x94, 0x3e, 0x3b, 0x29, 0x4a, 0x7f,
+	0x3f, 0x29, 0x4a, 0x1f, 0x3f, 0x2d, 0x8e, 0x7c, 0xf6, 0xb4, 0x38, 0xf2, 0xd7, 0xa7, 0xc5, 0x91,
+	0x0f, 0xee, 0x46, 0x9e, 0xb1, 0x39, 0xe2, 0x0e, 0x71, 0x6d, 0x03, 0x32, 0x4c, 0x6c, 0xcd, 0xff,
+	0xed, 0xae, 0x73, 0x57, 0xeb, 0x0e, 0x7e, 0xc0, 0x13, 0xcf, 0xda, 0xf5, 0x71, 0xf1, 0x8e, 0x74,
+	0xe7, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0x52, 0xff, 0x11, 0x45, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -874,10 +1158,22 @@ type MsgClient interface {
 	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// SetWhitelistedLimit sets the limit of how many tokens a specific account may hold.
 	SetWhitelistedLimit(ctx context.Context, in *MsgSetWhitelistedLimit, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// SetVelocityLimitOverride overrides the velocity limit amount of the token for a specific account.
+	SetVelocityLimitOverride(ctx context.Context, in *MsgSetVelocityLimitOverride, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// AddToDenylist blocks the account from sending and receiving the token.
+	AddToDenylist(ctx context.Context, in *MsgAddToDenylist, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// RemoveFromDenylist unblocks the account denylisted previously.
+	RemoveFromDenylist(ctx context.Context, in *MsgRemoveFromDenylist, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// TransferAdmin changes admin of a fungible token.
 	TransferAdmin(ctx context.Context, in *MsgTransferAdmin, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// ClearAdmin removes admin of a fungible token.
 	ClearAdmin(ctx context.Context, in *MsgClearAdmin, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// GrantRole grants the role of a fungible token to the account.
+	GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// RevokeRole revokes the role of a fungible token from the account.
+	RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*EmptyResponse, error)
+	// UpdateMaxSupply lowers the max supply of a fungible token.
+	UpdateMaxSupply(ctx context.Context, in *MsgUpdateMaxSupply, opts ...grpc.CallOption) (*EmptyResponse, error)
 	// UpdateParams is a governance operation to modify the parameters of the module.
 	// NOTE: all parameters must be provided.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*EmptyResponse, error)
@@ -985,6 +1281,33 @@ func (c *msgClient) SetWhitelistedLimit(ctx context.Context, in *MsgSetWhitelist
 	return out, nil
 }
 
+func (c *msgClient) SetVelocityLimitOverride(ctx context.Context, in *MsgSetVelocityLimitOverride, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/SetVelocityLimitOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddToDenylist(ctx context.Context, in *MsgAddToDenylist, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/AddToDenylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFromDenylist(ctx context.Context, in *MsgRemoveFromDenylist, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/RemoveFromDenylist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) TransferAdmin(ctx context.Context, in *MsgTransferAdmin, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	out := new(EmptyResponse)
 	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/TransferAdmin", in, out, opts...)
@@ -1003,6 +1326,33 @@ func (c *msgClient) ClearAdmin(ctx context.Context, in *MsgClearAdmin, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) GrantRole(ctx context.Context, in *MsgGrantRole, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/GrantRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeRole(ctx context.Context, in *MsgRevokeRole, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/RevokeRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMaxSupply(ctx context.Context, in *MsgUpdateMaxSupply, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/UpdateMaxSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*EmptyResponse, error) {
 	out := new(EmptyResponse)
 	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Msg/UpdateParams", in, out, opts...)
@@ -1057,10 +1407,22 @@ type MsgServer interface {
 	Clawback(context.Context, *MsgClawback) (*EmptyResponse, error)
 	// SetWhitelistedLimit sets the limit of how many tokens a specific account may hold.
 	SetWhitelistedLimit(context.Context, *MsgSetWhitelistedLimit) (*EmptyResponse, error)
+	// SetVelocityLimitOverride overrides the velocity limit amount of the token for a specific account.
+	SetVelocityLimitOverride(context.Context, *MsgSetVelocityLimitOverride) (*EmptyResponse, error)
+	// AddToDenylist blocks the account from sending and receiving the token.
+	AddToDenylist(context.Context, *MsgAddToDenylist) (*EmptyResponse, error)
+	// RemoveFromDenylist unblocks the account denylisted previously.
+	RemoveFromDenylist(context.Context, *MsgRemoveFromDenylist) (*EmptyResponse, error)
 	// TransferAdmin changes admin of a fungible token.
 	TransferAdmin(context.Context, *MsgTransferAdmin) (*EmptyResponse, error)
 	// ClearAdmin removes admin of a fungible token.
 	ClearAdmin(context.Context, *MsgClearAdmin) (*EmptyResponse, error)
+	// GrantRole grants the role of a fungible token to the account.
+	GrantRole(context.Context, *MsgGrantRole) (*EmptyResponse, error)
+	// RevokeRole revokes the role of a fungible token from the account.
+	RevokeRole(context.Context, *MsgRevokeRole) (*EmptyResponse, error)
+	// UpdateMaxSupply lowers the max supply of a fungible token.
+	UpdateMaxSupply(context.Context, *MsgUpdateMaxSupply) (*EmptyResponse, error)
 	// UpdateParams is a governance operation to modify the parameters of the module.
 	// NOTE: all parameters must be provided.
 	UpdateParams(context.Context, *MsgUpdateParams) (*EmptyResponse, error)
@@ -1104,12 +1466,30 @@ func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (
 func (*UnimplementedMsgServer) SetWhitelistedLimit(ctx context.Context, req *MsgSetWhitelistedLimit) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetWhitelistedLimit not implemented")
 }
+func (*UnimplementedMsgServer) SetVelocityLimitOverride(ctx context.Context, req *MsgSetVelocityLimitOverride) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVelocityLimitOverride not implemented")
+}
+func (*UnimplementedMsgServer) AddToDenylist(ctx context.Context, req *MsgAddToDenylist) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddToDenylist not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFromDenylist(ctx context.Context, req *MsgRemoveFromDenylist) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFromDenylist not implemented")
+}
 func (*UnimplementedMsgServer) TransferAdmin(ctx context.Context, req *MsgTransferAdmin) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TransferAdmin not implemented")
 }
 func (*UnimplementedMsgServer) ClearAdmin(ctx context.Context, req *MsgClearAdmin) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearAdmin not implemented")
 }
+func (*UnimplementedMsgServer) GrantRole(ctx context.Context, req *MsgGrantRole) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantRole not implemented")
+}
+func (*UnimplementedMsgServer) RevokeRole(ctx context.Context, req *MsgRevokeRole) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRole not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMaxSupply(ctx context.Context, req *MsgUpdateMaxSupply) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMaxSupply not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*EmptyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -1304,6 +1684,60 @@ func _Msg_SetWhitelistedLimit_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetVelocityLimitOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetVelocityLimitOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetVelocityLimitOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/SetVelocityLimitOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetVelocityLimitOverride(ctx, req.(*MsgSetVelocityLimitOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddToDenylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddToDenylist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddToDenylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/AddToDenylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddToDenylist(ctx, req.(*MsgAddToDenylist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFromDenylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFromDenylist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFromDenylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/RemoveFromDenylist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFromDenylist(ctx, req.(*MsgRemoveFromDenylist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_TransferAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgTransferAdmin)
 	if err := dec(in); err != nil {
@@ -1340,64 +1774,118 @@ func _Msg_ClearAdmin_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
+func _Msg_GrantRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantRole)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+		return srv.(MsgServer).GrantRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Msg/UpdateParams",
+		FullMethod: "/coreum.asset.ft.v1.Msg/GrantRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+		return srv.(MsgServer).GrantRole(ctx, req.(*MsgGrantRole))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateDEXUnifiedRefAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDEXUnifiedRefAmount)
+func _Msg_RevokeRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeRole)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateDEXUnifiedRefAmount(ctx, in)
+		return srv.(MsgServer).RevokeRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Msg/UpdateDEXUnifiedRefAmount",
+		FullMethod: "/coreum.asset.ft.v1.Msg/RevokeRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDEXUnifiedRefAmount(ctx, req.(*MsgUpdateDEXUnifiedRefAmount))
+		return srv.(MsgServer).RevokeRole(ctx, req.(*MsgRevokeRole))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateDEXWhitelistedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDEXWhitelistedDenoms)
+func _Msg_UpdateMaxSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMaxSupply)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateDEXWhitelistedDenoms(ctx, in)
+		return srv.(MsgServer).UpdateMaxSupply(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Msg/UpdateDEXWhitelistedDenoms",
+		FullMethod: "/coreum.asset.ft.v1.Msg/UpdateMaxSupply",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDEXWhitelistedDenoms(ctx, req.(*MsgUpdateDEXWhitelistedDenoms))
+		return srv.(MsgServer).UpdateMaxSupply(ctx, req.(*MsgUpdateMaxSupply))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "coreum.asset.ft.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDEXUnifiedRefAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDEXUnifiedRefAmount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDEXUnifiedRefAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/UpdateDEXUnifiedRefAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDEXUnifiedRefAmount(ctx, req.(*MsgUpdateDEXUnifiedRefAmount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDEXWhitelistedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDEXWhitelistedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDEXWhitelistedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Msg/UpdateDEXWhitelistedDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDEXWhitelistedDenoms(ctx, req.(*MsgUpdateDEXWhitelistedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.asset.ft.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "Issue",
 			Handler:    _Msg_Issue_Handler,
@@ -1438,6 +1926,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetWhitelistedLimit",
 			Handler:    _Msg_SetWhitelistedLimit_Handler,
 		},
+		{
+			MethodName: "SetVelocityLimitOverride",
+			Handler:    _Msg_SetVelocityLimitOverride_Handler,
+		},
+		{
+			MethodName: "AddToDenylist",
+			Handler:    _Msg_AddToDenylist_Handler,
+		},
+		{
+			MethodName: "RemoveFromDenylist",
+			Handler:    _Msg_RemoveFromDenylist_Handler,
+		},
 		{
 			MethodName: "TransferAdmin",
 			Handler:    _Msg_TransferAdmin_Handler,
@@ -1446,6 +1946,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClearAdmin",
 			Handler:    _Msg_ClearAdmin_Handler,
 		},
+		{
+			MethodName: "GrantRole",
+			Handler:    _Msg_GrantRole_Handler,
+		},
+		{
+			MethodName: "RevokeRole",
+			Handler:    _Msg_RevokeRole_Handler,
+		},
+		{
+			MethodName: "UpdateMaxSupply",
+			Handler:    _Msg_UpdateMaxSupply_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -1483,6 +1995,30 @@ func (m *MsgIssue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VelocityLimit != nil {
+		{
+			size, err := m.VelocityLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
 	if m.DEXSettings != nil {
 		{
 			size, err := m.DEXSettings.MarshalToSizedBuffer(dAtA[:i])
@@ -1542,20 +2078,20 @@ func (m *MsgIssue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x42
 	if len(m.Features) > 0 {
-		dAtA4 := make([]byte, len(m.Features)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.Features)*10)
+		var j4 int
 		for _, num := range m.Features {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTx(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -1768,6 +2304,16 @@ func (m *MsgFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTx(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1862,6 +2408,16 @@ func (m *MsgSetFrozen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != nil {
+		n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err11 != nil {
+			return 0, err11
+		}
+		i -= n11
+		i = encodeVarintTx(dAtA, i, uint64(n11))
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1909,6 +2465,16 @@ func (m *MsgGloballyFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAt != nil {
+		n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt):])
+		if err13 != nil {
+			return 0, err13
+		}
+		i -= n13
+		i = encodeVarintTx(dAtA, i, uint64(n13))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -2057,7 +2623,7 @@ func (m *MsgSetWhitelistedLimit) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgTransferAdmin) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetVelocityLimitOverride) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2067,12 +2633,59 @@ func (m *MsgTransferAdmin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgTransferAdmin) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetVelocityLimitOverride) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgTransferAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetVelocityLimitOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddToDenylist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddToDenylist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddToDenylist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2101,7 +2714,7 @@ func (m *MsgTransferAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgClearAdmin) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveFromDenylist) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2111,12 +2724,12 @@ func (m *MsgClearAdmin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgClearAdmin) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveFromDenylist) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgClearAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveFromDenylist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2126,6 +2739,13 @@ func (m *MsgClearAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.Denom)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.Sender) > 0 {
@@ -2138,7 +2758,7 @@ func (m *MsgClearAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgTransferAdmin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2148,37 +2768,41 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTransferAdmin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTransferAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateDEXUnifiedRefAmount) Marshal() (dAtA []byte, err error) {
+func (m *MsgClearAdmin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2188,26 +2812,16 @@ func (m *MsgUpdateDEXUnifiedRefAmount) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateDEXUnifiedRefAmount) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgClearAdmin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateDEXUnifiedRefAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgClearAdmin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.UnifiedRefAmount.Size()
-		i -= size
-		if _, err := m.UnifiedRefAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -2225,7 +2839,7 @@ func (m *MsgUpdateDEXUnifiedRefAmount) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateDEXWhitelistedDenoms) Marshal() (dAtA []byte, err error) {
+func (m *MsgGrantRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2235,24 +2849,27 @@ func (m *MsgUpdateDEXWhitelistedDenoms) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateDEXWhitelistedDenoms) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgGrantRole) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateDEXWhitelistedDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgGrantRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.WhitelistedDenoms) > 0 {
-		for iNdEx := len(m.WhitelistedDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.WhitelistedDenoms[iNdEx])
-			copy(dAtA[i:], m.WhitelistedDenoms[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.WhitelistedDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
@@ -2271,7 +2888,7 @@ func (m *MsgUpdateDEXWhitelistedDenoms) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRevokeRole) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2281,22 +2898,251 @@ func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRevokeRole) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRevokeRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
+func (m *MsgUpdateMaxSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMaxSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMaxSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDEXUnifiedRefAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDEXUnifiedRefAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDEXUnifiedRefAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UnifiedRefAmount.Size()
+		i -= size
+		if _, err := m.UnifiedRefAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDEXWhitelistedDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDEXWhitelistedDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDEXWhitelistedDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WhitelistedDenoms) > 0 {
+		for iNdEx := len(m.WhitelistedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhitelistedDenoms[iNdEx])
+			copy(dAtA[i:], m.WhitelistedDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.WhitelistedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmptyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmptyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmptyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -2359,6 +3205,14 @@ func (m *MsgIssue) Size() (n int) {
 		l = m.DEXSettings.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VelocityLimit != nil {
+		l = m.VelocityLimit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2438,6 +3292,10 @@ func (m *MsgFreeze) Size() (n int) {
 	}
 	l = m.Coin.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2476,6 +3334,10 @@ func (m *MsgSetFrozen) Size() (n int) {
 	}
 	l = m.Coin.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2493,6 +3355,10 @@ func (m *MsgGloballyFreeze) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ExpiresAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiresAt)
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2551,7 +3417,7 @@ func (m *MsgSetWhitelistedLimit) Size() (n int) {
 	return n
 }
 
-func (m *MsgTransferAdmin) Size() (n int) {
+func (m *MsgSetVelocityLimitOverride) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2565,14 +3431,12 @@ func (m *MsgTransferAdmin) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgClearAdmin) Size() (n int) {
+func (m *MsgAddToDenylist) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2582,6 +3446,10 @@ func (m *MsgClearAdmin) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
@@ -2589,22 +3457,28 @@ func (m *MsgClearAdmin) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgRemoveFromDenylist) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgUpdateDEXUnifiedRefAmount) Size() (n int) {
+func (m *MsgTransferAdmin) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2614,16 +3488,18 @@ func (m *MsgUpdateDEXUnifiedRefAmount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.UnifiedRefAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgUpdateDEXWhitelistedDenoms) Size() (n int) {
+func (m *MsgClearAdmin) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2637,24 +3513,142 @@ func (m *MsgUpdateDEXWhitelistedDenoms) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if len(m.WhitelistedDenoms) > 0 {
-		for _, s := range m.WhitelistedDenoms {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *EmptyResponse) Size() (n int) {
+func (m *MsgGrantRole) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	return n
-}
-
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateMaxSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDEXUnifiedRefAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.UnifiedRefAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateDEXWhitelistedDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.WhitelistedDenoms) > 0 {
+		for _, s := range m.WhitelistedDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmptyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2942,7 +3936,947 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			}
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtensionSettings == nil {
+				m.ExtensionSettings = &ExtensionIssueSettings{}
+			}
+			if err := m.ExtensionSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DEXSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DEXSettings == nil {
+				m.DEXSettings = &DEXSettings{}
+			}
+			if err := m.DEXSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VelocityLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VelocityLimit == nil {
+				m.VelocityLimit = &VelocityLimit{}
+			}
+			if err := m.VelocityLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionIssueSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionIssueSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionIssueSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuanceMsg = append(m.IssuanceMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.IssuanceMsg == nil {
+				m.IssuanceMsg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2970,13 +4904,11 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3004,13 +4936,94 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFrozen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFrozen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3038,11 +5051,11 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3070,11 +5083,11 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3101,16 +5114,13 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExtensionSettings == nil {
-				m.ExtensionSettings = &ExtensionIssueSettings{}
-			}
-			if err := m.ExtensionSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DEXSettings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3137,10 +5147,10 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DEXSettings == nil {
-				m.DEXSettings = &DEXSettings{}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
 			}
-			if err := m.DEXSettings.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3165,7 +5175,7 @@ func (m *MsgIssue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtensionIssueSettings) Unmarshal(dAtA []byte) error {
+func (m *MsgGloballyFreeze) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3188,34 +5198,15 @@ func (m *ExtensionIssueSettings) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtensionIssueSettings: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGloballyFreeze: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtensionIssueSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGloballyFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
-			}
-			m.CodeId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CodeId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3243,13 +5234,13 @@ func (m *ExtensionIssueSettings) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3259,31 +5250,29 @@ func (m *ExtensionIssueSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funds = append(m.Funds, types.Coin{})
-			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3293,24 +5282,26 @@ func (m *ExtensionIssueSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IssuanceMsg = append(m.IssuanceMsg[:0], dAtA[iNdEx:postIndex]...)
-			if m.IssuanceMsg == nil {
-				m.IssuanceMsg = []byte{}
+			if m.ExpiresAt == nil {
+				m.ExpiresAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -3334,7 +5325,7 @@ func (m *ExtensionIssueSettings) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMint) Unmarshal(dAtA []byte) error {
+func (m *MsgGloballyUnfreeze) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3357,10 +5348,10 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMint: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGloballyUnfreeze: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGloballyUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3397,40 +5388,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3458,7 +5416,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3481,7 +5439,7 @@ func (m *MsgMint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3504,15 +5462,47 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3540,7 +5530,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -3596,7 +5586,7 @@ func (m *MsgBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
+func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3619,10 +5609,10 @@ func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFreeze: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetWhitelistedLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetWhitelistedLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3743,7 +5733,7 @@ func (m *MsgFreeze) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
+func (m *MsgSetVelocityLimitOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3766,10 +5756,10 @@ func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnfreeze: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetVelocityLimitOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetVelocityLimitOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3890,7 +5880,7 @@ func (m *MsgUnfreeze) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetFrozen) Unmarshal(dAtA []byte) error {
+func (m *MsgAddToDenylist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3913,10 +5903,10 @@ func (m *MsgSetFrozen) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetFrozen: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddToDenylist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetFrozen: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddToDenylist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3985,9 +5975,9 @@ func (m *MsgSetFrozen) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3997,24 +5987,23 @@ func (m *MsgSetFrozen) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4037,7 +6026,7 @@ func (m *MsgSetFrozen) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGloballyFreeze) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFromDenylist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4060,10 +6049,10 @@ func (m *MsgGloballyFreeze) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGloballyFreeze: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFromDenylist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGloballyFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFromDenylist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4099,6 +6088,38 @@ func (m *MsgGloballyFreeze) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -4151,7 +6172,7 @@ func (m *MsgGloballyFreeze) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGloballyUnfreeze) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4174,10 +6195,10 @@ func (m *MsgGloballyUnfreeze) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGloballyUnfreeze: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGloballyUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4213,6 +6234,38 @@ func (m *MsgGloballyUnfreeze) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -4265,7 +6318,7 @@ func (m *MsgGloballyUnfreeze) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClawback) Unmarshal(dAtA []byte) error {
+func (m *MsgClearAdmin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4288,10 +6341,10 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClearAdmin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClearAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4328,7 +6381,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4356,40 +6409,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4412,7 +6432,7 @@ func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4435,10 +6455,10 @@ func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetWhitelistedLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetWhitelistedLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4475,7 +6495,7 @@ func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4503,13 +6523,32 @@ func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4519,24 +6558,23 @@ func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4559,7 +6597,7 @@ func (m *MsgSetWhitelistedLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeRole) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4582,10 +6620,10 @@ func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeRole: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeRole: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4622,7 +6660,7 @@ func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4650,11 +6688,30 @@ func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4682,7 +6739,7 @@ func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4705,7 +6762,7 @@ func (m *MsgTransferAdmin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClearAdmin) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMaxSupply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4728,10 +6785,10 @@ func (m *MsgClearAdmin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClearAdmin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMaxSupply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClearAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMaxSupply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4798,6 +6855,40 @@ func (m *MsgClearAdmin) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
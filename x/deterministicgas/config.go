@@ -49,6 +49,11 @@ const (
 	GrantBaseGas                     = 25000
 	DEXUpdateWhitelistedDenomBaseGas = 10_000
 	DEXWhitelistedPerDenomGas        = 10_000
+	DEXCancelOrdersBaseGas           = 10_000
+	DEXCancelOrdersPerOrderGas       = 20_000
+	AssetFTFreezeBaseGas             = 8_500
+	AssetFTGloballyFreezeBaseGas     = 5_000
+	AssetFTFreezeExpirationGas       = 18_000
 )
 
 type (
@@ -85,19 +90,31 @@ func DefaultConfig() Config {
 		MsgToMsgURL(&assetfttypes.MsgIssue{}):                     constantGasFunc(70_000),
 		MsgToMsgURL(&assetfttypes.MsgMint{}):                      constantGasFunc(31_000),
 		MsgToMsgURL(&assetfttypes.MsgBurn{}):                      constantGasFunc(35_000),
-		MsgToMsgURL(&assetfttypes.MsgFreeze{}):                    constantGasFunc(8_500),
 		MsgToMsgURL(&assetfttypes.MsgUnfreeze{}):                  constantGasFunc(8_500),
-		MsgToMsgURL(&assetfttypes.MsgSetFrozen{}):                 constantGasFunc(8_500),
-		MsgToMsgURL(&assetfttypes.MsgGloballyFreeze{}):            constantGasFunc(5_000),
 		MsgToMsgURL(&assetfttypes.MsgGloballyUnfreeze{}):          constantGasFunc(3_000),
 		MsgToMsgURL(&assetfttypes.MsgClawback{}):                  constantGasFunc(28_500),
 		MsgToMsgURL(&assetfttypes.MsgSetWhitelistedLimit{}):       constantGasFunc(9_000),
+		MsgToMsgURL(&assetfttypes.MsgSetVelocityLimitOverride{}):  constantGasFunc(9_000),
+		MsgToMsgURL(&assetfttypes.MsgAddToDenylist{}):             constantGasFunc(9_000),
+		MsgToMsgURL(&assetfttypes.MsgRemoveFromDenylist{}):        constantGasFunc(9_000),
 		MsgToMsgURL(&assetfttypes.MsgTransferAdmin{}):             constantGasFunc(10_000),
 		MsgToMsgURL(&assetfttypes.MsgClearAdmin{}):                constantGasFunc(8_500),
+		MsgToMsgURL(&assetfttypes.MsgGrantRole{}):                 constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgRevokeRole{}):                constantGasFunc(10_000),
+		MsgToMsgURL(&assetfttypes.MsgUpdateMaxSupply{}):           constantGasFunc(10_000),
 		MsgToMsgURL(&assetfttypes.MsgUpdateDEXUnifiedRefAmount{}): constantGasFunc(10_000),
 		MsgToMsgURL(&assetfttypes.MsgUpdateDEXWhitelistedDenoms{}): updateDEXWhitelistedDenomsGasFunc(
 			DEXUpdateWhitelistedDenomBaseGas, DEXWhitelistedPerDenomGas,
 		),
+		MsgToMsgURL(&assetfttypes.MsgFreeze{}): assetFTFreezeGasFunc(
+			AssetFTFreezeBaseGas, AssetFTFreezeExpirationGas,
+		),
+		MsgToMsgURL(&assetfttypes.MsgSetFrozen{}): assetFTFreezeGasFunc(
+			AssetFTFreezeBaseGas, AssetFTFreezeExpirationGas,
+		),
+		MsgToMsgURL(&assetfttypes.MsgGloballyFreeze{}): assetFTFreezeGasFunc(
+			AssetFTGloballyFreezeBaseGas, AssetFTFreezeExpirationGas,
+		),
 
 		// asset/nft
 		MsgToMsgURL(&assetnfttypes.MsgBurn{}):                     constantGasFunc(26_000),
@@ -115,6 +132,9 @@ func DefaultConfig() Config {
 
 		// dex
 		MsgToMsgURL(&dextypes.MsgCancelOrder{}): constantGasFunc(35_000),
+		MsgToMsgURL(&dextypes.MsgCancelOrders{}): dexCancelOrdersGasFunc(
+			DEXCancelOrdersBaseGas, DEXCancelOrdersPerOrderGas,
+		),
 
 		// authz
 		MsgToMsgURL(&authz.MsgGrant{}):  authzMsgGrantGasFunc(GrantBaseGas, storeConfig.WriteCostPerByte),
@@ -229,8 +249,20 @@ func DefaultConfig() Config {
 
 			// dex
 			&dextypes.MsgUpdateParams{},
+			&dextypes.MsgUpdateOrderBookFeeRates{},
+			&dextypes.MsgWithdrawFees{},
 			&dextypes.MsgPlaceOrder{},
+			&dextypes.MsgReplaceOrder{},
+			&dextypes.MsgPlaceOrders{},
 			&dextypes.MsgCancelOrdersByDenom{},
+			&dextypes.MsgSetCancelAllAfter{},
+			&dextypes.MsgHaltOrderBook{},
+			&dextypes.MsgResumeOrderBook{},
+			&dextypes.MsgSwapExactIn{},
+			&dextypes.MsgSwapExactOut{},
+			&dextypes.MsgCloseOrderBook{},
+			&dextypes.MsgReopenOrderBook{},
+			&dextypes.MsgSetOrderBookBatchAuction{},
 
 			// distribution
 			&distributiontypes.MsgUpdateParams{},       // This is non-deterministic because all the gov proposals are non-deterministic anyway
@@ -408,6 +440,7 @@ func authzMsgGrantGasFunc(baseGas, gasPerByte uint64) gasByMsgFunc {
 			"/" + proto.MessageName(&assetnfttypes.SendAuthorization{}),
 			"/" + proto.MessageName(&assetfttypes.MintAuthorization{}),
 			"/" + proto.MessageName(&assetfttypes.BurnAuthorization{}),
+			"/" + proto.MessageName(&dextypes.PlaceOrderAuthorization{}),
 		}, m.Grant.Authorization.TypeUrl) {
 			overHead = uint64(len(m.Grant.Authorization.Value)) * gasPerByte
 		}
@@ -498,6 +531,47 @@ func updateDEXWhitelistedDenomsGasFunc(
 	}
 }
 
+func dexCancelOrdersGasFunc(
+	dexCancelOrdersBaseGas,
+	dexCancelOrdersPerOrderGas uint64,
+) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*dextypes.MsgCancelOrders)
+		if !ok {
+			return 0, false
+		}
+
+		return dexCancelOrdersBaseGas + dexCancelOrdersPerOrderGas*uint64(len(m.IDs)), true
+	}
+}
+
+// assetFTFreezeGasFunc charges the additional gas for the freezing with the expiration time, since it stores the
+// expiration record and schedules the delayed unfreezing.
+func assetFTFreezeGasFunc(
+	assetFTFreezeBaseGas,
+	assetFTFreezeExpirationGas uint64,
+) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		var expiring bool
+		switch m := msg.(type) {
+		case *assetfttypes.MsgFreeze:
+			expiring = m.ExpiresAt != nil
+		case *assetfttypes.MsgSetFrozen:
+			expiring = m.ExpiresAt != nil
+		case *assetfttypes.MsgGloballyFreeze:
+			expiring = m.ExpiresAt != nil
+		default:
+			return 0, false
+		}
+
+		if !expiring {
+			return assetFTFreezeBaseGas, true
+		}
+
+		return assetFTFreezeBaseGas + assetFTFreezeExpirationGas, true
+	}
+}
+
 func reportUnknownMessageMetric(msgURL MsgURL) {
 	metrics.IncrCounterWithLabels([]string{"deterministic_gas_unknown_message"}, 1, []metrics.Label{
 		{Name: "msg_name", Value: string(msgURL)},
@@ -24,6 +24,7 @@ import (
 	assetnfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/nft/types"
 	"github.com/CoreumFoundation/coreum/v6/x/deterministicgas"
 	"github.com/CoreumFoundation/coreum/v6/x/deterministicgas/types"
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
 )
 
 // To access private variable from github.com/cosmos/gogoproto we link it to local variable.
@@ -107,10 +108,10 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 	// To make sure we do not increase/decrease deterministic and extension types accidentally,
 	// we assert length to be equal to exact number, so each change requires
 	// explicit adjustment of tests.
-	assert.Equal(t, 85, nondeterministicMsgCount)
-	assert.Equal(t, 68, deterministicMsgCount)
+	assert.Equal(t, 97, nondeterministicMsgCount)
+	assert.Equal(t, 75, deterministicMsgCount)
 	assert.Equal(t, 12, extensionMsgCount)
-	assert.Equal(t, 141, nonExtensionMsgCount)
+	assert.Equal(t, 160, nonExtensionMsgCount)
 }
 
 func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
@@ -121,6 +122,11 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 		assetFTIssue                 = 70000
 		bankSendPerCoinGas           = deterministicgas.BankSendPerCoinGas
 		bankMultiSendPerOperationGas = deterministicgas.BankMultiSendPerOperationsGas
+		dexCancelOrdersBaseGas       = deterministicgas.DEXCancelOrdersBaseGas
+		dexCancelOrdersPerOrderGas   = deterministicgas.DEXCancelOrdersPerOrderGas
+		assetFTFreezeBaseGas         = deterministicgas.AssetFTFreezeBaseGas
+		assetFTGloballyFreezeBaseGas = deterministicgas.AssetFTGloballyFreezeBaseGas
+		assetFTFreezeExpirationGas   = deterministicgas.AssetFTFreezeExpirationGas
 	)
 
 	cfg := deterministicgas.DefaultConfig()
@@ -143,6 +149,42 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 			expectedGas:             assetFTIssue,
 			expectedIsDeterministic: true,
 		},
+		{
+			name:                    "assetft.MsgFreeze: without expiration",
+			msg:                     &assetfttypes.MsgFreeze{},
+			expectedGas:             assetFTFreezeBaseGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name:                    "assetft.MsgFreeze: with expiration",
+			msg:                     &assetfttypes.MsgFreeze{ExpiresAt: lo.ToPtr(time.Now())},
+			expectedGas:             assetFTFreezeBaseGas + assetFTFreezeExpirationGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name:                    "assetft.MsgSetFrozen: without expiration",
+			msg:                     &assetfttypes.MsgSetFrozen{},
+			expectedGas:             assetFTFreezeBaseGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name:                    "assetft.MsgSetFrozen: with expiration",
+			msg:                     &assetfttypes.MsgSetFrozen{ExpiresAt: lo.ToPtr(time.Now())},
+			expectedGas:             assetFTFreezeBaseGas + assetFTFreezeExpirationGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name:                    "assetft.MsgGloballyFreeze: without expiration",
+			msg:                     &assetfttypes.MsgGloballyFreeze{},
+			expectedGas:             assetFTGloballyFreezeBaseGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name:                    "assetft.MsgGloballyFreeze: with expiration",
+			msg:                     &assetfttypes.MsgGloballyFreeze{ExpiresAt: lo.ToPtr(time.Now())},
+			expectedGas:             assetFTGloballyFreezeBaseGas + assetFTFreezeExpirationGas,
+			expectedIsDeterministic: true,
+		},
 		{
 			name:                    "bank.MsgSend: 0 entries",
 			msg:                     &banktypes.MsgSend{},
@@ -214,6 +256,24 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 			expectedGas:             5 * bankMultiSendPerOperationGas,
 			expectedIsDeterministic: true,
 		},
+		{
+			name:                    "dex.MsgCancelOrders: 1 order",
+			msg:                     &dextypes.MsgCancelOrders{IDs: []string{"id1"}},
+			expectedGas:             dexCancelOrdersBaseGas + dexCancelOrdersPerOrderGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name:                    "dex.MsgCancelOrders: 3 orders",
+			msg:                     &dextypes.MsgCancelOrders{IDs: []string{"id1", "id2", "id3"}},
+			expectedGas:             dexCancelOrdersBaseGas + 3*dexCancelOrdersPerOrderGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name:                    "dex.MsgPlaceOrders",
+			msg:                     &dextypes.MsgPlaceOrders{},
+			expectedGas:             0,
+			expectedIsDeterministic: false,
+		},
 		{
 			name: "authz.MsgExec: 1 bank.MsgSend & 1 wasm.MsgExecuteContract",
 			msg: lo.ToPtr(
@@ -236,6 +296,23 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 	}
 }
 
+func TestDeterministicGas_DEXCancelOrdersCheaperThanCancelOrder(t *testing.T) {
+	cfg := deterministicgas.DefaultConfig()
+
+	cancelOrderGas, isDeterministic := cfg.GasRequiredByMessage(&dextypes.MsgCancelOrder{})
+	require.True(t, isDeterministic)
+
+	for _, ordersCount := range []int{1, 2, 10, 100} {
+		ids := make([]string, 0, ordersCount)
+		for range ordersCount {
+			ids = append(ids, "id")
+		}
+		cancelOrdersGas, isDeterministic := cfg.GasRequiredByMessage(&dextypes.MsgCancelOrders{IDs: ids})
+		require.True(t, isDeterministic)
+		require.Less(t, cancelOrdersGas, cancelOrderGas*uint64(ordersCount), "orders count: %d", ordersCount)
+	}
+}
+
 func TestDeterministicGas_AuthzGrant(t *testing.T) {
 	address := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 	testCases := []struct {
@@ -302,6 +379,19 @@ func TestDeterministicGas_AuthzGrant(t *testing.T) {
 				return authorization
 			},
 		},
+		{
+			name: "place_order_auth",
+			fn: func(itemsCount int) authz.Authorization {
+				authorization := &dextypes.PlaceOrderAuthorization{}
+				for range itemsCount {
+					authorization.SpendLimit = append(
+						authorization.SpendLimit,
+						sdk.NewCoin("random-denom-"+address.String(), sdkmath.NewInt(1_000_000_000_000)),
+					)
+				}
+				return authorization
+			},
+		},
 	}
 
 	cfg := deterministicgas.DefaultConfig()
@@ -95,6 +95,11 @@ func main() {
 		NFTMsgMintCost                   uint64
 		DEXUpdateWhitelistedDenomBaseGas uint64
 		DEXWhitelistedPerDenomGas        uint64
+		DEXCancelOrdersBaseGas           uint64
+		DEXCancelOrdersPerOrderGas       uint64
+		AssetFTFreezeBaseGas             uint64
+		AssetFTGloballyFreezeBaseGas     uint64
+		AssetFTFreezeExpirationGas       uint64
 
 		DetermMsgsSpecialCases []deterministicgas.MsgURL
 		DetermMsgs             []determMsg
@@ -120,6 +125,11 @@ func main() {
 		NFTMsgMintCost:                   deterministicgas.NFTMintBaseGas,
 		DEXWhitelistedPerDenomGas:        deterministicgas.DEXWhitelistedPerDenomGas,
 		DEXUpdateWhitelistedDenomBaseGas: deterministicgas.DEXUpdateWhitelistedDenomBaseGas,
+		DEXCancelOrdersBaseGas:           deterministicgas.DEXCancelOrdersBaseGas,
+		DEXCancelOrdersPerOrderGas:       deterministicgas.DEXCancelOrdersPerOrderGas,
+		AssetFTFreezeBaseGas:             deterministicgas.AssetFTFreezeBaseGas,
+		AssetFTGloballyFreezeBaseGas:     deterministicgas.AssetFTGloballyFreezeBaseGas,
+		AssetFTFreezeExpirationGas:       deterministicgas.AssetFTFreezeExpirationGas,
 
 		DetermMsgsSpecialCases: determSpeicialCaseMsgURLs,
 		DetermMsgs:             determMsgs,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	sdkerrors "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -14,6 +15,17 @@ import (
 	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
 )
 
+const (
+	// LevelsFlag is price levels flag.
+	LevelsFlag = "levels"
+	// IncludeInvertedFlag is include inverted order book flag.
+	IncludeInvertedFlag = "include-inverted"
+	// AmountInFlag is swap amount in flag.
+	AmountInFlag = "amount-in"
+	// AmountOutFlag is swap amount out flag.
+	AmountOutFlag = "amount-out"
+)
+
 // GetQueryCmd returns the cli query commands for the module.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -30,7 +42,15 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryOrderBooks())
 	cmd.AddCommand(CmdQueryOrderBookParams())
 	cmd.AddCommand(CmdQueryOrderBookOrders())
+	cmd.AddCommand(CmdQueryOrderBookDepth())
 	cmd.AddCommand(CmdQueryAccountDenomOrdersCount())
+	cmd.AddCommand(CmdQuerySimulateOrder())
+	cmd.AddCommand(CmdQueryAccumulatedFees())
+	cmd.AddCommand(CmdQueryCancelAllAfter())
+	cmd.AddCommand(CmdQueryTrades())
+	cmd.AddCommand(CmdQueryCandles())
+	cmd.AddCommand(CmdQueryOrderBookHalt())
+	cmd.AddCommand(CmdQuerySimulateSwap())
 
 	return cmd
 }
@@ -276,6 +296,125 @@ $ %[1]s query %s order-book-orders denom1 denom2 buy
 	return cmd
 }
 
+// CmdQueryOrderBookDepth returns the QueryOrderBookDepth cobra command.
+func CmdQueryOrderBookDepth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-book-depth [base_denom] [quote_denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query order book orders aggregated by price levels",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query order book orders aggregated by price levels.
+
+Example:
+$ %[1]s query %s order-book-depth denom1 denom2 --%s=10 --%s
+`,
+				version.AppName, types.ModuleName, LevelsFlag, IncludeInvertedFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			levels, err := cmd.Flags().GetUint32(LevelsFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			includeInverted, err := cmd.Flags().GetBool(IncludeInvertedFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			res, err := queryClient.OrderBookDepth(cmd.Context(), &types.QueryOrderBookDepthRequest{
+				BaseDenom:       args[0],
+				QuoteDenom:      args[1],
+				Levels:          levels,
+				IncludeInverted: includeInverted,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint32(
+		LevelsFlag, 0, fmt.Sprintf("Max number of price levels per side, %d if not set", types.DefaultOrderBookDepthLevels),
+	)
+	cmd.Flags().Bool(
+		IncludeInvertedFlag, false, "Include orders of the inverted order book expressed in the order book price terms",
+	)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQuerySimulateOrder returns the QuerySimulateOrder cobra command.
+func CmdQuerySimulateOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-order [creator] [type] [id] [base_denom] [quote_denom] [quantity] [side]",
+		Args:  cobra.ExactArgs(7),
+		Short: "Simulate order placement without state change",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate order placement without state change.
+
+Example:
+$ %[1]s query %s simulate-order [creator] ORDER_TYPE_MARKET "" denom1 denom2 1000 SIDE_BUY
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			orderType, ok := types.OrderType_value[args[1]]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "unknown type '%s'", args[1])
+			}
+			side, ok := types.Side_value[args[6]]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "unknown side '%s'", args[6])
+			}
+			price, err := cmd.Flags().GetString(PriceFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			timeInForceString, err := cmd.Flags().GetString(TimeInForce)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			timeInForce, ok := types.TimeInForce_value[timeInForceString]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "unknown TimeInForce '%s'", timeInForceString)
+			}
+
+			res, err := queryClient.SimulateOrder(cmd.Context(), &types.QuerySimulateOrderRequest{
+				Creator:     args[0],
+				Type:        types.OrderType(orderType),
+				Id:          args[2],
+				BaseDenom:   args[3],
+				QuoteDenom:  args[4],
+				Price:       price,
+				Quantity:    args[5],
+				Side:        types.Side(side),
+				TimeInForce: types.TimeInForce(timeInForce),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(PriceFlag, "", "Order price.")
+	cmd.Flags().String(TimeInForce, types.TIME_IN_FORCE_UNSPECIFIED.String(), "Time in force.")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdQueryAccountDenomOrdersCount returns the QueryAccountDenomOrdersCount cobra command.
 func CmdQueryAccountDenomOrdersCount() *cobra.Command {
 	cmd := &cobra.Command{
@@ -311,3 +450,257 @@ $ %[1]s query %s account-denom-orders-count %s denom1
 
 	return cmd
 }
+
+// CmdQueryCancelAllAfter returns the QueryCancelAllAfter cobra command.
+func CmdQueryCancelAllAfter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-all-after [account]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query scheduled cancellation of account orders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query scheduled cancellation of account orders.
+
+Example:
+$ %[1]s query %s cancel-all-after %s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CancelAllAfter(cmd.Context(), &types.QueryCancelAllAfterRequest{
+				Account: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryAccumulatedFees returns the QueryAccumulatedFees cobra command.
+func CmdQueryAccumulatedFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accumulated-fees",
+		Args:  cobra.NoArgs,
+		Short: "Query trading fees accumulated by the DEX",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query trading fees accumulated by the DEX.
+
+Example:
+$ %[1]s query %s accumulated-fees
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccumulatedFees(cmd.Context(), &types.QueryAccumulatedFeesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "accumulated-fees")
+
+	return cmd
+}
+
+// CmdQueryTrades returns the QueryTrades cobra command.
+func CmdQueryTrades() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades [base_denom] [quote_denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query order book recent trades",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query order book recent trades.
+
+Example:
+$ %[1]s query %s trades denom1 denom2 --reverse
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Trades(cmd.Context(), &types.QueryTradesRequest{
+				BaseDenom:  args[0],
+				QuoteDenom: args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades")
+
+	return cmd
+}
+
+// CmdQueryCandles returns the QueryCandles cobra command.
+func CmdQueryCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [base_denom] [quote_denom] [interval]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query order book OHLCV candles",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query order book OHLCV candles.
+
+Example:
+$ %[1]s query %s candles denom1 denom2 %s --reverse
+`,
+				version.AppName, types.ModuleName, types.CANDLE_INTERVAL_1H.String(),
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			interval, ok := types.CandleInterval_value[args[2]]
+			if !ok {
+				return errors.Errorf("unknown candle interval '%s'", args[2])
+			}
+
+			res, err := queryClient.Candles(cmd.Context(), &types.QueryCandlesRequest{
+				BaseDenom:  args[0],
+				QuoteDenom: args[1],
+				Interval:   types.CandleInterval(interval),
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "candles")
+
+	return cmd
+}
+
+// CmdQueryOrderBookHalt returns the QueryOrderBookHalt cobra command.
+func CmdQueryOrderBookHalt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-book-halt [base_denom] [quote_denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query order book trading halt",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query order book trading halt.
+
+Example:
+$ %[1]s query %s order-book-halt denom1 denom2
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OrderBookHalt(cmd.Context(), &types.QueryOrderBookHaltRequest{
+				BaseDenom:  args[0],
+				QuoteDenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQuerySimulateSwap returns the QuerySimulateSwap cobra command.
+func CmdQuerySimulateSwap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-swap [sender] [denom1] [denom2] ...",
+		Args:  cobra.MinimumNArgs(3),
+		Short: "Simulate swap through the route order books without state change",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Simulate swap through the route order books without state change. The exact input swap is
+simulated with the --%[3]s flag, and the exact output swap is simulated with the --%[4]s flag.
+
+Example:
+$ %[1]s query %[2]s simulate-swap [sender] denom1 denom2 denom3 --%[3]s 1000000
+`,
+				version.AppName, types.ModuleName, AmountInFlag, AmountOutFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			amountIn, err := cmd.Flags().GetString(AmountInFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			amountOut, err := cmd.Flags().GetString(AmountOutFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			res, err := queryClient.SimulateSwap(cmd.Context(), &types.QuerySimulateSwapRequest{
+				Sender:    args[0],
+				Route:     args[1:],
+				AmountIn:  amountIn,
+				AmountOut: amountOut,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(AmountInFlag, "", "Amount of the input denom to swap.")
+	cmd.Flags().String(AmountOutFlag, "", "Amount of the output denom to receive.")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -42,6 +42,20 @@ func TestQueryOrderBookParams(t *testing.T) {
 	requireT.Equal("10000", resp.QuantityStep.String())
 	requireT.Equal("1000000", resp.BaseDenomUnifiedRefAmount.TruncateInt().String())
 	requireT.Equal("1000000", resp.QuoteDenomUnifiedRefAmount.TruncateInt().String())
+	requireT.True(resp.MakerFeeRate.IsZero())
+	requireT.True(resp.TakerFeeRate.IsZero())
+}
+
+func TestQueryAccumulatedFees(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	var resp types.QueryAccumulatedFeesResponse
+	coreumclitestutil.ExecQueryCmd(t, ctx, cli.CmdQueryAccumulatedFees(), []string{}, &resp)
+	requireT.True(resp.Fees.IsZero())
 }
 
 func TestCmdQueryOrderBooksAndOrders(t *testing.T) {
@@ -204,3 +218,160 @@ func TestCmdQueryAccountDenomOrdersCount(t *testing.T) {
 	)
 	requireT.Equal(uint64(2), ordersRes.Count)
 }
+
+func TestCmdQueryTradesAndCandles(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issueFT(ctx, requireT, testNetwork, defaultQuantity.MulRaw(10))
+	denom2 := issueFT(ctx, requireT, testNetwork, defaultQuantity.MulRaw(10))
+	// the trades are returned in the terms of the canonical order book with the lower denom as the base denom
+	if denom1 > denom2 {
+		denom1, denom2 = denom2, denom1
+	}
+
+	creator := validator1Address(testNetwork)
+	price := types.MustNewPriceFromString("123e-2")
+	placeOrder(ctx, requireT, testNetwork, types.Order{
+		Creator:     creator.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   denom1,
+		QuoteDenom:  denom2,
+		Price:       lo.ToPtr(price),
+		Quantity:    defaultQuantity,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	})
+	placeOrder(ctx, requireT, testNetwork, types.Order{
+		Creator:     creator.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id2",
+		BaseDenom:   denom1,
+		QuoteDenom:  denom2,
+		Price:       lo.ToPtr(price),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	})
+
+	var tradesRes types.QueryTradesResponse
+	coreumclitestutil.ExecQueryCmd(t, ctx, cli.CmdQueryTrades(), []string{denom1, denom2}, &tradesRes)
+	requireT.Len(tradesRes.Trades, 1)
+	trade := tradesRes.Trades[0]
+	requireT.Equal(uint64(1), trade.Sequence)
+	requireT.Equal(denom1, trade.BaseDenom)
+	requireT.Equal(denom2, trade.QuoteDenom)
+	requireT.Equal(price, trade.Price)
+	requireT.Equal(sdkmath.NewInt(100_000).String(), trade.BaseQuantity.String())
+	requireT.Equal(sdkmath.NewInt(123_000).String(), trade.QuoteQuantity.String())
+	requireT.Equal(types.SIDE_BUY, trade.TakerSide)
+
+	var candlesRes types.QueryCandlesResponse
+	coreumclitestutil.ExecQueryCmd(
+		t,
+		ctx,
+		cli.CmdQueryCandles(),
+		[]string{denom1, denom2, types.CANDLE_INTERVAL_1H.String()},
+		&candlesRes,
+	)
+	requireT.Len(candlesRes.Candles, 1)
+	candle := candlesRes.Candles[0]
+	requireT.Equal(price, candle.Open)
+	requireT.Equal(price, candle.Close)
+	requireT.Equal(trade.BaseQuantity.String(), candle.BaseVolume.String())
+	requireT.Equal(trade.QuoteQuantity.String(), candle.QuoteVolume.String())
+	requireT.Equal(uint64(1), candle.TradesCount)
+}
+
+func TestCmdQueryOrderBookDepth(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issueFT(ctx, requireT, testNetwork, defaultQuantity.MulRaw(10))
+	denom2 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+
+	creator := validator1Address(testNetwork)
+	for _, id := range []string{"id1", "id2"} {
+		placeOrder(ctx, requireT, testNetwork, types.Order{
+			Creator:     creator.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          id,
+			BaseDenom:   denom1,
+			QuoteDenom:  denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("123e-2")),
+			Quantity:    defaultQuantity,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		})
+	}
+
+	var depthRes types.QueryOrderBookDepthResponse
+	coreumclitestutil.ExecQueryCmd(
+		t,
+		ctx,
+		cli.CmdQueryOrderBookDepth(),
+		[]string{denom1, denom2, "--" + cli.LevelsFlag, "10", "--" + cli.IncludeInvertedFlag},
+		&depthRes,
+	)
+	requireT.Empty(depthRes.Bids)
+	requireT.Equal([]types.PriceLevel{
+		{
+			Price:        types.MustNewPriceFromString("123e-2"),
+			BaseQuantity: defaultQuantity.MulRaw(2),
+			OrdersCount:  2,
+		},
+	}, depthRes.Asks)
+}
+
+func TestCmdQuerySimulateOrder(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+	denom2 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+
+	creator := validator1Address(testNetwork)
+	placeOrder(ctx, requireT, testNetwork, types.Order{
+		Creator:     creator.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   denom1,
+		QuoteDenom:  denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	})
+
+	var simulateRes types.QuerySimulateOrderResponse
+	coreumclitestutil.ExecQueryCmd(
+		t,
+		ctx,
+		cli.CmdQuerySimulateOrder(),
+		[]string{
+			creator.String(),
+			types.ORDER_TYPE_MARKET.String(),
+			"id2",
+			denom1,
+			denom2,
+			"400000",
+			types.SIDE_BUY.String(),
+			"--" + cli.TimeInForce, types.TIME_IN_FORCE_IOC.String(),
+		},
+		&simulateRes,
+	)
+	requireT.Len(simulateRes.Fills, 1)
+	requireT.Equal("id1", simulateRes.Fills[0].MakerID)
+	requireT.Equal(sdkmath.NewInt(400_000).String(), simulateRes.ReceivedCoin.Amount.String())
+	requireT.Equal(sdkmath.NewInt(200_000).String(), simulateRes.SpentCoin.Amount.String())
+	requireT.Equal(types.MustNewPriceFromString("5e-1").String(), simulateRes.AveragePrice.String())
+
+	// the order is not placed
+	var ordersRes types.QueryOrdersResponse
+	coreumclitestutil.ExecQueryCmd(t, ctx, cli.CmdQueryOrders(), []string{creator.String()}, &ordersRes)
+	requireT.Len(ordersRes.Orders, 1)
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"os"
 	"sort"
 	"strings"
 	"time"
@@ -11,7 +12,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/pkg/errors"
 	"github.com/samber/lo"
 	"github.com/spf13/cobra"
@@ -29,6 +32,26 @@ const (
 	GoodTilBlockTimeFlag = "good-til-block-time"
 	// TimeInForce is time-in-force flag.
 	TimeInForce = "time-in-force"
+	// TriggerTypeFlag is trigger type flag.
+	TriggerTypeFlag = "trigger-type"
+	// TriggerPriceFlag is trigger price flag.
+	TriggerPriceFlag = "trigger-price"
+	// SelfTradePreventionFlag is self-trade prevention flag.
+	SelfTradePreventionFlag = "self-trade-prevention"
+	// VisibleQuantityFlag is visible quantity flag.
+	VisibleQuantityFlag = "visible-quantity"
+	// OrderBookFlag is authorized order book flag.
+	OrderBookFlag = "order-book"
+	// DenomFlag is authorized denom flag.
+	DenomFlag = "denom"
+	// SideFlag is authorized side flag.
+	SideFlag = "side"
+	// MaxOrderSpendFlag is max order spend flag.
+	MaxOrderSpendFlag = "max-order-spend"
+	// SpendLimitFlag is spend limit flag.
+	SpendLimitFlag = "spend-limit"
+	// ExpirationFlag is authorization expiration flag.
+	ExpirationFlag = "expiration"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -44,15 +67,22 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdPlaceOrder(),
 		CmdCancelOrder(),
+		CmdReplaceOrder(),
 		CmdCancelOrdersByDenom(),
+		CmdPlaceOrders(),
+		CmdCancelOrders(),
+		CmdSetCancelAllAfter(),
+		CmdHaltOrderBook(),
+		CmdResumeOrderBook(),
+		CmdSwapExactIn(),
+		CmdSwapExactOut(),
+		CmdGrantPlaceOrderAuthorization(),
 	)
 
 	return cmd
 }
 
 // CmdPlaceOrder returns PlaceOrder cobra command.
-//
-//nolint:funlen // Despite the length function is still manageable
 func CmdPlaceOrder() *cobra.Command {
 	availableTimeInForces := lo.Values(types.TimeInForce_name)
 	sort.Strings(availableTimeInForces)
@@ -61,7 +91,7 @@ func CmdPlaceOrder() *cobra.Command {
 	availableSides := lo.Values(types.Side_name)
 	sort.Strings(availableTimeInForces)
 	cmd := &cobra.Command{
-		Use:   "place-order [type (" + strings.Join(availableOrderTypes, ",") + ")] [id] [base_denom] [quote_denom] [quantity] [side (" + strings.Join(availableSides, ",") + ")] --price 123e-2 --time-in-force=" + strings.Join(availableTimeInForces, ",") + " --good-til-block-height=123 --good-til-block-time=1727124446 --from [sender]", //nolint:lll // string example
+		Use:   "place-order [type (" + strings.Join(availableOrderTypes, ",") + ")] [id] [base_denom] [quote_denom] [quantity] [side (" + strings.Join(availableSides, ",") + ")] --price 123e-2 --time-in-force=" + strings.Join(availableTimeInForces, ",") + " --good-til-block-height=123 --good-til-block-time=1727124446 --trigger-type=TRIGGER_TYPE_STOP_LOSS --trigger-price 11e-1 --self-trade-prevention=SELF_TRADE_PREVENTION_CANCEL_NEWEST --visible-quantity=100 --from [sender]", //nolint:lll // string example
 		Args:  cobra.ExactArgs(6),
 		Short: "Place new order",
 		Long: strings.TrimSpace(
@@ -78,95 +108,92 @@ $ %s tx %s place-order ORDER_TYPE_LIMIT "my-order-id1" denom1 denom2 1000 SIDE_S
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
+			order, err := parseOrder(cmd, clientCtx.GetFromAddress(), args)
+			if err != nil {
+				return err
+			}
 
-			orderType, ok := types.OrderType_value[args[0]]
-			if !ok {
-				return sdkerrors.Wrapf(types.ErrInvalidInput, "unknown type '%s'", args[0])
+			msg := &types.MsgPlaceOrder{
+				Sender:              order.Creator,
+				Type:                order.Type,
+				ID:                  order.ID,
+				BaseDenom:           order.BaseDenom,
+				QuoteDenom:          order.QuoteDenom,
+				Price:               order.Price,
+				Quantity:            order.Quantity,
+				Side:                order.Side,
+				GoodTil:             order.GoodTil,
+				TimeInForce:         order.TimeInForce,
+				Trigger:             order.Trigger,
+				SelfTradePrevention: order.SelfTradePrevention,
+				VisibleQuantity:     order.VisibleQuantity,
 			}
 
-			id := args[1]
-			baseDenom := args[2]
-			quoteDenom := args[3]
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-			quantity, ok := sdkmath.NewIntFromString(args[4])
-			if !ok {
-				return sdkerrors.Wrapf(types.ErrInvalidInput, "quantity is ivalid or too big")
-			}
+	addOrderFlags(cmd)
 
-			side, ok := types.Side_value[args[5]]
-			if !ok {
-				return sdkerrors.Wrapf(types.ErrInvalidInput, "unknown side '%s'", args[5])
-			}
+	flags.AddTxFlagsToCmd(cmd)
 
-			priceStr, err := cmd.Flags().GetString(PriceFlag)
-			if err != nil {
-				return errors.WithStack(err)
-			}
-			var price *types.Price
-			if priceStr != "" {
-				priceV, err := types.NewPriceFromString(priceStr)
-				if err != nil {
-					return sdkerrors.Wrap(err, "invalid price")
-				}
-				price = &priceV
-			}
+	return cmd
+}
 
-			goodTilBlockHeight, err := cmd.Flags().GetUint64(GoodTilBlockHeightFlag)
-			if err != nil {
-				return errors.WithStack(err)
-			}
+// CmdReplaceOrder returns ReplaceOrder cobra command.
+func CmdReplaceOrder() *cobra.Command {
+	availableTimeInForces := lo.Values(types.TimeInForce_name)
+	sort.Strings(availableTimeInForces)
+	availableOrderTypes := lo.Values(types.OrderType_name)
+	sort.Strings(availableOrderTypes)
+	availableSides := lo.Values(types.Side_name)
+	sort.Strings(availableSides)
+	cmd := &cobra.Command{
+		Use:   "replace-order [old_id] [type (" + strings.Join(availableOrderTypes, ",") + ")] [id] [base_denom] [quote_denom] [quantity] [side (" + strings.Join(availableSides, ",") + ")] --price 123e-2 --time-in-force=" + strings.Join(availableTimeInForces, ",") + " --good-til-block-height=123 --good-til-block-time=1727124446 --from [sender]", //nolint:lll // string example
+		Args:  cobra.ExactArgs(7),
+		Short: "Replace order with the new one",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Replace order with the new one.
 
-			goodTilBlockTimeNum, err := cmd.Flags().GetInt64(GoodTilBlockTimeFlag)
+Example:
+$ %s tx %s replace-order "my-order-id1" ORDER_TYPE_LIMIT "my-order-id1" denom1 denom2 900 SIDE_SELL --price 12e-1 --time-in-force=TIME_IN_FORCE_GTC --from [sender]`, //nolint:lll // string example
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return errors.WithStack(err)
 			}
-			var goodTilBlockTime *time.Time
-			if goodTilBlockTimeNum > 0 {
-				goodTilBlockTime = lo.ToPtr(time.Unix(goodTilBlockTimeNum, 0))
-			}
 
-			timeInForceString, err := cmd.Flags().GetString(TimeInForce)
-			timeInForceInt, ok := types.TimeInForce_value[timeInForceString]
-			if !ok {
-				return sdkerrors.Wrapf(
-					types.ErrInvalidInput,
-					"unknown TimeInForce '%s',available TimeInForces: %s",
-					timeInForceString, strings.Join(availableTimeInForces, ","),
-				)
-			}
+			oldID := args[0]
+			order, err := parseOrder(cmd, clientCtx.GetFromAddress(), args[1:])
 			if err != nil {
-				return errors.WithStack(err)
+				return err
 			}
-			timeInForce := types.TimeInForce(timeInForceInt)
 
-			msg := &types.MsgPlaceOrder{
-				Sender:      sender.String(),
-				Type:        types.OrderType(orderType),
-				ID:          id,
-				BaseDenom:   baseDenom,
-				QuoteDenom:  quoteDenom,
-				Price:       price,
-				Quantity:    quantity,
-				Side:        types.Side(side),
-				TimeInForce: timeInForce,
-			}
-
-			if goodTilBlockHeight != 0 || goodTilBlockTime != nil {
-				msg.GoodTil = &types.GoodTil{
-					GoodTilBlockHeight: goodTilBlockHeight,
-					GoodTilBlockTime:   goodTilBlockTime,
-				}
+			msg := &types.MsgReplaceOrder{
+				Sender:              order.Creator,
+				OldID:               oldID,
+				Type:                order.Type,
+				ID:                  order.ID,
+				BaseDenom:           order.BaseDenom,
+				QuoteDenom:          order.QuoteDenom,
+				Price:               order.Price,
+				Quantity:            order.Quantity,
+				Side:                order.Side,
+				GoodTil:             order.GoodTil,
+				TimeInForce:         order.TimeInForce,
+				Trigger:             order.Trigger,
+				SelfTradePrevention: order.SelfTradePrevention,
+				VisibleQuantity:     order.VisibleQuantity,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
-	cmd.Flags().String(PriceFlag, "", "Order price.")
-	cmd.Flags().Uint64(GoodTilBlockHeightFlag, 0, "Good til block height.")
-	cmd.Flags().Int64(GoodTilBlockTimeFlag, 0, "Good til block time.")
-	cmd.Flags().String(TimeInForce, types.TIME_IN_FORCE_UNSPECIFIED.String(), "Time in force.")
+	addOrderFlags(cmd)
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -250,3 +277,586 @@ $ %s tx %s cancel-orders-by-denom %s denom1 --from [sender]
 
 	return cmd
 }
+
+// CmdPlaceOrders returns PlaceOrders cobra command.
+func CmdPlaceOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-orders [orders_file] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Place batch of orders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Place batch of orders from the JSON file, each order is placed or rejected individually.
+
+Example:
+$ %s tx %s place-orders orders.json --from [sender]
+
+Where orders.json contains:
+{
+  "orders": [
+    {
+      "type": "ORDER_TYPE_LIMIT",
+      "id": "my-order-id1",
+      "base_denom": "denom1",
+      "quote_denom": "denom2",
+      "price": "12e-1",
+      "quantity": "1000",
+      "side": "SIDE_SELL",
+      "time_in_force": "TIME_IN_FORCE_GTC"
+    }
+  ]
+}
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgPlaceOrders{}
+			if err := clientCtx.Codec.UnmarshalJSON(contents, msg); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid orders file: %s", err)
+			}
+			msg.Sender = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdCancelOrders returns CancelOrders cobra command.
+func CmdCancelOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-orders [id1] [id2] ... --from [sender]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Cancel batch of orders",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel batch of orders, each order is canceled or rejected individually.
+
+Example:
+$ %s tx %s cancel-orders id1 id2 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgCancelOrders{
+				Sender: clientCtx.GetFromAddress().String(),
+				IDs:    args,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSetCancelAllAfter returns SetCancelAllAfter cobra command.
+func CmdSetCancelAllAfter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-cancel-all-after [timeout] [denom1] [denom2] ... --from [sender]",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Schedule the cancellation of all orders after the timeout",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedule the cancellation of all orders, optionally limited to the denoms, after the timeout.
+Each call moves the deadline, and the zero timeout disarms the scheduled cancellation.
+
+Example:
+$ %s tx %s set-cancel-all-after 1m denom1 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			timeout, err := time.ParseDuration(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid timeout: %s", args[0])
+			}
+
+			msg := &types.MsgSetCancelAllAfter{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Timeout: timeout,
+				Denoms:  args[1:],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdHaltOrderBook returns HaltOrderBook cobra command.
+func CmdHaltOrderBook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halt-order-book [base_denom] [quote_denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Halt the trading in the order book",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Halt the trading in the order book, allowed for the admin of the order book denom with the
+dex_order_book_halt feature.
+
+Example:
+$ %s tx %s halt-order-book denom1 denom2 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgHaltOrderBook{
+				Sender:     clientCtx.GetFromAddress().String(),
+				BaseDenom:  args[0],
+				QuoteDenom: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdResumeOrderBook returns ResumeOrderBook cobra command.
+func CmdResumeOrderBook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-order-book [base_denom] [quote_denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Resume the trading in the halted order book",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resume the trading in the halted order book, allowed for the admin of the order book denom with the
+dex_order_book_halt feature.
+
+Example:
+$ %s tx %s resume-order-book denom1 denom2 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgResumeOrderBook{
+				Sender:     clientCtx.GetFromAddress().String(),
+				BaseDenom:  args[0],
+				QuoteDenom: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSwapExactIn returns SwapExactIn cobra command.
+func CmdSwapExactIn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-in [amount_in] [min_amount_out] [denom1] [denom2] ... --from [sender]",
+		Args:  cobra.MinimumNArgs(4),
+		Short: "Swap the exact input amount through the route order books",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap the exact input amount of the first route denom to the last route denom through the order
+books of the adjacent route denoms. The swap is rejected if the received amount is less than the min amount out.
+
+Example:
+$ %s tx %s swap-exact-in 1000000 990000 denom1 denom2 denom3 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			amountIn, ok := sdkmath.NewIntFromString(args[0])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid amount in: %s", args[0])
+			}
+			minAmountOut, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid min amount out: %s", args[1])
+			}
+
+			msg := &types.MsgSwapExactIn{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Route:        args[2:],
+				AmountIn:     amountIn,
+				MinAmountOut: minAmountOut,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdSwapExactOut returns SwapExactOut cobra command.
+func CmdSwapExactOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-exact-out [amount_out] [max_amount_in] [denom1] [denom2] ... --from [sender]",
+		Args:  cobra.MinimumNArgs(4),
+		Short: "Swap the minimal input amount required to receive the output amount through the route order books",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Swap the minimal input amount of the first route denom required to receive the output amount of
+the last route denom through the order books of the adjacent route denoms. The swap is rejected if the required
+input amount exceeds the max amount in.
+
+Example:
+$ %s tx %s swap-exact-out 1000000 1010000 denom1 denom2 denom3 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			amountOut, ok := sdkmath.NewIntFromString(args[0])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid amount out: %s", args[0])
+			}
+			maxAmountIn, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid max amount in: %s", args[1])
+			}
+
+			msg := &types.MsgSwapExactOut{
+				Sender:      clientCtx.GetFromAddress().String(),
+				Route:       args[2:],
+				AmountOut:   amountOut,
+				MaxAmountIn: maxAmountIn,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdGrantPlaceOrderAuthorization returns a CLI command handler for creating a MsgGrant transaction with the
+// PlaceOrderAuthorization.
+func CmdGrantPlaceOrderAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-place-order [grantee] --from [granter]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Grant the authorization to place orders on behalf of the granter",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant the authorization to place orders on behalf of the granter within the limits. The empty
+limit allows any value.
+
+Example:
+$ %s tx %s grant-place-order [grantee] --%s denom1,denom2 --%s %s --%s 100000denom2 --from [granter]
+`,
+				version.AppName, types.ModuleName, OrderBookFlag, SideFlag, types.SIDE_BUY.String(), SpendLimitFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid grantee: %s", args[0])
+			}
+
+			authorization, expiration, err := parsePlaceOrderAuthorization(cmd)
+			if err != nil {
+				return err
+			}
+			if err := authorization.ValidateBasic(); err != nil {
+				return err
+			}
+
+			grantMsg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expiration)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), grantMsg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringArray(
+		OrderBookFlag, nil, "Allowed order book as the comma-separated base and quote denoms, can be repeated.",
+	)
+	cmd.Flags().StringSlice(DenomFlag, nil, "Allowed denoms.")
+	cmd.Flags().StringSlice(SideFlag, nil, "Allowed order sides.")
+	cmd.Flags().String(MaxOrderSpendFlag, "", "Max amount of the coins a single order is allowed to spend.")
+	cmd.Flags().String(SpendLimitFlag, "", "Cumulative amount of the coins the orders are allowed to spend.")
+	cmd.Flags().Int64(ExpirationFlag, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry.")
+
+	return cmd
+}
+
+func parsePlaceOrderAuthorization(cmd *cobra.Command) (*types.PlaceOrderAuthorization, *time.Time, error) {
+	authorization := &types.PlaceOrderAuthorization{}
+
+	orderBooks, err := cmd.Flags().GetStringArray(OrderBookFlag)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	for _, orderBook := range orderBooks {
+		denoms := strings.Split(orderBook, ",")
+		if len(denoms) != 2 {
+			return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid order book: %s", orderBook)
+		}
+		authorization.OrderBooks = append(authorization.OrderBooks, types.AuthorizedOrderBook{
+			BaseDenom:  denoms[0],
+			QuoteDenom: denoms[1],
+		})
+	}
+
+	authorization.Denoms, err = cmd.Flags().GetStringSlice(DenomFlag)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+
+	sides, err := cmd.Flags().GetStringSlice(SideFlag)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	for _, sideString := range sides {
+		side, ok := types.Side_value[sideString]
+		if !ok {
+			return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "unknown side '%s'", sideString)
+		}
+		authorization.Sides = append(authorization.Sides, types.Side(side))
+	}
+
+	maxOrderSpend, err := cmd.Flags().GetString(MaxOrderSpendFlag)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	authorization.MaxOrderSpend, err = sdk.ParseCoinsNormalized(maxOrderSpend)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid max order spend: %s", err)
+	}
+
+	spendLimit, err := cmd.Flags().GetString(SpendLimitFlag)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	authorization.SpendLimit, err = sdk.ParseCoinsNormalized(spendLimit)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid spend limit: %s", err)
+	}
+
+	expirationUnix, err := cmd.Flags().GetInt64(ExpirationFlag)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	if expirationUnix == 0 {
+		return authorization, nil, nil
+	}
+	// the grant expires together with the authorization
+	expiration := time.Unix(expirationUnix, 0)
+	authorization.Expiration = &expiration
+
+	return authorization, &expiration, nil
+}
+
+func addOrderFlags(cmd *cobra.Command) {
+	cmd.Flags().String(PriceFlag, "", "Order price.")
+	cmd.Flags().Uint64(GoodTilBlockHeightFlag, 0, "Good til block height.")
+	cmd.Flags().Int64(GoodTilBlockTimeFlag, 0, "Good til block time.")
+	cmd.Flags().String(TimeInForce, types.TIME_IN_FORCE_UNSPECIFIED.String(), "Time in force.")
+	cmd.Flags().String(TriggerTypeFlag, "", "Trigger type of the conditional order.")
+	cmd.Flags().String(TriggerPriceFlag, "", "Trigger price of the conditional order.")
+	cmd.Flags().String(
+		SelfTradePreventionFlag,
+		types.SELF_TRADE_PREVENTION_UNSPECIFIED.String(),
+		"Self-trade prevention mode applied when the order matches the order of the same account.",
+	)
+	cmd.Flags().String(
+		VisibleQuantityFlag,
+		"",
+		"Quantity of the iceberg order shown in the order book, the hidden remainder refills it when it's filled.",
+	)
+}
+
+// parseOrder parses the order from the [type] [id] [base_denom] [quote_denom] [quantity] [side] args and flags.
+func parseOrder(cmd *cobra.Command, sender sdk.AccAddress, args []string) (types.Order, error) {
+	orderType, ok := types.OrderType_value[args[0]]
+	if !ok {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrInvalidInput, "unknown type '%s'", args[0])
+	}
+
+	id := args[1]
+	baseDenom := args[2]
+	quoteDenom := args[3]
+
+	quantity, ok := sdkmath.NewIntFromString(args[4])
+	if !ok {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrInvalidInput, "quantity is ivalid or too big")
+	}
+
+	side, ok := types.Side_value[args[5]]
+	if !ok {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrInvalidInput, "unknown side '%s'", args[5])
+	}
+
+	priceStr, err := cmd.Flags().GetString(PriceFlag)
+	if err != nil {
+		return types.Order{}, errors.WithStack(err)
+	}
+	var price *types.Price
+	if priceStr != "" {
+		priceV, err := types.NewPriceFromString(priceStr)
+		if err != nil {
+			return types.Order{}, sdkerrors.Wrap(err, "invalid price")
+		}
+		price = &priceV
+	}
+
+	goodTilBlockHeight, err := cmd.Flags().GetUint64(GoodTilBlockHeightFlag)
+	if err != nil {
+		return types.Order{}, errors.WithStack(err)
+	}
+
+	goodTilBlockTimeNum, err := cmd.Flags().GetInt64(GoodTilBlockTimeFlag)
+	if err != nil {
+		return types.Order{}, errors.WithStack(err)
+	}
+	var goodTilBlockTime *time.Time
+	if goodTilBlockTimeNum > 0 {
+		goodTilBlockTime = lo.ToPtr(time.Unix(goodTilBlockTimeNum, 0))
+	}
+
+	timeInForceString, err := cmd.Flags().GetString(TimeInForce)
+	if err != nil {
+		return types.Order{}, errors.WithStack(err)
+	}
+	timeInForceInt, ok := types.TimeInForce_value[timeInForceString]
+	if !ok {
+		availableTimeInForces := lo.Values(types.TimeInForce_name)
+		sort.Strings(availableTimeInForces)
+		return types.Order{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"unknown TimeInForce '%s',available TimeInForces: %s",
+			timeInForceString, strings.Join(availableTimeInForces, ","),
+		)
+	}
+
+	triggerTypeString, err := cmd.Flags().GetString(TriggerTypeFlag)
+	if err != nil {
+		return types.Order{}, errors.WithStack(err)
+	}
+	triggerPriceStr, err := cmd.Flags().GetString(TriggerPriceFlag)
+	if err != nil {
+		return types.Order{}, errors.WithStack(err)
+	}
+	var trigger *types.Trigger
+	if triggerTypeString != "" || triggerPriceStr != "" {
+		triggerTypeInt, ok := types.TriggerType_value[triggerTypeString]
+		if !ok {
+			return types.Order{}, sdkerrors.Wrapf(types.ErrInvalidInput, "unknown trigger type '%s'", triggerTypeString)
+		}
+		triggerPrice, err := types.NewPriceFromString(triggerPriceStr)
+		if err != nil {
+			return types.Order{}, sdkerrors.Wrap(err, "invalid trigger price")
+		}
+		trigger = &types.Trigger{
+			Type:  types.TriggerType(triggerTypeInt),
+			Price: triggerPrice,
+		}
+	}
+
+	selfTradePreventionString, err := cmd.Flags().GetString(SelfTradePreventionFlag)
+	if err != nil {
+		return types.Order{}, errors.WithStack(err)
+	}
+	selfTradePreventionInt, ok := types.SelfTradePrevention_value[selfTradePreventionString]
+	if !ok {
+		return types.Order{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "unknown self-trade prevention '%s'", selfTradePreventionString,
+		)
+	}
+
+	visibleQuantityStr, err := cmd.Flags().GetString(VisibleQuantityFlag)
+	if err != nil {
+		return types.Order{}, errors.WithStack(err)
+	}
+	var visibleQuantity *sdkmath.Int
+	if visibleQuantityStr != "" {
+		visibleQuantityInt, ok := sdkmath.NewIntFromString(visibleQuantityStr)
+		if !ok {
+			return types.Order{}, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid visible quantity '%s'", visibleQuantityStr)
+		}
+		visibleQuantity = &visibleQuantityInt
+	}
+
+	order := types.Order{
+		Creator:             sender.String(),
+		Type:                types.OrderType(orderType),
+		ID:                  id,
+		BaseDenom:           baseDenom,
+		QuoteDenom:          quoteDenom,
+		Price:               price,
+		Quantity:            quantity,
+		Side:                types.Side(side),
+		TimeInForce:         types.TimeInForce(timeInForceInt),
+		Trigger:             trigger,
+		SelfTradePrevention: types.SelfTradePrevention(selfTradePreventionInt),
+		VisibleQuantity:     visibleQuantity,
+	}
+
+	if goodTilBlockHeight != 0 || goodTilBlockTime != nil {
+		order.GoodTil = &types.GoodTil{
+			GoodTilBlockHeight: goodTilBlockHeight,
+			GoodTilBlockTime:   goodTilBlockTime,
+		}
+	}
+
+	return order, nil
+}
@@ -2,6 +2,8 @@ package cli_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -251,6 +253,71 @@ func TestCmdCancelOrdersByDenom(t *testing.T) {
 	requireT.Zero(ordersRes.Count)
 }
 
+func TestCmdPlaceOrdersAndCancelOrders(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom1 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+	denom2 := issueFT(ctx, requireT, testNetwork, defaultQuantity)
+
+	ordersFile := filepath.Join(t.TempDir(), "orders.json")
+	requireT.NoError(os.WriteFile(ordersFile, []byte(fmt.Sprintf(`{
+  "orders": [
+    {
+      "type": "ORDER_TYPE_LIMIT",
+      "id": "id1",
+      "base_denom": %[1]q,
+      "quote_denom": %[2]q,
+      "price": "123e-2",
+      "quantity": "100000",
+      "side": "SIDE_SELL",
+      "time_in_force": "TIME_IN_FORCE_GTC"
+    },
+    {
+      "type": "ORDER_TYPE_LIMIT",
+      "id": "id2",
+      "base_denom": %[1]q,
+      "quote_denom": %[2]q,
+      "price": "124e-2",
+      "quantity": "100000",
+      "side": "SIDE_SELL",
+      "time_in_force": "TIME_IN_FORCE_GTC"
+    }
+  ]
+}`, denom1, denom2)), 0o600))
+
+	_, err := coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdPlaceOrders(),
+		append(
+			[]string{ordersFile, fmt.Sprintf("--%s=%d", flags.FlagGas, 2000000)},
+			txValidator1Args(testNetwork)...,
+		),
+	)
+	requireT.NoError(err)
+
+	var ordersRes types.QueryAccountDenomOrdersCountResponse
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryAccountDenomOrdersCount(), []string{validator1Address(testNetwork).String(), denom1}, &ordersRes,
+	)
+	requireT.Equal(uint64(2), ordersRes.Count)
+
+	_, err = coreumclitestutil.ExecTxCmd(
+		ctx,
+		testNetwork,
+		cli.CmdCancelOrders(),
+		append([]string{"id1", "id2"}, txValidator1Args(testNetwork)...),
+	)
+	requireT.NoError(err)
+
+	coreumclitestutil.ExecQueryCmd(
+		t, ctx, cli.CmdQueryAccountDenomOrdersCount(), []string{validator1Address(testNetwork).String(), denom1}, &ordersRes,
+	)
+	requireT.Zero(ordersRes.Count)
+}
+
 func placeOrder(
 	ctx client.Context,
 	requireT *require.Assertions,
@@ -266,6 +333,8 @@ func placeOrder(
 		order.Side.String(),
 		"--" + cli.PriceFlag, order.Price.String(),
 		"--" + cli.TimeInForce, order.TimeInForce.String(),
+		// the matched orders save the trades and candles, so the default gas isn't enough
+		fmt.Sprintf("--%s=%d", flags.FlagGas, 2000000),
 	}
 	if order.GoodTil != nil {
 		if order.GoodTil.GoodTilBlockHeight > 0 {
@@ -25,6 +25,9 @@ func InitGenesis(
 		panic(err)
 	}
 
+	// the call creates the fee collector module account if it doesn't exist
+	accountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+
 	maxOrderID := uint32(0)
 	for _, orderBook := range genState.OrderBooks {
 		if err := dexKeeper.SaveOrderBookIDWithData(ctx, orderBook.ID, orderBook.Data); err != nil {
@@ -40,6 +43,11 @@ func InitGenesis(
 		}
 	}
 
+	batchAuctionOrderSequences := make(map[uint64]struct{}, len(genState.BatchAuctionOrderSequences))
+	for _, orderSequence := range genState.BatchAuctionOrderSequences {
+		batchAuctionOrderSequences[orderSequence] = struct{}{}
+	}
+
 	accAddressToNumberCache := make(map[string]uint64)
 	for _, order := range genState.Orders {
 		// check that the order book exists
@@ -70,6 +78,20 @@ func InitGenesis(
 			accAddressToNumberCache[order.Creator] = accNumber
 		}
 
+		if order.Trigger != nil {
+			if err := dexKeeper.SaveTriggerOrder(ctx, accNumber, orderBookID, order); err != nil {
+				panic(errors.Wrap(err, "failed to set trigger order"))
+			}
+			continue
+		}
+
+		if _, ok := batchAuctionOrderSequences[order.Sequence]; ok {
+			if err := dexKeeper.SaveBatchAuctionOrder(ctx, accNumber, orderBookID, order); err != nil {
+				panic(errors.Wrap(err, "failed to set batch auction order"))
+			}
+			continue
+		}
+
 		record := types.OrderBookRecord{
 			OrderBookID:               orderBookID,
 			Side:                      order.Side,
@@ -80,6 +102,7 @@ func InitGenesis(
 			RemainingBaseQuantity:     order.RemainingBaseQuantity,
 			RemainingSpendableBalance: order.RemainingSpendableBalance,
 		}
+		record.SplitVisibleQuantity(order.VisibleQuantity)
 		if err := dexKeeper.SaveOrderWithOrderBookRecord(ctx, order, record); err != nil {
 			panic(errors.Wrap(err, "failed to set order with order book record"))
 		}
@@ -97,6 +120,62 @@ func InitGenesis(
 			panic(errors.Wrap(err, "failed to set accounts denoms orders counts"))
 		}
 	}
+
+	for _, lastPrice := range genState.OrderBookLastPrices {
+		if err := dexKeeper.ImportOrderBookLastPrice(ctx, lastPrice.OrderBookID, lastPrice.Price); err != nil {
+			panic(errors.Wrap(err, "failed to import order book last price"))
+		}
+	}
+
+	for _, trade := range genState.Trades {
+		if err := dexKeeper.ImportTrade(ctx, trade); err != nil {
+			panic(errors.Wrap(err, "failed to import trade"))
+		}
+	}
+
+	for _, candle := range genState.Candles {
+		if err := dexKeeper.ImportCandle(ctx, candle); err != nil {
+			panic(errors.Wrap(err, "failed to import candle"))
+		}
+	}
+
+	for _, orderBookFeeRates := range genState.OrderBooksFeeRates {
+		if err := dexKeeper.SetOrderBookFeeRates(
+			ctx, orderBookFeeRates.OrderBookID, orderBookFeeRates.FeeRates,
+		); err != nil {
+			panic(errors.Wrap(err, "failed to set order book fee rates"))
+		}
+	}
+
+	for _, fee := range genState.AccumulatedFees {
+		if err := dexKeeper.SetAccumulatedFee(ctx, fee); err != nil {
+			panic(errors.Wrap(err, "failed to set accumulated fee"))
+		}
+	}
+
+	for _, cancelAllAfter := range genState.CancelAllAfters {
+		if err := dexKeeper.ImportCancelAllAfter(ctx, cancelAllAfter); err != nil {
+			panic(errors.Wrap(err, "failed to import cancel all after"))
+		}
+	}
+
+	for _, orderBookHalt := range genState.OrderBookHalts {
+		if err := dexKeeper.ImportOrderBookHalt(ctx, orderBookHalt.OrderBookID, orderBookHalt.Halt); err != nil {
+			panic(errors.Wrap(err, "failed to import order book halt"))
+		}
+	}
+
+	for _, orderBookID := range genState.ClosedOrderBookIDs {
+		if err := dexKeeper.ImportClosedOrderBook(ctx, orderBookID); err != nil {
+			panic(errors.Wrap(err, "failed to import closed order book"))
+		}
+	}
+
+	for _, orderBookID := range genState.BatchAuctionOrderBookIDs {
+		if err := dexKeeper.ImportBatchAuctionOrderBook(ctx, orderBookID); err != nil {
+			panic(errors.Wrap(err, "failed to import batch auction order book"))
+		}
+	}
 }
 
 // ExportGenesis returns the dex module's exported genesis.
@@ -133,6 +212,56 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(errors.Wrap(err, "failed to fetch all reserved order IDs"))
 	}
 
+	orderBookLastPrices, _, err := k.GetOrderBookLastPrices(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get order book last prices"))
+	}
+
+	trades, _, err := k.GetOrderBooksTrades(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get trades"))
+	}
+
+	candles, _, err := k.GetOrderBooksCandles(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get candles"))
+	}
+
+	orderBooksFeeRates, _, err := k.GetOrderBooksFeeRates(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get order books fee rates"))
+	}
+
+	accumulatedFees, _, err := k.GetAccumulatedFees(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get accumulated fees"))
+	}
+
+	cancelAllAfters, _, err := k.GetCancelAllAfters(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get cancel all afters"))
+	}
+
+	orderBookHalts, _, err := k.GetOrderBookHalts(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get order book halts"))
+	}
+
+	closedOrderBookIDs, err := k.GetClosedOrderBookIDs(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get closed order book IDs"))
+	}
+
+	batchAuctionOrderBookIDs, err := k.GetBatchAuctionOrderBookIDs(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get batch auction order book IDs"))
+	}
+
+	batchAuctionOrderSequences, err := k.GetBatchAuctionOrderSequences(ctx)
+	if err != nil {
+		panic(errors.Wrap(err, "failed to get batch auction order sequences"))
+	}
+
 	return &types.GenesisState{
 		Params:                     params,
 		Orders:                     orders,
@@ -140,5 +269,15 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		OrderSequence:              orderSequence,
 		AccountsDenomsOrdersCounts: accountsDenomsOrdersCounts,
 		ReservedOrderIds:           reservedOrderIDs,
+		OrderBookLastPrices:        orderBookLastPrices,
+		Trades:                     trades,
+		Candles:                    candles,
+		OrderBooksFeeRates:         orderBooksFeeRates,
+		AccumulatedFees:            accumulatedFees,
+		CancelAllAfters:            cancelAllAfters,
+		OrderBookHalts:             orderBookHalts,
+		ClosedOrderBookIDs:         closedOrderBookIDs,
+		BatchAuctionOrderBookIDs:   batchAuctionOrderBookIDs,
+		BatchAuctionOrderSequences: batchAuctionOrderSequences,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -171,6 +172,76 @@ func TestInitAndExportGenesis(t *testing.T) {
 	// the order sequence is last order sequence
 	genState.OrderSequence = 3
 
+	genState.OrderBookLastPrices = []types.OrderBookLastPriceWithID{
+		{
+			OrderBookID: 1,
+			Price:       types.MustNewPriceFromString("12e-1"),
+		},
+	}
+	genState.Trades = []types.Trade{
+		{
+			Sequence:      7,
+			BaseDenom:     denoms[0],
+			QuoteDenom:    denoms[1],
+			Price:         types.MustNewPriceFromString("12e-1"),
+			BaseQuantity:  sdkmath.NewInt(100),
+			QuoteQuantity: sdkmath.NewInt(120),
+			TakerSide:     types.SIDE_BUY,
+			Height:        10,
+			Time:          time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+		},
+	}
+	genState.Candles = []types.Candle{
+		{
+			BaseDenom:   denoms[0],
+			QuoteDenom:  denoms[1],
+			Interval:    types.CANDLE_INTERVAL_1M,
+			StartTime:   time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC),
+			Open:        types.MustNewPriceFromString("12e-1"),
+			High:        types.MustNewPriceFromString("12e-1"),
+			Low:         types.MustNewPriceFromString("12e-1"),
+			Close:       types.MustNewPriceFromString("12e-1"),
+			BaseVolume:  sdkmath.NewInt(100),
+			QuoteVolume: sdkmath.NewInt(120),
+			TradesCount: 1,
+		},
+	}
+	genState.OrderBooksFeeRates = []types.OrderBookFeeRatesWithID{
+		{
+			OrderBookID: 2,
+			FeeRates: types.OrderBookFeeRates{
+				MakerFeeRate: sdkmath.LegacyMustNewDecFromStr("0.001"),
+				TakerFeeRate: sdkmath.LegacyMustNewDecFromStr("0.002"),
+			},
+		},
+	}
+	genState.AccumulatedFees = sdk.NewCoins(
+		sdk.NewInt64Coin(denoms[0], 10),
+		sdk.NewInt64Coin(denoms[1], 20),
+	)
+	genState.CancelAllAfters = []types.CancelAllAfter{
+		{
+			Account:  acc1.String(),
+			Deadline: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			Account:  acc2.String(),
+			Deadline: time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC),
+			Denoms:   []string{denoms[0], denoms[1]},
+		},
+	}
+	genState.OrderBookHalts = []types.OrderBookHaltWithID{
+		{
+			OrderBookID: 0,
+			Halt: types.OrderBookHalt{
+				HaltedBy: acc1.String(),
+			},
+		},
+	}
+	genState.ClosedOrderBookIDs = []uint32{0}
+	genState.BatchAuctionOrderBookIDs = []uint32{0}
+	genState.BatchAuctionOrderSequences = []uint64{1}
+
 	// init the keeper
 	dex.InitGenesis(sdkCtx, dexKeeper, testApp.AccountKeeper, genState)
 
@@ -186,6 +257,16 @@ func TestInitAndExportGenesis(t *testing.T) {
 	requireT.Equal(genState.Params, exportedGenState.Params)
 	requireT.Equal(genState.OrderBooks, exportedGenState.OrderBooks)
 	requireT.Equal(genState.Orders, exportedGenState.Orders)
+	requireT.Equal(genState.OrderBookLastPrices, exportedGenState.OrderBookLastPrices)
+	requireT.Equal(genState.Trades, exportedGenState.Trades)
+	requireT.Equal(genState.Candles, exportedGenState.Candles)
+	requireT.Equal(genState.OrderBooksFeeRates, exportedGenState.OrderBooksFeeRates)
+	requireT.Equal(genState.AccumulatedFees.String(), exportedGenState.AccumulatedFees.String())
+	requireT.Equal(genState.CancelAllAfters, exportedGenState.CancelAllAfters)
+	requireT.Equal(genState.OrderBookHalts, exportedGenState.OrderBookHalts)
+	requireT.Equal(genState.ClosedOrderBookIDs, exportedGenState.ClosedOrderBookIDs)
+	requireT.Equal(genState.BatchAuctionOrderBookIDs, exportedGenState.BatchAuctionOrderBookIDs)
+	requireT.Equal(genState.BatchAuctionOrderSequences, exportedGenState.BatchAuctionOrderSequences)
 
 	// check that imported state is valid
 
@@ -29,3 +29,58 @@ func NewDelayCancelOrderHandler(keeper CancelOrderKeeper) func(ctx sdk.Context,
 		return keeper.CancelOrderBySequence(ctx, sender, msg.OrderSequence)
 	}
 }
+
+// CancelAllKeeper is keeper interface required for CancelAll.
+type CancelAllKeeper interface {
+	CancelAll(ctx sdk.Context, acc sdk.AccAddress) error
+}
+
+// NewDelayCancelAllHandler handles the scheduled cancellation of the account orders.
+func NewDelayCancelAllHandler(keeper CancelAllKeeper) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.CancelAll)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+		sender, err := sdk.AccAddressFromBech32(msg.Creator)
+		if err != nil {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+		}
+
+		return keeper.CancelAll(ctx, sender)
+	}
+}
+
+// ResumeOrderBookKeeper is keeper interface required for ResumeOrderBook.
+type ResumeOrderBookKeeper interface {
+	ResumeHaltedOrderBook(ctx sdk.Context, orderBookID uint32) error
+}
+
+// NewDelayResumeOrderBookHandler handles the delayed resume of the halted order book.
+func NewDelayResumeOrderBookHandler(keeper ResumeOrderBookKeeper) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.ResumeOrderBook)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+
+		return keeper.ResumeHaltedOrderBook(ctx, msg.OrderBookID)
+	}
+}
+
+// CloseOrderBookKeeper is keeper interface required for CloseOrderBook.
+type CloseOrderBookKeeper interface {
+	CancelClosedOrderBookOrders(ctx sdk.Context, orderBookID uint32) error
+}
+
+// NewDelayCloseOrderBookHandler handles the batched cancellation of the closed order book orders.
+func NewDelayCloseOrderBookHandler(keeper CloseOrderBookKeeper) func(ctx sdk.Context, data proto.Message) error {
+	return func(ctx sdk.Context, data proto.Message) error {
+		msg, ok := data.(*types.CloseOrderBook)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "unrecognized %s message type: %T", types.ModuleName, data)
+		}
+
+		return keeper.CancelClosedOrderBookOrders(ctx, msg.OrderBookID)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -35,11 +36,38 @@ type QueryKeeper interface {
 		side types.Side,
 		pagination *query.PageRequest,
 	) ([]types.Order, *query.PageResponse, error)
+	GetOrderBookDepth(
+		ctx sdk.Context,
+		baseDenom, quoteDenom string,
+		levels uint32,
+		includeInverted bool,
+	) ([]types.PriceLevel, []types.PriceLevel, error)
 	GetAccountDenomOrdersCount(
 		ctx sdk.Context,
 		acc sdk.AccAddress,
 		denom string,
 	) (uint64, error)
+	SimulateOrder(ctx sdk.Context, order types.Order) (*types.QuerySimulateOrderResponse, error)
+	GetAccumulatedFees(
+		ctx sdk.Context,
+		pagination *query.PageRequest,
+	) (sdk.Coins, *query.PageResponse, error)
+	GetCancelAllAfter(ctx sdk.Context, acc sdk.AccAddress) (types.CancelAllAfter, error)
+	GetTrades(
+		ctx sdk.Context,
+		baseDenom, quoteDenom string,
+		pagination *query.PageRequest,
+	) ([]types.Trade, *query.PageResponse, error)
+	GetCandles(
+		ctx sdk.Context,
+		baseDenom, quoteDenom string,
+		interval types.CandleInterval,
+		pagination *query.PageRequest,
+	) ([]types.Candle, *query.PageResponse, error)
+	GetOrderBookHalt(ctx sdk.Context, baseDenom, quoteDenom string) (types.OrderBookHalt, bool, error)
+	SimulateSwap(
+		ctx sdk.Context, sender sdk.AccAddress, route []string, amountIn, amountOut sdkmath.Int,
+	) (*types.QuerySimulateSwapResponse, error)
 }
 
 // QueryService serves grpc query requests for the module.
@@ -126,6 +154,24 @@ func (qs QueryService) OrderBookOrders(
 	}, nil
 }
 
+// OrderBookDepth queries order book orders aggregated by price levels.
+func (qs QueryService) OrderBookDepth(
+	ctx context.Context,
+	req *types.QueryOrderBookDepthRequest,
+) (*types.QueryOrderBookDepthResponse, error) {
+	bids, asks, err := qs.keeper.GetOrderBookDepth(
+		sdk.UnwrapSDKContext(ctx), req.BaseDenom, req.QuoteDenom, req.Levels, req.IncludeInverted,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOrderBookDepthResponse{
+		Bids: bids,
+		Asks: asks,
+	}, nil
+}
+
 // Order queries order by creator and ID.
 func (qs QueryService) Order(ctx context.Context, req *types.QueryOrderRequest) (*types.QueryOrderResponse, error) {
 	creatorAddr, err := sdk.AccAddressFromBech32(req.Creator)
@@ -160,3 +206,153 @@ func (qs QueryService) AccountDenomOrdersCount(
 		Count: count,
 	}, nil
 }
+
+// SimulateOrder simulates the order placement and returns the order execution result without changing the state.
+func (qs QueryService) SimulateOrder(
+	ctx context.Context,
+	req *types.QuerySimulateOrderRequest,
+) (*types.QuerySimulateOrderResponse, error) {
+	var price *types.Price
+	if req.Price != "" {
+		p, err := types.NewPriceFromString(req.Price)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid price %s: %s", req.Price, err)
+		}
+		price = &p
+	}
+	quantity, ok := sdkmath.NewIntFromString(req.Quantity)
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid quantity: %s", req.Quantity)
+	}
+
+	return qs.keeper.SimulateOrder(sdk.UnwrapSDKContext(ctx), types.Order{
+		Creator:     req.Creator,
+		Type:        req.Type,
+		ID:          req.Id,
+		BaseDenom:   req.BaseDenom,
+		QuoteDenom:  req.QuoteDenom,
+		Price:       price,
+		Quantity:    quantity,
+		Side:        req.Side,
+		TimeInForce: req.TimeInForce,
+	})
+}
+
+// AccumulatedFees queries the total amount of the fees charged by the DEX per denom.
+func (qs QueryService) AccumulatedFees(
+	ctx context.Context,
+	req *types.QueryAccumulatedFeesRequest,
+) (*types.QueryAccumulatedFeesResponse, error) {
+	fees, pageRes, err := qs.keeper.GetAccumulatedFees(sdk.UnwrapSDKContext(ctx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccumulatedFeesResponse{
+		Fees:       fees,
+		Pagination: pageRes,
+	}, nil
+}
+
+// CancelAllAfter queries the scheduled cancellation of the account orders.
+func (qs QueryService) CancelAllAfter(
+	ctx context.Context,
+	req *types.QueryCancelAllAfterRequest,
+) (*types.QueryCancelAllAfterResponse, error) {
+	acc, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", req.Account)
+	}
+	cancelAllAfter, err := qs.keeper.GetCancelAllAfter(sdk.UnwrapSDKContext(ctx), acc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCancelAllAfterResponse{
+		CancelAllAfter: cancelAllAfter,
+	}, nil
+}
+
+// Trades queries recent order book trades, the most recent first.
+func (qs QueryService) Trades(
+	ctx context.Context,
+	req *types.QueryTradesRequest,
+) (*types.QueryTradesResponse, error) {
+	trades, pageRes, err := qs.keeper.GetTrades(
+		sdk.UnwrapSDKContext(ctx), req.BaseDenom, req.QuoteDenom, req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTradesResponse{
+		Trades:     trades,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Candles queries order book OHLCV candles.
+func (qs QueryService) Candles(
+	ctx context.Context,
+	req *types.QueryCandlesRequest,
+) (*types.QueryCandlesResponse, error) {
+	candles, pageRes, err := qs.keeper.GetCandles(
+		sdk.UnwrapSDKContext(ctx), req.BaseDenom, req.QuoteDenom, req.Interval, req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCandlesResponse{
+		Candles:    candles,
+		Pagination: pageRes,
+	}, nil
+}
+
+// OrderBookHalt queries the trading halt of the order book.
+func (qs QueryService) OrderBookHalt(
+	ctx context.Context,
+	req *types.QueryOrderBookHaltRequest,
+) (*types.QueryOrderBookHaltResponse, error) {
+	halt, halted, err := qs.keeper.GetOrderBookHalt(sdk.UnwrapSDKContext(ctx), req.BaseDenom, req.QuoteDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOrderBookHaltResponse{
+		Halted: halted,
+		Halt:   halt,
+	}, nil
+}
+
+// SimulateSwap simulates the swap through the route order books and returns the swap execution result without
+// changing the state.
+func (qs QueryService) SimulateSwap(
+	ctx context.Context,
+	req *types.QuerySimulateSwapRequest,
+) (*types.QuerySimulateSwapResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", req.Sender)
+	}
+	if (req.AmountIn == "") == (req.AmountOut == "") {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "exactly one of amount in and amount out must be set")
+	}
+
+	var amountIn, amountOut sdkmath.Int
+	if req.AmountIn != "" {
+		var ok bool
+		amountIn, ok = sdkmath.NewIntFromString(req.AmountIn)
+		if !ok || !amountIn.IsPositive() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid amount in: %s", req.AmountIn)
+		}
+	} else {
+		var ok bool
+		amountOut, ok = sdkmath.NewIntFromString(req.AmountOut)
+		if !ok || !amountOut.IsPositive() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid amount out: %s", req.AmountOut)
+		}
+	}
+
+	return qs.keeper.SimulateSwap(sdk.UnwrapSDKContext(ctx), sender, req.Route, amountIn, amountOut)
+}
@@ -0,0 +1,346 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/samber/lo"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+const (
+	// LockedBalancesInvariantName is locked balances invariant name.
+	LockedBalancesInvariantName = "locked-balances"
+	// ExpectedToReceiveBalancesInvariantName is expected to receive balances invariant name.
+	ExpectedToReceiveBalancesInvariantName = "expected-to-receive-balances"
+	// OrderReserveInvariantName is order reserve invariant name.
+	OrderReserveInvariantName = "order-reserve"
+	// AccountDenomOrdersCountInvariantName is account denom orders count invariant name.
+	AccountDenomOrdersCountInvariantName = "account-denom-orders-count"
+)
+
+// RegisterInvariants registers the dex module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, LockedBalancesInvariantName, LockedBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, ExpectedToReceiveBalancesInvariantName, ExpectedToReceiveBalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, OrderReserveInvariantName, OrderReserveInvariant(k))
+	ir.RegisterRoute(types.ModuleName, AccountDenomOrdersCountInvariantName, AccountDenomOrdersCountInvariant(k))
+}
+
+// LockedBalancesInvariant checks that the DEX locked balances of the accounts are equal to the sum of the remaining
+// spendable balances and reserves of their orders.
+func LockedBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		count, msg := checkLockedBalances(ctx, k)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, LockedBalancesInvariantName,
+			fmt.Sprintf("amount of invalid locked balances found: %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// ExpectedToReceiveBalancesInvariant checks that the DEX expected to receive balances of the accounts are equal to
+// the sum of the amounts expected to be received by their orders.
+func ExpectedToReceiveBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		count, msg := checkExpectedToReceiveBalances(ctx, k)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, ExpectedToReceiveBalancesInvariantName,
+			fmt.Sprintf("amount of invalid expected to receive balances found: %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// OrderReserveInvariant checks that the reserves of the orders, including the trigger orders, are backed by the bank
+// balances of the order creators. The reserve stays in the creator account, so the bank balance of the reserve denom
+// must cover the reserves together with the remaining spendable balances of the orders in that denom.
+func OrderReserveInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		count, msg := checkOrderReserves(ctx, k)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, OrderReserveInvariantName,
+			fmt.Sprintf("amount of invalid order reserves found: %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// AccountDenomOrdersCountInvariant checks that the stored account denom orders counts are equal to the number of
+// the account orders with the denom.
+func AccountDenomOrdersCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		count, msg := checkAccountDenomOrdersCounts(ctx, k)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, AccountDenomOrdersCountInvariantName,
+			fmt.Sprintf("amount of invalid account denom orders counts found: %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// accountsBalances is the map of the account address to the denom amounts.
+type accountsBalances map[string]map[string]sdkmath.Int
+
+func (b accountsBalances) add(addr, denom string, amount sdkmath.Int) {
+	if amount.IsNil() || amount.IsZero() {
+		return
+	}
+	denomAmounts, ok := b[addr]
+	if !ok {
+		denomAmounts = make(map[string]sdkmath.Int)
+		b[addr] = denomAmounts
+	}
+	if prevAmount, ok := denomAmounts[denom]; ok {
+		amount = prevAmount.Add(amount)
+	}
+	denomAmounts[denom] = amount
+}
+
+func (b accountsBalances) get(addr, denom string) sdkmath.Int {
+	amount, ok := b[addr][denom]
+	if !ok {
+		return sdkmath.ZeroInt()
+	}
+
+	return amount
+}
+
+func newAccountsBalances(balances []assetfttypes.Balance) accountsBalances {
+	res := make(accountsBalances)
+	for _, balance := range balances {
+		for _, coin := range balance.Coins {
+			res.add(balance.Address, coin.Denom, coin.Amount)
+		}
+	}
+
+	return res
+}
+
+func checkLockedBalances(ctx sdk.Context, k Keeper) (int, string) {
+	orders, _, err := k.GetAccountsOrders(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		return 1, fmt.Sprintf("\tcan't get orders: %s\n", err)
+	}
+	lockedBalances, _, err := k.assetFTKeeper.GetAccountsDEXLockedBalances(
+		ctx, &query.PageRequest{Limit: query.PaginationMaxLimit},
+	)
+	if err != nil {
+		return 1, fmt.Sprintf("\tcan't get locked balances: %s\n", err)
+	}
+
+	expectedBalances := make(accountsBalances)
+	for _, order := range orders {
+		expectedBalances.add(order.Creator, order.GetSpendDenom(), order.RemainingSpendableBalance)
+		if !order.Reserve.IsNil() {
+			expectedBalances.add(order.Creator, order.Reserve.Denom, order.Reserve.Amount)
+		}
+	}
+
+	return compareAccountsBalances(expectedBalances, newAccountsBalances(lockedBalances), "locked")
+}
+
+func checkExpectedToReceiveBalances(ctx sdk.Context, k Keeper) (int, string) {
+	orders, _, err := k.GetAccountsOrders(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		return 1, fmt.Sprintf("\tcan't get orders: %s\n", err)
+	}
+	expectedToReceiveBalances, _, err := k.assetFTKeeper.GetAccountsDEXExpectedToReceiveBalances(
+		ctx, &query.PageRequest{Limit: query.PaginationMaxLimit},
+	)
+	if err != nil {
+		return 1, fmt.Sprintf("\tcan't get expected to receive balances: %s\n", err)
+	}
+
+	var (
+		count int
+		msg   string
+	)
+	recordedDenoms := make(map[string]bool)
+	expectedBalances := make(accountsBalances)
+	for _, order := range orders {
+		var expectedToReceiveCoin sdk.Coin
+		if order.Trigger != nil {
+			_, expectedToReceiveCoin, err = computeTriggerOrderLimits(order)
+		} else {
+			expectedToReceiveCoin, err = types.ComputeLimitOrderExpectedToReceiveBalance(
+				order.Side, order.BaseDenom, order.QuoteDenom, order.RemainingBaseQuantity, *order.Price,
+			)
+		}
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("\tcan't compute expected to receive balance of the order %d: %s\n", order.Sequence, err)
+			continue
+		}
+
+		shouldRecord, ok := recordedDenoms[expectedToReceiveCoin.Denom]
+		if !ok {
+			shouldRecord, err = k.assetFTKeeper.ShouldRecordDEXExpectedToReceiveBalance(ctx, expectedToReceiveCoin.Denom)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tcan't check the denom %s: %s\n", expectedToReceiveCoin.Denom, err)
+				continue
+			}
+			recordedDenoms[expectedToReceiveCoin.Denom] = shouldRecord
+		}
+		if shouldRecord {
+			expectedBalances.add(order.Creator, expectedToReceiveCoin.Denom, expectedToReceiveCoin.Amount)
+		}
+	}
+
+	compareCount, compareMsg := compareAccountsBalances(
+		expectedBalances, newAccountsBalances(expectedToReceiveBalances), "expected to receive",
+	)
+
+	return count + compareCount, msg + compareMsg
+}
+
+func checkOrderReserves(ctx sdk.Context, k Keeper) (int, string) {
+	orders, _, err := k.GetAccountsOrders(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		return 1, fmt.Sprintf("\tcan't get orders: %s\n", err)
+	}
+
+	// the trigger orders are returned together with the order book orders, so their reserves are included
+	reserves := make(accountsBalances)
+	spendableBalances := make(accountsBalances)
+	for _, order := range orders {
+		spendableBalances.add(order.Creator, order.GetSpendDenom(), order.RemainingSpendableBalance)
+		if !order.Reserve.IsNil() {
+			reserves.add(order.Creator, order.Reserve.Denom, order.Reserve.Amount)
+		}
+	}
+
+	var (
+		count int
+		msg   string
+	)
+	for _, addr := range sortedKeys(reserves) {
+		creator, err := sdk.AccAddressFromBech32(addr)
+		if err != nil {
+			count++
+			msg += fmt.Sprintf("\tinvalid order creator %s: %s\n", addr, err)
+			continue
+		}
+		for _, denom := range sortedKeys(reserves[addr]) {
+			requiredAmount := reserves.get(addr, denom).Add(spendableBalances.get(addr, denom))
+			balance := k.bankKeeper.GetBalance(ctx, creator, denom)
+			if balance.Amount.LT(requiredAmount) {
+				count++
+				msg += fmt.Sprintf(
+					"\taddress %s has balance %s, but the order reserves and spendable balances require %s%s\n",
+					addr, balance, requiredAmount, denom,
+				)
+			}
+		}
+	}
+
+	return count, msg
+}
+
+func checkAccountDenomOrdersCounts(ctx sdk.Context, k Keeper) (int, string) {
+	orders, _, err := k.GetAccountsOrders(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		return 1, fmt.Sprintf("\tcan't get orders: %s\n", err)
+	}
+	storedCounts, _, err := k.GetAccountsDenomsOrdersCounts(ctx, &query.PageRequest{Limit: query.PaginationMaxLimit})
+	if err != nil {
+		return 1, fmt.Sprintf("\tcan't get account denom orders counts: %s\n", err)
+	}
+
+	var (
+		count int
+		msg   string
+	)
+	accNumbers := make(map[string]uint64)
+	expectedCounts := make(map[uint64]map[string]uint64)
+	for _, order := range orders {
+		accNumber, ok := accNumbers[order.Creator]
+		if !ok {
+			creator, err := sdk.AccAddressFromBech32(order.Creator)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tinvalid creator of the order %d: %s\n", order.Sequence, err)
+				continue
+			}
+			accNumber, err = k.getAccountNumber(ctx, creator)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\tcan't get account number of the order %d: %s\n", order.Sequence, err)
+				continue
+			}
+			accNumbers[order.Creator] = accNumber
+		}
+		if _, ok := expectedCounts[accNumber]; !ok {
+			expectedCounts[accNumber] = make(map[string]uint64)
+		}
+		for _, denom := range order.Denoms() {
+			expectedCounts[accNumber][denom]++
+		}
+	}
+
+	for _, storedCount := range storedCounts {
+		expectedCount := expectedCounts[storedCount.AccountNumber][storedCount.Denom]
+		if storedCount.OrdersCount != expectedCount {
+			count++
+			msg += fmt.Sprintf(
+				"\taccount %d has %d orders with the denom %s, but the stored count is %d\n",
+				storedCount.AccountNumber, expectedCount, storedCount.Denom, storedCount.OrdersCount,
+			)
+		}
+		delete(expectedCounts[storedCount.AccountNumber], storedCount.Denom)
+	}
+	// the remaining counts aren't stored
+	for _, accNumber := range sortedKeys(expectedCounts) {
+		for _, denom := range sortedKeys(expectedCounts[accNumber]) {
+			count++
+			msg += fmt.Sprintf(
+				"\taccount %d has %d orders with the denom %s, but the count isn't stored\n",
+				accNumber, expectedCounts[accNumber][denom], denom,
+			)
+		}
+	}
+
+	return count, msg
+}
+
+func compareAccountsBalances(expected, actual accountsBalances, balanceName string) (int, string) {
+	var (
+		count int
+		msg   string
+	)
+
+	addrs := lo.Uniq(append(sortedKeys(expected), sortedKeys(actual)...))
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		denoms := lo.Uniq(append(sortedKeys(expected[addr]), sortedKeys(actual[addr])...))
+		sort.Strings(denoms)
+		for _, denom := range denoms {
+			expectedAmount, actualAmount := expected.get(addr, denom), actual.get(addr, denom)
+			if !expectedAmount.Equal(actualAmount) {
+				count++
+				msg += fmt.Sprintf(
+					"\taddress %s has %s balance %s%s, but the orders require %s%s\n",
+					addr, balanceName, actualAmount, denom, expectedAmount, denom,
+				)
+			}
+		}
+	}
+
+	return count, msg
+}
+
+func sortedKeys[K string | uint64, V any](m map[K]V) []K {
+	keys := lo.Keys(m)
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	return keys
+}
@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/keeper"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestInvariants(t *testing.T) {
+	tests := []struct {
+		name       string
+		breakState func(sdkCtx sdk.Context, testApp *simapp.App, testSet TestSet)
+		wantBroken []string
+	}{
+		{
+			name:       "valid",
+			breakState: func(sdk.Context, *simapp.App, TestSet) {},
+		},
+		{
+			name: "locked_balance",
+			breakState: func(sdkCtx sdk.Context, testApp *simapp.App, testSet TestSet) {
+				testApp.AssetFTKeeper.SetDEXLockedBalances(
+					sdkCtx, testSet.acc1, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 1)),
+				)
+			},
+			wantBroken: []string{keeper.LockedBalancesInvariantName},
+		},
+		{
+			name: "expected_to_receive_balance",
+			breakState: func(sdkCtx sdk.Context, testApp *simapp.App, testSet TestSet) {
+				testApp.AssetFTKeeper.SetDEXExpectedToReceiveBalances(
+					sdkCtx, testSet.acc1, sdk.NewCoins(sdk.NewInt64Coin(testSet.ftDenomWhitelisting1, 1)),
+				)
+			},
+			wantBroken: []string{keeper.ExpectedToReceiveBalancesInvariantName},
+		},
+		{
+			name: "order_reserve",
+			breakState: func(sdkCtx sdk.Context, testApp *simapp.App, testSet TestSet) {
+				// the bank keeper without the asset ft checks moves the reserve out of the creator account
+				recipient, _ := testApp.GenAccount(sdkCtx)
+				require.NoError(t, testApp.BankKeeper.BaseKeeper.SendCoins(
+					sdkCtx, testSet.acc1, recipient, sdk.NewCoins(testSet.orderReserve),
+				))
+			},
+			wantBroken: []string{keeper.OrderReserveInvariantName},
+		},
+		{
+			name: "account_denom_orders_count",
+			breakState: func(sdkCtx sdk.Context, testApp *simapp.App, testSet TestSet) {
+				require.NoError(t, testApp.DEXKeeper.SetAccountDenomOrdersCount(sdkCtx, types.AccountDenomOrdersCount{
+					AccountNumber: testApp.AccountKeeper.GetAccount(sdkCtx, testSet.acc1).GetAccountNumber(),
+					Denom:         testSet.denom1,
+					OrdersCount:   3,
+				}))
+			},
+			wantBroken: []string{keeper.AccountDenomOrdersCountInvariantName},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testApp := simapp.New()
+			sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+			testSet := genTestSet(t, sdkCtx, testApp)
+
+			acc1, acc2 := testSet.acc1, testSet.acc2
+			require.NoError(t, testApp.AssetFTKeeper.SetWhitelistedBalance(
+				sdkCtx, testSet.issuer, acc1, sdk.NewInt64Coin(testSet.ftDenomWhitelisting1, 100_000),
+			))
+			require.NoError(t, testApp.AssetFTKeeper.SetWhitelistedBalance(
+				sdkCtx, testSet.issuer, acc2, sdk.NewInt64Coin(testSet.ftDenomWhitelisting1, 100_000),
+			))
+			testApp.MintAndSendCoin(t, sdkCtx, acc1, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 110_000)))
+			testApp.MintAndSendCoin(t, sdkCtx, acc2, sdk.NewCoins(sdk.NewInt64Coin(testSet.ftDenomWhitelisting1, 100_000)))
+			fundOrderReserve(t, testApp, sdkCtx, acc1)
+			fundOrderReserve(t, testApp, sdkCtx, acc1)
+			fundOrderReserve(t, testApp, sdkCtx, acc2)
+
+			// the partially filled maker order remains in the order book
+			require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, types.Order{
+				Creator:     acc1.String(),
+				Type:        types.ORDER_TYPE_LIMIT,
+				ID:          "sell",
+				BaseDenom:   testSet.denom1,
+				QuoteDenom:  testSet.ftDenomWhitelisting1,
+				Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+				Quantity:    sdkmath.NewInt(100_000),
+				Side:        types.SIDE_SELL,
+				TimeInForce: types.TIME_IN_FORCE_GTC,
+			}))
+			require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, types.Order{
+				Creator:     acc2.String(),
+				Type:        types.ORDER_TYPE_LIMIT,
+				ID:          "buy",
+				BaseDenom:   testSet.denom1,
+				QuoteDenom:  testSet.ftDenomWhitelisting1,
+				Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+				Quantity:    sdkmath.NewInt(40_000),
+				Side:        types.SIDE_BUY,
+				TimeInForce: types.TIME_IN_FORCE_IOC,
+			}))
+
+			// the trigger order isn't in the order book, but its balances and reserve are locked
+			require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, types.Order{
+				Creator:     acc1.String(),
+				Type:        types.ORDER_TYPE_LIMIT,
+				ID:          "take-profit",
+				BaseDenom:   testSet.denom1,
+				QuoteDenom:  testSet.ftDenomWhitelisting1,
+				Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+				Quantity:    sdkmath.NewInt(10_000),
+				Side:        types.SIDE_SELL,
+				TimeInForce: types.TIME_IN_FORCE_GTC,
+				Trigger: &types.Trigger{
+					Type:  types.TRIGGER_TYPE_TAKE_PROFIT,
+					Price: types.MustNewPriceFromString("2"),
+				},
+			}))
+
+			tt.breakState(sdkCtx, testApp, testSet)
+
+			for name, invariant := range map[string]sdk.Invariant{
+				keeper.LockedBalancesInvariantName:            keeper.LockedBalancesInvariant(testApp.DEXKeeper),
+				keeper.ExpectedToReceiveBalancesInvariantName: keeper.ExpectedToReceiveBalancesInvariant(testApp.DEXKeeper),
+				keeper.OrderReserveInvariantName:              keeper.OrderReserveInvariant(testApp.DEXKeeper),
+				keeper.AccountDenomOrdersCountInvariantName:   keeper.AccountDenomOrdersCountInvariant(testApp.DEXKeeper),
+			} {
+				msg, isBroken := invariant(sdkCtx)
+				require.Equal(t, lo.Contains(tt.wantBroken, name), isBroken, "%s: %s", name, msg)
+			}
+		})
+	}
+}
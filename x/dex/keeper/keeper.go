@@ -27,6 +27,7 @@ type Keeper struct {
 	storeService       sdkstore.KVStoreService
 	accountKeeper      types.AccountKeeper
 	accountQueryServer types.AccountQueryServer
+	bankKeeper         types.BankKeeper
 	assetFTKeeper      types.AssetFTKeeper
 	delayKeeper        types.DelayKeeper
 	authority          string
@@ -38,6 +39,7 @@ func NewKeeper(
 	storeService sdkstore.KVStoreService,
 	accountKeeper types.AccountKeeper,
 	accountQueryServer types.AccountQueryServer,
+	bankKeeper types.BankKeeper,
 	assetFTKeeper types.AssetFTKeeper,
 	delayKeeper types.DelayKeeper,
 	authority string,
@@ -47,6 +49,7 @@ func NewKeeper(
 		storeService:       storeService,
 		accountKeeper:      accountKeeper,
 		accountQueryServer: accountQueryServer,
+		bankKeeper:         bankKeeper,
 		assetFTKeeper:      assetFTKeeper,
 		authority:          authority,
 		delayKeeper:        delayKeeper,
@@ -55,46 +58,43 @@ func NewKeeper(
 
 // PlaceOrder places an order on the corresponding order book, and matches the order.
 func (k Keeper) PlaceOrder(ctx sdk.Context, order types.Order) error {
-	k.logger(ctx).Debug("Placing order.", "order", order)
-
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		return err
 	}
-	if err := k.validateOrder(ctx, params, order); err != nil {
-		return err
-	}
 
-	creator, err := sdk.AccAddressFromBech32(order.Creator)
-	if err != nil {
-		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
-	}
+	return k.placeOrder(ctx, params, newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer), order)
+}
 
-	accNumber, err := k.getAccountNumber(ctx, creator)
+// PlaceOrders places the orders one by one, and returns the placement result of each order. The order which can't be
+// placed doesn't affect the placement of other orders.
+func (k Keeper) PlaceOrders(ctx sdk.Context, orders []types.Order) ([]types.BatchOrderResult, error) {
+	params, err := k.GetParams(ctx)
 	if err != nil {
-		return err
-	}
-
-	if err := k.reserveOrderID(ctx, accNumber, order.ID); err != nil {
-		return err
+		return nil, err
 	}
+	cachedAccKeeper := newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer)
 
-	// validate duplicated order ID
-	_, err = k.getOrderSequenceByID(ctx, accNumber, order.ID)
-	if err != nil {
-		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
-			return err
+	results := make([]types.BatchOrderResult, 0, len(orders))
+	for _, order := range orders {
+		// the order is placed in the cache context to revert only its changes if the placement fails
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.placeOrder(cacheCtx, params, cachedAccKeeper, order); err != nil {
+			k.logger(ctx).Debug("Failed to place order from batch.", "order", order.String(), "err", err)
+			results = append(results, types.BatchOrderResult{
+				ID:    order.ID,
+				Error: err.Error(),
+			})
+			continue
 		}
-	} else {
-		return sdkerrors.Wrapf(types.ErrInvalidInput, "order with the id %q is already created", order.ID)
+		writeCache()
+		results = append(results, types.BatchOrderResult{
+			ID:      order.ID,
+			Success: true,
+		})
 	}
 
-	orderBookID, oppositeOrderBookID, err := k.getOrGenOrderBookIDs(ctx, order.BaseDenom, order.QuoteDenom)
-	if err != nil {
-		return err
-	}
-
-	return k.matchOrder(ctx, params, accNumber, orderBookID, oppositeOrderBookID, order)
+	return results, nil
 }
 
 // CancelOrder cancels order and unlock locked balance.
@@ -102,6 +102,30 @@ func (k Keeper) CancelOrder(ctx sdk.Context, acc sdk.AccAddress, orderID string)
 	return k.cancelOrder(ctx, acc, orderID)
 }
 
+// CancelOrders cancels the orders one by one, and returns the cancellation result of each order. The order which
+// can't be canceled doesn't affect the cancellation of other orders.
+func (k Keeper) CancelOrders(ctx sdk.Context, acc sdk.AccAddress, orderIDs []string) ([]types.BatchOrderResult, error) {
+	results := make([]types.BatchOrderResult, 0, len(orderIDs))
+	for _, orderID := range orderIDs {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.cancelOrder(cacheCtx, acc, orderID); err != nil {
+			k.logger(ctx).Debug("Failed to cancel order from batch.", "orderID", orderID, "err", err)
+			results = append(results, types.BatchOrderResult{
+				ID:    orderID,
+				Error: err.Error(),
+			})
+			continue
+		}
+		writeCache()
+		results = append(results, types.BatchOrderResult{
+			ID:      orderID,
+			Success: true,
+		})
+	}
+
+	return results, nil
+}
+
 // CancelOrderBySequence cancels order and unlock locked balance by order sequence.
 func (k Keeper) CancelOrderBySequence(ctx sdk.Context, acc sdk.AccAddress, orderSequence uint64) error {
 	return k.cancelOrderBySequence(ctx, acc, orderSequence)
@@ -145,17 +169,32 @@ func (k Keeper) GetOrderBookIDByDenoms(ctx sdk.Context, baseDenom, quoteDenom st
 	return k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
 }
 
-// GetOrderByAddressAndID returns order by holder address and it's ID.
+// GetOrderByAddressAndID returns order by holder address and it's ID, only the visible part of the iceberg order is
+// returned.
 func (k Keeper) GetOrderByAddressAndID(ctx sdk.Context, acc sdk.AccAddress, orderID string) (types.Order, error) {
-	order, _, err := k.getOrderWithRecordByAddressAndID(ctx, acc, orderID)
+	offBookOrder, found, err := k.findOffBookOrderByAddressAndID(ctx, acc, orderID)
 	if err != nil {
 		return types.Order{}, err
 	}
+	if found {
+		return offBookOrder, nil
+	}
+
+	order, record, err := k.getOrderWithRecordByAddressAndID(ctx, acc, orderID)
+	if err != nil {
+		return types.Order{}, err
+	}
+	if order.VisibleQuantity != nil {
+		// only the visible part of the iceberg order is shown
+		if err := maskIcebergOrder(&order, record.RemainingBaseQuantity); err != nil {
+			return types.Order{}, err
+		}
+	}
 
 	return order, nil
 }
 
-// GetOrders returns creator orders.
+// GetOrders returns creator orders, only the visible part of the iceberg orders is returned.
 func (k Keeper) GetOrders(
 	ctx sdk.Context,
 	creator sdk.AccAddress,
@@ -210,11 +249,29 @@ func (k Keeper) GetOrderBookParams(
 		return nil, err
 	}
 
+	feeRates := types.OrderBookFeeRates{
+		MakerFeeRate: params.MakerFeeRate,
+		TakerFeeRate: params.TakerFeeRate,
+	}
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return nil, err
+		}
+	} else {
+		feeRates, err = k.getOrderBookFeeRates(ctx, params, orderBookID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.QueryOrderBookParamsResponse{
 		PriceTick:                  priceTick,
 		QuantityStep:               quantityStepRes,
 		BaseDenomUnifiedRefAmount:  baseURA,
 		QuoteDenomUnifiedRefAmount: quoteURA,
+		MakerFeeRate:               feeRates.MakerFeeRate,
+		TakerFeeRate:               feeRates.TakerFeeRate,
 	}, nil
 }
 
@@ -293,6 +350,14 @@ func (k Keeper) GetAccountsOrders(
 			}
 
 			orderSequence := record.Value
+			offBookOrder, found, err := k.findOffBookOrder(ctx, orderSequence)
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				return &offBookOrder, nil
+			}
+
 			orderData, err := k.getOrderData(ctx, orderSequence)
 			if err != nil {
 				return nil, err
@@ -308,13 +373,7 @@ func (k Keeper) GetAccountsOrders(
 				orderBookIDToOrderBookData[orderBookID] = orderBookData
 			}
 
-			orderBookRecord, err := k.getOrderBookRecord(
-				ctx,
-				orderData.OrderBookID,
-				orderData.Side,
-				orderData.Price,
-				orderSequence,
-			)
+			orderBookRecord, err := k.getOrderBookRecord(ctx, orderSequence, orderData)
 			if err != nil {
 				return nil, err
 			}
@@ -330,10 +389,11 @@ func (k Keeper) GetAccountsOrders(
 				Quantity:                  orderData.Quantity,
 				Side:                      orderData.Side,
 				GoodTil:                   orderData.GoodTil,
-				TimeInForce:               types.TIME_IN_FORCE_GTC,
-				RemainingBaseQuantity:     orderBookRecord.RemainingBaseQuantity,
+				TimeInForce:               orderDataTimeInForce(orderData),
+				RemainingBaseQuantity:     orderBookRecord.GetTotalRemainingBaseQuantity(),
 				RemainingSpendableBalance: orderBookRecord.RemainingSpendableBalance,
 				Reserve:                   orderData.Reserve,
+				VisibleQuantity:           orderData.VisibleQuantity,
 			}, nil
 		},
 		// constructor
@@ -479,6 +539,85 @@ func (k Keeper) ImportReservedOrderIDs(
 	return nil
 }
 
+func (k Keeper) placeOrder(
+	ctx sdk.Context,
+	params types.Params,
+	cachedAccKeeper cachedAccountKeeper,
+	order types.Order,
+) error {
+	k.logger(ctx).Debug("Placing order.", "order", order)
+
+	if err := k.validateOrder(ctx, params, order); err != nil {
+		return err
+	}
+
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+	}
+
+	accNumber, err := k.getAccountNumber(ctx, creator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.reserveOrderID(ctx, accNumber, order.ID); err != nil {
+		return err
+	}
+
+	// validate duplicated order ID
+	_, err = k.getOrderSequenceByID(ctx, accNumber, order.ID)
+	if err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return err
+		}
+	} else {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "order with the id %q is already created", order.ID)
+	}
+
+	return k.placeValidOrder(ctx, params, cachedAccKeeper, accNumber, order, orderLimits{})
+}
+
+// placeValidOrder places the validated order with the reserved ID to the batch auction, the trigger orders or the
+// order book. The limits released by the replaced order are netted with the limits of the new order.
+func (k Keeper) placeValidOrder(
+	ctx sdk.Context,
+	params types.Params,
+	cachedAccKeeper cachedAccountKeeper,
+	accNumber uint64,
+	order types.Order,
+	releasedLimits orderLimits,
+) error {
+	orderBookID, oppositeOrderBookID, err := k.getOrGenOrderBookIDs(ctx, order.BaseDenom, order.QuoteDenom)
+	if err != nil {
+		return err
+	}
+
+	if err := k.validateOrderBookIsOpen(
+		ctx, orderBookID, oppositeOrderBookID, order.BaseDenom, order.QuoteDenom,
+	); err != nil {
+		return err
+	}
+
+	batchAuction, err := k.isOrderBookInBatchAuction(ctx, orderBookID, oppositeOrderBookID)
+	if err != nil {
+		return err
+	}
+	if batchAuction {
+		return k.queueBatchAuctionOrder(
+			ctx, params, accNumber, orderBookID, oppositeOrderBookID, order, releasedLimits,
+		)
+	}
+
+	if order.Trigger != nil {
+		return k.placeTriggerOrder(ctx, params, accNumber, orderBookID, order, releasedLimits)
+	}
+
+	return k.matchOrder(
+		ctx, params, cachedAccKeeper, accNumber, orderBookID, oppositeOrderBookID, order, releasedLimits,
+	)
+}
+
 func (k Keeper) validateOrder(ctx sdk.Context, params types.Params, order types.Order) error {
 	if err := order.Validate(); err != nil {
 		return err
@@ -501,6 +640,13 @@ func (k Keeper) validateOrder(ctx sdk.Context, params types.Params, order types.
 	if err := validateQuantityStep(order.Quantity.BigInt(), baseURA, params.QuantityStepExponent); err != nil {
 		return err
 	}
+	if order.VisibleQuantity != nil {
+		if err := validateQuantityStep(
+			order.VisibleQuantity.BigInt(), baseURA, params.QuantityStepExponent,
+		); err != nil {
+			return sdkerrors.Wrap(err, "invalid visible quantity")
+		}
+	}
 
 	// price
 	if order.Type == types.ORDER_TYPE_LIMIT {
@@ -509,6 +655,13 @@ func (k Keeper) validateOrder(ctx sdk.Context, params types.Params, order types.
 		}
 	}
 
+	// trigger price
+	if order.Trigger != nil {
+		if err := validatePriceTick(order.Trigger.Price.Rat(), baseURA, quoteURA, params.PriceTickExponent); err != nil {
+			return err
+		}
+	}
+
 	// good til
 	if order.GoodTil != nil {
 		if err := validateGoodTil(ctx, order); err != nil {
@@ -693,6 +846,10 @@ func (k Keeper) createOrder(
 		order.Reserve = params.OrderReserve
 	}
 
+	// only the visible part of the iceberg order is saved to the order book record quantity
+	remainingBaseQuantity := record.RemainingBaseQuantity
+	record.SplitVisibleQuantity(order.VisibleQuantity)
+
 	// the remaining quantity and balance will be taker from record
 	if err := k.saveOrderWithOrderBookRecord(ctx, order, record); err != nil {
 		return err
@@ -702,7 +859,7 @@ func (k Keeper) createOrder(
 		Creator:                   order.Creator,
 		ID:                        order.ID,
 		Sequence:                  order.Sequence,
-		RemainingBaseQuantity:     record.RemainingBaseQuantity,
+		RemainingBaseQuantity:     remainingBaseQuantity,
 		RemainingSpendableBalance: record.RemainingSpendableBalance,
 	}); err != nil {
 		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventOrderCreated: %s", err)
@@ -724,10 +881,10 @@ func (k Keeper) saveOrderWithOrderBookRecord(
 			order.Type.String(),
 		)
 	}
-	if order.TimeInForce != types.TIME_IN_FORCE_GTC {
+	if order.TimeInForce != types.TIME_IN_FORCE_GTC && order.TimeInForce != types.TIME_IN_FORCE_POST_ONLY {
 		return sdkerrors.Wrapf(
 			types.ErrInvalidInput,
-			"it's prohibited to save not GTC order types, type: %s",
+			"it's prohibited to save not GTC or post-only order types, type: %s",
 			order.TimeInForce.String(),
 		)
 	}
@@ -753,13 +910,16 @@ func (k Keeper) saveOrderWithOrderBookRecord(
 	}
 
 	if err := k.saveOrderData(ctx, record.OrderSequence, types.OrderData{
-		OrderID:     order.ID,
-		OrderBookID: record.OrderBookID,
-		Price:       *order.Price,
-		Quantity:    order.Quantity,
-		Side:        order.Side,
-		GoodTil:     order.GoodTil,
-		Reserve:     order.Reserve,
+		OrderID:          order.ID,
+		OrderBookID:      record.OrderBookID,
+		Price:            *order.Price,
+		Quantity:         order.Quantity,
+		Side:             order.Side,
+		GoodTil:          order.GoodTil,
+		Reserve:          order.Reserve,
+		VisibleQuantity:  order.VisibleQuantity,
+		PrioritySequence: record.PrioritySequence,
+		TimeInForce:      order.TimeInForce,
 	}); err != nil {
 		return err
 	}
@@ -781,8 +941,27 @@ func (k Keeper) removeOrderByRecord(
 		"record", record,
 	)
 
+	if err := k.deleteOrderByRecord(ctx, record); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderClosed{
+		Creator:                   creator.String(),
+		ID:                        record.OrderID,
+		Sequence:                  record.OrderSequence,
+		RemainingBaseQuantity:     record.RemainingBaseQuantity,
+		RemainingSpendableBalance: record.RemainingSpendableBalance,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventOrderClosed: %s", err)
+	}
+
+	return nil
+}
+
+// deleteOrderByRecord deletes the order and its order book record from the store without emitting the events.
+func (k Keeper) deleteOrderByRecord(ctx sdk.Context, record types.OrderBookRecord) error {
 	if err := k.removeOrderBookRecord(
-		ctx, record.OrderBookID, record.Side, record.Price, record.OrderSequence,
+		ctx, record.OrderBookID, record.Side, record.Price, record.GetPrioritySequence(),
 	); err != nil {
 		return err
 	}
@@ -814,21 +993,7 @@ func (k Keeper) removeOrderByRecord(
 	if err := k.decrementAccountDenomOrdersCounter(ctx, record.AccountNumber, denoms); err != nil {
 		return err
 	}
-	if err := k.removeAccountDenomOrderSequence(ctx, record.AccountNumber, denoms, record.OrderSequence); err != nil {
-		return err
-	}
-
-	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderClosed{
-		Creator:                   creator.String(),
-		ID:                        record.OrderID,
-		Sequence:                  record.OrderSequence,
-		RemainingBaseQuantity:     record.RemainingBaseQuantity,
-		RemainingSpendableBalance: record.RemainingSpendableBalance,
-	}); err != nil {
-		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventOrderClosed: %s", err)
-	}
-
-	return nil
+	return k.removeAccountDenomOrderSequence(ctx, record.AccountNumber, denoms, record.OrderSequence)
 }
 
 func (k Keeper) saveOrderBookData(ctx sdk.Context, orderBookID uint32, data types.OrderBookData) error {
@@ -836,6 +1001,14 @@ func (k Keeper) saveOrderBookData(ctx sdk.Context, orderBookID uint32, data type
 }
 
 func (k Keeper) cancelOrderBySequence(ctx sdk.Context, acc sdk.AccAddress, orderSequence uint64) error {
+	offBookOrder, found, err := k.findOffBookOrder(ctx, orderSequence)
+	if err != nil {
+		return err
+	}
+	if found {
+		return k.cancelOrder(ctx, acc, offBookOrder.ID)
+	}
+
 	orderData, err := k.getOrderData(ctx, orderSequence)
 	if err != nil {
 		return err
@@ -844,21 +1017,39 @@ func (k Keeper) cancelOrderBySequence(ctx sdk.Context, acc sdk.AccAddress, order
 }
 
 func (k Keeper) cancelOrder(ctx sdk.Context, acc sdk.AccAddress, orderID string) error {
-	order, record, err := k.getOrderWithRecordByAddressAndID(ctx, acc, orderID)
+	limits, err := k.closeOrder(ctx, acc, orderID)
 	if err != nil {
 		return err
 	}
 
+	return k.decreaseOrderLimits(ctx, acc, limits)
+}
+
+// closeOrder removes the order and returns its limits without decreasing them.
+func (k Keeper) closeOrder(ctx sdk.Context, acc sdk.AccAddress, orderID string) (orderLimits, error) {
+	offBookOrder, found, err := k.findOffBookOrderByAddressAndID(ctx, acc, orderID)
+	if err != nil {
+		return orderLimits{}, err
+	}
+	if found {
+		return k.closeOffBookOrder(ctx, acc, offBookOrder)
+	}
+
+	order, record, err := k.getOrderWithRecordByAddressAndID(ctx, acc, orderID)
+	if err != nil {
+		return orderLimits{}, err
+	}
+
 	if err := k.removeOrderByRecord(ctx, acc, record); err != nil {
-		return err
+		return orderLimits{}, err
 	}
 
 	lockedCoins := sdk.NewCoins(sdk.NewCoin(order.GetSpendDenom(), order.RemainingSpendableBalance))
 	expectedToReceiveCoin, err := types.ComputeLimitOrderExpectedToReceiveBalance(
-		order.Side, order.BaseDenom, order.QuoteDenom, record.RemainingBaseQuantity, *order.Price,
+		order.Side, order.BaseDenom, order.QuoteDenom, record.GetTotalRemainingBaseQuantity(), *order.Price,
 	)
 	if err != nil {
-		return err
+		return orderLimits{}, err
 	}
 
 	// unlock the reserve if present
@@ -866,9 +1057,126 @@ func (k Keeper) cancelOrder(ctx sdk.Context, acc sdk.AccAddress, orderID string)
 		lockedCoins = lockedCoins.Add(order.Reserve)
 	}
 
-	return k.assetFTKeeper.DEXDecreaseLimits(
-		ctx, acc, lockedCoins, expectedToReceiveCoin,
-	)
+	return orderLimits{
+		LockedCoins:           lockedCoins,
+		ExpectedToReceiveCoin: expectedToReceiveCoin,
+	}, nil
+}
+
+// closeOffBookOrder removes the order kept out of the order book and returns its limits without decreasing them.
+func (k Keeper) closeOffBookOrder(ctx sdk.Context, creator sdk.AccAddress, order types.Order) (orderLimits, error) {
+	k.logger(ctx).Debug("Closing off-book order.", "order", order.String())
+
+	accNumber, err := k.getAccountNumber(ctx, creator)
+	if err != nil {
+		return orderLimits{}, err
+	}
+
+	var limits orderLimits
+	if order.Trigger != nil {
+		if err := k.removeTriggerOrder(ctx, accNumber, order); err != nil {
+			return orderLimits{}, err
+		}
+		limits, err = computeTriggerOrderLimitsWithReserve(order)
+	} else {
+		if err := k.removeBatchAuctionOrder(ctx, accNumber, order); err != nil {
+			return orderLimits{}, err
+		}
+		limits, err = computeBatchAuctionOrderLimits(order)
+	}
+	if err != nil {
+		return orderLimits{}, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderClosed{
+		Creator:                   order.Creator,
+		ID:                        order.ID,
+		Sequence:                  order.Sequence,
+		RemainingBaseQuantity:     order.RemainingBaseQuantity,
+		RemainingSpendableBalance: order.RemainingSpendableBalance,
+	}); err != nil {
+		return orderLimits{}, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventOrderClosed: %s", err)
+	}
+
+	return limits, nil
+}
+
+// saveOffBookOrderIndexes saves the indexes of the order kept out of the order book, which are the same as the
+// indexes of the order in the order book, so the order is canceled and listed as the regular order.
+func (k Keeper) saveOffBookOrderIndexes(ctx sdk.Context, accNumber uint64, order types.Order) error {
+	if order.GoodTil != nil {
+		creator, err := sdk.AccAddressFromBech32(order.Creator)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+		}
+		if err := k.delayGoodTilCancellation(ctx, *order.GoodTil, order.Sequence, creator); err != nil {
+			return err
+		}
+	}
+
+	if err := k.saveOrderIDToSequence(ctx, accNumber, order.ID, order.Sequence); err != nil {
+		return err
+	}
+
+	return k.saveAccountDenomOrderSequence(ctx, accNumber, order.Denoms(), order.Sequence)
+}
+
+// removeOffBookOrderIndexes removes the indexes of the order kept out of the order book and decrements the orders
+// counter of the account.
+func (k Keeper) removeOffBookOrderIndexes(ctx sdk.Context, accNumber uint64, order types.Order) error {
+	if order.GoodTil != nil {
+		if err := k.removeGoodTilDelay(ctx, *order.GoodTil, order.Sequence); err != nil {
+			return err
+		}
+	}
+
+	if err := k.removeOrderIDToSequence(ctx, accNumber, order.ID); err != nil {
+		return err
+	}
+
+	if err := k.decrementAccountDenomOrdersCounter(ctx, accNumber, order.Denoms()); err != nil {
+		return err
+	}
+
+	return k.removeAccountDenomOrderSequence(ctx, accNumber, order.Denoms(), order.Sequence)
+}
+
+// findOffBookOrder returns the order kept out of the order book by sequence, which is the not activated trigger order
+// or the order queued by the batch auction, and false if the order is in the order book.
+func (k Keeper) findOffBookOrder(ctx sdk.Context, orderSequence uint64) (types.Order, bool, error) {
+	order, found, err := k.findTriggerOrder(ctx, orderSequence)
+	if err != nil || found {
+		return order, found, err
+	}
+
+	return k.findBatchAuctionOrder(ctx, orderSequence)
+}
+
+// findOffBookOrderByAddressAndID returns the order kept out of the order book by creator and ID and false if the
+// order is not found or is in the order book.
+func (k Keeper) findOffBookOrderByAddressAndID(
+	ctx sdk.Context,
+	acc sdk.AccAddress,
+	orderID string,
+) (types.Order, bool, error) {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return types.Order{}, false, err
+	}
+
+	orderSequence, err := k.getOrderSequenceByID(ctx, accNumber, orderID)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.Order{}, false, nil
+		}
+		return types.Order{}, false, err
+	}
+
+	return k.findOffBookOrder(ctx, orderSequence)
+}
+
+func (k Keeper) decreaseOrderLimits(ctx sdk.Context, acc sdk.AccAddress, limits orderLimits) error {
+	return k.assetFTKeeper.DEXDecreaseLimits(ctx, acc, limits.LockedCoins, limits.ExpectedToReceiveCoin)
 }
 
 func (k Keeper) getOrderBookData(ctx sdk.Context, orderBookID uint32) (types.OrderBookData, error) {
@@ -890,17 +1198,28 @@ func (k Keeper) saveOrderBookRecord(
 ) error {
 	k.logger(ctx).Debug("Saving order book record.", "record", record.String())
 
-	key, err := types.CreateOrderBookRecordKey(record.OrderBookID, record.Side, record.Price, record.OrderSequence)
+	key, err := types.CreateOrderBookRecordKey(
+		record.OrderBookID, record.Side, record.Price, record.GetPrioritySequence(),
+	)
 	if err != nil {
 		return err
 	}
 
-	return k.setDataToStore(ctx, key, &types.OrderBookRecordData{
+	data := types.OrderBookRecordData{
 		OrderID:                   record.OrderID,
 		AccountNumber:             record.AccountNumber,
 		RemainingBaseQuantity:     record.RemainingBaseQuantity,
 		RemainingSpendableBalance: record.RemainingSpendableBalance,
-	})
+	}
+	// the order sequence is stored only if the key contains the priority sequence
+	if record.PrioritySequence != 0 {
+		data.OrderSequence = record.OrderSequence
+	}
+	if record.HasHiddenQuantity() {
+		data.RemainingHiddenQuantity = &record.RemainingHiddenQuantity
+	}
+
+	return k.setDataToStore(ctx, key, &data)
 }
 
 func (k Keeper) getOrderWithRecordByAddressAndID(
@@ -923,13 +1242,7 @@ func (k Keeper) getOrderWithRecordByAddressAndID(
 		return types.Order{}, types.OrderBookRecord{}, err
 	}
 
-	orderBookRecord, err := k.getOrderBookRecord(
-		ctx,
-		orderData.OrderBookID,
-		orderData.Side,
-		orderData.Price,
-		orderSequence,
-	)
+	orderBookRecord, err := k.getOrderBookRecord(ctx, orderSequence, orderData)
 	if err != nil {
 		return types.Order{}, types.OrderBookRecord{}, err
 	}
@@ -950,10 +1263,11 @@ func (k Keeper) getOrderWithRecordByAddressAndID(
 			Quantity:                  orderData.Quantity,
 			Side:                      orderBookRecord.Side,
 			GoodTil:                   orderData.GoodTil,
-			TimeInForce:               types.TIME_IN_FORCE_GTC,
-			RemainingBaseQuantity:     orderBookRecord.RemainingBaseQuantity,
+			TimeInForce:               orderDataTimeInForce(orderData),
+			RemainingBaseQuantity:     orderBookRecord.GetTotalRemainingBaseQuantity(),
 			RemainingSpendableBalance: orderBookRecord.RemainingSpendableBalance,
 			Reserve:                   orderData.Reserve,
+			VisibleQuantity:           orderData.VisibleQuantity,
 		},
 		orderBookRecord,
 		nil
@@ -961,12 +1275,19 @@ func (k Keeper) getOrderWithRecordByAddressAndID(
 
 func (k Keeper) getOrderBookRecord(
 	ctx sdk.Context,
-	orderBookID uint32,
-	side types.Side,
-	price types.Price,
 	orderSequence uint64,
+	orderData types.OrderData,
 ) (types.OrderBookRecord, error) {
-	key, err := types.CreateOrderBookRecordKey(orderBookID, side, price, orderSequence)
+	record := types.OrderBookRecord{
+		OrderBookID:      orderData.OrderBookID,
+		Side:             orderData.Side,
+		Price:            orderData.Price,
+		OrderSequence:    orderSequence,
+		PrioritySequence: orderData.PrioritySequence,
+	}
+	key, err := types.CreateOrderBookRecordKey(
+		record.OrderBookID, record.Side, record.Price, record.GetPrioritySequence(),
+	)
 	if err != nil {
 		return types.OrderBookRecord{}, err
 	}
@@ -977,18 +1298,17 @@ func (k Keeper) getOrderBookRecord(
 			sdkerrors.Wrapf(
 				err,
 				"faild to get order book record, orderBookID: %d, side: %s, price: %s, orderSequence: %d",
-				orderBookID, side.String(), price.String(), orderSequence)
-	}
-	return types.OrderBookRecord{
-		OrderBookID:               orderBookID,
-		Side:                      side,
-		Price:                     price,
-		OrderSequence:             orderSequence,
-		OrderID:                   val.OrderID,
-		AccountNumber:             val.AccountNumber,
-		RemainingBaseQuantity:     val.RemainingBaseQuantity,
-		RemainingSpendableBalance: val.RemainingSpendableBalance,
-	}, nil
+				record.OrderBookID, record.Side.String(), record.Price.String(), orderSequence)
+	}
+	record.OrderID = val.OrderID
+	record.AccountNumber = val.AccountNumber
+	record.RemainingBaseQuantity = val.RemainingBaseQuantity
+	record.RemainingSpendableBalance = val.RemainingSpendableBalance
+	if val.RemainingHiddenQuantity != nil {
+		record.RemainingHiddenQuantity = *val.RemainingHiddenQuantity
+	}
+
+	return record, nil
 }
 
 func (k Keeper) getPaginatedOrders(
@@ -1010,6 +1330,14 @@ func (k Keeper) getPaginatedOrders(
 		// builder
 		func(_ []byte, record *gogotypes.UInt64Value) (*types.Order, error) {
 			orderSequence := record.Value
+			offBookOrder, found, err := k.findOffBookOrder(ctx, orderSequence)
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				return &offBookOrder, nil
+			}
+
 			orderData, err := k.getOrderData(ctx, orderSequence)
 			if err != nil {
 				return nil, err
@@ -1025,18 +1353,12 @@ func (k Keeper) getPaginatedOrders(
 				orderBookIDToOrderBookData[orderBookID] = orderBookData
 			}
 
-			orderBookRecord, err := k.getOrderBookRecord(
-				ctx,
-				orderData.OrderBookID,
-				orderData.Side,
-				orderData.Price,
-				orderSequence,
-			)
+			orderBookRecord, err := k.getOrderBookRecord(ctx, orderSequence, orderData)
 			if err != nil {
 				return nil, err
 			}
 
-			return &types.Order{
+			order := &types.Order{
 				Creator:                   acc.String(),
 				Type:                      types.ORDER_TYPE_LIMIT,
 				ID:                        orderBookRecord.OrderID,
@@ -1047,11 +1369,20 @@ func (k Keeper) getPaginatedOrders(
 				Quantity:                  orderData.Quantity,
 				Side:                      orderData.Side,
 				GoodTil:                   orderData.GoodTil,
-				TimeInForce:               types.TIME_IN_FORCE_GTC,
-				RemainingBaseQuantity:     orderBookRecord.RemainingBaseQuantity,
+				TimeInForce:               orderDataTimeInForce(orderData),
+				RemainingBaseQuantity:     orderBookRecord.GetTotalRemainingBaseQuantity(),
 				RemainingSpendableBalance: orderBookRecord.RemainingSpendableBalance,
 				Reserve:                   orderData.Reserve,
-			}, nil
+				VisibleQuantity:           orderData.VisibleQuantity,
+			}
+			if orderData.VisibleQuantity != nil {
+				// only the visible part of the iceberg order is shown
+				if err := maskIcebergOrder(order, orderBookRecord.RemainingBaseQuantity); err != nil {
+					return nil, err
+				}
+			}
+
+			return order, nil
 		},
 		// constructor
 		func() *gogotypes.UInt64Value {
@@ -1127,6 +1458,10 @@ func (k Keeper) getPaginatedOrderBookOrders(
 			if err != nil {
 				return nil, err
 			}
+			// the key contains the priority sequence of the refilled iceberg order
+			if record.OrderSequence != 0 {
+				orderSequence = record.OrderSequence
+			}
 
 			var acc sdk.AccAddress
 			acc, err = cachedAccKeeper.getAccountAddressWithCache(ctx, record.AccountNumber)
@@ -1139,7 +1474,7 @@ func (k Keeper) getPaginatedOrderBookOrders(
 				return nil, err
 			}
 
-			return &types.Order{
+			order := &types.Order{
 				Creator:                   acc.String(),
 				Type:                      types.ORDER_TYPE_LIMIT,
 				ID:                        record.OrderID,
@@ -1150,11 +1485,20 @@ func (k Keeper) getPaginatedOrderBookOrders(
 				Quantity:                  orderData.Quantity,
 				Side:                      side,
 				GoodTil:                   orderData.GoodTil,
-				TimeInForce:               types.TIME_IN_FORCE_GTC,
+				TimeInForce:               orderDataTimeInForce(orderData),
 				RemainingBaseQuantity:     record.RemainingBaseQuantity,
 				RemainingSpendableBalance: record.RemainingSpendableBalance,
 				Reserve:                   orderData.Reserve,
-			}, nil
+				VisibleQuantity:           orderData.VisibleQuantity,
+			}
+			if orderData.VisibleQuantity != nil {
+				// only the visible part of the iceberg order is shown in the order book
+				if err := maskIcebergOrder(order, record.RemainingBaseQuantity); err != nil {
+					return nil, err
+				}
+			}
+
+			return order, nil
 		},
 		// constructor
 		func() *types.OrderBookRecordData {
@@ -1169,6 +1513,23 @@ func (k Keeper) getPaginatedOrderBookOrders(
 	}), pageRes, nil
 }
 
+// maskIcebergOrder replaces the quantity, the remaining quantity and the remaining spendable balance of the iceberg
+// order with the amounts of its visible part, so the hidden quantity isn't exposed by the queries.
+func maskIcebergOrder(order *types.Order, remainingVisibleQuantity sdkmath.Int) error {
+	visibleLockedBalance, err := types.ComputeLimitOrderLockedBalance(
+		order.Side, order.BaseDenom, order.QuoteDenom, remainingVisibleQuantity, *order.Price,
+	)
+	if err != nil {
+		return err
+	}
+
+	order.Quantity = *order.VisibleQuantity
+	order.RemainingBaseQuantity = remainingVisibleQuantity
+	order.RemainingSpendableBalance = sdkmath.MinInt(order.RemainingSpendableBalance, visibleLockedBalance.Amount)
+
+	return nil
+}
+
 func (k Keeper) removeOrderBookRecord(
 	ctx sdk.Context,
 	orderBookID uint32,
@@ -1199,6 +1560,14 @@ func (k Keeper) getOrderData(ctx sdk.Context, orderSequence uint64) (types.Order
 	return val, nil
 }
 
+// orderDataTimeInForce returns the stored order time in force, the orders saved without it are GTC.
+func orderDataTimeInForce(orderData types.OrderData) types.TimeInForce {
+	if orderData.TimeInForce == types.TIME_IN_FORCE_UNSPECIFIED {
+		return types.TIME_IN_FORCE_GTC
+	}
+	return orderData.TimeInForce
+}
+
 func (k Keeper) saveOrderIDToSequence(ctx sdk.Context, accNumber uint64, orderID string, orderSequence uint64) error {
 	key := types.CreateOrderIDToSequenceKey(accNumber, orderID)
 	return k.setDataToStore(ctx, key, &gogotypes.UInt64Value{Value: orderSequence})
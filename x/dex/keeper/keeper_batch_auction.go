@@ -0,0 +1,1617 @@
+package keeper
+
+import (
+	"maps"
+	"math/big"
+	"sort"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	cbig "github.com/CoreumFoundation/coreum/v6/pkg/math/big"
+	"github.com/CoreumFoundation/coreum/v6/pkg/store"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+const (
+	// maxBatchAuctionOrdersPerBlock is the max number of the queued orders cleared in one block. The queue of the
+	// order book is always cleared completely, so the order books not fitting the limit are cleared in the next blocks.
+	maxBatchAuctionOrdersPerBlock = 1000
+	// maxBatchAuctionOrderBooksPerBlock is the max number of the order books cleared in one block.
+	maxBatchAuctionOrderBooksPerBlock = 20
+)
+
+// batchAuctionParticipant is the order book record participating in the batch auction clearing, the side, price and
+// quantity are defined in the terms of the clearing order book.
+type batchAuctionParticipant struct {
+	record   types.OrderBookRecord
+	side     OrderSide
+	price    *big.Rat
+	quantity *big.Rat
+}
+
+// batchAuctionClearing is the batch auction clearing price and the quantities the orders execute at it.
+type batchAuctionClearing struct {
+	// orderBookID is the order book the clearing price and the allotments are defined for.
+	orderBookID uint32
+	price       *big.Rat
+	// priceOrderBookID and orderBookPrice are the order book and the order price the clearing price is taken from,
+	// the price of the inverted order book isn't always representable as the order book price.
+	priceOrderBookID uint32
+	orderBookPrice   types.Price
+	// allotments are the base quantities the orders execute at the clearing price by the order sequence.
+	allotments map[uint64]*big.Rat
+}
+
+// batchAuctionQueue is the order book with the orders queued by the batch auction.
+type batchAuctionQueue struct {
+	orderBookID uint32
+	size        uint64
+}
+
+// batchAuctionCounterparties are the sequences of the participants with the positive allotments by the side in the
+// terms of the clearing order book, ordered by the price and time priority.
+type batchAuctionCounterparties struct {
+	buys  []uint64
+	sells []uint64
+}
+
+// batchAuctionMakers iterates the counterparties of the executed order with the positive allotments, the records are
+// loaded from the store to take the execution of the previous orders into account.
+type batchAuctionMakers struct {
+	k              Keeper
+	ctx            sdk.Context
+	clearing       *batchAuctionClearing
+	orderSequences []uint64
+	next           int
+	requeued       []types.OrderBookRecord
+}
+
+// SetOrderBookBatchAuction enables or disables the batch auction mode of the order book and its inverted order book.
+// In the batch auction mode the orders are queued during the block and cleared at the end of the block at the
+// single clearing price.
+func (k Keeper) SetOrderBookBatchAuction(
+	ctx sdk.Context,
+	authority, baseDenom, quoteDenom string,
+	enabled bool,
+) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	if err := k.validateDenomPair(ctx, baseDenom, quoteDenom); err != nil {
+		return err
+	}
+
+	orderBookID, invertedOrderBookID, err := k.getOrGenOrderBookIDs(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return err
+	}
+
+	batchAuction, err := k.isOrderBookInBatchAuction(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	if batchAuction == enabled {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"batch auction of the order book %s/%s is already set to %t", baseDenom, quoteDenom, enabled,
+		)
+	}
+
+	key := types.CreateOrderBookBatchAuctionKey(getOrderBookPairID(orderBookID, invertedOrderBookID))
+	if enabled {
+		if err := k.storeService.OpenKVStore(ctx).Set(key, types.StoreTrue); err != nil {
+			return err
+		}
+	} else {
+		if err := k.storeService.OpenKVStore(ctx).Delete(key); err != nil {
+			return err
+		}
+		// the trigger orders skipped during the batch auction are checked again
+		for _, id := range []uint32{orderBookID, invertedOrderBookID} {
+			_, found, err := k.getOrderBookLastPrice(ctx, id)
+			if err != nil {
+				return err
+			}
+			if !found {
+				continue
+			}
+			if err := k.savePendingTriggerOrderBook(ctx, id); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderBookBatchAuctionUpdated{
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+		Enabled:    enabled,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderBookBatchAuctionUpdated: %s", err)
+	}
+
+	return nil
+}
+
+// GetBatchAuctionOrderBookIDs returns the IDs of the order books in the batch auction mode.
+func (k Keeper) GetBatchAuctionOrderBookIDs(ctx sdk.Context) ([]uint32, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.OrderBookBatchAuctionKeyPrefix,
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	orderBookIDs := make([]uint32, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		orderBookID, err := types.DecodeOrderBookBatchAuctionKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		orderBookIDs = append(orderBookIDs, orderBookID)
+	}
+
+	return orderBookIDs, nil
+}
+
+// ImportBatchAuctionOrderBook saves the order book in the batch auction mode.
+func (k Keeper) ImportBatchAuctionOrderBook(ctx sdk.Context, orderBookID uint32) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.CreateOrderBookBatchAuctionKey(orderBookID), types.StoreTrue)
+}
+
+// SaveBatchAuctionOrder saves the order queued by the batch auction.
+func (k Keeper) SaveBatchAuctionOrder(ctx sdk.Context, accNumber uint64, orderBookID uint32, order types.Order) error {
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+
+	return k.saveBatchAuctionOrder(ctx, accNumber, orderBookID, invertedOrderBookID, order)
+}
+
+// GetBatchAuctionOrderSequences returns the sequences of the orders queued by the batch auctions.
+func (k Keeper) GetBatchAuctionOrderSequences(ctx sdk.Context) ([]uint64, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.BatchAuctionOrderKeyPrefix,
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	orderSequences := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		orderSequence, _, err := store.ReadOrderedBytesToUint64(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		orderSequences = append(orderSequences, orderSequence)
+	}
+
+	return orderSequences, nil
+}
+
+// ClearBatchAuctions clears the orders queued by the batch auctions. The queue of the order book is cleared completely
+// in the same block, up to maxBatchAuctionOrderBooksPerBlock order books and maxBatchAuctionOrdersPerBlock orders are
+// cleared in one block and the order books left are cleared in the next blocks starting from the first not cleared
+// one. The orders of the halted order books are cleared once the order book is resumed, and the orders of the closed
+// order books are canceled. If the order book is halted by the circuit breaker during the clearing, the orders not
+// cleared yet are returned to the queue. Each order book is cleared in its own cache context, and if the clearing
+// fails, the queued orders of the order book are canceled.
+func (k Keeper) ClearBatchAuctions(ctx sdk.Context) error {
+	queues, err := k.getBatchAuctionQueuesToClear(ctx, maxBatchAuctionOrderBooksPerBlock)
+	if err != nil {
+		return err
+	}
+	if len(queues) == 0 {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	cachedAccKeeper := newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer)
+	ordersLeft := uint64(maxBatchAuctionOrdersPerBlock)
+	for i, queue := range queues {
+		// the first queue is cleared regardless of its size, so the clearing always progresses
+		if i > 0 && queue.size > ordersLeft {
+			break
+		}
+		ordersLeft -= min(queue.size, ordersLeft)
+
+		orderSequences, err := k.getBatchAuctionOrderBookOrderSequences(ctx, queue.orderBookID)
+		if err != nil {
+			return err
+		}
+		if err := k.clearOrCancelBatchAuction(
+			ctx, params, cachedAccKeeper, queue.orderBookID, orderSequences,
+		); err != nil {
+			k.logger(ctx).Error(
+				"Failed to clear batch auction, cancelling orders.", "orderBookID", queue.orderBookID, "err", err,
+			)
+			k.cancelBatchAuctionOrders(ctx, cachedAccKeeper, orderSequences)
+		}
+
+		// the next clearing starts after the cleared order book
+		if err := k.setUint32Value(ctx, types.BatchAuctionClearingCursorKey, queue.orderBookID+1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) queueBatchAuctionOrder(
+	ctx sdk.Context,
+	params types.Params,
+	accNumber uint64,
+	orderBookID, invertedOrderBookID uint32,
+	order types.Order,
+	releasedLimits orderLimits,
+) error {
+	if err := validateBatchAuctionOrder(order); err != nil {
+		return err
+	}
+
+	queueSize, err := k.getBatchAuctionQueueSize(ctx, getOrderBookPairID(orderBookID, invertedOrderBookID))
+	if err != nil {
+		return err
+	}
+	if queueSize >= params.MaxBatchAuctionOrders {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"it's prohibited to queue more than %d orders by the batch auction of the order book",
+			params.MaxBatchAuctionOrders,
+		)
+	}
+
+	k.logger(ctx).Debug("Queueing batch auction order.", "order", order.String())
+
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+	}
+
+	orderSequence, err := k.genNextOrderSequence(ctx)
+	if err != nil {
+		return err
+	}
+	order.Sequence = orderSequence
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderPlaced{
+		Creator:  order.Creator,
+		ID:       order.ID,
+		Sequence: order.Sequence,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderPlaced: %s", err)
+	}
+
+	if err := k.incrementAccountDenomsOrdersCounter(
+		ctx,
+		accNumber,
+		params.MaxOrdersPerDenom,
+		order.Denoms(),
+	); err != nil {
+		return err
+	}
+
+	lockedCoin, err := order.ComputeLimitOrderLockedBalance()
+	if err != nil {
+		return err
+	}
+	expectedToReceiveCoin, err := types.ComputeLimitOrderExpectedToReceiveBalance(
+		order.Side, order.BaseDenom, order.QuoteDenom, order.Quantity, *order.Price,
+	)
+	if err != nil {
+		return err
+	}
+	order.RemainingBaseQuantity = order.Quantity
+	order.RemainingSpendableBalance = lockedCoin.Amount
+	if params.OrderReserve.IsPositive() {
+		order.Reserve = params.OrderReserve
+	}
+
+	// the order is kept out of the order book until the clearing, so the order book isn't crossed
+	if err := k.saveBatchAuctionOrder(ctx, accNumber, orderBookID, invertedOrderBookID, order); err != nil {
+		return err
+	}
+
+	return k.lockOffBookOrderLimits(ctx, creator, order, lockedCoin, expectedToReceiveCoin, releasedLimits)
+}
+
+// clearOrCancelBatchAuction clears the queued orders of the order book in the cache context, the orders of the
+// closed order book are canceled and the orders of the halted order book are kept in the queue.
+func (k Keeper) clearOrCancelBatchAuction(
+	ctx sdk.Context,
+	params types.Params,
+	cachedAccKeeper cachedAccountKeeper,
+	orderBookID uint32,
+	orderSequences []uint64,
+) error {
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+
+	halted, err := k.isOrderBookHalted(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	if halted {
+		return nil
+	}
+
+	closed, err := k.isOrderBookClosed(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	if closed {
+		k.cancelBatchAuctionOrders(ctx, cachedAccKeeper, orderSequences)
+		return nil
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.clearBatchAuction(
+		cacheCtx, params, cachedAccKeeper, orderBookID, invertedOrderBookID, orderSequences,
+	); err != nil {
+		return err
+	}
+	writeCache()
+
+	return nil
+}
+
+// clearBatchAuction moves the queued orders of the order book to the order book and clears them. The remaining parts
+// of the cleared orders still crossing the order book are matched as the regular orders, so the order book is never
+// left crossed. If the order book is halted during the clearing, the orders left in the order book are returned to
+// the queue without matching, and they are cleared once the order book is resumed.
+func (k Keeper) clearBatchAuction(
+	ctx sdk.Context,
+	params types.Params,
+	cachedAccKeeper cachedAccountKeeper,
+	orderBookID, invertedOrderBookID uint32,
+	orderSequences []uint64,
+) error {
+	for _, orderSequence := range orderSequences {
+		if err := k.moveBatchAuctionOrderToOrderBook(ctx, orderSequence); err != nil {
+			return err
+		}
+	}
+
+	halted, err := k.clearOrderBookBatchAuction(
+		ctx, params, cachedAccKeeper, orderBookID, invertedOrderBookID, orderSequences,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, orderSequence := range orderSequences {
+		if !halted {
+			halted, err = k.isOrderBookHaltedOrClosed(ctx, orderBookID, invertedOrderBookID)
+			if err != nil {
+				return err
+			}
+		}
+		if halted {
+			if err := k.returnBatchAuctionOrderToQueue(ctx, cachedAccKeeper, orderSequence); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the remainder is matched in the cache context to cancel it if the matching fails
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.matchBatchAuctionOrderRemainder(cacheCtx, params, cachedAccKeeper, orderSequence); err != nil {
+			k.logger(ctx).Info(
+				"Failed to match batch auction order remainder, cancelling.",
+				"orderSequence", orderSequence,
+				"err", err,
+			)
+			if err := k.cancelBatchAuctionOrder(ctx, cachedAccKeeper, orderSequence); err != nil {
+				return err
+			}
+			continue
+		}
+		writeCache()
+	}
+
+	return nil
+}
+
+// cancelBatchAuctionOrders cancels the queued orders, each order is canceled in its own cache context and the order
+// failing the cancellation is kept in the queue to be cleared or canceled in the next block.
+func (k Keeper) cancelBatchAuctionOrders(
+	ctx sdk.Context,
+	cachedAccKeeper cachedAccountKeeper,
+	orderSequences []uint64,
+) {
+	for _, orderSequence := range orderSequences {
+		k.cancelBatchAuctionOrderOrSkip(ctx, cachedAccKeeper, orderSequence)
+	}
+}
+
+// getBatchAuctionQueuesToClear returns up to limit order books with the queued orders starting from the clearing
+// cursor and continuing from the first order book after the last one.
+func (k Keeper) getBatchAuctionQueuesToClear(ctx sdk.Context, limit int) ([]batchAuctionQueue, error) {
+	var cursor gogotypes.UInt32Value
+	if err := k.getDataFromStore(ctx, types.BatchAuctionClearingCursorKey, &cursor); err != nil &&
+		!sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+		return nil, err
+	}
+	start := store.AppendUint32ToOrderedBytes(make([]byte, 0), cursor.Value)
+
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	countStore := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.BatchAuctionOrderCountKeyPrefix)
+	queues := make([]batchAuctionQueue, 0)
+	for _, bounds := range [][2][]byte{{start, nil}, {nil, start}} {
+		if err := func() error {
+			iterator := countStore.Iterator(bounds[0], bounds[1])
+			defer iterator.Close()
+
+			for ; iterator.Valid() && len(queues) < limit; iterator.Next() {
+				orderBookID, err := types.DecodeBatchAuctionOrderCountKey(iterator.Key())
+				if err != nil {
+					return err
+				}
+				var size gogotypes.UInt64Value
+				if err := k.cdc.Unmarshal(iterator.Value(), &size); err != nil {
+					return sdkerrors.Wrapf(
+						types.ErrInvalidState, "failed to unmarshal batch auction queue size: %s", err,
+					)
+				}
+				queues = append(queues, batchAuctionQueue{
+					orderBookID: orderBookID,
+					size:        size.Value,
+				})
+			}
+
+			return nil
+		}(); err != nil {
+			return nil, err
+		}
+	}
+
+	return queues, nil
+}
+
+// getBatchAuctionOrderBookOrderSequences returns the sequences of the orders queued by the batch auction of the order
+// book in the order of their placement.
+func (k Keeper) getBatchAuctionOrderBookOrderSequences(ctx sdk.Context, orderBookID uint32) ([]uint64, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateBatchAuctionOrderBookKey(orderBookID),
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	orderSequences := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		orderSequence, _, err := store.ReadOrderedBytesToUint64(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		orderSequences = append(orderSequences, orderSequence)
+	}
+
+	return orderSequences, nil
+}
+
+func (k Keeper) getBatchAuctionQueueSize(ctx sdk.Context, orderBookID uint32) (uint64, error) {
+	size, err := k.getUint64Value(ctx, types.CreateBatchAuctionOrderCountKey(orderBookID))
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return size, nil
+}
+
+// clearOrderBookBatchAuction computes the clearing price of the queued orders and executes them as takers at the
+// clearing price in the order of the placement. The execution is stopped and true is returned if the order book is
+// halted by the circuit breaker.
+func (k Keeper) clearOrderBookBatchAuction(
+	ctx sdk.Context,
+	params types.Params,
+	cachedAccKeeper cachedAccountKeeper,
+	orderBookID, invertedOrderBookID uint32,
+	orderSequences []uint64,
+) (bool, error) {
+	participants, err := k.getBatchAuctionParticipants(ctx, orderBookID, invertedOrderBookID, orderSequences)
+	if err != nil {
+		return false, err
+	}
+	clearing, found := computeBatchAuctionClearing(orderBookID, participants)
+	if !found {
+		return false, nil
+	}
+
+	k.logger(ctx).Debug(
+		"Clearing batch auction.",
+		"orderBookID", orderBookID,
+		"price", clearing.orderBookPrice.String(),
+	)
+
+	orderBookData, err := k.getOrderBookData(ctx, clearing.priceOrderBookID)
+	if err != nil {
+		return false, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderBookBatchAuctionCleared{
+		BaseDenom:  orderBookData.BaseDenom,
+		QuoteDenom: orderBookData.QuoteDenom,
+		Price:      clearing.orderBookPrice,
+	}); err != nil {
+		return false, sdkerrors.Wrapf(
+			cosmoserrors.ErrIO, "failed to emit event EventOrderBookBatchAuctionCleared: %s", err,
+		)
+	}
+
+	// only the participants with the positive allotments are matched, so the takers don't iterate the others
+	counterparties := newBatchAuctionCounterparties(&clearing, participants)
+	for _, orderSequence := range orderSequences {
+		allotment, ok := clearing.allotments[orderSequence]
+		if !ok || allotment.Sign() <= 0 {
+			continue
+		}
+
+		// the execution of the previous order might halt the order book by the circuit breaker
+		halted, err := k.isOrderBookHaltedOrClosed(ctx, orderBookID, invertedOrderBookID)
+		if err != nil {
+			return false, err
+		}
+		if halted {
+			return true, nil
+		}
+
+		// the order is executed in the cache context to cancel it if the execution fails, the allotments are updated
+		// only if the execution succeeds
+		executionClearing := clearing
+		executionClearing.allotments = maps.Clone(clearing.allotments)
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.executeBatchAuctionOrder(
+			cacheCtx, params, cachedAccKeeper, &executionClearing, &counterparties, invertedOrderBookID, orderSequence,
+		); err != nil {
+			k.logger(ctx).Info(
+				"Failed to execute batch auction order, cancelling.", "orderSequence", orderSequence, "err", err,
+			)
+			// the order failing the cancellation would be left in the order book, so the clearing is failed
+			if err := k.cancelBatchAuctionOrder(ctx, cachedAccKeeper, orderSequence); err != nil {
+				return false, err
+			}
+			continue
+		}
+		writeCache()
+		clearing = executionClearing
+		counterparties.pruneExecuted(&clearing)
+	}
+
+	return false, nil
+}
+
+// getBatchAuctionParticipants returns the queued orders and the order book records crossing them. The records
+// placed before the block don't cross each other, so only the records crossing the queued orders are returned.
+func (k Keeper) getBatchAuctionParticipants(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	orderSequences []uint64,
+) ([]batchAuctionParticipant, error) {
+	participants := make([]batchAuctionParticipant, 0)
+	collected := make(map[uint64]struct{})
+	var maxBuyPrice, minSellPrice *big.Rat
+	for _, orderSequence := range orderSequences {
+		orderData, err := k.getOrderData(ctx, orderSequence)
+		if err != nil {
+			// the order is canceled before the clearing
+			if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		record, err := k.getOrderBookRecord(ctx, orderSequence, orderData)
+		if err != nil {
+			return nil, err
+		}
+
+		participant := newBatchAuctionParticipant(orderBookID, record)
+		if participant.side == buyOrderSide {
+			if maxBuyPrice == nil || cbig.RatGT(participant.price, maxBuyPrice) {
+				maxBuyPrice = participant.price
+			}
+		} else {
+			if minSellPrice == nil || cbig.RatLT(participant.price, minSellPrice) {
+				minSellPrice = participant.price
+			}
+		}
+		collected[orderSequence] = struct{}{}
+		participants = append(participants, participant)
+	}
+
+	for _, id := range []uint32{orderBookID, invertedOrderBookID} {
+		for _, side := range []types.Side{types.SIDE_BUY, types.SIDE_SELL} {
+			if err := func() error {
+				iterator := k.NewOrderBookSideIterator(ctx, id, side)
+				defer iterator.Close()
+
+				// the records are read from the best price, so the iteration is stopped on the first not crossing one
+				for {
+					record, found, err := iterator.Next()
+					if err != nil {
+						return err
+					}
+					if !found {
+						return nil
+					}
+					participant := newBatchAuctionParticipant(orderBookID, record)
+					if participant.side == buyOrderSide &&
+						(minSellPrice == nil || cbig.RatLT(participant.price, minSellPrice)) {
+						return nil
+					}
+					if participant.side == sellOrderSide &&
+						(maxBuyPrice == nil || cbig.RatGT(participant.price, maxBuyPrice)) {
+						return nil
+					}
+					if _, ok := collected[record.OrderSequence]; ok {
+						continue
+					}
+					participants = append(participants, participant)
+				}
+			}(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return participants, nil
+}
+
+// executeBatchAuctionOrder removes the cleared order from the order book and executes it as the taker at the
+// clearing price against the counterparties with the positive allotments, the remaining part is saved back to the
+// order book with the same sequence.
+func (k Keeper) executeBatchAuctionOrder(
+	ctx sdk.Context,
+	params types.Params,
+	cachedAccKeeper cachedAccountKeeper,
+	clearing *batchAuctionClearing,
+	counterparties *batchAuctionCounterparties,
+	invertedOrderBookID uint32,
+	orderSequence uint64,
+) error {
+	takerOrder, takerRecord, takerLimits, found, err := k.getClearedBatchAuctionOrder(
+		ctx, cachedAccKeeper, orderSequence,
+	)
+	if err != nil {
+		return err
+	}
+	// the order is filled as the maker of the order executed before
+	if !found {
+		return nil
+	}
+
+	k.logger(ctx).Debug("Executing batch auction order.", "order", takerOrder.String())
+
+	if err := k.deleteOrderByRecord(ctx, takerRecord); err != nil {
+		return err
+	}
+
+	takerOrderBookID := takerRecord.OrderBookID
+	if takerOrderBookID == invertedOrderBookID {
+		invertedOrderBookID = clearing.orderBookID
+	}
+
+	mr, err := NewMatchingResult(takerOrder)
+	if err != nil {
+		return err
+	}
+	// the limits of the cleared order are released to the executed order, so the velocity allowance consumed by the
+	// queued order isn't consumed again
+	mr.ReleaseTakerLimits(takerLimits)
+
+	feeRates, err := k.getOrderBookFeeRates(ctx, params, takerOrderBookID)
+	if err != nil {
+		return err
+	}
+	invertedFeeRates, err := k.getOrderBookFeeRates(ctx, params, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+
+	makers := &batchAuctionMakers{
+		k:              k,
+		ctx:            ctx,
+		clearing:       clearing,
+		orderSequences: counterparties.forTaker(newBatchAuctionParticipant(clearing.orderBookID, takerRecord).side),
+	}
+	for clearing.allotments[orderSequence].Sign() > 0 {
+		makerRecord, found, err := makers.Next()
+		if err != nil {
+			return err
+		}
+		if !found {
+			break
+		}
+
+		if err := k.matchBatchAuctionRecords(
+			ctx,
+			cachedAccKeeper,
+			mr,
+			makers,
+			clearing,
+			&takerRecord,
+			&makerRecord,
+			takerOrder,
+			feeRates,
+			invertedFeeRates,
+		); err != nil {
+			return err
+		}
+	}
+
+	return k.saveBatchAuctionOrderRemainder(ctx, params, mr, takerOrder, takerRecord, false)
+}
+
+// matchBatchAuctionOrderRemainder matches the remaining part of the cleared order as the regular order if it still
+// crosses the order book, which is possible if the execution of the counterparty fails or the allotment isn't
+// executable at the clearing price.
+func (k Keeper) matchBatchAuctionOrderRemainder(
+	ctx sdk.Context,
+	params types.Params,
+	cachedAccKeeper cachedAccountKeeper,
+	orderSequence uint64,
+) error {
+	takerOrder, takerRecord, takerLimits, found, err := k.getClearedBatchAuctionOrder(
+		ctx, cachedAccKeeper, orderSequence,
+	)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, takerRecord.OrderBookID)
+	if err != nil {
+		return err
+	}
+	mf, err := k.NewMatchingFinder(ctx, takerRecord.OrderBookID, invertedOrderBookID, takerOrder)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := mf.Close(); err != nil {
+			k.logger(ctx).Error(err.Error())
+		}
+	}()
+
+	mr, err := NewMatchingResult(takerOrder)
+	if err != nil {
+		return err
+	}
+	mr.ReleaseTakerLimits(takerLimits)
+
+	feeRates, err := k.getOrderBookFeeRates(ctx, params, takerRecord.OrderBookID)
+	if err != nil {
+		return err
+	}
+	invertedFeeRates, err := k.getOrderBookFeeRates(ctx, params, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+
+	// the matching finder doesn't iterate the side of the taker record, so the record is kept in the order book
+	// during the matching and replaced only if the order is matched
+	recordBeforeMatching := takerRecord
+	takerIsFilled, err := k.matchTakerRecord(
+		ctx, cachedAccKeeper, mr, mf, &takerRecord, takerOrder, feeRates, invertedFeeRates,
+	)
+	if err != nil {
+		return err
+	}
+	if takerRecord.RemainingBaseQuantity.Equal(recordBeforeMatching.RemainingBaseQuantity) {
+		return nil
+	}
+
+	k.logger(ctx).Debug("Matched batch auction order remainder.", "order", takerOrder.String())
+
+	if err := k.deleteOrderByRecord(ctx, recordBeforeMatching); err != nil {
+		return err
+	}
+
+	return k.saveBatchAuctionOrderRemainder(ctx, params, mr, takerOrder, takerRecord, takerIsFilled)
+}
+
+// getClearedBatchAuctionOrder returns the order moved to the order book by the clearing with its record and limits,
+// and false if the order is already closed.
+func (k Keeper) getClearedBatchAuctionOrder(
+	ctx sdk.Context,
+	cachedAccKeeper cachedAccountKeeper,
+	orderSequence uint64,
+) (types.Order, types.OrderBookRecord, orderLimits, bool, error) {
+	orderData, err := k.getOrderData(ctx, orderSequence)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.Order{}, types.OrderBookRecord{}, orderLimits{}, false, nil
+		}
+		return types.Order{}, types.OrderBookRecord{}, orderLimits{}, false, err
+	}
+	record, err := k.getOrderBookRecord(ctx, orderSequence, orderData)
+	if err != nil {
+		return types.Order{}, types.OrderBookRecord{}, orderLimits{}, false, err
+	}
+	creator, err := cachedAccKeeper.getAccountAddressWithCache(ctx, record.AccountNumber)
+	if err != nil {
+		return types.Order{}, types.OrderBookRecord{}, orderLimits{}, false, err
+	}
+	order, record, err := k.getOrderWithRecordByAddressAndID(ctx, creator, orderData.OrderID)
+	if err != nil {
+		return types.Order{}, types.OrderBookRecord{}, orderLimits{}, false, err
+	}
+
+	lockedCoins, expectedToReceiveCoin, err := k.getMakerLockedAndExpectedToReceiveCoins(
+		ctx, &record, order.GetSpendDenom(), order.GetReceiveDenom(),
+	)
+	if err != nil {
+		return types.Order{}, types.OrderBookRecord{}, orderLimits{}, false, err
+	}
+
+	return order, record, orderLimits{
+		LockedCoins:           lockedCoins,
+		ExpectedToReceiveCoin: expectedToReceiveCoin,
+	}, true, nil
+}
+
+// saveBatchAuctionOrderRemainder saves the remaining part of the executed order back to the order book with the same
+// sequence, or closes the order if the remaining part isn't executable, and applies the matching result.
+func (k Keeper) saveBatchAuctionOrderRemainder(
+	ctx sdk.Context,
+	params types.Params,
+	mr *MatchingResult,
+	takerOrder types.Order,
+	takerRecord types.OrderBookRecord,
+	takerIsFilled bool,
+) error {
+	// unlike the regular taker the order is created by the clearing, so its closing is reported
+	if takerIsFilled || !isOrderRecordExecutableAsMaker(&takerRecord) {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderClosed{
+			Creator:                   takerOrder.Creator,
+			ID:                        takerOrder.ID,
+			Sequence:                  takerOrder.Sequence,
+			RemainingBaseQuantity:     takerRecord.RemainingBaseQuantity,
+			RemainingSpendableBalance: takerRecord.RemainingSpendableBalance,
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventOrderClosed: %s", err)
+		}
+	}
+
+	return k.saveTakerRemainder(ctx, params, mr, takerOrder, takerRecord, takerIsFilled)
+}
+
+// matchBatchAuctionRecords matches the taker and maker records at the clearing price up to their allotments, and
+// settles the trade as the regular matching.
+func (k Keeper) matchBatchAuctionRecords(
+	ctx sdk.Context,
+	cachedAccKeeper cachedAccountKeeper,
+	mr *MatchingResult,
+	makers *batchAuctionMakers,
+	clearing *batchAuctionClearing,
+	takerRecord, makerRecord *types.OrderBookRecord,
+	takerOrder types.Order,
+	feeRates, invertedFeeRates types.OrderBookFeeRates,
+) error {
+	k.logger(ctx).Debug(
+		"Matching batch auction OB records.",
+		"takerRecord", takerRecord.String(),
+		"makerRecord", makerRecord.String(),
+	)
+
+	isMakerInverted := takerRecord.Side == makerRecord.Side
+	// the taker quantity is limited by both allotments, and the maker price is replaced with the clearing price
+	allotment := cbig.RatMin(
+		clearing.allotments[takerRecord.OrderSequence],
+		clearing.allotments[makerRecord.OrderSequence],
+	)
+	takerRecordForMatching := newMatchingOBRecord(takerRecord, false)
+	takerRecordForMatching.BaseQuantity = cbig.RatMin(
+		takerRecordForMatching.BaseQuantity,
+		clearing.quantityForOrderBook(takerRecord.OrderBookID, allotment),
+	)
+	makerRecordForMatching := newMatchingOBRecord(makerRecord, isMakerInverted)
+	makerRecordForMatching.Price = clearing.priceForOrderBook(takerRecord.OrderBookID)
+	// the inverted maker quantity is converted at the clearing price, at its own price the maker would be closed
+	// before its remaining quantity is executed
+	if isMakerInverted {
+		makerRecordForMatching.BaseQuantity = cbig.RatMul(
+			cbig.NewRatFromBigInt(makerRecord.RemainingBaseQuantity.BigInt()),
+			clearing.priceForOrderBook(makerRecord.OrderBookID),
+		)
+	}
+	trade, closeResult := match(takerRecordForMatching, makerRecordForMatching)
+	k.logger(ctx).Debug(
+		"Matching result.",
+		"trade", trade,
+		"closeResult", closeResult.String(),
+	)
+	// the allotment is less than the minimal executable quantity at the clearing price
+	if trade.BaseQuantity.Sign() == 0 {
+		return nil
+	}
+
+	makerExpectedToReceiveBefore, err := types.ComputeLimitOrderExpectedToReceiveAmount(
+		makerRecord.Side, makerRecord.GetTotalRemainingBaseQuantity(), makerRecord.Price,
+	)
+	if err != nil {
+		return err
+	}
+	if err := k.settleMatchedRecords(
+		ctx,
+		cachedAccKeeper,
+		mr,
+		makers,
+		takerRecord,
+		makerRecord,
+		takerOrder,
+		feeRates,
+		invertedFeeRates,
+		trade,
+		closeResult,
+		clearing.priceOrderBookID,
+		clearing.orderBookPrice,
+	); err != nil {
+		return err
+	}
+	makerExpectedToReceiveAfter, err := types.ComputeLimitOrderExpectedToReceiveAmount(
+		makerRecord.Side, makerRecord.GetTotalRemainingBaseQuantity(), makerRecord.Price,
+	)
+	if err != nil {
+		return err
+	}
+
+	// the maker might receive more at the clearing price than expected at its price, so the expected to receive
+	// amount is corrected to be decreased exactly by the part expected for the executed quantity
+	makerAddr, err := cachedAccKeeper.getAccountAddressWithCache(ctx, makerRecord.AccountNumber)
+	if err != nil {
+		return err
+	}
+	correction := sdkmath.NewIntFromBigInt(trade.TakerSpends).
+		Sub(makerExpectedToReceiveBefore.Sub(makerExpectedToReceiveAfter))
+	if correction.IsPositive() {
+		mr.FTActions.AddIncreaseExpectedToReceive(makerAddr, sdk.NewCoin(takerOrder.GetSpendDenom(), correction))
+	} else if correction.IsNegative() {
+		mr.FTActions.AddDecreaseExpectedToReceive(
+			makerAddr, sdk.NewCoin(takerOrder.GetSpendDenom(), correction.Neg()),
+		)
+	}
+
+	executedQuantity := clearing.executedQuantity(takerRecord.OrderBookID, trade)
+	clearing.reduceAllotment(takerRecord.OrderSequence, executedQuantity)
+	clearing.reduceAllotment(makerRecord.OrderSequence, executedQuantity)
+
+	return nil
+}
+
+// cancelBatchAuctionOrderOrSkip cancels the order in the cache context, the order failing the cancellation is kept.
+func (k Keeper) cancelBatchAuctionOrderOrSkip(
+	ctx sdk.Context,
+	cachedAccKeeper cachedAccountKeeper,
+	orderSequence uint64,
+) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.cancelBatchAuctionOrder(cacheCtx, cachedAccKeeper, orderSequence); err != nil {
+		k.logger(ctx).Error(
+			"Failed to cancel batch auction order, skipping.", "orderSequence", orderSequence, "err", err,
+		)
+		return
+	}
+	writeCache()
+}
+
+// cancelBatchAuctionOrder cancels the order queued by the batch auction or moved to the order book by the clearing.
+func (k Keeper) cancelBatchAuctionOrder(
+	ctx sdk.Context,
+	cachedAccKeeper cachedAccountKeeper,
+	orderSequence uint64,
+) error {
+	order, found, err := k.findBatchAuctionOrder(ctx, orderSequence)
+	if err != nil {
+		return err
+	}
+	if found {
+		creator, err := sdk.AccAddressFromBech32(order.Creator)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+		}
+		return k.cancelOrder(ctx, creator, order.ID)
+	}
+
+	orderData, err := k.getOrderData(ctx, orderSequence)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	record, err := k.getOrderBookRecord(ctx, orderSequence, orderData)
+	if err != nil {
+		return err
+	}
+	creator, err := cachedAccKeeper.getAccountAddressWithCache(ctx, record.AccountNumber)
+	if err != nil {
+		return err
+	}
+
+	return k.cancelOrder(ctx, creator, orderData.OrderID)
+}
+
+// computeBatchAuctionOrderLimits returns the limits of the remaining part of the queued order including its reserve.
+func computeBatchAuctionOrderLimits(order types.Order) (orderLimits, error) {
+	lockedCoins := sdk.NewCoins(sdk.NewCoin(order.GetSpendDenom(), order.RemainingSpendableBalance))
+	expectedToReceiveCoin, err := types.ComputeLimitOrderExpectedToReceiveBalance(
+		order.Side, order.BaseDenom, order.QuoteDenom, order.RemainingBaseQuantity, *order.Price,
+	)
+	if err != nil {
+		return orderLimits{}, err
+	}
+	// unlock the reserve if present
+	if order.Reserve.IsPositive() {
+		lockedCoins = lockedCoins.Add(order.Reserve)
+	}
+
+	return orderLimits{
+		LockedCoins:           lockedCoins,
+		ExpectedToReceiveCoin: expectedToReceiveCoin,
+	}, nil
+}
+
+// moveBatchAuctionOrderToOrderBook removes the order from the batch auction queue and saves it to the order book with
+// the same sequence, the locked balances, the order ID and the good til cancellation of the order are kept.
+func (k Keeper) moveBatchAuctionOrderToOrderBook(ctx sdk.Context, orderSequence uint64) error {
+	order, err := k.getBatchAuctionOrder(ctx, orderSequence)
+	if err != nil {
+		return err
+	}
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+	}
+	accNumber, err := k.getAccountNumber(ctx, creator)
+	if err != nil {
+		return err
+	}
+
+	orderBookID, err := k.removeBatchAuctionOrderFromQueue(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	record := types.OrderBookRecord{
+		OrderBookID:               orderBookID,
+		Side:                      order.Side,
+		Price:                     *order.Price,
+		OrderSequence:             order.Sequence,
+		OrderID:                   order.ID,
+		AccountNumber:             accNumber,
+		RemainingBaseQuantity:     order.RemainingBaseQuantity,
+		RemainingSpendableBalance: order.RemainingSpendableBalance,
+	}
+	if err := k.saveOrderBookRecord(ctx, record); err != nil {
+		return err
+	}
+	if err := k.saveOrderData(ctx, record.OrderSequence, types.OrderData{
+		OrderID:     order.ID,
+		OrderBookID: record.OrderBookID,
+		Price:       *order.Price,
+		Quantity:    order.Quantity,
+		Side:        order.Side,
+		GoodTil:     order.GoodTil,
+		Reserve:     order.Reserve,
+		TimeInForce: order.TimeInForce,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderCreated{
+		Creator:                   order.Creator,
+		ID:                        order.ID,
+		Sequence:                  order.Sequence,
+		RemainingBaseQuantity:     record.RemainingBaseQuantity,
+		RemainingSpendableBalance: record.RemainingSpendableBalance,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventOrderCreated: %s", err)
+	}
+
+	return nil
+}
+
+// returnBatchAuctionOrderToQueue removes the order moved to the order book by the clearing from the order book and
+// saves it back to the batch auction queue with its remaining quantity, the locked balances, the order ID and the
+// good til cancellation of the order are kept.
+func (k Keeper) returnBatchAuctionOrderToQueue(
+	ctx sdk.Context,
+	cachedAccKeeper cachedAccountKeeper,
+	orderSequence uint64,
+) error {
+	order, record, _, found, err := k.getClearedBatchAuctionOrder(ctx, cachedAccKeeper, orderSequence)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	k.logger(ctx).Debug("Returning batch auction order to the queue.", "order", order.String())
+
+	if err := k.removeOrderBookRecord(
+		ctx, record.OrderBookID, record.Side, record.Price, record.GetPrioritySequence(),
+	); err != nil {
+		return err
+	}
+	if err := k.removeOrderData(ctx, orderSequence); err != nil {
+		return err
+	}
+
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, record.OrderBookID)
+	if err != nil {
+		return err
+	}
+
+	return k.saveBatchAuctionOrderToQueue(ctx, record.OrderBookID, invertedOrderBookID, order)
+}
+
+func (k Keeper) saveBatchAuctionOrder(
+	ctx sdk.Context,
+	accNumber uint64,
+	orderBookID, invertedOrderBookID uint32,
+	order types.Order,
+) error {
+	if err := k.saveBatchAuctionOrderToQueue(ctx, orderBookID, invertedOrderBookID, order); err != nil {
+		return err
+	}
+
+	return k.saveOffBookOrderIndexes(ctx, accNumber, order)
+}
+
+func (k Keeper) removeBatchAuctionOrder(ctx sdk.Context, accNumber uint64, order types.Order) error {
+	if _, err := k.removeBatchAuctionOrderFromQueue(ctx, order); err != nil {
+		return err
+	}
+
+	return k.removeOffBookOrderIndexes(ctx, accNumber, order)
+}
+
+// saveBatchAuctionOrderToQueue saves the order to the batch auction queue of the order book, the order indexes aren't
+// saved.
+func (k Keeper) saveBatchAuctionOrderToQueue(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	order types.Order,
+) error {
+	pairOrderBookID := getOrderBookPairID(orderBookID, invertedOrderBookID)
+	if err := k.storeService.OpenKVStore(ctx).Set(
+		types.CreateBatchAuctionOrderBookRecordKey(pairOrderBookID, order.Sequence), types.StoreTrue,
+	); err != nil {
+		return err
+	}
+	if _, err := k.incrementUint64Counter(ctx, types.CreateBatchAuctionOrderCountKey(pairOrderBookID)); err != nil {
+		return err
+	}
+
+	return k.setDataToStore(ctx, types.CreateBatchAuctionOrderKey(order.Sequence), &order)
+}
+
+// removeBatchAuctionOrderFromQueue removes the order from the batch auction queue and returns the ID of its order book,
+// the order indexes are kept.
+func (k Keeper) removeBatchAuctionOrderFromQueue(ctx sdk.Context, order types.Order) (uint32, error) {
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, order.BaseDenom, order.QuoteDenom)
+	if err != nil {
+		return 0, err
+	}
+	invertedOrderBookID, err := k.getOrderBookIDByDenoms(ctx, order.QuoteDenom, order.BaseDenom)
+	if err != nil {
+		return 0, err
+	}
+	pairOrderBookID := getOrderBookPairID(orderBookID, invertedOrderBookID)
+
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	if err := moduleStore.Delete(
+		types.CreateBatchAuctionOrderBookRecordKey(pairOrderBookID, order.Sequence),
+	); err != nil {
+		return 0, err
+	}
+	if err := moduleStore.Delete(types.CreateBatchAuctionOrderKey(order.Sequence)); err != nil {
+		return 0, err
+	}
+
+	// the order book without the queued orders is removed from the clearing
+	countKey := types.CreateBatchAuctionOrderCountKey(pairOrderBookID)
+	size, err := k.decrementUint64Counter(ctx, countKey)
+	if err != nil {
+		return 0, err
+	}
+	if size == 0 {
+		if err := moduleStore.Delete(countKey); err != nil {
+			return 0, err
+		}
+	}
+
+	return orderBookID, nil
+}
+
+func (k Keeper) getBatchAuctionOrder(ctx sdk.Context, orderSequence uint64) (types.Order, error) {
+	var val types.Order
+	if err := k.getDataFromStore(ctx, types.CreateBatchAuctionOrderKey(orderSequence), &val); err != nil {
+		return types.Order{}, sdkerrors.Wrapf(
+			err, "failed to get batch auction order, orderSequence: %d", orderSequence,
+		)
+	}
+	return val, nil
+}
+
+// findBatchAuctionOrder returns the order queued by the batch auction by sequence and false if the order is not
+// queued.
+func (k Keeper) findBatchAuctionOrder(ctx sdk.Context, orderSequence uint64) (types.Order, bool, error) {
+	order, err := k.getBatchAuctionOrder(ctx, orderSequence)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.Order{}, false, nil
+		}
+		return types.Order{}, false, err
+	}
+
+	return order, true, nil
+}
+
+// isOrderBookHaltedOrClosed returns true if the order book doesn't pass the validation of the order placement, since
+// it's halted or closed, so its orders can't be matched.
+func (k Keeper) isOrderBookHaltedOrClosed(ctx sdk.Context, orderBookID, invertedOrderBookID uint32) (bool, error) {
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return false, err
+	}
+	if err := k.validateOrderBookIsOpen(
+		ctx, orderBookID, invertedOrderBookID, orderBookData.BaseDenom, orderBookData.QuoteDenom,
+	); err != nil {
+		if sdkerrors.IsOf(err, types.ErrOrderBookHalted, types.ErrOrderBookClosed) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	return false, nil
+}
+
+func (k Keeper) isOrderBookInBatchAuction(ctx sdk.Context, orderBookID, invertedOrderBookID uint32) (bool, error) {
+	return k.storeService.OpenKVStore(ctx).Has(
+		types.CreateOrderBookBatchAuctionKey(getOrderBookPairID(orderBookID, invertedOrderBookID)),
+	)
+}
+
+func (k Keeper) validateOrderBookIsNotInBatchAuction(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	baseDenom, quoteDenom string,
+) error {
+	batchAuction, err := k.isOrderBookInBatchAuction(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	if batchAuction {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "order book %s/%s is in the batch auction mode", baseDenom, quoteDenom,
+		)
+	}
+
+	return nil
+}
+
+// validateBatchAuctionOrder validates that the order can be queued by the batch auction, only the GTC limit orders
+// can wait for the clearing.
+func validateBatchAuctionOrder(order types.Order) error {
+	if order.Type != types.ORDER_TYPE_LIMIT {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "order type %s is not supported in the batch auction mode", order.Type.String(),
+		)
+	}
+	if order.TimeInForce != types.TIME_IN_FORCE_GTC {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"time in force %s is not supported in the batch auction mode", order.TimeInForce.String(),
+		)
+	}
+	if order.Trigger != nil {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "trigger orders are not supported in the batch auction mode")
+	}
+	if order.VisibleQuantity != nil {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "iceberg orders are not supported in the batch auction mode")
+	}
+	if order.SelfTradePrevention != types.SELF_TRADE_PREVENTION_UNSPECIFIED {
+		return sdkerrors.Wrap(
+			types.ErrInvalidInput, "self-trade prevention is not supported in the batch auction mode",
+		)
+	}
+
+	return nil
+}
+
+func newBatchAuctionParticipant(orderBookID uint32, record types.OrderBookRecord) batchAuctionParticipant {
+	matchingRecord := newMatchingOBRecord(&record, record.OrderBookID != orderBookID)
+	return batchAuctionParticipant{
+		record:   record,
+		side:     matchingRecord.Side,
+		price:    matchingRecord.Price,
+		quantity: matchingRecord.BaseQuantity,
+	}
+}
+
+// computeBatchAuctionClearing computes the clearing price maximizing the executed quantity, if there are several
+// prices with the same quantity, the price with the minimal imbalance between the buy and sell quantities is used,
+// and then the highest price for the buy surplus or the lowest price otherwise.
+//
+// The participants on the side with the lower quantity are executed fully, on the other side the participants with
+// the better prices are executed fully and the participants with the clearing price share the rest pro-rata.
+func computeBatchAuctionClearing(
+	orderBookID uint32,
+	participants []batchAuctionParticipant,
+) (batchAuctionClearing, bool) {
+	buys := make([]batchAuctionParticipant, 0)
+	sells := make([]batchAuctionParticipant, 0)
+	totalBuyQuantity := big.NewRat(0, 1)
+	for _, participant := range participants {
+		if participant.side == buyOrderSide {
+			buys = append(buys, participant)
+			totalBuyQuantity = new(big.Rat).Add(totalBuyQuantity, participant.quantity)
+		} else {
+			sells = append(sells, participant)
+		}
+	}
+	if len(buys) == 0 || len(sells) == 0 {
+		return batchAuctionClearing{}, false
+	}
+
+	candidates := sortBatchAuctionParticipantsByPrice(participants)
+	buys = sortBatchAuctionParticipantsByPrice(buys)
+	sells = sortBatchAuctionParticipantsByPrice(sells)
+
+	var (
+		best                                   *batchAuctionParticipant
+		bestBuyQuantity, bestSellQuantity      *big.Rat
+		bestQuantity, bestImbalance            *big.Rat
+		buyIndex, sellIndex                    int
+		lowerBuyQuantity, sellQuantityForPrice = big.NewRat(0, 1), big.NewRat(0, 1)
+	)
+	for i := range candidates {
+		price := candidates[i].price
+		for ; buyIndex < len(buys) && cbig.RatLT(buys[buyIndex].price, price); buyIndex++ {
+			lowerBuyQuantity = new(big.Rat).Add(lowerBuyQuantity, buys[buyIndex].quantity)
+		}
+		for ; sellIndex < len(sells) && cbig.RatLTE(sells[sellIndex].price, price); sellIndex++ {
+			sellQuantityForPrice = new(big.Rat).Add(sellQuantityForPrice, sells[sellIndex].quantity)
+		}
+		buyQuantityForPrice := new(big.Rat).Sub(totalBuyQuantity, lowerBuyQuantity)
+
+		quantity := cbig.RatMin(buyQuantityForPrice, sellQuantityForPrice)
+		imbalance := new(big.Rat).Abs(new(big.Rat).Sub(buyQuantityForPrice, sellQuantityForPrice))
+		if best != nil {
+			switch quantity.Cmp(bestQuantity) {
+			case -1:
+				continue
+			case 0:
+				switch imbalance.Cmp(bestImbalance) {
+				case 1:
+					continue
+				case 0:
+					// the candidates are sorted by price, so the higher price replaces the lower one for buy surplus
+					if !cbig.RatGT(buyQuantityForPrice, sellQuantityForPrice) {
+						continue
+					}
+				}
+			}
+		}
+
+		best = &candidates[i]
+		bestBuyQuantity, bestSellQuantity = buyQuantityForPrice, sellQuantityForPrice
+		bestQuantity, bestImbalance = quantity, imbalance
+	}
+	if best == nil || bestQuantity.Sign() == 0 {
+		return batchAuctionClearing{}, false
+	}
+
+	clearing := batchAuctionClearing{
+		orderBookID:      orderBookID,
+		price:            best.price,
+		priceOrderBookID: best.record.OrderBookID,
+		orderBookPrice:   best.record.Price,
+		allotments:       make(map[uint64]*big.Rat),
+	}
+
+	// the short side is executed fully
+	longSideParticipants, shortSideParticipants := buys, sells
+	if cbig.RatGT(bestSellQuantity, bestBuyQuantity) {
+		longSideParticipants, shortSideParticipants = sells, buys
+	}
+	for _, participant := range shortSideParticipants {
+		if crossesBatchAuctionPrice(participant, clearing.price) {
+			clearing.allotments[participant.record.OrderSequence] = participant.quantity
+		}
+	}
+
+	// the better prices of the long side are executed fully, and the clearing price shares the rest
+	rest := bestQuantity
+	boundaryQuantity := big.NewRat(0, 1)
+	for _, participant := range longSideParticipants {
+		if !crossesBatchAuctionPrice(participant, clearing.price) {
+			continue
+		}
+		if cbig.RatEQ(participant.price, clearing.price) {
+			boundaryQuantity = new(big.Rat).Add(boundaryQuantity, participant.quantity)
+			continue
+		}
+		clearing.allotments[participant.record.OrderSequence] = participant.quantity
+		rest = new(big.Rat).Sub(rest, participant.quantity)
+	}
+	if rest.Sign() > 0 && boundaryQuantity.Sign() > 0 {
+		for _, participant := range longSideParticipants {
+			if !cbig.RatEQ(participant.price, clearing.price) {
+				continue
+			}
+			clearing.allotments[participant.record.OrderSequence] = cbig.RatMul(
+				participant.quantity, cbig.RatDiv(rest, boundaryQuantity),
+			)
+		}
+	}
+
+	return clearing, true
+}
+
+func sortBatchAuctionParticipantsByPrice(participants []batchAuctionParticipant) []batchAuctionParticipant {
+	sorted := make([]batchAuctionParticipant, len(participants))
+	copy(sorted, participants)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return cbig.RatLT(sorted[i].price, sorted[j].price)
+	})
+
+	return sorted
+}
+
+func crossesBatchAuctionPrice(participant batchAuctionParticipant, price *big.Rat) bool {
+	if participant.side == buyOrderSide {
+		return cbig.RatGTE(participant.price, price)
+	}
+
+	return cbig.RatLTE(participant.price, price)
+}
+
+func newBatchAuctionCounterparties(
+	clearing *batchAuctionClearing,
+	participants []batchAuctionParticipant,
+) batchAuctionCounterparties {
+	buys := make([]batchAuctionParticipant, 0)
+	sells := make([]batchAuctionParticipant, 0)
+	for _, participant := range participants {
+		if allotment, ok := clearing.allotments[participant.record.OrderSequence]; !ok || allotment.Sign() <= 0 {
+			continue
+		}
+		if participant.side == buyOrderSide {
+			buys = append(buys, participant)
+		} else {
+			sells = append(sells, participant)
+		}
+	}
+
+	// the best prices go first, and the earlier placed records within the same price
+	sortByPriority := func(participants []batchAuctionParticipant, better func(a, b *big.Rat) bool) []uint64 {
+		sort.SliceStable(participants, func(i, j int) bool {
+			if !cbig.RatEQ(participants[i].price, participants[j].price) {
+				return better(participants[i].price, participants[j].price)
+			}
+			return participants[i].record.GetPrioritySequence() < participants[j].record.GetPrioritySequence()
+		})
+		orderSequences := make([]uint64, 0, len(participants))
+		for _, participant := range participants {
+			orderSequences = append(orderSequences, participant.record.OrderSequence)
+		}
+		return orderSequences
+	}
+
+	return batchAuctionCounterparties{
+		buys:  sortByPriority(buys, cbig.RatGT),
+		sells: sortByPriority(sells, cbig.RatLT),
+	}
+}
+
+// forTaker returns the counterparties of the taker with the side in the terms of the clearing order book.
+func (c *batchAuctionCounterparties) forTaker(side OrderSide) []uint64 {
+	if side == buyOrderSide {
+		return c.sells
+	}
+
+	return c.buys
+}
+
+// pruneExecuted removes the executed counterparties from the head of the queues, the counterparties are executed in
+// the priority order, so the executed ones aren't iterated by the next takers.
+func (c *batchAuctionCounterparties) pruneExecuted(clearing *batchAuctionClearing) {
+	prune := func(orderSequences []uint64) []uint64 {
+		for len(orderSequences) > 0 && clearing.allotments[orderSequences[0]].Sign() <= 0 {
+			orderSequences = orderSequences[1:]
+		}
+		return orderSequences
+	}
+	c.buys = prune(c.buys)
+	c.sells = prune(c.sells)
+}
+
+// Next returns the next counterparty record with the positive allotment.
+func (m *batchAuctionMakers) Next() (types.OrderBookRecord, bool, error) {
+	if len(m.requeued) > 0 {
+		record := m.requeued[0]
+		m.requeued = m.requeued[1:]
+		return record, true, nil
+	}
+
+	for ; m.next < len(m.orderSequences); m.next++ {
+		orderSequence := m.orderSequences[m.next]
+		if m.clearing.allotments[orderSequence].Sign() <= 0 {
+			continue
+		}
+		orderData, err := m.k.getOrderData(m.ctx, orderSequence)
+		if err != nil {
+			// the counterparty is canceled because of the failed execution
+			if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+				continue
+			}
+			return types.OrderBookRecord{}, false, err
+		}
+		record, err := m.k.getOrderBookRecord(m.ctx, orderSequence, orderData)
+		if err != nil {
+			return types.OrderBookRecord{}, false, err
+		}
+		m.next++
+		return record, true, nil
+	}
+
+	return types.OrderBookRecord{}, false, nil
+}
+
+// Requeue returns the refilled iceberg record to be matched again by the same taker.
+func (m *batchAuctionMakers) Requeue(record types.OrderBookRecord) {
+	m.requeued = append(m.requeued, record)
+}
+
+// priceForOrderBook returns the clearing price in the terms of the order book.
+func (c *batchAuctionClearing) priceForOrderBook(orderBookID uint32) *big.Rat {
+	if orderBookID == c.orderBookID {
+		return c.price
+	}
+
+	return cbig.RatInv(c.price)
+}
+
+// quantityForOrderBook converts the clearing order book base quantity to the base quantity of the order book.
+func (c *batchAuctionClearing) quantityForOrderBook(orderBookID uint32, quantity *big.Rat) *big.Rat {
+	if orderBookID == c.orderBookID {
+		return quantity
+	}
+
+	return cbig.RatMul(quantity, c.price)
+}
+
+// executedQuantity returns the clearing order book base quantity of the trade executed in the order book.
+func (c *batchAuctionClearing) executedQuantity(orderBookID uint32, trade Trade) *big.Rat {
+	if orderBookID == c.orderBookID {
+		return cbig.NewRatFromBigInt(trade.BaseQuantity)
+	}
+
+	return cbig.NewRatFromBigInt(trade.QuoteQuantity)
+}
+
+func (c *batchAuctionClearing) reduceAllotment(orderSequence uint64, quantity *big.Rat) {
+	allotment, ok := c.allotments[orderSequence]
+	if !ok {
+		return
+	}
+	c.allotments[orderSequence] = cbig.RatMax(new(big.Rat).Sub(allotment, quantity), big.NewRat(0, 1))
+}
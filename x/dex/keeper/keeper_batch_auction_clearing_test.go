@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+type testBatchAuctionOrder struct {
+	orderBookID uint32
+	sequence    uint64
+	side        types.Side
+	price       string
+	quantity    int64
+}
+
+func Test_computeBatchAuctionClearing(t *testing.T) {
+	tests := []struct {
+		name                 string
+		orders               []testBatchAuctionOrder
+		wantFound            bool
+		wantPrice            *big.Rat
+		wantPriceOrderBookID uint32
+		wantOrderBookPrice   string
+		wantAllotments       map[uint64]*big.Rat
+	}{
+		{
+			name: "no_crossing",
+			orders: []testBatchAuctionOrder{
+				{sequence: 1, side: types.SIDE_BUY, price: "1", quantity: 10},
+				{sequence: 2, side: types.SIDE_SELL, price: "2", quantity: 10},
+			},
+			wantFound: false,
+		},
+		{
+			name: "one_side_only",
+			orders: []testBatchAuctionOrder{
+				{sequence: 1, side: types.SIDE_BUY, price: "2", quantity: 10},
+				{sequence: 2, side: types.SIDE_BUY, price: "1", quantity: 10},
+			},
+			wantFound: false,
+		},
+		{
+			name: "max_quantity_and_highest_price_for_buy_surplus",
+			orders: []testBatchAuctionOrder{
+				{sequence: 1, side: types.SIDE_BUY, price: "3", quantity: 10},
+				{sequence: 2, side: types.SIDE_BUY, price: "2", quantity: 10},
+				{sequence: 3, side: types.SIDE_SELL, price: "1", quantity: 15},
+				{sequence: 4, side: types.SIDE_SELL, price: "25e-1", quantity: 10},
+			},
+			wantFound:          true,
+			wantPrice:          big.NewRat(2, 1),
+			wantOrderBookPrice: "2",
+			wantAllotments: map[uint64]*big.Rat{
+				1: big.NewRat(10, 1),
+				2: big.NewRat(5, 1),
+				3: big.NewRat(15, 1),
+			},
+		},
+		{
+			name: "min_imbalance",
+			orders: []testBatchAuctionOrder{
+				{sequence: 1, side: types.SIDE_BUY, price: "2", quantity: 10},
+				{sequence: 2, side: types.SIDE_SELL, price: "1", quantity: 10},
+				{sequence: 3, side: types.SIDE_SELL, price: "2", quantity: 5},
+			},
+			wantFound:          true,
+			wantPrice:          big.NewRat(1, 1),
+			wantOrderBookPrice: "1",
+			wantAllotments: map[uint64]*big.Rat{
+				1: big.NewRat(10, 1),
+				2: big.NewRat(10, 1),
+			},
+		},
+		{
+			name: "lowest_price_for_sell_surplus",
+			orders: []testBatchAuctionOrder{
+				{sequence: 1, side: types.SIDE_BUY, price: "3", quantity: 10},
+				{sequence: 2, side: types.SIDE_SELL, price: "1", quantity: 20},
+			},
+			wantFound:          true,
+			wantPrice:          big.NewRat(1, 1),
+			wantOrderBookPrice: "1",
+			wantAllotments: map[uint64]*big.Rat{
+				1: big.NewRat(10, 1),
+				2: big.NewRat(10, 1),
+			},
+		},
+		{
+			name: "highest_price_for_buy_surplus",
+			orders: []testBatchAuctionOrder{
+				{sequence: 1, side: types.SIDE_BUY, price: "3", quantity: 20},
+				{sequence: 2, side: types.SIDE_SELL, price: "1", quantity: 10},
+			},
+			wantFound:          true,
+			wantPrice:          big.NewRat(3, 1),
+			wantOrderBookPrice: "3",
+			wantAllotments: map[uint64]*big.Rat{
+				1: big.NewRat(10, 1),
+				2: big.NewRat(10, 1),
+			},
+		},
+		{
+			name: "pro_rata_at_clearing_price",
+			orders: []testBatchAuctionOrder{
+				{sequence: 1, side: types.SIDE_BUY, price: "2", quantity: 10},
+				{sequence: 2, side: types.SIDE_BUY, price: "2", quantity: 30},
+				{sequence: 3, side: types.SIDE_SELL, price: "1", quantity: 20},
+			},
+			wantFound:          true,
+			wantPrice:          big.NewRat(2, 1),
+			wantOrderBookPrice: "2",
+			wantAllotments: map[uint64]*big.Rat{
+				1: big.NewRat(5, 1),
+				2: big.NewRat(15, 1),
+				3: big.NewRat(20, 1),
+			},
+		},
+		{
+			name: "inverted_order_book_participant",
+			orders: []testBatchAuctionOrder{
+				// buys 10 at 2 in terms of the clearing order book
+				{orderBookID: 1, sequence: 1, side: types.SIDE_SELL, price: "5e-1", quantity: 20},
+				{sequence: 2, side: types.SIDE_SELL, price: "1", quantity: 10},
+			},
+			wantFound:          true,
+			wantPrice:          big.NewRat(1, 1),
+			wantOrderBookPrice: "1",
+			wantAllotments: map[uint64]*big.Rat{
+				1: big.NewRat(10, 1),
+				2: big.NewRat(10, 1),
+			},
+		},
+		{
+			name: "price_from_inverted_order_book",
+			orders: []testBatchAuctionOrder{
+				// buys 20 at 2 in terms of the clearing order book
+				{orderBookID: 1, sequence: 1, side: types.SIDE_SELL, price: "5e-1", quantity: 40},
+				{sequence: 2, side: types.SIDE_SELL, price: "1", quantity: 10},
+			},
+			wantFound:            true,
+			wantPrice:            big.NewRat(2, 1),
+			wantPriceOrderBookID: 1,
+			wantOrderBookPrice:   "5e-1",
+			wantAllotments: map[uint64]*big.Rat{
+				1: big.NewRat(10, 1),
+				2: big.NewRat(10, 1),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			participants := make([]batchAuctionParticipant, 0, len(tt.orders))
+			for _, order := range tt.orders {
+				participants = append(participants, newBatchAuctionParticipant(0, types.OrderBookRecord{
+					OrderBookID:               order.orderBookID,
+					Side:                      order.side,
+					Price:                     types.MustNewPriceFromString(order.price),
+					OrderSequence:             order.sequence,
+					RemainingBaseQuantity:     sdkmath.NewInt(order.quantity),
+					RemainingSpendableBalance: sdkmath.NewInt(order.quantity),
+				}))
+			}
+
+			clearing, found := computeBatchAuctionClearing(0, participants)
+			require.Equal(t, tt.wantFound, found)
+			if !tt.wantFound {
+				return
+			}
+			assert.Equal(t, tt.wantPrice.String(), clearing.price.String())
+			assert.Equal(t, tt.wantPriceOrderBookID, clearing.priceOrderBookID)
+			assert.Equal(t, tt.wantOrderBookPrice, clearing.orderBookPrice.String())
+			require.Len(t, clearing.allotments, len(tt.wantAllotments))
+			for sequence, want := range tt.wantAllotments {
+				actual, ok := clearing.allotments[sequence]
+				require.True(t, ok, "allotment of order %d not found", sequence)
+				assert.Equal(t, want.String(), actual.String(), "allotment of order %d", sequence)
+			}
+		})
+	}
+}
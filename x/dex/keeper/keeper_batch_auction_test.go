@@ -0,0 +1,592 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_BatchAuction(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	// only the governance can enable the batch auction
+	require.ErrorIs(t, dexKeeper.SetOrderBookBatchAuction(
+		sdkCtx, testSet.acc1.String(), testSet.denom1, testSet.denom2, true,
+	), govtypes.ErrInvalidSigner)
+
+	require.NoError(t, dexKeeper.SetOrderBookBatchAuction(
+		sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2, true,
+	))
+	require.ErrorIs(t, dexKeeper.SetOrderBookBatchAuction(
+		sdkCtx, govAddr.String(), testSet.denom2, testSet.denom1, true,
+	), types.ErrInvalidInput)
+
+	// the orders which can't wait for the clearing are rejected
+	require.ErrorIs(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "ioc",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}), types.ErrInvalidInput)
+
+	quantity := defaultQuantityStep.MulRaw(2)
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    quantity,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, sellOrder)
+	buyOrder1 := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "buy",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+		Quantity:    quantity,
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, buyOrder1)
+	buyOrder2 := buyOrder1
+	buyOrder2.Creator = testSet.acc3.String()
+	placeFundedOrder(t, sdkCtx, testApp, buyOrder2)
+
+	// the crossing orders aren't matched until the end of the block, and are kept out of the order book
+	for _, order := range []types.Order{sellOrder, buyOrder1, buyOrder2} {
+		placedOrder, err := dexKeeper.GetOrderByAddressAndID(
+			sdkCtx, sdk.MustAccAddressFromBech32(order.Creator), order.ID,
+		)
+		require.NoError(t, err)
+		require.Equal(t, quantity.String(), placedOrder.RemainingBaseQuantity.String())
+	}
+	for _, side := range []types.Side{types.SIDE_BUY, types.SIDE_SELL} {
+		orders, _, err := dexKeeper.GetOrderBookOrders(
+			sdkCtx, testSet.denom1, testSet.denom2, side, &query.PageRequest{},
+		)
+		require.NoError(t, err)
+		require.Empty(t, orders)
+	}
+	batchAuctionOrderSequences, err := dexKeeper.GetBatchAuctionOrderSequences(sdkCtx)
+	require.NoError(t, err)
+	require.Len(t, batchAuctionOrderSequences, 3)
+
+	_, err = testApp.EndBlocker(sdkCtx)
+	require.NoError(t, err)
+
+	// all the queued orders are cleared in the block, and the remaining parts are moved to the order book
+	batchAuctionOrderSequences, err = dexKeeper.GetBatchAuctionOrderSequences(sdkCtx)
+	require.NoError(t, err)
+	require.Empty(t, batchAuctionOrderSequences)
+	sellOrders, _, err := dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_SELL, &query.PageRequest{},
+	)
+	require.NoError(t, err)
+	require.Empty(t, sellOrders)
+	buyOrders, _, err := dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_BUY, &query.PageRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, buyOrders, 2)
+
+	// the sell order is executed fully at the clearing price and the buy orders share it pro-rata
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, sellOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.Equal(
+		t,
+		quantity.MulRaw(2).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc1, testSet.denom2).Amount.String(),
+	)
+	for _, order := range []types.Order{buyOrder1, buyOrder2} {
+		creator := sdk.MustAccAddressFromBech32(order.Creator)
+		placedOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, creator, order.ID)
+		require.NoError(t, err)
+		require.Equal(t, defaultQuantityStep.String(), placedOrder.RemainingBaseQuantity.String())
+		require.Equal(
+			t,
+			defaultQuantityStep.String(),
+			testApp.BankKeeper.GetBalance(sdkCtx, creator, testSet.denom1).Amount.String(),
+		)
+		require.Equal(
+			t,
+			defaultQuantityStep.MulRaw(2).String(),
+			testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, creator, testSet.denom2).Amount.String(),
+		)
+	}
+
+	// the batch auction can be disabled through any of the order books
+	require.NoError(t, dexKeeper.SetOrderBookBatchAuction(
+		sdkCtx, govAddr.String(), testSet.denom2, testSet.denom1, false,
+	))
+	batchAuctionOrderBookIDs, err := dexKeeper.GetBatchAuctionOrderBookIDs(sdkCtx)
+	require.NoError(t, err)
+	require.Empty(t, batchAuctionOrderBookIDs)
+}
+
+func TestKeeper_BatchAuctionClosedOrderBook(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+
+	require.NoError(t, dexKeeper.SetOrderBookBatchAuction(
+		sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2, true,
+	))
+
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, sellOrder)
+	require.Equal(
+		t,
+		defaultQuantityStep.String(),
+		testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).Amount.String(),
+	)
+
+	// the queued orders of the order book closed before the clearing are canceled at the end of the block
+	require.NoError(t, dexKeeper.CloseOrderBook(sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2))
+	_, err = testApp.EndBlocker(sdkCtx)
+	require.NoError(t, err)
+
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, sellOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	batchAuctionOrderSequences, err := dexKeeper.GetBatchAuctionOrderSequences(sdkCtx)
+	require.NoError(t, err)
+	require.Empty(t, batchAuctionOrderSequences)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).IsZero())
+	require.True(
+		t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, params.OrderReserve.Denom).IsZero(),
+	)
+	ordersCount, err := dexKeeper.GetAccountDenomOrdersCount(sdkCtx, testSet.acc1, testSet.denom1)
+	require.NoError(t, err)
+	require.Zero(t, ordersCount)
+}
+
+func TestKeeper_BatchAuctionCircuitBreaker(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.CircuitBreakerPriceChangeRate = sdkmath.LegacyMustNewDecFromStr("0.1")
+	params.CircuitBreakerWindowBlocks = 10
+	params.CircuitBreakerHaltBlocks = 5
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	// the trade at the price 1 starts the circuit breaker window
+	for _, order := range []types.Order{
+		{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "reference-sell",
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		{
+			Creator:     testSet.acc2.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "reference-buy",
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_IOC,
+		},
+	} {
+		placeFundedOrder(t, sdkCtx, testApp, order)
+	}
+
+	require.NoError(t, dexKeeper.SetOrderBookBatchAuction(
+		sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2, true,
+	))
+
+	buyOrder1 := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "buy",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, buyOrder1)
+	buyOrder2 := buyOrder1
+	buyOrder2.Creator = testSet.acc3.String()
+	placeFundedOrder(t, sdkCtx, testApp, buyOrder2)
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+		Quantity:    defaultQuantityStep.MulRaw(2),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, sellOrder)
+
+	// the execution of the first order at the clearing price 2 halts the order book, so the clearing is stopped
+	_, err = testApp.EndBlocker(sdkCtx)
+	require.NoError(t, err)
+
+	halt, halted, err := dexKeeper.GetOrderBookHalt(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.True(t, halted)
+	require.Equal(t, types.OrderBookHalt{ResumeHeight: 6}, halt)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, buyOrder1.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// the orders left are returned to the queue, so the halted order book isn't crossed
+	for _, side := range []types.Side{types.SIDE_BUY, types.SIDE_SELL} {
+		orders, _, err := dexKeeper.GetOrderBookOrders(
+			sdkCtx, testSet.denom1, testSet.denom2, side, &query.PageRequest{},
+		)
+		require.NoError(t, err)
+		require.Empty(t, orders)
+	}
+	batchAuctionOrderSequences, err := dexKeeper.GetBatchAuctionOrderSequences(sdkCtx)
+	require.NoError(t, err)
+	require.Len(t, batchAuctionOrderSequences, 2)
+	placedSellOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, sellOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, defaultQuantityStep.String(), placedSellOrder.RemainingBaseQuantity.String())
+	require.Equal(
+		t,
+		defaultQuantityStep.String(),
+		testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).Amount.String(),
+	)
+
+	// the queue is cleared once the order book is resumed
+	for height := int64(2); height <= 7; height++ {
+		sdkCtx = testApp.NewContextLegacy(false, cmtproto.Header{
+			Height: height,
+		})
+		_, err := testApp.BeginBlocker(sdkCtx)
+		require.NoError(t, err)
+		_, err = testApp.EndBlocker(sdkCtx)
+		require.NoError(t, err)
+	}
+
+	batchAuctionOrderSequences, err = dexKeeper.GetBatchAuctionOrderSequences(sdkCtx)
+	require.NoError(t, err)
+	require.Empty(t, batchAuctionOrderSequences)
+	for _, order := range []types.Order{buyOrder2, sellOrder} {
+		_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, sdk.MustAccAddressFromBech32(order.Creator), order.ID)
+		require.ErrorIs(t, err, types.ErrRecordNotFound)
+	}
+	require.Equal(
+		t,
+		defaultQuantityStep.String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc3, testSet.denom1).Amount.String(),
+	)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).IsZero())
+}
+
+func TestKeeper_BatchAuctionMaxOrders(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MaxBatchAuctionOrders = 1
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	require.NoError(t, dexKeeper.SetOrderBookBatchAuction(
+		sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2, true,
+	))
+
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, sellOrder)
+
+	// the queue of the order book and its inverted order book is full
+	buyOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "buy",
+		BaseDenom:   testSet.denom2,
+		QuoteDenom:  testSet.denom1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	lockedBalance, err := buyOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc2, sdk.NewCoins(lockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc2)
+	require.ErrorIs(t, dexKeeper.PlaceOrder(sdkCtx, buyOrder), types.ErrInvalidInput)
+
+	// the canceled order frees the queue
+	require.NoError(t, dexKeeper.CancelOrder(sdkCtx, testSet.acc1, sellOrder.ID))
+	buyOrder.ID = "buy2"
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, buyOrder))
+
+	// the cleared queue accepts the new orders in the next block
+	_, err = testApp.EndBlocker(sdkCtx)
+	require.NoError(t, err)
+	batchAuctionOrderSequences, err := dexKeeper.GetBatchAuctionOrderSequences(sdkCtx)
+	require.NoError(t, err)
+	require.Empty(t, batchAuctionOrderSequences)
+	sellOrder.ID = "sell2"
+	placeFundedOrder(t, sdkCtx, testApp, sellOrder)
+}
+
+func TestKeeper_BatchAuctionInvertedOrderBook(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	require.NoError(t, dexKeeper.SetOrderBookBatchAuction(
+		sdkCtx, govAddr.String(), testSet.denom1, testSet.ftDenomWhitelisting1, true,
+	))
+
+	quantity := defaultQuantityStep.MulRaw(2)
+	require.NoError(t, assetFTKeeper.SetWhitelistedBalance(
+		sdkCtx, testSet.issuer, testSet.acc1, sdk.NewCoin(testSet.ftDenomWhitelisting1, quantity.MulRaw(2)),
+	))
+	require.NoError(t, assetFTKeeper.SetWhitelistedBalance(
+		sdkCtx, testSet.issuer, testSet.acc2, sdk.NewCoin(testSet.ftDenomWhitelisting1, quantity.MulRaw(4)),
+	))
+
+	// buys the double quantity at the price 2 in the terms of the order book of the sell order
+	invertedSellOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "inverted-sell",
+		BaseDenom:   testSet.ftDenomWhitelisting1,
+		QuoteDenom:  testSet.denom1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    quantity.MulRaw(4),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, invertedSellOrder)
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.ftDenomWhitelisting1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    quantity,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, sellOrder)
+	require.Equal(
+		t,
+		quantity.String(),
+		assetFTKeeper.GetDEXExpectedToReceivedBalance(sdkCtx, testSet.acc1, testSet.ftDenomWhitelisting1).Amount.String(),
+	)
+
+	_, err := testApp.EndBlocker(sdkCtx)
+	require.NoError(t, err)
+
+	// the buy surplus clears at the highest price, so the sell order is executed by the inverted order at the price 2
+	// and receives more than expected at its own price
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, sellOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.Equal(
+		t,
+		quantity.MulRaw(2).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc1, testSet.ftDenomWhitelisting1).Amount.String(),
+	)
+	require.True(t, assetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).IsZero())
+	require.True(
+		t,
+		assetFTKeeper.GetDEXExpectedToReceivedBalance(sdkCtx, testSet.acc1, testSet.ftDenomWhitelisting1).IsZero(),
+	)
+
+	// the remaining part of the inverted order is kept in the order book
+	require.Equal(
+		t,
+		quantity.String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc2, testSet.denom1).Amount.String(),
+	)
+	placedOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, invertedSellOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, quantity.MulRaw(2).String(), placedOrder.RemainingBaseQuantity.String())
+	require.Equal(
+		t,
+		quantity.MulRaw(2).String(),
+		assetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc2, testSet.ftDenomWhitelisting1).Amount.String(),
+	)
+	sellOrders, _, err := dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.ftDenomWhitelisting1, testSet.denom1, types.SIDE_SELL, &query.PageRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, sellOrders, 1)
+}
+
+func TestKeeper_BatchAuctionRemainderMatching(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	quoteDenom, err := testApp.AssetFTKeeper.Issue(sdkCtx, assetfttypes.IssueSettings{
+		Issuer:        testSet.issuer,
+		Subunit:       "ftdenylisting",
+		Symbol:        "FTDENYLISTING",
+		Precision:     6,
+		InitialAmount: sdkmath.NewIntWithDecimal(1, 20),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_denylisting,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, dexKeeper.SetOrderBookBatchAuction(
+		sdkCtx, govAddr.String(), testSet.denom1, quoteDenom, true,
+	))
+
+	denylistedBuyOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "denylisted-buy",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  quoteDenom,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, denylistedBuyOrder)
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "sell",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  quoteDenom,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, sellOrder)
+	// crosses the sell order, but isn't executed by the clearing at the price 2
+	buyOrder := types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "buy",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  quoteDenom,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("15e-1")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, buyOrder)
+
+	// the execution of the order of the denylisted account fails, so the order is canceled
+	require.NoError(t, testApp.AssetFTKeeper.AddToDenylist(sdkCtx, testSet.issuer, testSet.acc2, quoteDenom))
+
+	_, err = testApp.EndBlocker(sdkCtx)
+	require.NoError(t, err)
+
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, denylistedBuyOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc2, quoteDenom).IsZero())
+
+	// the remaining sell order still crossing the order book is matched as the regular order at the maker price
+	for _, order := range []types.Order{sellOrder, buyOrder} {
+		_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, sdk.MustAccAddressFromBech32(order.Creator), order.ID)
+		require.ErrorIs(t, err, types.ErrRecordNotFound)
+	}
+	require.Equal(
+		t,
+		defaultQuantityStep.MulRaw(3).QuoRaw(2).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc1, quoteDenom).Amount.String(),
+	)
+	require.Equal(
+		t,
+		defaultQuantityStep.String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, testSet.acc3, testSet.denom1).Amount.String(),
+	)
+	for _, side := range []types.Side{types.SIDE_BUY, types.SIDE_SELL} {
+		orders, _, err := dexKeeper.GetOrderBookOrders(sdkCtx, testSet.denom1, quoteDenom, side, &query.PageRequest{})
+		require.NoError(t, err)
+		require.Empty(t, orders)
+	}
+}
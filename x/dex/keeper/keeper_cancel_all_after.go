@@ -0,0 +1,315 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// cancelAllBatchSize is the max number of the account orders canceled in one block by the scheduled cancellation.
+const cancelAllBatchSize = 100
+
+// SetCancelAllAfter schedules the cancellation of the account orders, optionally limited to the denoms, after the
+// timeout. Each call replaces the previously scheduled cancellation, and the zero timeout only removes it.
+func (k Keeper) SetCancelAllAfter(ctx sdk.Context, acc sdk.AccAddress, timeout time.Duration, denoms []string) error {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return err
+	}
+
+	if err := k.removeCancelAllAfter(ctx, accNumber); err != nil {
+		return err
+	}
+	if timeout == 0 {
+		return nil
+	}
+
+	cancelAllAfter := types.CancelAllAfter{
+		Account:  acc.String(),
+		Deadline: ctx.BlockTime().Add(timeout),
+		Denoms:   denoms,
+	}
+	if err := k.saveCancelAllAfter(ctx, accNumber, cancelAllAfter); err != nil {
+		return err
+	}
+
+	k.logger(ctx).Debug(
+		"Delaying cancel all after.",
+		"deadline", cancelAllAfter.Deadline,
+		"creator", cancelAllAfter.Account,
+	)
+	if err := k.delayKeeper.ExecuteAfter(
+		ctx,
+		types.BuildCancelAllAfterDelayKey(accNumber),
+		&types.CancelAll{
+			Creator: cancelAllAfter.Account,
+		},
+		cancelAllAfter.Deadline,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to create cancel all after delayed cancellation")
+	}
+
+	return nil
+}
+
+// CancelAll cancels the next batch of the account orders scheduled for the cancellation, delays the cancellation of
+// the next batch if there are orders left, and removes the scheduled cancellation once the orders are canceled.
+func (k Keeper) CancelAll(ctx sdk.Context, acc sdk.AccAddress) error {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return err
+	}
+
+	cancelAllAfter, err := k.getCancelAllAfter(ctx, accNumber)
+	if err != nil {
+		// the scheduled cancellation might be disarmed after the previous batch
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	// the scheduled cancellation might be replaced after the previous batch
+	if cancelAllAfter.Deadline.After(ctx.BlockTime()) {
+		return nil
+	}
+
+	failedOrdersKeyPrefix := types.CreateCancelAllFailedOrderKeyPrefix(accNumber)
+	failedOrderSequences, err := k.getFailedOrderSequences(ctx, failedOrdersKeyPrefix)
+	if err != nil {
+		return err
+	}
+	// one extra order is loaded to find out whether the next batch is needed
+	orderSequences, err := k.getAccountOrderSequences(
+		ctx, accNumber, cancelAllAfter.Denoms, cancelAllBatchSize+1, failedOrderSequences,
+	)
+	if err != nil {
+		return err
+	}
+	batchOrderSequences := orderSequences[:min(len(orderSequences), cancelAllBatchSize)]
+
+	k.logger(ctx).Debug(
+		"Cancelling account orders.",
+		"creator", cancelAllAfter.Account,
+		"count", len(batchOrderSequences),
+	)
+
+	// the orders failing the cancellation are skipped by the next batches, so the cancellation progresses even if
+	// the whole batch fails
+	if err := k.cancelOrdersBatch(
+		ctx,
+		lo.Map(batchOrderSequences, func(orderSequence uint64, _ int) batchOrder {
+			return batchOrder{
+				creator:       acc,
+				orderSequence: orderSequence,
+			}
+		}),
+		failedOrdersKeyPrefix,
+	); err != nil {
+		return err
+	}
+	if len(orderSequences) > cancelAllBatchSize {
+		return k.delayCancelAllNextBatch(ctx, accNumber, cancelAllAfter)
+	}
+
+	if err := k.removeFailedOrderSequences(ctx, failedOrdersKeyPrefix); err != nil {
+		return err
+	}
+	return k.storeService.OpenKVStore(ctx).Delete(types.CreateCancelAllAfterKey(accNumber))
+}
+
+// GetCancelAllAfter returns the scheduled cancellation of the account orders.
+func (k Keeper) GetCancelAllAfter(ctx sdk.Context, acc sdk.AccAddress) (types.CancelAllAfter, error) {
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return types.CancelAllAfter{}, err
+	}
+
+	return k.getCancelAllAfter(ctx, accNumber)
+}
+
+// GetCancelAllAfters returns the paginated scheduled cancellations of the accounts orders.
+func (k Keeper) GetCancelAllAfters(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.CancelAllAfter, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	cancelAllAfters, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.CancelAllAfterKeyPrefix),
+		pagination,
+		// builder
+		func(_ []byte, cancelAllAfter *types.CancelAllAfter) (*types.CancelAllAfter, error) {
+			return cancelAllAfter, nil
+		},
+		// constructor
+		func() *types.CancelAllAfter {
+			return &types.CancelAllAfter{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(cancelAllAfters, func(cancelAllAfter *types.CancelAllAfter, _ int) types.CancelAllAfter {
+		return *cancelAllAfter
+	}), pageRes, nil
+}
+
+// ImportCancelAllAfter saves the scheduled cancellation of the account orders, the delayed cancellation is imported
+// by the delay module.
+func (k Keeper) ImportCancelAllAfter(ctx sdk.Context, cancelAllAfter types.CancelAllAfter) error {
+	acc, err := sdk.AccAddressFromBech32(cancelAllAfter.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", cancelAllAfter.Account)
+	}
+	accNumber, err := k.getAccountNumber(ctx, acc)
+	if err != nil {
+		return err
+	}
+
+	return k.saveCancelAllAfter(ctx, accNumber, cancelAllAfter)
+}
+
+func (k Keeper) saveCancelAllAfter(ctx sdk.Context, accNumber uint64, cancelAllAfter types.CancelAllAfter) error {
+	return k.setDataToStore(ctx, types.CreateCancelAllAfterKey(accNumber), &cancelAllAfter)
+}
+
+func (k Keeper) getCancelAllAfter(ctx sdk.Context, accNumber uint64) (types.CancelAllAfter, error) {
+	var cancelAllAfter types.CancelAllAfter
+	if err := k.getDataFromStore(ctx, types.CreateCancelAllAfterKey(accNumber), &cancelAllAfter); err != nil {
+		return types.CancelAllAfter{}, err
+	}
+
+	return cancelAllAfter, nil
+}
+
+func (k Keeper) removeCancelAllAfter(ctx sdk.Context, accNumber uint64) error {
+	cancelAllAfter, err := k.getCancelAllAfter(ctx, accNumber)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	k.logger(ctx).Debug(
+		"Removing cancel all after delayed cancellation.",
+		"deadline", cancelAllAfter.Deadline,
+		"creator", cancelAllAfter.Account,
+	)
+	if err := k.delayKeeper.RemoveExecuteAfter(
+		ctx,
+		types.BuildCancelAllAfterDelayKey(accNumber),
+		cancelAllAfter.Deadline,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to remove cancel all after delayed cancellation")
+	}
+	// the orders failed by the replaced cancellation are loaded again by the new one
+	if err := k.removeFailedOrderSequences(ctx, types.CreateCancelAllFailedOrderKeyPrefix(accNumber)); err != nil {
+		return err
+	}
+
+	return k.storeService.OpenKVStore(ctx).Delete(types.CreateCancelAllAfterKey(accNumber))
+}
+
+func (k Keeper) delayCancelAllNextBatch(
+	ctx sdk.Context,
+	accNumber uint64,
+	cancelAllAfter types.CancelAllAfter,
+) error {
+	// the next batch is canceled in the next block
+	height := uint64(ctx.BlockHeight())
+	k.logger(ctx).Debug(
+		"Delaying cancel all next batch.",
+		"creator", cancelAllAfter.Account,
+		"height", height,
+	)
+	if err := k.delayKeeper.ExecuteAfterBlock(
+		ctx,
+		types.BuildCancelAllAfterDelayKey(accNumber),
+		&types.CancelAll{
+			Creator: cancelAllAfter.Account,
+		},
+		height,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to create cancel all after delayed next batch cancellation")
+	}
+
+	return nil
+}
+
+// getAccountOrderSequences returns up to the limit sequences of the account orders with any of the denoms, or of all
+// account orders if the denoms are empty, excluding the skipped orders.
+func (k Keeper) getAccountOrderSequences(
+	ctx sdk.Context,
+	accNumber uint64,
+	denoms []string,
+	limit int,
+	skippedOrderSequences map[uint64]struct{},
+) ([]uint64, error) {
+	moduleStore := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if len(denoms) == 0 {
+		iterator := prefix.NewStore(moduleStore, types.CreateOrderIDToSequenceKeyPrefix(accNumber)).Iterator(nil, nil)
+		defer iterator.Close()
+
+		orderSequences := make([]uint64, 0)
+		for ; iterator.Valid() && len(orderSequences) < limit; iterator.Next() {
+			var val gogotypes.UInt64Value
+			if err := k.cdc.Unmarshal(iterator.Value(), &val); err != nil {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal order sequence, err: %s", err)
+			}
+			if _, ok := skippedOrderSequences[val.Value]; ok {
+				continue
+			}
+			orderSequences = append(orderSequences, val.Value)
+		}
+
+		return orderSequences, nil
+	}
+
+	orderSequences := make([]uint64, 0)
+	usedOrderSequences := make(map[uint64]struct{})
+	for _, denom := range denoms {
+		if len(orderSequences) == limit {
+			break
+		}
+		accountDenomKeyPrefix, err := types.CreateAccountDenomKeyPrefix(accNumber, denom)
+		if err != nil {
+			return nil, err
+		}
+		if err := func() error {
+			iterator := prefix.NewStore(moduleStore, accountDenomKeyPrefix).Iterator(nil, nil)
+			defer iterator.Close()
+
+			for ; iterator.Valid() && len(orderSequences) < limit; iterator.Next() {
+				orderSequence, err := types.DecodeAccountDenomKeyOrderSequence(iterator.Key())
+				if err != nil {
+					return err
+				}
+				// the order has two denoms, so it might be found twice
+				if _, ok := usedOrderSequences[orderSequence]; ok {
+					continue
+				}
+				if _, ok := skippedOrderSequences[orderSequence]; ok {
+					continue
+				}
+				usedOrderSequences[orderSequence] = struct{}{}
+				orderSequences = append(orderSequences, orderSequence)
+			}
+
+			return nil
+		}(); err != nil {
+			return nil, err
+		}
+	}
+
+	return orderSequences, nil
+}
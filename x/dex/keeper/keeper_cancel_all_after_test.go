@@ -0,0 +1,355 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_CancelAllAfter(t *testing.T) {
+	initialBlockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		// the cancel all after calls with the seconds since the initial block time
+		calls          func(testSet TestSet) map[int64]*types.MsgSetCancelAllAfter
+		wantOrderIDs   []string
+		wantCancelTime *time.Time
+	}{
+		{
+			name: "not_set",
+			calls: func(TestSet) map[int64]*types.MsgSetCancelAllAfter {
+				return nil
+			},
+			wantOrderIDs: []string{"id1", "id2", "id3"},
+		},
+		{
+			name: "all_orders",
+			calls: func(TestSet) map[int64]*types.MsgSetCancelAllAfter {
+				return map[int64]*types.MsgSetCancelAllAfter{
+					10: {Timeout: time.Minute},
+				}
+			},
+			wantOrderIDs:   []string{},
+			wantCancelTime: lo.ToPtr(initialBlockTime.Add(70 * time.Second)),
+		},
+		{
+			name: "denom_orders",
+			calls: func(testSet TestSet) map[int64]*types.MsgSetCancelAllAfter {
+				return map[int64]*types.MsgSetCancelAllAfter{
+					10: {Timeout: time.Minute, Denoms: []string{testSet.denom3}},
+				}
+			},
+			wantOrderIDs:   []string{"id1"},
+			wantCancelTime: lo.ToPtr(initialBlockTime.Add(70 * time.Second)),
+		},
+		{
+			name: "deadline_moved",
+			calls: func(TestSet) map[int64]*types.MsgSetCancelAllAfter {
+				return map[int64]*types.MsgSetCancelAllAfter{
+					10: {Timeout: time.Minute},
+					50: {Timeout: time.Minute},
+				}
+			},
+			wantOrderIDs:   []string{},
+			wantCancelTime: lo.ToPtr(initialBlockTime.Add(110 * time.Second)),
+		},
+		{
+			name: "disarmed",
+			calls: func(TestSet) map[int64]*types.MsgSetCancelAllAfter {
+				return map[int64]*types.MsgSetCancelAllAfter{
+					10: {Timeout: time.Minute},
+					50: {Timeout: 0},
+				}
+			},
+			wantOrderIDs: []string{"id1", "id2", "id3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testApp := simapp.New()
+			sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+				Time:   initialBlockTime,
+				Height: 1,
+			})
+			testSet := genTestSet(t, sdkCtx, testApp)
+
+			orders := []types.Order{
+				{
+					Creator:     testSet.acc1.String(),
+					Type:        types.ORDER_TYPE_LIMIT,
+					ID:          "id1",
+					BaseDenom:   testSet.denom1,
+					QuoteDenom:  testSet.denom2,
+					Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+					Quantity:    defaultQuantityStep,
+					Side:        types.SIDE_SELL,
+					TimeInForce: types.TIME_IN_FORCE_GTC,
+				},
+				{
+					Creator:     testSet.acc1.String(),
+					Type:        types.ORDER_TYPE_LIMIT,
+					ID:          "id2",
+					BaseDenom:   testSet.denom1,
+					QuoteDenom:  testSet.denom3,
+					Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+					Quantity:    defaultQuantityStep,
+					Side:        types.SIDE_SELL,
+					TimeInForce: types.TIME_IN_FORCE_GTC,
+				},
+				{
+					Creator:     testSet.acc1.String(),
+					Type:        types.ORDER_TYPE_LIMIT,
+					ID:          "id3",
+					BaseDenom:   testSet.denom2,
+					QuoteDenom:  testSet.denom3,
+					Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+					Quantity:    defaultQuantityStep,
+					Side:        types.SIDE_BUY,
+					TimeInForce: types.TIME_IN_FORCE_GTC,
+				},
+			}
+			for _, order := range orders {
+				lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+				require.NoError(t, err)
+				testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(lockedBalance))
+				fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+				require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, order))
+			}
+
+			calls := tt.calls(testSet)
+			var cancelTime *time.Time
+			// simulate a block every 10 seconds
+			for sec := int64(10); sec <= 200; sec += 10 {
+				sdkCtx = testApp.NewContextLegacy(false, cmtproto.Header{
+					Time:   initialBlockTime.Add(time.Duration(sec) * time.Second),
+					Height: 1 + sec/10,
+				})
+				// the delayed cancellation is executed in the begin blocker
+				ordersBefore, _, err := testApp.DEXKeeper.GetOrders(sdkCtx, testSet.acc1, &query.PageRequest{})
+				require.NoError(t, err)
+				_, err = testApp.BeginBlocker(sdkCtx)
+				require.NoError(t, err)
+
+				if msg, ok := calls[sec]; ok {
+					require.NoError(t, testApp.DEXKeeper.SetCancelAllAfter(sdkCtx, testSet.acc1, msg.Timeout, msg.Denoms))
+					if msg.Timeout == 0 {
+						_, err := testApp.DEXKeeper.GetCancelAllAfter(sdkCtx, testSet.acc1)
+						require.ErrorIs(t, err, types.ErrRecordNotFound)
+					} else {
+						cancelAllAfter, err := testApp.DEXKeeper.GetCancelAllAfter(sdkCtx, testSet.acc1)
+						require.NoError(t, err)
+						require.Equal(t, types.CancelAllAfter{
+							Account:  testSet.acc1.String(),
+							Deadline: sdkCtx.BlockTime().Add(msg.Timeout),
+							Denoms:   msg.Denoms,
+						}, cancelAllAfter)
+					}
+				}
+
+				_, err = testApp.EndBlocker(sdkCtx)
+				require.NoError(t, err)
+				ordersAfter, _, err := testApp.DEXKeeper.GetOrders(sdkCtx, testSet.acc1, &query.PageRequest{})
+				require.NoError(t, err)
+				if len(ordersAfter) != len(ordersBefore) {
+					require.Nil(t, cancelTime, "orders are canceled twice")
+					cancelTime = lo.ToPtr(sdkCtx.BlockTime())
+				}
+			}
+			require.Equal(t, tt.wantCancelTime, cancelTime)
+
+			gotOrders, _, err := testApp.DEXKeeper.GetOrders(sdkCtx, testSet.acc1, &query.PageRequest{})
+			require.NoError(t, err)
+			require.ElementsMatch(t, tt.wantOrderIDs, lo.Map(gotOrders, func(order types.Order, _ int) string {
+				return order.ID
+			}))
+
+			// the scheduled cancellation is removed after the execution
+			_, err = testApp.DEXKeeper.GetCancelAllAfter(sdkCtx, testSet.acc1)
+			require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+			// the locked balance is released for the canceled orders only
+			lockedBalances := sdk.NewCoins()
+			for _, order := range gotOrders {
+				lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+				require.NoError(t, err)
+				lockedBalances = lockedBalances.Add(lockedBalance).Add(order.Reserve)
+			}
+			dexLockedBalances, _, err := testApp.AssetFTKeeper.GetDEXLockedBalances(
+				sdkCtx, testSet.acc1, &query.PageRequest{},
+			)
+			require.NoError(t, err)
+			require.Equal(t, lockedBalances.String(), dexLockedBalances.String())
+		})
+	}
+}
+
+func TestKeeper_CancelAllInBatches(t *testing.T) {
+	initialBlockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Time:   initialBlockTime,
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	params, err := testApp.DEXKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MaxOrdersPerDenom = 200
+	require.NoError(t, testApp.DEXKeeper.SetParams(sdkCtx, params))
+
+	// the order failing the cancellation is loaded first
+	placeFundedOrder(t, sdkCtx, testApp, types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "a-failing",
+		BaseDenom:   testSet.denom3,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	})
+	const ordersCount = 150
+	for i := range ordersCount {
+		placeFundedOrder(t, sdkCtx, testApp, types.Order{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          fmt.Sprintf("id-%d", i),
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		})
+	}
+	// break the locked balance to fail the cancellation of the order
+	testApp.AssetFTKeeper.SetDEXLockedBalances(
+		sdkCtx, testSet.acc1, []sdk.Coin{sdk.NewCoin(testSet.denom3, sdkmath.ZeroInt())},
+	)
+	require.NoError(t, testApp.DEXKeeper.SetCancelAllAfter(sdkCtx, testSet.acc1, time.Second, nil))
+
+	ordersCountFn := func() int {
+		orders, _, err := testApp.DEXKeeper.GetOrders(
+			sdkCtx, testSet.acc1, &query.PageRequest{Limit: query.PaginationMaxLimit},
+		)
+		require.NoError(t, err)
+		return len(orders)
+	}
+	require.Equal(t, ordersCount+1, ordersCountFn())
+
+	// the first batch skips the failing order and the second one cancels the rest
+	for _, expectedCount := range []int{ordersCount + 1 - 99, 1} {
+		sdkCtx = testApp.NewContextLegacy(false, cmtproto.Header{
+			Time:   sdkCtx.BlockTime().Add(10 * time.Second),
+			Height: sdkCtx.BlockHeight() + 1,
+		})
+		_, err := testApp.BeginBlocker(sdkCtx)
+		require.NoError(t, err)
+		_, err = testApp.EndBlocker(sdkCtx)
+		require.NoError(t, err)
+
+		require.Equal(t, expectedCount, ordersCountFn())
+	}
+
+	_, err = testApp.DEXKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, "a-failing")
+	require.NoError(t, err)
+	_, err = testApp.DEXKeeper.GetCancelAllAfter(sdkCtx, testSet.acc1)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+}
+
+func TestKeeper_CancelAllWithFailedBatch(t *testing.T) {
+	initialBlockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Time:   initialBlockTime,
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	params, err := testApp.DEXKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MaxOrdersPerDenom = 200
+	require.NoError(t, testApp.DEXKeeper.SetParams(sdkCtx, params))
+
+	// the orders failing the cancellation fill the whole first batch of 100 orders
+	const failingOrdersCount = 101
+	for i := range failingOrdersCount {
+		placeFundedOrder(t, sdkCtx, testApp, types.Order{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          fmt.Sprintf("a-failing-%03d", i),
+			BaseDenom:   testSet.denom3,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		})
+	}
+	const ordersCount = 50
+	for i := range ordersCount {
+		placeFundedOrder(t, sdkCtx, testApp, types.Order{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          fmt.Sprintf("id-%d", i),
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		})
+	}
+	// break the locked balance to fail the cancellation of the orders
+	testApp.AssetFTKeeper.SetDEXLockedBalances(
+		sdkCtx, testSet.acc1, []sdk.Coin{sdk.NewCoin(testSet.denom3, sdkmath.ZeroInt())},
+	)
+	require.NoError(t, testApp.DEXKeeper.SetCancelAllAfter(sdkCtx, testSet.acc1, time.Second, nil))
+
+	ordersCountFn := func() int {
+		orders, _, err := testApp.DEXKeeper.GetOrders(
+			sdkCtx, testSet.acc1, &query.PageRequest{Limit: query.PaginationMaxLimit},
+		)
+		require.NoError(t, err)
+		return len(orders)
+	}
+	require.Equal(t, failingOrdersCount+ordersCount, ordersCountFn())
+
+	// the first batch fails completely, but the cancellation is kept, and the second batch skips the failed orders
+	for _, expectedCount := range []int{failingOrdersCount + ordersCount, failingOrdersCount} {
+		sdkCtx = testApp.NewContextLegacy(false, cmtproto.Header{
+			Time:   sdkCtx.BlockTime().Add(10 * time.Second),
+			Height: sdkCtx.BlockHeight() + 1,
+		})
+		_, err := testApp.BeginBlocker(sdkCtx)
+		require.NoError(t, err)
+		_, err = testApp.EndBlocker(sdkCtx)
+		require.NoError(t, err)
+
+		require.Equal(t, expectedCount, ordersCountFn())
+	}
+
+	_, err = testApp.DEXKeeper.GetCancelAllAfter(sdkCtx, testSet.acc1)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+}
+
+func placeFundedOrder(t *testing.T, sdkCtx sdk.Context, testApp *simapp.App, order types.Order) {
+	creator := sdk.MustAccAddressFromBech32(order.Creator)
+	lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, creator, sdk.NewCoins(lockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, creator)
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, order))
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v6/pkg/store"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// batchOrder is the order canceled in the batch.
+type batchOrder struct {
+	creator       sdk.AccAddress
+	orderSequence uint64
+}
+
+// cancelOrdersBatch cancels the orders and saves the orders failing the cancellation under the failed orders key
+// prefix, so the next batches skip them instead of loading them again.
+func (k Keeper) cancelOrdersBatch(ctx sdk.Context, orders []batchOrder, failedOrdersKeyPrefix []byte) error {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	for _, order := range orders {
+		// the order is canceled in the cache context to skip it instead of halting the chain if the cancellation
+		// fails, the skipped order remains and can be canceled by its creator
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.cancelOrderBySequence(cacheCtx, order.creator, order.orderSequence); err != nil {
+			k.logger(ctx).Error(
+				"Failed to cancel order, skipping.",
+				"creator", order.creator.String(),
+				"orderSequence", order.orderSequence,
+				"err", err,
+			)
+			if err := moduleStore.Set(
+				types.CreateFailedOrderKey(failedOrdersKeyPrefix, order.orderSequence), types.StoreTrue,
+			); err != nil {
+				return err
+			}
+			continue
+		}
+		writeCache()
+	}
+
+	return nil
+}
+
+// getFailedOrderSequences returns the sequences of the orders failing the cancellation saved under the key prefix.
+func (k Keeper) getFailedOrderSequences(ctx sdk.Context, failedOrdersKeyPrefix []byte) (map[uint64]struct{}, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), failedOrdersKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	orderSequences := make(map[uint64]struct{})
+	for ; iterator.Valid(); iterator.Next() {
+		orderSequence, err := types.DecodeFailedOrderKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		orderSequences[orderSequence] = struct{}{}
+	}
+
+	return orderSequences, nil
+}
+
+// removeFailedOrderSequences removes the orders failing the cancellation saved under the key prefix.
+func (k Keeper) removeFailedOrderSequences(ctx sdk.Context, failedOrdersKeyPrefix []byte) error {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	// the keys are collected before the removal to not modify the store during the iteration
+	keys := func() [][]byte {
+		iterator := prefix.NewStore(runtime.KVStoreAdapter(moduleStore), failedOrdersKeyPrefix).Iterator(nil, nil)
+		defer iterator.Close()
+
+		keys := make([][]byte, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, store.JoinKeys(failedOrdersKeyPrefix, iterator.Key()))
+		}
+		return keys
+	}()
+
+	for _, key := range keys {
+		if err := moduleStore.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
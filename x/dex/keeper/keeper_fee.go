@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/samber/lo"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// UpdateOrderBookFeeRates is a governance operation that sets the order book fee rates overriding the default fee
+// rates, or removes them if the fee rates are nil.
+func (k Keeper) UpdateOrderBookFeeRates(
+	ctx sdk.Context,
+	authority string,
+	baseDenom, quoteDenom string,
+	feeRates *types.OrderBookFeeRates,
+) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	if err := k.validateDenomPair(ctx, baseDenom, quoteDenom); err != nil {
+		return err
+	}
+
+	orderBookID, invertedOrderBookID, err := k.getOrGenOrderBookIDs(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return err
+	}
+
+	// the fee rates are set for both order book directions since the taker order can match the makers of both
+	if feeRates == nil {
+		moduleStore := k.storeService.OpenKVStore(ctx)
+		if err := moduleStore.Delete(types.CreateOrderBookFeeRatesKey(orderBookID)); err != nil {
+			return err
+		}
+		return moduleStore.Delete(types.CreateOrderBookFeeRatesKey(invertedOrderBookID))
+	}
+	if err := feeRates.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.SetOrderBookFeeRates(ctx, orderBookID, *feeRates); err != nil {
+		return err
+	}
+	return k.SetOrderBookFeeRates(ctx, invertedOrderBookID, *feeRates)
+}
+
+// WithdrawFees is a governance operation that sends the trading fees collected by the DEX fee collector to the
+// recipient. The fees are sent through the bank keeper, so the withdrawal is subject to the asset ft features of the
+// withdrawn tokens the same way as any other transfer.
+func (k Keeper) WithdrawFees(ctx sdk.Context, authority string, recipient sdk.AccAddress, amount sdk.Coins) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.FeeCollectorName, recipient, amount); err != nil {
+		return sdkerrors.Wrap(err, "failed to withdraw fees")
+	}
+
+	return nil
+}
+
+// SetOrderBookFeeRates sets the order book fee rates.
+func (k Keeper) SetOrderBookFeeRates(ctx sdk.Context, orderBookID uint32, feeRates types.OrderBookFeeRates) error {
+	return k.setDataToStore(ctx, types.CreateOrderBookFeeRatesKey(orderBookID), &feeRates)
+}
+
+// GetOrderBooksFeeRates returns the paginated order books fee rates overriding the default fee rates.
+func (k Keeper) GetOrderBooksFeeRates(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.OrderBookFeeRatesWithID, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	feeRates, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.OrderBookFeeRatesKeyPrefix),
+		pagination,
+		// builder
+		func(key []byte, record *types.OrderBookFeeRates) (*types.OrderBookFeeRatesWithID, error) {
+			orderBookID, err := types.DecodeOrderBookFeeRatesKey(key)
+			if err != nil {
+				return nil, err
+			}
+
+			return &types.OrderBookFeeRatesWithID{
+				OrderBookID: orderBookID,
+				FeeRates:    *record,
+			}, nil
+		},
+		// constructor
+		func() *types.OrderBookFeeRates {
+			return &types.OrderBookFeeRates{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(feeRates, func(data *types.OrderBookFeeRatesWithID, _ int) types.OrderBookFeeRatesWithID {
+		return *data
+	}), pageRes, nil
+}
+
+// GetAccumulatedFees returns the paginated total amount of the fees charged by the DEX per denom.
+func (k Keeper) GetAccumulatedFees(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) (sdk.Coins, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	fees, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.AccumulatedFeeKeyPrefix),
+		pagination,
+		// builder
+		func(_ []byte, fee *sdk.Coin) (*sdk.Coin, error) {
+			return fee, nil
+		},
+		// constructor
+		func() *sdk.Coin {
+			return &sdk.Coin{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return sdk.NewCoins(lo.Map(fees, func(fee *sdk.Coin, _ int) sdk.Coin {
+		return *fee
+	})...), pageRes, nil
+}
+
+// SetAccumulatedFee sets the total amount of the fees charged by the DEX in the coin denom.
+func (k Keeper) SetAccumulatedFee(ctx sdk.Context, fee sdk.Coin) error {
+	return k.setDataToStore(ctx, types.CreateAccumulatedFeeKey(fee.Denom), &fee)
+}
+
+func (k Keeper) addAccumulatedFees(ctx sdk.Context, fees sdk.Coins) error {
+	for _, fee := range fees {
+		var accumulatedFee sdk.Coin
+		if err := k.getDataFromStore(ctx, types.CreateAccumulatedFeeKey(fee.Denom), &accumulatedFee); err != nil {
+			if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+				return err
+			}
+			accumulatedFee = sdk.NewCoin(fee.Denom, sdkmath.ZeroInt())
+		}
+		if err := k.SetAccumulatedFee(ctx, accumulatedFee.Add(fee)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getOrderBookFeeRates returns the order book fee rates, or the default fee rates if the order book fee rates
+// aren't set.
+func (k Keeper) getOrderBookFeeRates(
+	ctx sdk.Context,
+	params types.Params,
+	orderBookID uint32,
+) (types.OrderBookFeeRates, error) {
+	var feeRates types.OrderBookFeeRates
+	if err := k.getDataFromStore(ctx, types.CreateOrderBookFeeRatesKey(orderBookID), &feeRates); err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.OrderBookFeeRates{}, err
+		}
+		return types.OrderBookFeeRates{
+			MakerFeeRate: params.MakerFeeRate,
+			TakerFeeRate: params.TakerFeeRate,
+		}, nil
+	}
+
+	return feeRates, nil
+}
+
+// computeFee returns the part of the coin charged as the fee, the fee is rounded down.
+func computeFee(coin sdk.Coin, feeRate sdkmath.LegacyDec) sdk.Coin {
+	if !feeRate.IsPositive() {
+		return sdk.NewCoin(coin.Denom, sdkmath.ZeroInt())
+	}
+
+	return sdk.NewCoin(coin.Denom, feeRate.MulInt(coin.Amount).TruncateInt())
+}
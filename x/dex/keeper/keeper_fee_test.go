@@ -0,0 +1,364 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_TradingFees(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	feeCollector := authtypes.NewModuleAddress(types.FeeCollectorName)
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.001")
+	params.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.002")
+	require.NoError(t, dexKeeper.UpdateParams(sdkCtx, govAddr, params))
+
+	maker := testSet.acc1
+	taker := testSet.acc2
+	testApp.MintAndSendCoin(t, sdkCtx, maker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 1_000_000)))
+	testApp.MintAndSendCoin(t, sdkCtx, taker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 150_000)))
+	fundOrderReserve(t, testApp, sdkCtx, maker)
+	for range 2 {
+		fundOrderReserve(t, testApp, sdkCtx, taker)
+	}
+
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     maker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+
+	// the default fee rates are applied
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     taker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(200_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+	events := readOrderEvents(t, sdkCtx)
+	require.Len(t, events.OrdersReduced, 2)
+	makerEvent, takerEvent := events.OrdersReduced[0], events.OrdersReduced[1]
+	require.Equal(t, "maker1", makerEvent.ID)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom2, 100).String(), makerEvent.FeeCoin.String())
+	require.Equal(t, params.MakerFeeRate.String(), makerEvent.FeeRate.String())
+	require.Equal(t, "taker1", takerEvent.ID)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 200_000).String(), takerEvent.ReceivedCoin.String())
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 400).String(), takerEvent.FeeCoin.String())
+	require.Equal(t, params.TakerFeeRate.String(), takerEvent.FeeRate.String())
+
+	// the order book fee rates override the default fee rates
+	orderBookFeeRates := types.OrderBookFeeRates{
+		MakerFeeRate: sdkmath.LegacyZeroDec(),
+		TakerFeeRate: sdkmath.LegacyMustNewDecFromStr("0.01"),
+	}
+	require.ErrorIs(t, dexKeeper.UpdateOrderBookFeeRates(
+		sdkCtx, taker.String(), testSet.denom1, testSet.denom2, &orderBookFeeRates,
+	), govtypes.ErrInvalidSigner)
+	require.NoError(t, dexKeeper.UpdateOrderBookFeeRates(
+		sdkCtx, govAddr, testSet.denom1, testSet.denom2, &orderBookFeeRates,
+	))
+	orderBookParams, err := dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Equal(t, orderBookFeeRates.MakerFeeRate.String(), orderBookParams.MakerFeeRate.String())
+	require.Equal(t, orderBookFeeRates.TakerFeeRate.String(), orderBookParams.TakerFeeRate.String())
+	// the inverted order book uses the same fee rates
+	orderBookParams, err = dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom2, testSet.denom1)
+	require.NoError(t, err)
+	require.Equal(t, orderBookFeeRates.MakerFeeRate.String(), orderBookParams.MakerFeeRate.String())
+	require.Equal(t, orderBookFeeRates.TakerFeeRate.String(), orderBookParams.TakerFeeRate.String())
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     taker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker2",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+	events = readOrderEvents(t, sdkCtx)
+	require.Len(t, events.OrdersReduced, 2)
+	makerEvent, takerEvent = events.OrdersReduced[0], events.OrdersReduced[1]
+	require.True(t, makerEvent.FeeCoin.IsZero())
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 1_000).String(), takerEvent.FeeCoin.String())
+	require.Equal(t, orderBookFeeRates.TakerFeeRate.String(), takerEvent.FeeRate.String())
+
+	// the fees are deducted from the received coins and sent to the fee collector
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom2, 149_900).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, maker, testSet.denom2).String())
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 298_600).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, taker, testSet.denom1).String())
+	wantFees := sdk.NewCoins(
+		sdk.NewInt64Coin(testSet.denom1, 1_400),
+		sdk.NewInt64Coin(testSet.denom2, 100),
+	)
+	require.Equal(t, wantFees.String(), testApp.BankKeeper.GetAllBalances(sdkCtx, feeCollector).String())
+	accumulatedFees, _, err := dexKeeper.GetAccumulatedFees(sdkCtx, nil)
+	require.NoError(t, err)
+	require.Equal(t, wantFees.String(), accumulatedFees.String())
+
+	// the taker on the inverted order book is charged with the same fee rates
+	testApp.MintAndSendCoin(t, sdkCtx, taker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 50_000)))
+	fundOrderReserve(t, testApp, sdkCtx, taker)
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     taker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker3",
+		BaseDenom:   testSet.denom2,
+		QuoteDenom:  testSet.denom1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+		Quantity:    sdkmath.NewInt(50_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+	events = readOrderEvents(t, sdkCtx)
+	require.Len(t, events.OrdersReduced, 2)
+	makerEvent, takerEvent = events.OrdersReduced[0], events.OrdersReduced[1]
+	require.Equal(t, "maker1", makerEvent.ID)
+	require.True(t, makerEvent.FeeCoin.IsZero())
+	require.Equal(t, orderBookFeeRates.MakerFeeRate.String(), makerEvent.FeeRate.String())
+	require.Equal(t, "taker3", takerEvent.ID)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 100_000).String(), takerEvent.ReceivedCoin.String())
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 1_000).String(), takerEvent.FeeCoin.String())
+	require.Equal(t, orderBookFeeRates.TakerFeeRate.String(), takerEvent.FeeRate.String())
+
+	// the order book fee rates removal restores the default fee rates
+	require.NoError(t, dexKeeper.UpdateOrderBookFeeRates(sdkCtx, govAddr, testSet.denom1, testSet.denom2, nil))
+	orderBookParams, err = dexKeeper.GetOrderBookParams(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Equal(t, params.TakerFeeRate.String(), orderBookParams.TakerFeeRate.String())
+	orderBooksFeeRates, _, err := dexKeeper.GetOrderBooksFeeRates(sdkCtx, nil)
+	require.NoError(t, err)
+	require.Empty(t, orderBooksFeeRates)
+}
+
+func TestKeeper_WithdrawFees(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	feeCollector := authtypes.NewModuleAddress(types.FeeCollectorName)
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.01")
+	require.NoError(t, dexKeeper.UpdateParams(sdkCtx, govAddr, params))
+
+	issuer := testSet.issuer
+	denom, err := testApp.AssetFTKeeper.Issue(sdkCtx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "FTFEE",
+		Subunit:       "ftfee",
+		Precision:     6,
+		InitialAmount: sdkmath.NewIntWithDecimal(1, 10),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_whitelisting,
+			assetfttypes.Feature_freezing,
+		},
+	})
+	require.NoError(t, err)
+
+	maker := testSet.acc1
+	taker := testSet.acc2
+	recipient := testSet.acc3
+	require.NoError(t, testApp.AssetFTKeeper.SetWhitelistedBalance(
+		sdkCtx, issuer, maker, sdk.NewInt64Coin(denom, 100_000),
+	))
+	require.NoError(t, testApp.BankKeeper.SendCoins(
+		sdkCtx, issuer, maker, sdk.NewCoins(sdk.NewInt64Coin(denom, 100_000)),
+	))
+	require.NoError(t, testApp.AssetFTKeeper.SetWhitelistedBalance(
+		sdkCtx, issuer, taker, sdk.NewInt64Coin(denom, 100_000),
+	))
+	testApp.MintAndSendCoin(t, sdkCtx, taker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 100_000)))
+	fundOrderReserve(t, testApp, sdkCtx, maker)
+	fundOrderReserve(t, testApp, sdkCtx, taker)
+
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     maker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker1",
+		BaseDenom:   denom,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     taker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker1",
+		BaseDenom:   denom,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+
+	// the fee is charged in the whitelisted and freezable denom and held by the fee collector
+	fee := sdk.NewInt64Coin(denom, 1_000)
+	require.Equal(t, sdk.NewInt64Coin(denom, 99_000).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, taker, denom).String())
+	require.Equal(t, fee.String(), testApp.BankKeeper.GetBalance(sdkCtx, feeCollector, denom).String())
+
+	// only the governance can withdraw the fees
+	require.ErrorIs(t, dexKeeper.WithdrawFees(
+		sdkCtx, taker.String(), recipient, sdk.NewCoins(fee),
+	), govtypes.ErrInvalidSigner)
+
+	// the recipient must be whitelisted
+	require.ErrorIs(t, dexKeeper.WithdrawFees(
+		sdkCtx, govAddr, recipient, sdk.NewCoins(fee),
+	), assetfttypes.ErrWhitelistedLimitExceeded)
+	require.NoError(t, testApp.AssetFTKeeper.SetWhitelistedBalance(sdkCtx, issuer, recipient, fee))
+
+	// the globally frozen fees can't be withdrawn
+	require.NoError(t, testApp.AssetFTKeeper.GloballyFreeze(sdkCtx, issuer, denom, nil))
+	require.ErrorIs(t, dexKeeper.WithdrawFees(
+		sdkCtx, govAddr, recipient, sdk.NewCoins(fee),
+	), assetfttypes.ErrGloballyFrozen)
+	require.NoError(t, testApp.AssetFTKeeper.GloballyUnfreeze(sdkCtx, issuer, denom))
+
+	// the frozen fee collector balance can't be withdrawn
+	require.NoError(t, testApp.AssetFTKeeper.Freeze(sdkCtx, issuer, feeCollector, fee, nil))
+	require.ErrorIs(t, dexKeeper.WithdrawFees(
+		sdkCtx, govAddr, recipient, sdk.NewCoins(fee),
+	), cosmoserrors.ErrInsufficientFunds)
+	require.NoError(t, testApp.AssetFTKeeper.Unfreeze(sdkCtx, issuer, feeCollector, fee))
+
+	// the fees can't be withdrawn over the collected amount
+	require.ErrorIs(t, dexKeeper.WithdrawFees(
+		sdkCtx, govAddr, recipient, sdk.NewCoins(fee.AddAmount(sdkmath.OneInt())),
+	), cosmoserrors.ErrInsufficientFunds)
+
+	require.NoError(t, dexKeeper.WithdrawFees(sdkCtx, govAddr, recipient, sdk.NewCoins(fee)))
+	require.Equal(t, fee.String(), testApp.BankKeeper.GetBalance(sdkCtx, recipient, denom).String())
+	require.True(t, testApp.BankKeeper.GetBalance(sdkCtx, feeCollector, denom).IsZero())
+	// the accumulated fees keep the total amount of the charged fees
+	accumulatedFees, _, err := dexKeeper.GetAccumulatedFees(sdkCtx, nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(fee).String(), accumulatedFees.String())
+}
+
+func TestKeeper_WithdrawFeesWithVelocityLimit(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time: time.Now(),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	feeCollector := authtypes.NewModuleAddress(types.FeeCollectorName)
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.01")
+	require.NoError(t, dexKeeper.UpdateParams(sdkCtx, govAddr, params))
+
+	issuer := testSet.issuer
+	denom, err := testApp.AssetFTKeeper.Issue(sdkCtx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "FTFEE",
+		Subunit:       "ftfee",
+		Precision:     6,
+		InitialAmount: sdkmath.NewIntWithDecimal(1, 10),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_velocity_limiting,
+		},
+		VelocityLimit: &assetfttypes.VelocityLimit{
+			Window: 24 * time.Hour,
+			Amount: sdkmath.NewInt(500),
+		},
+	})
+	require.NoError(t, err)
+
+	maker := testSet.acc1
+	taker := testSet.acc2
+	recipient := testSet.acc3
+	require.NoError(t, testApp.BankKeeper.SendCoins(
+		sdkCtx, issuer, maker, sdk.NewCoins(sdk.NewInt64Coin(denom, 100_000)),
+	))
+	require.NoError(t, testApp.AssetFTKeeper.SetVelocityLimitOverride(
+		sdkCtx, issuer, maker, sdk.NewInt64Coin(denom, 100_000),
+	))
+	testApp.MintAndSendCoin(t, sdkCtx, taker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 100_000)))
+	fundOrderReserve(t, testApp, sdkCtx, maker)
+	fundOrderReserve(t, testApp, sdkCtx, taker)
+
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     maker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker1",
+		BaseDenom:   denom,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     taker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker1",
+		BaseDenom:   denom,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+
+	fee := sdk.NewInt64Coin(denom, 1_000)
+	require.Equal(t, fee.String(), testApp.BankKeeper.GetBalance(sdkCtx, feeCollector, denom).String())
+
+	// the taker can't send more than the velocity limit
+	require.ErrorIs(t, testApp.BankKeeper.SendCoins(
+		sdkCtx, taker, recipient, sdk.NewCoins(fee),
+	), assetfttypes.ErrVelocityLimitExceeded)
+
+	// the fee collector module account isn't limited
+	require.NoError(t, dexKeeper.WithdrawFees(sdkCtx, govAddr, recipient, sdk.NewCoins(fee)))
+	require.Equal(t, fee.String(), testApp.BankKeeper.GetBalance(sdkCtx, recipient, denom).String())
+	require.True(t, testApp.BankKeeper.GetBalance(sdkCtx, feeCollector, denom).IsZero())
+}
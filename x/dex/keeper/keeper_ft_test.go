@@ -602,3 +602,221 @@ func addValidator(ctx sdk.Context, stakingKeeper *stakingkeeper.Keeper, owner sd
 	_, err = stakingkeeper.NewMsgServerImpl(stakingKeeper).CreateValidator(ctx, msg)
 	return err
 }
+
+func TestKeeper_PlaceOrderWithVelocityLimit(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time:    time.Now(),
+		AppHash: []byte("some-hash"),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	issuer, _ := testApp.GenAccount(sdkCtx)
+	acc := testSet.acc1
+
+	settings := assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEFEXT",
+		Subunit:       "defext",
+		Precision:     6,
+		InitialAmount: sdkmath.NewIntWithDecimal(1, 10),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_velocity_limiting,
+		},
+		VelocityLimit: &assetfttypes.VelocityLimit{
+			Window: 24 * time.Hour,
+			Amount: sdkmath.NewInt(1_000_000),
+		},
+	}
+	denom, err := testApp.AssetFTKeeper.Issue(sdkCtx, settings)
+	require.NoError(t, err)
+	// the admin isn't limited
+	require.NoError(t, testApp.BankKeeper.SendCoins(
+		sdkCtx, issuer, acc, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10_000_000))),
+	))
+	for range 3 {
+		fundOrderReserve(t, testApp, sdkCtx, acc)
+	}
+
+	requireAllowance := func(expected int64) {
+		allowance, err := testApp.AssetFTKeeper.GetVelocityAllowance(sdkCtx, acc, denom)
+		require.NoError(t, err)
+		require.Equal(t, sdkmath.NewInt(expected).String(), allowance.Amount.String())
+	}
+
+	order := types.Order{
+		Creator:     acc.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   denom,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    sdkmath.NewInt(400_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, order))
+	requireAllowance(600_000)
+
+	// the replaced order allowance is reused by the new order
+	replacingOrder := order
+	replacingOrder.ID = "id2"
+	replacingOrder.Quantity = sdkmath.NewInt(500_000)
+	require.NoError(t, testApp.DEXKeeper.ReplaceOrder(sdkCtx, order.ID, replacingOrder))
+	requireAllowance(500_000)
+
+	// the allowance is restored once the order is cancelled
+	require.NoError(t, testApp.DEXKeeper.CancelOrder(sdkCtx, acc, replacingOrder.ID))
+	requireAllowance(1_000_000)
+
+	triggerOrder := order
+	triggerOrder.ID = "stop-loss"
+	triggerOrder.Price = lo.ToPtr(types.MustNewPriceFromString("8e-1"))
+	triggerOrder.Trigger = &types.Trigger{
+		Type:  types.TRIGGER_TYPE_STOP_LOSS,
+		Price: types.MustNewPriceFromString("9e-1"),
+	}
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, triggerOrder))
+	requireAllowance(600_000)
+
+	// trade with the 9e-1 price to activate the trigger order
+	makerOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker",
+		BaseDenom:   denom,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("9e-1")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	require.NoError(t, testApp.BankKeeper.SendCoins(
+		sdkCtx, issuer, testSet.acc2, sdk.NewCoins(sdk.NewCoin(denom, makerOrder.Quantity)),
+	))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc2)
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, makerOrder))
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc3, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 90_000)))
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_MARKET,
+		ID:          "taker",
+		BaseDenom:   denom,
+		QuoteDenom:  testSet.denom2,
+		Quantity:    makerOrder.Quantity,
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}))
+
+	// the activated order doesn't consume the allowance again
+	require.NoError(t, testApp.DEXKeeper.ActivateTriggerOrders(sdkCtx))
+	activatedOrder, err := testApp.DEXKeeper.GetOrderByAddressAndID(sdkCtx, acc, triggerOrder.ID)
+	require.NoError(t, err)
+	require.Nil(t, activatedOrder.Trigger)
+	requireAllowance(600_000)
+
+	require.NoError(t, testApp.DEXKeeper.CancelOrder(sdkCtx, acc, triggerOrder.ID))
+	requireAllowance(1_000_000)
+
+	// the executed part of the order isn't restored once the order is cancelled
+	executedOrder := order
+	executedOrder.ID = "executed"
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, executedOrder))
+	requireAllowance(600_000)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc3, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 100_000)))
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_MARKET,
+		ID:          "taker2",
+		BaseDenom:   denom,
+		QuoteDenom:  testSet.denom2,
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}))
+	require.NoError(t, testApp.DEXKeeper.CancelOrder(sdkCtx, acc, executedOrder.ID))
+	requireAllowance(900_000)
+}
+
+func TestKeeper_PlaceOrderWithVelocityLimitWindows(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time:    time.Now(),
+		AppHash: []byte("some-hash"),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	issuer, _ := testApp.GenAccount(sdkCtx)
+	acc := testSet.acc1
+
+	settings := assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEFEXT",
+		Subunit:       "defext",
+		Precision:     6,
+		InitialAmount: sdkmath.NewIntWithDecimal(1, 10),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_velocity_limiting,
+		},
+		VelocityLimit: &assetfttypes.VelocityLimit{
+			Window: 24 * time.Hour,
+			Amount: sdkmath.NewInt(1_000_000),
+		},
+	}
+	denom, err := testApp.AssetFTKeeper.Issue(sdkCtx, settings)
+	require.NoError(t, err)
+	require.NoError(t, testApp.BankKeeper.SendCoins(
+		sdkCtx, issuer, acc, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10_000_000))),
+	))
+	for range 3 {
+		fundOrderReserve(t, testApp, sdkCtx, acc)
+	}
+
+	requireAllowance := func(expected int64) {
+		allowance, err := testApp.AssetFTKeeper.GetVelocityAllowance(sdkCtx, acc, denom)
+		require.NoError(t, err)
+		require.Equal(t, sdkmath.NewInt(expected).String(), allowance.Amount.String())
+	}
+	newOrder := func(id string, quantity int64) types.Order {
+		return types.Order{
+			Creator:     acc.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          id,
+			BaseDenom:   denom,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    sdkmath.NewInt(quantity),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+	}
+
+	previousWindowOrder := newOrder("previous-window", 400_000)
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, previousWindowOrder))
+	replacedOrder := newOrder("replaced", 100_000)
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, replacedOrder))
+	requireAllowance(500_000)
+
+	// the allowance consumed by the orders is released once the window passes after the end of their bucket
+	sdkCtx = sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(25 * time.Hour))
+	requireAllowance(1_000_000)
+
+	currentWindowOrder := newOrder("current-window", 300_000)
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, currentWindowOrder))
+	requireAllowance(700_000)
+
+	// the released allowance isn't restored again once the order is cancelled
+	require.NoError(t, testApp.DEXKeeper.CancelOrder(sdkCtx, acc, previousWindowOrder.ID))
+	requireAllowance(700_000)
+
+	// the released allowance of the replaced order isn't reused by the new order
+	replacingOrder := newOrder("replacing", 200_000)
+	require.NoError(t, testApp.DEXKeeper.ReplaceOrder(sdkCtx, replacedOrder.ID, replacingOrder))
+	requireAllowance(500_000)
+
+	// the allowance consumed within the window is restored
+	require.NoError(t, testApp.DEXKeeper.CancelOrder(sdkCtx, acc, replacingOrder.ID))
+	requireAllowance(700_000)
+	require.NoError(t, testApp.DEXKeeper.CancelOrder(sdkCtx, acc, currentWindowOrder.ID))
+	requireAllowance(1_000_000)
+}
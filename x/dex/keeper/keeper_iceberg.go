@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// orderBookRecordRequeuer returns the record to the matched records to match it again by the same taker.
+type orderBookRecordRequeuer interface {
+	Requeue(record types.OrderBookRecord)
+}
+
+// refillIcebergRecord refills the visible quantity of the closed iceberg maker record from its hidden quantity and
+// moves the record to the back of its price level, it returns false if the record can't be refilled and must be
+// closed.
+func (k Keeper) refillIcebergRecord(
+	ctx sdk.Context,
+	mr *MatchingResult,
+	requeuer orderBookRecordRequeuer,
+	makerAddr sdk.AccAddress,
+	makerRecord *types.OrderBookRecord,
+) (bool, error) {
+	if !makerRecord.HasHiddenQuantity() {
+		return false, nil
+	}
+
+	orderData, err := k.getOrderData(ctx, makerRecord.OrderSequence)
+	if err != nil {
+		return false, err
+	}
+	if orderData.VisibleQuantity == nil {
+		return false, sdkerrors.Wrapf(
+			types.ErrInvalidState,
+			"visible quantity isn't set for the order with the hidden quantity, sequence: %d",
+			makerRecord.OrderSequence,
+		)
+	}
+
+	refilledRecord := *makerRecord
+	refilledRecord.RemainingBaseQuantity = makerRecord.GetTotalRemainingBaseQuantity()
+	refilledRecord.RemainingHiddenQuantity = sdkmath.Int{}
+	refilledRecord.SplitVisibleQuantity(orderData.VisibleQuantity)
+	if !isOrderRecordExecutableAsMaker(&refilledRecord) {
+		return false, nil
+	}
+
+	// the new priority sequence moves the record to the back of its price level
+	refilledRecord.PrioritySequence, err = k.genNextOrderSequence(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	k.logger(ctx).Debug(
+		"Refilling iceberg record.",
+		"makerRecord", makerRecord.String(),
+		"refilledRecord", refilledRecord.String(),
+	)
+
+	mr.RefillIcebergRecord(makerAddr, makerRecord.GetPrioritySequence(), refilledRecord)
+	// the refilled record can be matched again by the same taker
+	requeuer.Requeue(refilledRecord)
+
+	return true, nil
+}
+
+func (k Keeper) applyIcebergRefills(ctx sdk.Context, mr *MatchingResult) error {
+	for _, refill := range mr.IcebergRefills {
+		record := refill.Record
+		if err := k.removeOrderBookRecord(
+			ctx, record.OrderBookID, record.Side, record.Price, refill.PreviousPrioritySequence,
+		); err != nil {
+			return err
+		}
+		if err := k.saveOrderBookRecord(ctx, record); err != nil {
+			return err
+		}
+
+		orderData, err := k.getOrderData(ctx, record.OrderSequence)
+		if err != nil {
+			return err
+		}
+		orderData.PrioritySequence = record.PrioritySequence
+		if err := k.saveOrderData(ctx, record.OrderSequence, orderData); err != nil {
+			return err
+		}
+
+		remainingHiddenQuantity := sdkmath.ZeroInt()
+		if record.HasHiddenQuantity() {
+			remainingHiddenQuantity = record.RemainingHiddenQuantity
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderRefilled{
+			Creator:                  refill.Address.String(),
+			ID:                       record.OrderID,
+			Sequence:                 record.OrderSequence,
+			RemainingVisibleQuantity: record.RemainingBaseQuantity,
+			RemainingHiddenQuantity:  remainingHiddenQuantity,
+		}); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderRefilled: %s", err)
+		}
+	}
+
+	return nil
+}
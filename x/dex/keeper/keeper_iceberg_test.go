@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/keeper"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_IcebergOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+
+	acc1, acc2, acc3 := testSet.acc1, testSet.acc2, testSet.acc3
+	testApp.MintAndSendCoin(t, sdkCtx, acc1, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 100_000)))
+	testApp.MintAndSendCoin(t, sdkCtx, acc2, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 50_000)))
+	testApp.MintAndSendCoin(t, sdkCtx, acc3, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 50_000)))
+	for _, acc := range []sdk.AccAddress{acc1, acc2, acc3} {
+		fundOrderReserve(t, testApp, sdkCtx, acc)
+	}
+
+	newSellOrder := func(acc sdk.AccAddress, id string, quantity int64) types.Order {
+		return types.Order{
+			Creator:     acc.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          id,
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+			Quantity:    sdkmath.NewInt(quantity),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+	}
+
+	icebergOrder := newSellOrder(acc1, "iceberg", 100_000)
+	icebergOrder.VisibleQuantity = lo.ToPtr(sdkmath.NewInt(30_000))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, icebergOrder))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, newSellOrder(acc2, "regular", 50_000)))
+
+	// the full quantity is locked
+	require.Equal(t, "100000", assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc1, testSet.denom1).Amount.String())
+
+	// only the visible quantity is shown in the order book
+	orders, _, err := dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_SELL, &query.PageRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	require.Equal(t, "iceberg", orders[0].ID)
+	require.Equal(t, "30000", orders[0].Quantity.String())
+	require.Equal(t, "30000", orders[0].RemainingBaseQuantity.String())
+	require.Equal(t, "30000", orders[0].RemainingSpendableBalance.String())
+
+	_, asks, err := dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom1, testSet.denom2, 0, false)
+	require.NoError(t, err)
+	require.Len(t, asks, 1)
+	require.Equal(t, "80000", asks[0].BaseQuantity.String())
+
+	// the orders queries don't expose the hidden quantity
+	queryService := keeper.NewQueryService(dexKeeper)
+	orderRes, err := queryService.Order(sdkCtx, &types.QueryOrderRequest{
+		Creator: acc1.String(),
+		Id:      "iceberg",
+	})
+	require.NoError(t, err)
+	order := orderRes.Order
+	require.Equal(t, "30000", order.Quantity.String())
+	require.Equal(t, "30000", order.RemainingBaseQuantity.String())
+	require.Equal(t, "30000", order.RemainingSpendableBalance.String())
+	require.Equal(t, "30000", order.VisibleQuantity.String())
+	ordersRes, err := queryService.Orders(sdkCtx, &types.QueryOrdersRequest{
+		Creator: acc1.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, ordersRes.Orders, 1)
+	require.Equal(t, "30000", ordersRes.Orders[0].Quantity.String())
+	require.Equal(t, "30000", ordersRes.Orders[0].RemainingBaseQuantity.String())
+	require.Equal(t, "30000", ordersRes.Orders[0].RemainingSpendableBalance.String())
+
+	// the taker fills the visible quantity, the refilled iceberg order is moved behind the regular order
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     acc3.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(40_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+
+	events := readOrderEvents(t, sdkCtx)
+	require.Len(t, events.OrdersRefilled, 1)
+	require.Equal(t, types.EventOrderRefilled{
+		Creator:                  acc1.String(),
+		ID:                       "iceberg",
+		Sequence:                 order.Sequence,
+		RemainingVisibleQuantity: sdkmath.NewInt(30_000),
+		RemainingHiddenQuantity:  sdkmath.NewInt(40_000),
+	}, events.OrdersRefilled[0])
+
+	orders, _, err = dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_SELL, &query.PageRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	require.Equal(t, "regular", orders[0].ID)
+	require.Equal(t, "40000", orders[0].RemainingBaseQuantity.String())
+	require.Equal(t, "iceberg", orders[1].ID)
+	require.Equal(t, order.Sequence, orders[1].Sequence)
+	require.Equal(t, "30000", orders[1].RemainingBaseQuantity.String())
+
+	order, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc1, "iceberg")
+	require.NoError(t, err)
+	require.Equal(t, "30000", order.RemainingBaseQuantity.String())
+	require.Equal(t, "70000", assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc1, testSet.denom1).Amount.String())
+
+	// the refilled order is canceled with the total remaining quantity
+	require.NoError(t, dexKeeper.CancelOrder(sdkCtx, acc1, "iceberg"))
+	require.True(t, assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc1, testSet.denom1).IsZero())
+	require.True(t, assetFTKeeper.GetDEXExpectedToReceivedBalance(sdkCtx, acc1, testSet.denom2).IsZero())
+}
@@ -16,9 +16,11 @@ import (
 func (k Keeper) matchOrder(
 	ctx sdk.Context,
 	params types.Params,
+	cachedAccKeeper cachedAccountKeeper,
 	accNumber uint64,
 	orderBookID, invertedOrderBookID uint32,
 	takerOrder types.Order,
+	releasedLimits orderLimits,
 ) error {
 	k.logger(ctx).Debug("Matching order.", "order", takerOrder.String())
 
@@ -32,7 +34,7 @@ func (k Keeper) matchOrder(
 		}
 	}()
 
-	takerRecord, err := k.initTakerRecord(ctx, accNumber, orderBookID, takerOrder)
+	takerRecord, err := k.initTakerRecord(ctx, accNumber, orderBookID, takerOrder, releasedLimits)
 	if err != nil {
 		return err
 	}
@@ -50,62 +52,37 @@ func (k Keeper) matchOrder(
 	if err != nil {
 		return err
 	}
+	mr.ReleaseTakerLimits(releasedLimits)
 
-	cachedAccKeeper := newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer)
+	feeRates, err := k.getOrderBookFeeRates(ctx, params, orderBookID)
+	if err != nil {
+		return err
+	}
+	invertedFeeRates, err := k.getOrderBookFeeRates(ctx, params, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
 
-	takerIsFilled := false
-	for {
-		makerRecord, matches, err := mf.Next()
-		if err != nil {
-			return err
-		}
-		if !matches {
-			break
-		}
-		takerIsFilled, err = k.matchRecords(ctx, cachedAccKeeper, mr, &takerRecord, &makerRecord, takerOrder)
-		if err != nil {
-			return err
-		}
-		if takerIsFilled {
-			break
-		}
+	takerIsFilled, err := k.matchTakerRecord(
+		ctx, cachedAccKeeper, mr, mf, &takerRecord, takerOrder, feeRates, invertedFeeRates,
+	)
+	if err != nil {
+		return err
 	}
 
 	switch takerOrder.Type {
 	case types.ORDER_TYPE_LIMIT:
 		switch takerOrder.TimeInForce {
-		case types.TIME_IN_FORCE_GTC:
-			// If taker order is filled fully or not executable as maker we just apply matching result and return.
-			if takerIsFilled || !isOrderRecordExecutableAsMaker(&takerRecord) {
-				return k.applyMatchingResult(ctx, mr)
-			}
-
-			// If taker orders is not filled fully we need to:
-			// - increase taker limits for record for remaining amount
-			// - apply matching result
-			// - add remaining order to the order book
-			if err := mr.IncreaseTakerLimitsForRecord(params, takerOrder, &takerRecord); err != nil {
-				return err
-			}
-
-			// In partial match case, we should create an order for the remaining part, and it makes sense to happen
-			// after finalizing the match, but since a call to smart contract happens in applyMatchingResult, it will be
-			// created before that. (The reason is explained inside DEXExecuteActions function) So, smart contract will
-			// see the match already happened, and it can calculate what the state was before the match, with the data
-			// passed to it.
-			if err := k.createOrder(ctx, params, takerOrder, takerRecord); err != nil {
-				return err
-			}
-
-			return k.applyMatchingResult(ctx, mr)
+		case types.TIME_IN_FORCE_GTC, types.TIME_IN_FORCE_POST_ONLY:
+			return k.saveTakerRemainder(ctx, params, mr, takerOrder, takerRecord, takerIsFilled)
 		case types.TIME_IN_FORCE_IOC:
-			return k.applyMatchingResult(ctx, mr)
+			return k.applyMatchingResult(ctx, params, mr)
 		case types.TIME_IN_FORCE_FOK:
 			// ensure full order fill
 			if takerRecord.RemainingBaseQuantity.IsPositive() {
-				return nil
+				return k.decreaseReleasedLimits(ctx, mr)
 			}
-			return k.applyMatchingResult(ctx, mr)
+			return k.applyMatchingResult(ctx, params, mr)
 		default:
 			return sdkerrors.Wrapf(
 				types.ErrInvalidInput,
@@ -115,7 +92,7 @@ func (k Keeper) matchOrder(
 	case types.ORDER_TYPE_MARKET:
 		switch takerOrder.TimeInForce {
 		case types.TIME_IN_FORCE_IOC:
-			return k.applyMatchingResult(ctx, mr)
+			return k.applyMatchingResult(ctx, params, mr)
 		default:
 			return sdkerrors.Wrapf(
 				types.ErrInvalidInput,
@@ -129,13 +106,95 @@ func (k Keeper) matchOrder(
 	}
 }
 
+// matchTakerRecord matches the taker record with the order book records found by the matching finder until the taker
+// is filled or no record matches, it returns true if the taker is filled.
+func (k Keeper) matchTakerRecord(
+	ctx sdk.Context,
+	cachedAccKeeper cachedAccountKeeper,
+	mr *MatchingResult,
+	mf *MatchingFinder,
+	takerRecord *types.OrderBookRecord,
+	takerOrder types.Order,
+	feeRates, invertedFeeRates types.OrderBookFeeRates,
+) (bool, error) {
+	for {
+		makerRecord, matches, err := mf.Next()
+		if err != nil {
+			return false, err
+		}
+		if !matches {
+			return false, nil
+		}
+		if takerOrder.TimeInForce == types.TIME_IN_FORCE_POST_ONLY {
+			return false, sdkerrors.Wrapf(
+				types.ErrInvalidInput,
+				"post-only order %q matches the order book and can't be placed as maker",
+				takerOrder.ID,
+			)
+		}
+		var takerIsFilled bool
+		if isSelfTrade(takerOrder, takerRecord, &makerRecord) {
+			// the taker closed by the self-trade prevention is handled as filled to skip the remaining part
+			takerIsFilled, err = k.preventSelfTrade(
+				ctx, cachedAccKeeper, mr, mf, takerRecord, &makerRecord, takerOrder,
+			)
+		} else {
+			takerIsFilled, err = k.matchRecords(
+				ctx, cachedAccKeeper, mr, mf, takerRecord, &makerRecord, takerOrder, feeRates, invertedFeeRates,
+			)
+		}
+		if err != nil {
+			return false, err
+		}
+		if takerIsFilled {
+			return true, nil
+		}
+	}
+}
+
+// saveTakerRemainder saves the remaining part of the GTC taker order to the order book, if it's executable as maker,
+// and applies the matching result.
+func (k Keeper) saveTakerRemainder(
+	ctx sdk.Context,
+	params types.Params,
+	mr *MatchingResult,
+	takerOrder types.Order,
+	takerRecord types.OrderBookRecord,
+	takerIsFilled bool,
+) error {
+	// If taker order is filled fully or not executable as maker we just apply matching result and return.
+	if takerIsFilled || !isOrderRecordExecutableAsMaker(&takerRecord) {
+		return k.applyMatchingResult(ctx, params, mr)
+	}
+
+	// If taker orders is not filled fully we need to:
+	// - increase taker limits for record for remaining amount
+	// - apply matching result
+	// - add remaining order to the order book
+	if err := mr.IncreaseTakerLimitsForRecord(params, takerOrder, &takerRecord); err != nil {
+		return err
+	}
+
+	// In partial match case, we should create an order for the remaining part, and it makes sense to happen
+	// after finalizing the match, but since a call to smart contract happens in applyMatchingResult, it will be
+	// created before that. (The reason is explained inside DEXExecuteActions function) So, smart contract will
+	// see the match already happened, and it can calculate what the state was before the match, with the data
+	// passed to it.
+	if err := k.createOrder(ctx, params, takerOrder, takerRecord); err != nil {
+		return err
+	}
+
+	return k.applyMatchingResult(ctx, params, mr)
+}
+
 func (k Keeper) initTakerRecord(
 	ctx sdk.Context,
 	accNumber uint64,
 	orderBookID uint32,
 	order types.Order,
+	releasedLimits orderLimits,
 ) (types.OrderBookRecord, error) {
-	remainingBalance, err := k.getInitialRemainingBalance(ctx, order)
+	remainingBalance, err := k.getInitialRemainingBalance(ctx, order, releasedLimits)
 	if err != nil {
 		return types.OrderBookRecord{}, err
 	}
@@ -165,6 +224,7 @@ func (k Keeper) initTakerRecord(
 func (k Keeper) getInitialRemainingBalance(
 	ctx sdk.Context,
 	order types.Order,
+	releasedLimits orderLimits,
 ) (sdkmath.Int, error) {
 	creatorAddr, err := sdk.AccAddressFromBech32(order.Creator)
 	if err != nil {
@@ -184,6 +244,8 @@ func (k Keeper) getInitialRemainingBalance(
 		if err != nil {
 			return sdkmath.Int{}, err
 		}
+		// the balance locked by the replaced order is released together with the execution
+		spendableBalance = spendableBalance.AddAmount(releasedLimits.LockedCoins.AmountOf(spendableBalance.Denom))
 
 		// For market buy order we lock whole spendable balance.
 		remainingBalance = spendableBalance
@@ -207,8 +269,10 @@ func (k Keeper) matchRecords(
 	ctx sdk.Context,
 	cachedAccKeeper cachedAccountKeeper,
 	mr *MatchingResult,
+	mf *MatchingFinder,
 	takerRecord, makerRecord *types.OrderBookRecord,
 	takerOrder types.Order,
+	feeRates, invertedFeeRates types.OrderBookFeeRates,
 ) (bool, error) {
 	k.logger(ctx).Debug(
 		"Matching OB records.",
@@ -216,13 +280,7 @@ func (k Keeper) matchRecords(
 		"makerRecord", makerRecord.String(),
 	)
 
-	takerReceivesDenom, takerSpendsDenom := takerOrder.BaseDenom, takerOrder.QuoteDenom
-	if takerOrder.Side == types.SIDE_SELL {
-		takerReceivesDenom, takerSpendsDenom = takerOrder.QuoteDenom, takerOrder.BaseDenom
-	}
-
 	isMakerInverted := takerRecord.Side == makerRecord.Side
-
 	takerRecordForMatching := newMatchingOBRecord(takerRecord, false)
 	makerRecordForMatching := newMatchingOBRecord(makerRecord, isMakerInverted)
 	trade, closeResult := match(takerRecordForMatching, makerRecordForMatching)
@@ -232,50 +290,98 @@ func (k Keeper) matchRecords(
 		"closeResult", closeResult.String(),
 	)
 
+	if err := k.settleMatchedRecords(
+		ctx,
+		cachedAccKeeper,
+		mr,
+		mf,
+		takerRecord,
+		makerRecord,
+		takerOrder,
+		feeRates,
+		invertedFeeRates,
+		trade,
+		closeResult,
+		makerRecord.OrderBookID,
+		makerRecord.Price,
+	); err != nil {
+		return false, err
+	}
+
+	// We continue only if closeResult shouldn't close the taker record
+	return closeResult == closeTaker || closeResult == closeBoth, nil
+}
+
+// settleMatchedRecords sends the funds of the trade between the taker and maker, registers the trade with the price of
+// the order book, reduces the matched records and refills, closes or updates the maker record.
+func (k Keeper) settleMatchedRecords(
+	ctx sdk.Context,
+	cachedAccKeeper cachedAccountKeeper,
+	mr *MatchingResult,
+	requeuer orderBookRecordRequeuer,
+	takerRecord, makerRecord *types.OrderBookRecord,
+	takerOrder types.Order,
+	feeRates, invertedFeeRates types.OrderBookFeeRates,
+	trade Trade,
+	closeResult CloseResult,
+	tradeOrderBookID uint32,
+	tradePrice types.Price,
+) error {
+	takerReceivesDenom, takerSpendsDenom := takerOrder.BaseDenom, takerOrder.QuoteDenom
+	if takerOrder.Side == types.SIDE_SELL {
+		takerReceivesDenom, takerSpendsDenom = takerOrder.QuoteDenom, takerOrder.BaseDenom
+	}
+
+	isMakerInverted := takerRecord.Side == makerRecord.Side
+	// the maker fee rate is defined by the order book of the maker order
+	makerFeeRate := feeRates.MakerFeeRate
+	if isMakerInverted {
+		makerFeeRate = invertedFeeRates.MakerFeeRate
+	}
+
 	// Send funds
 	makerAddr, err := cachedAccKeeper.getAccountAddressWithCache(ctx, makerRecord.AccountNumber)
 	if err != nil {
-		return false, err
+		return err
 	}
 	mr.SendFromTaker(
 		makerAddr,
 		makerRecord.OrderID,
 		makerRecord.OrderSequence,
 		sdk.NewCoin(takerSpendsDenom, sdkmath.NewIntFromBigInt(trade.TakerSpends)),
+		makerFeeRate,
 	)
 	mr.SendFromMaker(
 		makerAddr,
 		makerRecord.OrderID,
 		sdk.NewCoin(takerReceivesDenom, sdkmath.NewIntFromBigInt(trade.TakerReceives)),
+		feeRates.TakerFeeRate,
 	)
-
-	// Reduce taker
-	takerRecord.RemainingBaseQuantity = takerRecord.RemainingBaseQuantity.Sub(
-		sdkmath.NewIntFromBigInt(trade.BaseQuantity))
-	takerRecord.RemainingSpendableBalance = takerRecord.RemainingSpendableBalance.Sub(
-		sdkmath.NewIntFromBigInt(trade.TakerSpends))
-
-	// Reduce maker
-	if !isMakerInverted {
-		makerRecord.RemainingBaseQuantity = makerRecord.RemainingBaseQuantity.Sub(
-			sdkmath.NewIntFromBigInt(trade.BaseQuantity))
-		makerRecord.RemainingSpendableBalance = makerRecord.RemainingSpendableBalance.Sub(
-			sdkmath.NewIntFromBigInt(trade.TakerReceives))
-	} else {
-		makerRecord.RemainingBaseQuantity = makerRecord.RemainingBaseQuantity.Sub(
-			sdkmath.NewIntFromBigInt(trade.QuoteQuantity))
-		makerRecord.RemainingSpendableBalance = makerRecord.RemainingSpendableBalance.Sub(
-			sdkmath.NewIntFromBigInt(trade.TakerReceives))
+	if trade.BaseQuantity.Sign() > 0 {
+		mr.SetLastPrice(tradeOrderBookID, tradePrice)
+		if err := addOrderBookTrade(mr, tradeOrderBookID, tradePrice, takerRecord, trade); err != nil {
+			return err
+		}
 	}
 
+	reduceMatchedRecords(takerRecord, makerRecord, trade, isMakerInverted)
+
 	k.logger(ctx).Debug(
 		"Matched OB records after reduction.",
 		"takerRecord", takerRecord.String(),
 		"makerRecord", makerRecord.String(),
 	)
 
-	// Close or update maker record
+	// Refill, close or update maker record
 	if closeResult == closeMaker || closeResult == closeBoth || !isOrderRecordExecutableAsMaker(makerRecord) {
+		refilled, err := k.refillIcebergRecord(ctx, mr, requeuer, makerAddr, makerRecord)
+		if err != nil {
+			return err
+		}
+		if refilled {
+			return nil
+		}
+
 		lockedCoins, expectedToReceiveCoin, err := k.getMakerLockedAndExpectedToReceiveCoins(
 			ctx,
 			makerRecord,
@@ -283,7 +389,7 @@ func (k Keeper) matchRecords(
 			takerSpendsDenom,
 		)
 		if err != nil {
-			return false, err
+			return err
 		}
 
 		mr.DecreaseMakerLimits(makerAddr, lockedCoins, expectedToReceiveCoin)
@@ -292,8 +398,29 @@ func (k Keeper) matchRecords(
 		mr.UpdateRecord(*makerRecord)
 	}
 
-	// We continue only if closeResult shouldn't close the taker record
-	return closeResult == closeTaker || closeResult == closeBoth, nil
+	return nil
+}
+
+// reduceMatchedRecords reduces the taker and maker records by the trade quantities.
+func reduceMatchedRecords(takerRecord, makerRecord *types.OrderBookRecord, trade Trade, isMakerInverted bool) {
+	// Reduce taker
+	takerRecord.RemainingBaseQuantity = takerRecord.RemainingBaseQuantity.Sub(
+		sdkmath.NewIntFromBigInt(trade.BaseQuantity))
+	takerRecord.RemainingSpendableBalance = takerRecord.RemainingSpendableBalance.Sub(
+		sdkmath.NewIntFromBigInt(trade.TakerSpends))
+
+	// Reduce maker
+	if !isMakerInverted {
+		makerRecord.RemainingBaseQuantity = makerRecord.RemainingBaseQuantity.Sub(
+			sdkmath.NewIntFromBigInt(trade.BaseQuantity))
+		makerRecord.RemainingSpendableBalance = makerRecord.RemainingSpendableBalance.Sub(
+			sdkmath.NewIntFromBigInt(trade.TakerReceives))
+	} else {
+		makerRecord.RemainingBaseQuantity = makerRecord.RemainingBaseQuantity.Sub(
+			sdkmath.NewIntFromBigInt(trade.QuoteQuantity))
+		makerRecord.RemainingSpendableBalance = makerRecord.RemainingSpendableBalance.Sub(
+			sdkmath.NewIntFromBigInt(trade.TakerReceives))
+	}
 }
 
 // isOrderRecordExecutableAsMaker returns true if RemainingBaseQuantity inside order is executable with order price.
@@ -376,7 +503,7 @@ func (k Keeper) getMakerLockedAndExpectedToReceiveCoins(
 	}
 
 	expectedToReceiveAmt, err := types.ComputeLimitOrderExpectedToReceiveAmount(
-		makerRecord.Side, makerRecord.RemainingBaseQuantity, makerRecord.Price,
+		makerRecord.Side, makerRecord.GetTotalRemainingBaseQuantity(), makerRecord.Price,
 	)
 	if err != nil {
 		return nil, sdk.Coin{}, err
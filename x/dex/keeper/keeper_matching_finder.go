@@ -23,6 +23,13 @@ type MatchingFinder struct {
 
 	directOBRecord   *types.OrderBookRecord
 	invertedOBRecord *types.OrderBookRecord
+
+	// the records read from the iterators but not returned yet, since the requeued records are returned before them
+	directOBIteratorRecord   *types.OrderBookRecord
+	invertedOBIteratorRecord *types.OrderBookRecord
+	// the refilled iceberg records moved to the back of their price levels
+	directRequeuedRecords   []types.OrderBookRecord
+	invertedRequeuedRecords []types.OrderBookRecord
 }
 
 // NewMatchingFinder returns new instance of the MatchingFinder.
@@ -90,6 +97,16 @@ func (mf *MatchingFinder) Next() (types.OrderBookRecord, bool, error) {
 	return record, true, nil
 }
 
+// Requeue puts the record back to the order book behind the records with the same price, it's used for the refilled
+// iceberg records which must be matched again within the same matching.
+func (mf *MatchingFinder) Requeue(record types.OrderBookRecord) {
+	if record.OrderBookID == mf.directOBIterator.orderBookID {
+		mf.directRequeuedRecords = append(mf.directRequeuedRecords, record)
+		return
+	}
+	mf.invertedRequeuedRecords = append(mf.invertedRequeuedRecords, record)
+}
+
 // Close closes used iterators for the MatchingFinder.
 func (mf *MatchingFinder) Close() error {
 	if err := mf.directOBIterator.Close(); err != nil {
@@ -104,26 +121,56 @@ func (mf *MatchingFinder) Close() error {
 
 func (mf *MatchingFinder) loadOrders() error {
 	if mf.directOBRecord == nil {
-		directOBRecord, found, err := mf.directOBIterator.Next()
+		var err error
+		mf.directOBRecord, err = loadNextRecord(
+			mf.directOBIterator, &mf.directOBIteratorRecord, &mf.directRequeuedRecords,
+		)
 		if err != nil {
 			return err
 		}
-		if found {
-			mf.directOBRecord = &directOBRecord
-		}
 	}
 
 	if mf.invertedOBRecord == nil {
-		invertedOBRecord, found, err := mf.invertedOBIterator.Next()
+		var err error
+		mf.invertedOBRecord, err = loadNextRecord(
+			mf.invertedOBIterator, &mf.invertedOBIteratorRecord, &mf.invertedRequeuedRecords,
+		)
 		if err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
+
+// loadNextRecord returns the next record of the order book side, the requeued record is returned once the iterator
+// reaches the record with the different price, since the requeued record has the lowest priority at its price level,
+// and its price is the best price of the remaining records.
+func loadNextRecord(
+	iterator *OrderBookIterator,
+	iteratorRecord **types.OrderBookRecord,
+	requeuedRecords *[]types.OrderBookRecord,
+) (*types.OrderBookRecord, error) {
+	if *iteratorRecord == nil {
+		record, found, err := iterator.Next()
+		if err != nil {
+			return nil, err
+		}
 		if found {
-			mf.invertedOBRecord = &invertedOBRecord
+			*iteratorRecord = &record
 		}
 	}
 
-	return nil
+	if len(*requeuedRecords) > 0 &&
+		(*iteratorRecord == nil || !cbig.RatEQ((*iteratorRecord).Price.Rat(), (*requeuedRecords)[0].Price.Rat())) {
+		record := (*requeuedRecords)[0]
+		*requeuedRecords = (*requeuedRecords)[1:]
+		return &record, nil
+	}
+
+	record := *iteratorRecord
+	*iteratorRecord = nil
+	return record, nil
 }
 
 func (mf *MatchingFinder) isDirectRecordBestMatch(directMatches, invertedMatches bool) bool {
@@ -137,9 +184,9 @@ func (mf *MatchingFinder) isDirectRecordBestMatch(directMatches, invertedMatches
 	directOBPriceRat := mf.directOBRecord.Price.Rat()
 	invertedOBInvPriceRat := cbig.RatInv(mf.invertedOBRecord.Price.Rat())
 
-	// if both prices are the same then FIFO by OrderSequence wins
+	// if both prices are the same then FIFO by the priority sequence wins
 	if cbig.RatEQ(directOBPriceRat, invertedOBInvPriceRat) {
-		return mf.directOBRecord.OrderSequence < mf.invertedOBRecord.OrderSequence
+		return mf.directOBRecord.GetPrioritySequence() < mf.invertedOBRecord.GetPrioritySequence()
 	}
 
 	if mf.order.Side == types.SIDE_BUY {
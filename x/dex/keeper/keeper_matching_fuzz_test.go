@@ -409,7 +409,7 @@ func (fa *FuzzApp) FundAccountAndApplyFTFeatures(
 	if spendDef.IsFeatureEnabled(assetfttypes.Feature_freezing) {
 		freezeCoin := sdk.NewCoin(order.GetSpendDenom(), sdkmath.NewIntFromUint64(orderRnd.Uint64()))
 		t.Logf("Freezing account's coin: %s, %s", creator.String(), freezeCoin.String())
-		require.NoError(t, fa.testApp.AssetFTKeeper.SetFrozen(sdkCtx, fa.issuer, creator, freezeCoin))
+		require.NoError(t, fa.testApp.AssetFTKeeper.SetFrozen(sdkCtx, fa.issuer, creator, freezeCoin, nil))
 	}
 
 	if receiveDef.IsFeatureEnabled(assetfttypes.Feature_whitelisting) {
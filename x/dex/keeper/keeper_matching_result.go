@@ -5,6 +5,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/samber/lo"
 
 	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
@@ -17,6 +18,29 @@ type RecordToAddress struct {
 	Record  *types.OrderBookRecord
 }
 
+// OrderBookTrade is the trade executed in the order book.
+type OrderBookTrade struct {
+	OrderBookID uint32
+	Trade       types.Trade
+}
+
+// SelfTradeReduction is the order book record reduced by the self-trade prevention without the execution, the record
+// is nil if it's refilled from its hidden quantity, so only the limits are decreased.
+type SelfTradeReduction struct {
+	Address               sdk.AccAddress
+	Record                *types.OrderBookRecord
+	Cancel                bool
+	LockedCoins           sdk.Coins
+	ExpectedToReceiveCoin sdk.Coin
+}
+
+// IcebergRefill is the iceberg order book record refilled from its hidden quantity.
+type IcebergRefill struct {
+	Address                  sdk.AccAddress
+	PreviousPrioritySequence uint64
+	Record                   types.OrderBookRecord
+}
+
 // MatchingResult holds the result of a matching operation.
 type MatchingResult struct {
 	TakerAddress            sdk.AccAddress
@@ -24,7 +48,15 @@ type MatchingResult struct {
 	TakerOrderReducedEvent  types.EventOrderReduced
 	MakerOrderReducedEvents []types.EventOrderReduced
 	RecordsToRemove         []RecordToAddress
-	RecordToUpdate          *types.OrderBookRecord
+	RecordsToUpdate         []types.OrderBookRecord
+	LastPriceOrderBookID    uint32
+	LastPrice               *types.Price
+	Trades                  []OrderBookTrade
+	FeeCollectorAddress     sdk.AccAddress
+	Fees                    sdk.Coins
+	SelfTradeReductions     []SelfTradeReduction
+	IcebergRefills          []IcebergRefill
+	TakerReleasedLimits     orderLimits
 }
 
 // NewMatchingResult creates a new instance of MatchingResult.
@@ -34,67 +66,89 @@ func NewMatchingResult(order types.Order) (*MatchingResult, error) {
 		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
 	}
 
-	var orderStrPrice *string
-	if order.Price != nil {
-		orderStrPrice = lo.ToPtr(order.Price.String())
-	}
-
 	return &MatchingResult{
 		TakerAddress: takerAddress,
-		FTActions: assetfttypes.NewDEXActions(
-			assetfttypes.DEXOrder{
-				Creator:    takerAddress,
-				Type:       order.Type.String(),
-				ID:         order.ID,
-				Sequence:   order.Sequence,
-				BaseDenom:  order.BaseDenom,
-				QuoteDenom: order.QuoteDenom,
-				Price:      orderStrPrice,
-				Quantity:   order.Quantity,
-				Side:       order.Side.String(),
-			},
-		),
+		FTActions:    assetfttypes.NewDEXActions(newDEXOrder(takerAddress, order)),
 		TakerOrderReducedEvent: types.EventOrderReduced{
 			Creator:      order.Creator,
 			ID:           order.ID,
 			Sequence:     order.Sequence,
 			SentCoin:     sdk.NewCoin(order.GetSpendDenom(), sdkmath.ZeroInt()),
 			ReceivedCoin: sdk.NewCoin(order.GetReceiveDenom(), sdkmath.ZeroInt()),
+			FeeCoin:      sdk.NewCoin(order.GetReceiveDenom(), sdkmath.ZeroInt()),
+			FeeRate:      sdkmath.LegacyZeroDec(),
 		},
 		MakerOrderReducedEvents: make([]types.EventOrderReduced, 0),
 		RecordsToRemove:         make([]RecordToAddress, 0),
-		RecordToUpdate:          nil,
+		RecordsToUpdate:         make([]types.OrderBookRecord, 0),
+		Trades:                  make([]OrderBookTrade, 0),
+		FeeCollectorAddress:     authtypes.NewModuleAddress(types.FeeCollectorName),
+		Fees:                    sdk.NewCoins(),
+		SelfTradeReductions:     make([]SelfTradeReduction, 0),
+		IcebergRefills:          make([]IcebergRefill, 0),
 	}, nil
 }
 
-// SendFromTaker registers the coin to be sent from taker to maker.
+func newDEXOrder(creator sdk.AccAddress, order types.Order) assetfttypes.DEXOrder {
+	var orderStrPrice *string
+	if order.Price != nil {
+		orderStrPrice = lo.ToPtr(order.Price.String())
+	}
+
+	return assetfttypes.DEXOrder{
+		Creator:    creator,
+		Type:       order.Type.String(),
+		ID:         order.ID,
+		Sequence:   order.Sequence,
+		BaseDenom:  order.BaseDenom,
+		QuoteDenom: order.QuoteDenom,
+		Price:      orderStrPrice,
+		Quantity:   order.Quantity,
+		Side:       order.Side.String(),
+	}
+}
+
+// SendFromTaker registers the coin to be sent from taker to maker, the maker fee is deducted from the coin and sent
+// to the fee collector.
 func (mr *MatchingResult) SendFromTaker(
-	makerAddr sdk.AccAddress, makerOrderID string, makerOrderSequence uint64, coin sdk.Coin,
+	makerAddr sdk.AccAddress,
+	makerOrderID string,
+	makerOrderSequence uint64,
+	coin sdk.Coin,
+	makerFeeRate sdkmath.LegacyDec,
 ) {
 	if coin.IsZero() {
 		return
 	}
 
+	fee := computeFee(coin, makerFeeRate)
 	mr.FTActions.AddCreatorExpectedToSpend(coin)
-	mr.FTActions.AddSend(mr.TakerAddress, makerAddr, coin)
+	mr.sendWithFee(mr.TakerAddress, makerAddr, coin, fee)
 	mr.FTActions.AddDecreaseExpectedToReceive(makerAddr, coin)
 
-	mr.updateTakerSendEvents(makerAddr, makerOrderID, makerOrderSequence, coin)
+	mr.updateTakerSendEvents(makerAddr, makerOrderID, makerOrderSequence, coin, fee, makerFeeRate)
 }
 
-// SendFromMaker registers the coin to be sent from maker to taker.
-func (mr *MatchingResult) SendFromMaker(makerAddr sdk.AccAddress, makerOrderID string, coin sdk.Coin) {
+// SendFromMaker registers the coin to be sent from maker to taker, the taker fee is deducted from the coin and sent
+// to the fee collector.
+func (mr *MatchingResult) SendFromMaker(
+	makerAddr sdk.AccAddress,
+	makerOrderID string,
+	coin sdk.Coin,
+	takerFeeRate sdkmath.LegacyDec,
+) {
 	if coin.IsZero() {
 		return
 	}
 
+	fee := computeFee(coin, takerFeeRate)
 	// call `AddCreatorExpectedToReceive` but don't call AddIncreaseExpectedToReceive since
 	// `AddIncreaseExpectedToReceive` is used for the state after the matching, but CreatorExpectedToReceive before
 	mr.FTActions.AddCreatorExpectedToReceive(coin)
 	mr.FTActions.AddDecreaseLocked(makerAddr, coin)
-	mr.FTActions.AddSend(makerAddr, mr.TakerAddress, coin)
+	mr.sendWithFee(makerAddr, mr.TakerAddress, coin, fee)
 
-	mr.updateMakerSendEvents(makerAddr, makerOrderID, coin)
+	mr.updateMakerSendEvents(makerAddr, makerOrderID, coin, fee, takerFeeRate)
 }
 
 // DecreaseMakerLimits registers the coins to be unlocked and decreases the expected to receive.
@@ -148,6 +202,11 @@ func (mr *MatchingResult) IncreaseTakerLimitsForRecord(
 	return nil
 }
 
+// ReleaseTakerLimits registers the limits of the order replaced by the taker order, to be netted with the taker limits.
+func (mr *MatchingResult) ReleaseTakerLimits(limits orderLimits) {
+	mr.TakerReleasedLimits = limits
+}
+
 // RemoveRecord registers the record for removal.
 func (mr *MatchingResult) RemoveRecord(creator sdk.AccAddress, record *types.OrderBookRecord) {
 	mr.RecordsToRemove = append(mr.RecordsToRemove, RecordToAddress{
@@ -158,14 +217,74 @@ func (mr *MatchingResult) RemoveRecord(creator sdk.AccAddress, record *types.Ord
 
 // UpdateRecord registers the record for update.
 func (mr *MatchingResult) UpdateRecord(record types.OrderBookRecord) {
-	mr.RecordToUpdate = &record
+	mr.RecordsToUpdate = append(mr.RecordsToUpdate, record)
+}
+
+// ReduceSelfTradeRecord registers the record to be reduced or canceled by the self-trade prevention and the limits
+// to be decreased, the nil record means that the record is refilled and only the limits are decreased.
+func (mr *MatchingResult) ReduceSelfTradeRecord(
+	creator sdk.AccAddress,
+	record *types.OrderBookRecord,
+	cancel bool,
+	lockedCoins sdk.Coins, expectedToReceiveCoin sdk.Coin,
+) {
+	mr.SelfTradeReductions = append(mr.SelfTradeReductions, SelfTradeReduction{
+		Address:               creator,
+		Record:                record,
+		Cancel:                cancel,
+		LockedCoins:           lockedCoins,
+		ExpectedToReceiveCoin: expectedToReceiveCoin,
+	})
+}
+
+// RefillIcebergRecord registers the iceberg record to be moved from the previous priority sequence with the
+// refilled quantities.
+func (mr *MatchingResult) RefillIcebergRecord(
+	creator sdk.AccAddress,
+	previousPrioritySequence uint64,
+	record types.OrderBookRecord,
+) {
+	mr.IcebergRefills = append(mr.IcebergRefills, IcebergRefill{
+		Address:                  creator,
+		PreviousPrioritySequence: previousPrioritySequence,
+		Record:                   record,
+	})
+}
+
+// SetLastPrice registers the price of the last trade in the order book.
+func (mr *MatchingResult) SetLastPrice(orderBookID uint32, price types.Price) {
+	mr.LastPriceOrderBookID = orderBookID
+	mr.LastPrice = &price
+}
+
+// AddTrade registers the trade executed in the order book.
+func (mr *MatchingResult) AddTrade(orderBookID uint32, trade types.Trade) {
+	mr.Trades = append(mr.Trades, OrderBookTrade{
+		OrderBookID: orderBookID,
+		Trade:       trade,
+	})
+}
+
+// sendWithFee registers the coin to be sent to the recipient and the fee part of the coin to the fee collector.
+func (mr *MatchingResult) sendWithFee(fromAddr, toAddr sdk.AccAddress, coin, fee sdk.Coin) {
+	if !fee.IsPositive() {
+		mr.FTActions.AddSend(fromAddr, toAddr, coin)
+		return
+	}
+
+	if amount := coin.Sub(fee); amount.IsPositive() {
+		mr.FTActions.AddSend(fromAddr, toAddr, amount)
+	}
+	mr.FTActions.AddSend(fromAddr, mr.FeeCollectorAddress, fee)
+	mr.Fees = mr.Fees.Add(fee)
 }
 
 func (mr *MatchingResult) updateTakerSendEvents(
 	makerAddr sdk.AccAddress,
 	makerOrderID string,
 	makerOrderSequence uint64,
-	coin sdk.Coin,
+	coin, makerFee sdk.Coin,
+	makerFeeRate sdkmath.LegacyDec,
 ) {
 	mr.TakerOrderReducedEvent.SentCoin = mr.TakerOrderReducedEvent.SentCoin.Add(coin)
 	mr.MakerOrderReducedEvents = append(mr.MakerOrderReducedEvents, types.EventOrderReduced{
@@ -173,16 +292,22 @@ func (mr *MatchingResult) updateTakerSendEvents(
 		ID:           makerOrderID,
 		Sequence:     makerOrderSequence,
 		ReceivedCoin: coin,
+		FeeCoin:      makerFee,
+		FeeRate:      makerFeeRate,
 	})
 }
 
 func (mr *MatchingResult) updateMakerSendEvents(
 	makerAddr sdk.AccAddress,
 	makerOrderID string,
-	coin sdk.Coin,
+	coin, takerFee sdk.Coin,
+	takerFeeRate sdkmath.LegacyDec,
 ) {
 	mr.TakerOrderReducedEvent.ReceivedCoin = mr.TakerOrderReducedEvent.ReceivedCoin.Add(coin)
-	for i := range mr.MakerOrderReducedEvents {
+	mr.TakerOrderReducedEvent.FeeCoin = mr.TakerOrderReducedEvent.FeeCoin.Add(takerFee)
+	mr.TakerOrderReducedEvent.FeeRate = takerFeeRate
+	// the refilled iceberg order might be matched more than once, so the search starts from the last event
+	for i := len(mr.MakerOrderReducedEvents) - 1; i >= 0; i-- {
 		// find corresponding event created by `updateTakerSendEvents`
 		if mr.MakerOrderReducedEvents[i].Creator == makerAddr.String() && mr.MakerOrderReducedEvents[i].ID == makerOrderID {
 			mr.MakerOrderReducedEvents[i].SentCoin = coin
@@ -191,10 +316,20 @@ func (mr *MatchingResult) updateMakerSendEvents(
 	}
 }
 
-func (k Keeper) applyMatchingResult(ctx sdk.Context, mr *MatchingResult) error {
-	// if matched passed but no changes are applied return
+func (k Keeper) applyMatchingResult(ctx sdk.Context, params types.Params, mr *MatchingResult) error {
+	// the refills are applied first since the refilled records might be updated or removed later in the matching
+	if err := k.applyIcebergRefills(ctx, mr); err != nil {
+		return err
+	}
+
+	// the self-trade reductions are applied even without the execution
+	if err := k.applySelfTradeReductions(ctx, mr); err != nil {
+		return err
+	}
+
+	// if matched passed but no changes are applied, only the limits of the replaced order are released
 	if mr.FTActions.CreatorExpectedToSpend.IsNil() {
-		return nil
+		return k.decreaseReleasedLimits(ctx, mr)
 	}
 
 	for _, item := range mr.RecordsToRemove {
@@ -203,20 +338,45 @@ func (k Keeper) applyMatchingResult(ctx sdk.Context, mr *MatchingResult) error {
 		}
 	}
 
-	if mr.RecordToUpdate != nil {
-		if err := k.saveOrderBookRecord(ctx, *mr.RecordToUpdate); err != nil {
+	for _, record := range mr.RecordsToUpdate {
+		if err := k.saveOrderBookRecord(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	if mr.LastPrice != nil {
+		if err := k.setOrderBookLastPrice(ctx, mr.LastPriceOrderBookID, *mr.LastPrice); err != nil {
+			return err
+		}
+		if err := k.checkCircuitBreaker(ctx, params, mr.LastPriceOrderBookID, *mr.LastPrice); err != nil {
 			return err
 		}
 	}
 
+	if err := k.saveTrades(ctx, mr.Trades); err != nil {
+		return err
+	}
+
+	if err := k.addAccumulatedFees(ctx, mr.Fees); err != nil {
+		return err
+	}
+
 	if err := k.publishMatchingEvents(ctx, mr); err != nil {
 		return err
 	}
 
+	mr.FTActions.ReleaseCreatorLimits(mr.TakerReleasedLimits.LockedCoins, mr.TakerReleasedLimits.ExpectedToReceiveCoin)
+
 	// the call to smart contract is the last call here to avoid reentrancy vulnerability.
 	return k.assetFTKeeper.DEXExecuteActions(ctx, mr.FTActions)
 }
 
+// decreaseReleasedLimits decreases the limits of the order replaced by the taker order if the taker limits aren't
+// applied.
+func (k Keeper) decreaseReleasedLimits(ctx sdk.Context, mr *MatchingResult) error {
+	return k.decreaseOrderLimits(ctx, mr.TakerAddress, mr.TakerReleasedLimits)
+}
+
 func (k Keeper) publishMatchingEvents(
 	ctx sdk.Context,
 	mr *MatchingResult,
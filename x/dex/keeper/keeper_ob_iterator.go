@@ -158,7 +158,7 @@ func (i *OrderBookIterator) readOrderBookRecordFromIterator() (types.OrderBookRe
 			sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal OrderBookRecordData, err: %s", err)
 	}
 
-	return types.OrderBookRecord{
+	record := types.OrderBookRecord{
 		// key attributes
 		OrderBookID:   i.orderBookID,
 		Side:          i.side,
@@ -169,7 +169,17 @@ func (i *OrderBookIterator) readOrderBookRecordFromIterator() (types.OrderBookRe
 		AccountNumber:             storedRecord.AccountNumber,
 		RemainingBaseQuantity:     storedRecord.RemainingBaseQuantity,
 		RemainingSpendableBalance: storedRecord.RemainingSpendableBalance,
-	}, nil
+	}
+	// the key contains the priority sequence of the refilled iceberg order
+	if storedRecord.OrderSequence != 0 {
+		record.OrderSequence = storedRecord.OrderSequence
+		record.PrioritySequence = orderSequence
+	}
+	if storedRecord.RemainingHiddenQuantity != nil {
+		record.RemainingHiddenQuantity = *storedRecord.RemainingHiddenQuantity
+	}
+
+	return record, nil
 }
 
 // NewOrderBookSideIterator returns order book iterator with the reading based on side (buy - tail, sell head).
@@ -0,0 +1,406 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// closeOrderBookBatchSize is the max number of orders canceled in one block for the closed order book.
+const closeOrderBookBatchSize = 100
+
+// CloseOrderBook closes the order book and its inverted order book. The trading is rejected until the order book is
+// reopened, and all orders are canceled in the batches starting from the next block.
+func (k Keeper) CloseOrderBook(ctx sdk.Context, authority, baseDenom, quoteDenom string) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	if err := k.validateDenomPair(ctx, baseDenom, quoteDenom); err != nil {
+		return err
+	}
+
+	orderBookID, invertedOrderBookID, err := k.getOrGenOrderBookIDs(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return err
+	}
+	pairOrderBookID := getOrderBookPairID(orderBookID, invertedOrderBookID)
+
+	closed, err := k.isOrderBookClosed(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	if closed {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "order book %s/%s is already closed", baseDenom, quoteDenom)
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Set(
+		types.CreateOrderBookClosedKey(pairOrderBookID), types.StoreTrue,
+	); err != nil {
+		return err
+	}
+	if err := k.delayOrderBookOrdersCancellation(ctx, pairOrderBookID); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderBookClosed{
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderBookClosed: %s", err)
+	}
+
+	return nil
+}
+
+// ReopenOrderBook reopens the closed order book and its inverted order book. The reopening is rejected until all
+// orders of the closed order book are canceled.
+func (k Keeper) ReopenOrderBook(ctx sdk.Context, authority, baseDenom, quoteDenom string) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return err
+	}
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+
+	closed, err := k.isOrderBookClosed(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	if !closed {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "order book %s/%s is not closed", baseDenom, quoteDenom)
+	}
+
+	orders, err := k.getOrderBookPairOrders(ctx, orderBookID, invertedOrderBookID, 1, nil)
+	if err != nil {
+		return err
+	}
+	if len(orders) != 0 {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidState,
+			"order book %s/%s orders are still being canceled", baseDenom, quoteDenom,
+		)
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(
+		types.CreateOrderBookClosedKey(getOrderBookPairID(orderBookID, invertedOrderBookID)),
+	); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderBookReopened{
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderBookReopened: %s", err)
+	}
+
+	return nil
+}
+
+// CancelClosedOrderBookOrders cancels the next batch of the closed order book orders, and delays the cancellation of
+// the next batch if there are orders left.
+func (k Keeper) CancelClosedOrderBookOrders(ctx sdk.Context, orderBookID uint32) error {
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	// the order book might be reopened with the new orders after the previous batch
+	closed, err := k.isOrderBookClosed(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	if !closed {
+		return nil
+	}
+
+	failedOrdersKeyPrefix := types.CreateClosedOrderBookFailedOrderKeyPrefix(
+		getOrderBookPairID(orderBookID, invertedOrderBookID),
+	)
+	failedOrderSequences, err := k.getFailedOrderSequences(ctx, failedOrdersKeyPrefix)
+	if err != nil {
+		return err
+	}
+	// one extra order is loaded to find out whether the next batch is needed
+	orders, err := k.getOrderBookPairOrders(
+		ctx, orderBookID, invertedOrderBookID, closeOrderBookBatchSize+1, failedOrderSequences,
+	)
+	if err != nil {
+		return err
+	}
+	batchOrders := orders[:min(len(orders), closeOrderBookBatchSize)]
+
+	k.logger(ctx).Debug(
+		"Cancelling closed order book orders.",
+		"orderBookID", orderBookID,
+		"count", len(batchOrders),
+	)
+
+	// the orders failing the cancellation are skipped by the next batches, so the cancellation progresses even if
+	// the whole batch fails, the skipped orders remain in the order book and can be canceled by their creators
+	if err := k.cancelOrdersBatch(ctx, batchOrders, failedOrdersKeyPrefix); err != nil {
+		return err
+	}
+	if len(orders) > closeOrderBookBatchSize {
+		return k.delayOrderBookOrdersCancellation(ctx, orderBookID)
+	}
+
+	return k.removeFailedOrderSequences(ctx, failedOrdersKeyPrefix)
+}
+
+// GetClosedOrderBookIDs returns the IDs of the closed order books.
+func (k Keeper) GetClosedOrderBookIDs(ctx sdk.Context) ([]uint32, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.OrderBookClosedKeyPrefix,
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	orderBookIDs := make([]uint32, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		orderBookID, err := types.DecodeOrderBookClosedKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		orderBookIDs = append(orderBookIDs, orderBookID)
+	}
+
+	return orderBookIDs, nil
+}
+
+// ImportClosedOrderBook saves the closed order book, the delayed orders cancellation is imported by the delay module.
+func (k Keeper) ImportClosedOrderBook(ctx sdk.Context, orderBookID uint32) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.CreateOrderBookClosedKey(orderBookID), types.StoreTrue)
+}
+
+func (k Keeper) delayOrderBookOrdersCancellation(ctx sdk.Context, orderBookID uint32) error {
+	// the delayed items are executed for the heights lower than the current one, so the batch is canceled in the
+	// next block
+	height := uint64(ctx.BlockHeight())
+	k.logger(ctx).Debug(
+		"Delaying closed order book orders cancellation.",
+		"orderBookID", orderBookID,
+		"height", height,
+	)
+	if err := k.delayKeeper.ExecuteAfterBlock(
+		ctx,
+		types.BuildOrderBookCloseDelayKey(orderBookID),
+		&types.CloseOrderBook{
+			OrderBookID: orderBookID,
+		},
+		height,
+	); err != nil {
+		return sdkerrors.Wrap(err, "failed to create closed order book delayed orders cancellation")
+	}
+
+	return nil
+}
+
+// getOrderBookPairOrders returns up to the limit orders of the order book and its inverted order book including the
+// trigger orders waiting for the activation or its retry, excluding the skipped orders.
+func (k Keeper) getOrderBookPairOrders(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	limit int,
+	skippedOrderSequences map[uint64]struct{},
+) ([]batchOrder, error) {
+	cachedAccKeeper := newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer)
+	orders := make([]batchOrder, 0)
+	for _, id := range []uint32{orderBookID, invertedOrderBookID} {
+		for _, side := range []types.Side{types.SIDE_BUY, types.SIDE_SELL} {
+			if len(orders) == limit {
+				return orders, nil
+			}
+			// the records are collected before the cancellation to not modify the store during the iteration
+			if err := func() error {
+				iterator := k.NewOrderBookSideIterator(ctx, id, side)
+				defer iterator.Close()
+
+				for len(orders) < limit {
+					record, found, err := iterator.Next()
+					if err != nil {
+						return err
+					}
+					if !found {
+						return nil
+					}
+					if _, ok := skippedOrderSequences[record.OrderSequence]; ok {
+						continue
+					}
+					creator, err := cachedAccKeeper.getAccountAddressWithCache(ctx, record.AccountNumber)
+					if err != nil {
+						return err
+					}
+					orders = append(orders, batchOrder{
+						creator:       creator,
+						orderSequence: record.OrderSequence,
+					})
+				}
+
+				return nil
+			}(); err != nil {
+				return nil, err
+			}
+		}
+
+		for _, direction := range []types.TriggerDirection{types.TriggerDirectionUp, types.TriggerDirectionDown} {
+			if len(orders) == limit {
+				return orders, nil
+			}
+			triggerOrders, err := k.getOrderBookTriggerOrders(
+				ctx, id, direction, limit-len(orders), skippedOrderSequences,
+			)
+			if err != nil {
+				return nil, err
+			}
+			orders = append(orders, triggerOrders...)
+		}
+	}
+	if len(orders) == limit {
+		return orders, nil
+	}
+
+	// the trigger orders failing both the activation and the cancellation are kept out of the trigger index until
+	// their retry height
+	retriedTriggerOrders, err := k.getOrderBookPairRetriedTriggerOrders(
+		ctx, orderBookID, invertedOrderBookID, limit-len(orders), skippedOrderSequences,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(orders, retriedTriggerOrders...), nil
+}
+
+func (k Keeper) getOrderBookPairRetriedTriggerOrders(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	limit int,
+	skippedOrderSequences map[uint64]struct{},
+) ([]batchOrder, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.TriggerOrderRetryKeyPrefix,
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	orders := make([]batchOrder, 0)
+	for ; iterator.Valid() && len(orders) < limit; iterator.Next() {
+		_, orderSequence, err := types.DecodeTriggerOrderRetryKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := skippedOrderSequences[orderSequence]; ok {
+			continue
+		}
+		order, found, err := k.findTriggerOrder(ctx, orderSequence)
+		if err != nil {
+			return nil, err
+		}
+		// the retry record of the removed order is skipped at its height
+		if !found {
+			continue
+		}
+		id, err := k.getOrderBookIDByDenoms(ctx, order.BaseDenom, order.QuoteDenom)
+		if err != nil {
+			return nil, err
+		}
+		if id != orderBookID && id != invertedOrderBookID {
+			continue
+		}
+		creator, err := sdk.AccAddressFromBech32(order.Creator)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+		}
+		orders = append(orders, batchOrder{
+			creator:       creator,
+			orderSequence: orderSequence,
+		})
+	}
+
+	return orders, nil
+}
+
+func (k Keeper) getOrderBookTriggerOrders(
+	ctx sdk.Context,
+	orderBookID uint32,
+	direction types.TriggerDirection,
+	limit int,
+	skippedOrderSequences map[uint64]struct{},
+) ([]batchOrder, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateTriggerOrderBookDirectionKey(orderBookID, direction),
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	orders := make([]batchOrder, 0)
+	for ; iterator.Valid() && len(orders) < limit; iterator.Next() {
+		_, orderSequence, err := types.DecodeOrderBookSideRecordKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := skippedOrderSequences[orderSequence]; ok {
+			continue
+		}
+		order, err := k.getTriggerOrder(ctx, orderSequence)
+		if err != nil {
+			return nil, err
+		}
+		creator, err := sdk.AccAddressFromBech32(order.Creator)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+		}
+		orders = append(orders, batchOrder{
+			creator:       creator,
+			orderSequence: orderSequence,
+		})
+	}
+
+	return orders, nil
+}
+
+func (k Keeper) isOrderBookClosed(ctx sdk.Context, orderBookID, invertedOrderBookID uint32) (bool, error) {
+	return k.storeService.OpenKVStore(ctx).Has(
+		types.CreateOrderBookClosedKey(getOrderBookPairID(orderBookID, invertedOrderBookID)),
+	)
+}
+
+func (k Keeper) validateOrderBookIsNotClosed(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	baseDenom, quoteDenom string,
+) error {
+	closed, err := k.isOrderBookClosed(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	if closed {
+		return sdkerrors.Wrapf(types.ErrOrderBookClosed, "order book %s/%s is closed", baseDenom, quoteDenom)
+	}
+
+	return nil
+}
+
+// validateOrderBookIsOpen validates that the order book is neither halted nor closed, so its orders can be matched.
+func (k Keeper) validateOrderBookIsOpen(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	baseDenom, quoteDenom string,
+) error {
+	if err := k.validateOrderBookIsNotHalted(ctx, orderBookID, invertedOrderBookID, baseDenom, quoteDenom); err != nil {
+		return err
+	}
+
+	return k.validateOrderBookIsNotClosed(ctx, orderBookID, invertedOrderBookID, baseDenom, quoteDenom)
+}
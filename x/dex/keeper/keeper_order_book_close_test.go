@@ -0,0 +1,314 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_CloseOrderBook(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	// the orders are canceled in two batches
+	const ordersPerAccount = 75
+	for i := range ordersPerAccount {
+		for _, order := range []types.Order{
+			{
+				Creator:     testSet.acc1.String(),
+				Type:        types.ORDER_TYPE_LIMIT,
+				ID:          fmt.Sprintf("sell-%d", i),
+				BaseDenom:   testSet.denom1,
+				QuoteDenom:  testSet.denom2,
+				Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+				Quantity:    defaultQuantityStep,
+				Side:        types.SIDE_SELL,
+				TimeInForce: types.TIME_IN_FORCE_GTC,
+				GoodTil: &types.GoodTil{
+					GoodTilBlockHeight: 1000,
+				},
+			},
+			{
+				Creator:     testSet.acc2.String(),
+				Type:        types.ORDER_TYPE_LIMIT,
+				ID:          fmt.Sprintf("buy-%d", i),
+				BaseDenom:   testSet.denom2,
+				QuoteDenom:  testSet.denom1,
+				Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+				Quantity:    defaultQuantityStep,
+				Side:        types.SIDE_SELL,
+				TimeInForce: types.TIME_IN_FORCE_GTC,
+			},
+		} {
+			placeFundedOrder(t, sdkCtx, testApp, order)
+		}
+	}
+
+	// only the governance can close the order book
+	require.ErrorIs(t, dexKeeper.CloseOrderBook(
+		sdkCtx, testSet.acc1.String(), testSet.denom1, testSet.denom2,
+	), govtypes.ErrInvalidSigner)
+
+	require.NoError(t, dexKeeper.CloseOrderBook(sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2))
+	require.ErrorIs(t, dexKeeper.CloseOrderBook(
+		sdkCtx, govAddr.String(), testSet.denom2, testSet.denom1,
+	), types.ErrInvalidInput)
+
+	// the new orders are rejected in both order books
+	for _, denoms := range [][]string{{testSet.denom1, testSet.denom2}, {testSet.denom2, testSet.denom1}} {
+		require.ErrorIs(t, dexKeeper.PlaceOrder(simapp.CopyContextWithMultiStore(sdkCtx), types.Order{
+			Creator:     testSet.acc3.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "id1",
+			BaseDenom:   denoms[0],
+			QuoteDenom:  denoms[1],
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}), types.ErrOrderBookClosed)
+	}
+
+	// the order book can't be reopened until all orders are canceled
+	require.ErrorIs(t, dexKeeper.ReopenOrderBook(
+		sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2,
+	), types.ErrInvalidState)
+
+	ordersCount := func() uint64 {
+		var count uint64
+		for _, acc := range []sdk.AccAddress{testSet.acc1, testSet.acc2} {
+			accCount, err := dexKeeper.GetAccountDenomOrdersCount(sdkCtx, acc, testSet.denom1)
+			require.NoError(t, err)
+			count += accCount
+		}
+		return count
+	}
+	require.Equal(t, uint64(2*ordersPerAccount), ordersCount())
+
+	for _, expectedCount := range []uint64{2*ordersPerAccount - 100, 0} {
+		sdkCtx = testApp.NewContextLegacy(false, cmtproto.Header{
+			Height: sdkCtx.BlockHeight() + 1,
+		})
+		_, err := testApp.BeginBlocker(sdkCtx)
+		require.NoError(t, err)
+		_, err = testApp.EndBlocker(sdkCtx)
+		require.NoError(t, err)
+
+		require.Equal(t, expectedCount, ordersCount())
+	}
+
+	// the locked balances and the order reserves are returned
+	for _, acc := range []sdk.AccAddress{testSet.acc1, testSet.acc2} {
+		dexLockedBalances, _, err := testApp.AssetFTKeeper.GetDEXLockedBalances(sdkCtx, acc, &query.PageRequest{})
+		require.NoError(t, err)
+		require.True(t, dexLockedBalances.IsZero())
+	}
+
+	require.NoError(t, dexKeeper.ReopenOrderBook(sdkCtx, govAddr.String(), testSet.denom2, testSet.denom1))
+	require.ErrorIs(t, dexKeeper.ReopenOrderBook(
+		sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2,
+	), types.ErrInvalidInput)
+
+	placeFundedOrder(t, sdkCtx, testApp, types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          fmt.Sprintf("sell-%d", ordersPerAccount),
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	})
+}
+
+func TestKeeper_CloseOrderBookWithFailedCancellation(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	for _, order := range []types.Order{
+		{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "sell",
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		{
+			Creator:     testSet.acc2.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "buy",
+			BaseDenom:   testSet.denom2,
+			QuoteDenom:  testSet.denom1,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+	} {
+		placeFundedOrder(t, sdkCtx, testApp, order)
+	}
+
+	// break the locked balance to fail the cancellation of the acc1 order
+	testApp.AssetFTKeeper.SetDEXLockedBalances(
+		sdkCtx, testSet.acc1, []sdk.Coin{sdk.NewCoin(testSet.denom1, sdkmath.ZeroInt())},
+	)
+
+	require.NoError(t, dexKeeper.CloseOrderBook(sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2))
+
+	sdkCtx = testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: sdkCtx.BlockHeight() + 1,
+	})
+	_, err := testApp.BeginBlocker(sdkCtx)
+	require.NoError(t, err)
+	_, err = testApp.EndBlocker(sdkCtx)
+	require.NoError(t, err)
+
+	// the failed order is skipped, and the other orders are canceled
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, "sell")
+	require.NoError(t, err)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc2, "buy")
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+}
+
+func TestKeeper_CloseOrderBookWithFailedBatch(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MaxOrdersPerDenom = 200
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	// the orders failing the cancellation have the best price, so they fill the whole first batch of 100 orders
+	const failingOrdersCount = 101
+	for i := range failingOrdersCount {
+		placeFundedOrder(t, sdkCtx, testApp, types.Order{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          fmt.Sprintf("failing-%d", i),
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		})
+	}
+	const ordersCount = 50
+	for i := range ordersCount {
+		placeFundedOrder(t, sdkCtx, testApp, types.Order{
+			Creator:     testSet.acc2.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          fmt.Sprintf("id-%d", i),
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		})
+	}
+
+	// break the locked balance to fail the cancellation of the acc1 orders
+	testApp.AssetFTKeeper.SetDEXLockedBalances(
+		sdkCtx, testSet.acc1, []sdk.Coin{sdk.NewCoin(testSet.denom1, sdkmath.ZeroInt())},
+	)
+
+	require.NoError(t, dexKeeper.CloseOrderBook(sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2))
+
+	ordersCountFn := func(acc sdk.AccAddress) int {
+		orders, _, err := dexKeeper.GetOrders(sdkCtx, acc, &query.PageRequest{Limit: query.PaginationMaxLimit})
+		require.NoError(t, err)
+		return len(orders)
+	}
+
+	// the first batch fails completely, but the cancellation is delayed, and the second batch skips the failed orders
+	for _, expectedCount := range []int{ordersCount, 0} {
+		sdkCtx = testApp.NewContextLegacy(false, cmtproto.Header{
+			Height: sdkCtx.BlockHeight() + 1,
+		})
+		_, err := testApp.BeginBlocker(sdkCtx)
+		require.NoError(t, err)
+		_, err = testApp.EndBlocker(sdkCtx)
+		require.NoError(t, err)
+
+		require.Equal(t, failingOrdersCount, ordersCountFn(testSet.acc1))
+		require.Equal(t, expectedCount, ordersCountFn(testSet.acc2))
+	}
+
+	// the skipped orders are still in the order book
+	require.ErrorIs(
+		t,
+		dexKeeper.ReopenOrderBook(sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2),
+		types.ErrInvalidState,
+	)
+}
+
+func TestKeeper_CloseOrderBookWithRetriedTriggerOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 100,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	// the trigger order fails both the activation and the cancellation, so it's moved to the retry queue
+	stopLossOrder, lockedBalance, creatorAccount := placeFailingTriggerOrder(t, sdkCtx, testApp, testSet)
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+
+	require.NoError(t, dexKeeper.CloseOrderBook(sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2))
+
+	// the retried order is still locking the funds, so the order book can't be reopened
+	require.ErrorIs(t, dexKeeper.ReopenOrderBook(
+		sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2,
+	), types.ErrInvalidState)
+
+	// the retried order is canceled with the closed order book orders
+	testApp.AccountKeeper.SetAccount(sdkCtx, creatorAccount)
+	orderBookID, err := dexKeeper.GetOrderBookIDByDenoms(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, stopLossOrder.ID)
+	require.NoError(t, err)
+	require.NoError(t, dexKeeper.CancelClosedOrderBookOrders(sdkCtx, orderBookID))
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, stopLossOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, lockedBalance.Denom).IsZero())
+
+	require.NoError(t, dexKeeper.ReopenOrderBook(sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2))
+}
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cbig "github.com/CoreumFoundation/coreum/v6/pkg/math/big"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// orderBookDepthSource reads the order book side records as the price levels of the single order.
+type orderBookDepthSource struct {
+	iterator *OrderBookIterator
+	// inverted defines whether the records are read from the inverted order book and must be converted to the
+	// direct order book price terms.
+	inverted bool
+	// roundUp defines the rounding direction of the inverted price.
+	roundUp bool
+	next    *types.PriceLevel
+}
+
+// GetOrderBookDepth returns the order book bids and asks aggregated by price levels.
+func (k Keeper) GetOrderBookDepth(
+	ctx sdk.Context,
+	baseDenom, quoteDenom string,
+	levels uint32,
+	includeInverted bool,
+) ([]types.PriceLevel, []types.PriceLevel, error) {
+	if levels == 0 {
+		levels = types.DefaultOrderBookDepthLevels
+	}
+	if levels > types.MaxOrderBookDepthLevels {
+		return nil, nil, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "levels must be less than or equal to %d", types.MaxOrderBookDepthLevels,
+		)
+	}
+
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return nil, nil, err
+	}
+	var invertedOrderBookID uint32
+	if includeInverted {
+		invertedOrderBookID, err = k.getOrderBookIDByDenoms(ctx, quoteDenom, baseDenom)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	bids, err := k.getOrderBookSideDepth(ctx, orderBookID, invertedOrderBookID, types.SIDE_BUY, includeInverted, levels)
+	if err != nil {
+		return nil, nil, err
+	}
+	asks, err := k.getOrderBookSideDepth(ctx, orderBookID, invertedOrderBookID, types.SIDE_SELL, includeInverted, levels)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return bids, asks, nil
+}
+
+// getOrderBookSideDepth returns the price levels of the order book side sorted from the best price. The inverted order
+// book records are merged the same way as the MatchingFinder does, the buy levels are built from the direct buy and
+// inverted sell records, and the sell levels from the direct sell and inverted buy records.
+func (k Keeper) getOrderBookSideDepth(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	side types.Side,
+	includeInverted bool,
+	levels uint32,
+) ([]types.PriceLevel, error) {
+	sources := []*orderBookDepthSource{
+		{
+			iterator: k.NewOrderBookSideIterator(ctx, orderBookID, side),
+		},
+	}
+	if includeInverted {
+		oppositeSide, err := side.Opposite()
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, &orderBookDepthSource{
+			iterator: k.NewOrderBookSideIterator(ctx, invertedOrderBookID, oppositeSide),
+			inverted: true,
+			// round in the direction of the worse price for the taker
+			roundUp: side == types.SIDE_SELL,
+		})
+	}
+	defer func() {
+		for _, source := range sources {
+			if err := source.iterator.Close(); err != nil {
+				k.logger(ctx).Error(err.Error())
+			}
+		}
+	}()
+
+	priceLevels := make([]types.PriceLevel, 0)
+	for {
+		var (
+			bestSource *orderBookDepthSource
+			bestLevel  *types.PriceLevel
+		)
+		for _, source := range sources {
+			level, err := source.peek()
+			if err != nil {
+				return nil, err
+			}
+			if level == nil {
+				continue
+			}
+			if bestLevel == nil || isBetterDepthPrice(side, level.Price, bestLevel.Price) {
+				bestSource = source
+				bestLevel = level
+			}
+		}
+		if bestLevel == nil {
+			break
+		}
+
+		if len(priceLevels) > 0 && cbig.RatEQ(priceLevels[len(priceLevels)-1].Price.Rat(), bestLevel.Price.Rat()) {
+			lastLevel := &priceLevels[len(priceLevels)-1]
+			lastLevel.BaseQuantity = lastLevel.BaseQuantity.Add(bestLevel.BaseQuantity)
+			lastLevel.OrdersCount += bestLevel.OrdersCount
+		} else {
+			if uint32(len(priceLevels)) == levels {
+				break
+			}
+			priceLevels = append(priceLevels, *bestLevel)
+		}
+		bestSource.pop()
+	}
+
+	return priceLevels, nil
+}
+
+// peek returns the next price level without removing it, or nil if the records are over.
+func (s *orderBookDepthSource) peek() (*types.PriceLevel, error) {
+	if s.next != nil {
+		return s.next, nil
+	}
+
+	record, found, err := s.iterator.Next()
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, nil
+	}
+
+	if !s.inverted {
+		s.next = &types.PriceLevel{
+			Price:        record.Price,
+			BaseQuantity: record.RemainingBaseQuantity,
+			OrdersCount:  1,
+		}
+		return s.next, nil
+	}
+
+	price, err := types.NewPriceFromRat(cbig.RatInv(record.Price.Rat()), s.roundUp)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to invert price %s: %s", record.Price, err)
+	}
+	// the base quantity of the inverted record is the quote quantity of the record
+	baseQuantity, _ := cbig.IntMulRatWithRemainder(record.RemainingBaseQuantity.BigInt(), record.Price.Rat())
+	s.next = &types.PriceLevel{
+		Price:        price,
+		BaseQuantity: sdkmath.NewIntFromBigInt(baseQuantity),
+		OrdersCount:  1,
+	}
+
+	return s.next, nil
+}
+
+// pop removes the price level returned by the peek.
+func (s *orderBookDepthSource) pop() {
+	s.next = nil
+}
+
+// isBetterDepthPrice returns true if the price is better than the current for the order book side, the higher price
+// is better for the buy side, and the lower for the sell side.
+func isBetterDepthPrice(side types.Side, price, currentPrice types.Price) bool {
+	if side == types.SIDE_BUY {
+		return cbig.RatGT(price.Rat(), currentPrice.Rat())
+	}
+
+	return cbig.RatLT(price.Rat(), currentPrice.Rat())
+}
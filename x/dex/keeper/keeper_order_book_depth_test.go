@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_GetOrderBookDepth(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	acc1 := testSet.acc1
+	acc2 := testSet.acc2
+	testApp.MintAndSendCoin(t, sdkCtx, acc1, sdk.NewCoins(
+		sdk.NewInt64Coin(testSet.denom1, 400_000),
+		sdk.NewInt64Coin(testSet.denom2, 30_000),
+	))
+	testApp.MintAndSendCoin(t, sdkCtx, acc2, sdk.NewCoins(
+		sdk.NewInt64Coin(testSet.denom1, 200_000),
+		sdk.NewInt64Coin(testSet.denom2, 70_000),
+	))
+	for range 4 {
+		fundOrderReserve(t, testApp, sdkCtx, acc1)
+	}
+	for range 3 {
+		fundOrderReserve(t, testApp, sdkCtx, acc2)
+	}
+
+	newOrder := func(
+		acc sdk.AccAddress, id, baseDenom, quoteDenom, price string, quantity int64, side types.Side,
+	) types.Order {
+		return types.Order{
+			Creator:     acc.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          id,
+			BaseDenom:   baseDenom,
+			QuoteDenom:  quoteDenom,
+			Price:       lo.ToPtr(types.MustNewPriceFromString(price)),
+			Quantity:    sdkmath.NewInt(quantity),
+			Side:        side,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+	}
+	for _, order := range []types.Order{
+		newOrder(acc1, "id1", testSet.denom1, testSet.denom2, "5e-1", 100_000, types.SIDE_SELL),
+		newOrder(acc1, "id2", testSet.denom1, testSet.denom2, "5e-1", 200_000, types.SIDE_SELL),
+		newOrder(acc1, "id3", testSet.denom1, testSet.denom2, "6e-1", 100_000, types.SIDE_SELL),
+		newOrder(acc2, "id4", testSet.denom1, testSet.denom2, "4e-1", 100_000, types.SIDE_BUY),
+		newOrder(acc2, "id5", testSet.denom1, testSet.denom2, "3e-1", 100_000, types.SIDE_BUY),
+		// sells 200_000 denom1 for 5e-1 denom2 in the direct order book terms
+		newOrder(acc2, "id6", testSet.denom2, testSet.denom1, "2", 100_000, types.SIDE_BUY),
+		// buys 90_000 denom1 for 1/3 denom2 in the direct order book terms
+		newOrder(acc1, "id7", testSet.denom2, testSet.denom1, "3", 30_000, types.SIDE_SELL),
+	} {
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	}
+
+	newPriceLevel := func(price string, baseQuantity int64, ordersCount uint64) types.PriceLevel {
+		return types.PriceLevel{
+			Price:        types.MustNewPriceFromString(price),
+			BaseQuantity: sdkmath.NewInt(baseQuantity),
+			OrdersCount:  ordersCount,
+		}
+	}
+
+	// direct order book only
+	bids, asks, err := dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom1, testSet.denom2, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceLevel{
+		newPriceLevel("4e-1", 100_000, 1),
+		newPriceLevel("3e-1", 100_000, 1),
+	}, bids)
+	require.Equal(t, []types.PriceLevel{
+		newPriceLevel("5e-1", 300_000, 2),
+		newPriceLevel("6e-1", 100_000, 1),
+	}, asks)
+
+	// with inverted order book
+	bids, asks, err = dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom1, testSet.denom2, 0, true)
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceLevel{
+		newPriceLevel("4e-1", 100_000, 1),
+		newPriceLevel("3333333333333333333e-19", 90_000, 1),
+		newPriceLevel("3e-1", 100_000, 1),
+	}, bids)
+	require.Equal(t, []types.PriceLevel{
+		newPriceLevel("5e-1", 500_000, 3),
+		newPriceLevel("6e-1", 100_000, 1),
+	}, asks)
+
+	// limited levels
+	bids, asks, err = dexKeeper.GetOrderBookDepth(sdkCtx, testSet.denom1, testSet.denom2, 1, true)
+	require.NoError(t, err)
+	require.Equal(t, []types.PriceLevel{
+		newPriceLevel("4e-1", 100_000, 1),
+	}, bids)
+	require.Equal(t, []types.PriceLevel{
+		newPriceLevel("5e-1", 500_000, 3),
+	}, asks)
+
+	// too many levels
+	_, _, err = dexKeeper.GetOrderBookDepth(
+		sdkCtx, testSet.denom1, testSet.denom2, types.MaxOrderBookDepthLevels+1, true,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+}
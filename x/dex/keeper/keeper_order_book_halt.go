@@ -0,0 +1,403 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/samber/lo"
+
+	cbig "github.com/CoreumFoundation/coreum/v6/pkg/math/big"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+const (
+	orderBookHaltPrecedenceAdmin = iota
+	orderBookHaltPrecedenceCircuitBreaker
+	orderBookHaltPrecedenceGov
+)
+
+// HaltOrderBook halts the trading in the order book and its inverted order book until it's resumed. The halt is
+// allowed for the governance and the admin of any order book denom with the dex_order_book_halt feature, the admin
+// can't replace the halt of the governance or the circuit breaker.
+func (k Keeper) HaltOrderBook(ctx sdk.Context, sender sdk.AccAddress, baseDenom, quoteDenom string) error {
+	if err := k.validateOrderBookHaltIsAllowed(ctx, sender, baseDenom, quoteDenom); err != nil {
+		return err
+	}
+	if err := k.validateDenomPair(ctx, baseDenom, quoteDenom); err != nil {
+		return err
+	}
+
+	orderBookID, invertedOrderBookID, err := k.getOrGenOrderBookIDs(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return err
+	}
+
+	return k.haltOrderBook(ctx, getOrderBookPairID(orderBookID, invertedOrderBookID), types.OrderBookHalt{
+		HaltedBy: sender.String(),
+	})
+}
+
+// ResumeOrderBook resumes the trading in the halted order book and its inverted order book. The resume is allowed
+// for the governance and the admin of any order book denom with the dex_order_book_halt feature, the admin can
+// resume only the halt set by an admin.
+func (k Keeper) ResumeOrderBook(ctx sdk.Context, sender sdk.AccAddress, baseDenom, quoteDenom string) error {
+	if err := k.validateOrderBookHaltIsAllowed(ctx, sender, baseDenom, quoteDenom); err != nil {
+		return err
+	}
+
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return err
+	}
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	pairOrderBookID := getOrderBookPairID(orderBookID, invertedOrderBookID)
+
+	halt, found, err := k.getOrderBookHalt(ctx, pairOrderBookID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput, "order book %s/%s is not halted", baseDenom, quoteDenom,
+		)
+	}
+	if k.getOrderBookHaltPrecedence(sender.String()) < k.getOrderBookHaltPrecedence(halt.HaltedBy) {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized,
+			"the halt of the order book %s/%s can't be resumed by the sender %s", baseDenom, quoteDenom, sender,
+		)
+	}
+	if halt.ResumeHeight > 0 {
+		if err := k.delayKeeper.RemoveExecuteAtBlock(
+			ctx, types.BuildOrderBookResumeDelayKey(pairOrderBookID), halt.ResumeHeight,
+		); err != nil {
+			return sdkerrors.Wrap(err, "failed to remove order book delayed resume")
+		}
+	}
+
+	return k.resumeOrderBook(ctx, pairOrderBookID, sender.String())
+}
+
+// ResumeHaltedOrderBook resumes the trading in the order book halted until the resume height.
+func (k Keeper) ResumeHaltedOrderBook(ctx sdk.Context, orderBookID uint32) error {
+	_, found, err := k.getOrderBookHalt(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return nil
+	}
+
+	return k.resumeOrderBook(ctx, orderBookID, "")
+}
+
+// GetOrderBookHalt returns the trading halt of the order book and false if the order book isn't halted.
+func (k Keeper) GetOrderBookHalt(
+	ctx sdk.Context,
+	baseDenom, quoteDenom string,
+) (types.OrderBookHalt, bool, error) {
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.OrderBookHalt{}, false, nil
+		}
+		return types.OrderBookHalt{}, false, err
+	}
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return types.OrderBookHalt{}, false, err
+	}
+
+	return k.getOrderBookHalt(ctx, getOrderBookPairID(orderBookID, invertedOrderBookID))
+}
+
+// GetOrderBookHalts returns the paginated order books trading halts.
+func (k Keeper) GetOrderBookHalts(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.OrderBookHaltWithID, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	halts, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.OrderBookHaltKeyPrefix),
+		pagination,
+		// builder
+		func(key []byte, record *types.OrderBookHalt) (*types.OrderBookHaltWithID, error) {
+			orderBookID, err := types.DecodeOrderBookHaltKey(key)
+			if err != nil {
+				return nil, err
+			}
+
+			return &types.OrderBookHaltWithID{
+				OrderBookID: orderBookID,
+				Halt:        *record,
+			}, nil
+		},
+		// constructor
+		func() *types.OrderBookHalt {
+			return &types.OrderBookHalt{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(halts, func(data *types.OrderBookHaltWithID, _ int) types.OrderBookHaltWithID {
+		return *data
+	}), pageRes, nil
+}
+
+// ImportOrderBookHalt saves the order book trading halt, the delayed resume is imported by the delay module.
+func (k Keeper) ImportOrderBookHalt(ctx sdk.Context, orderBookID uint32, halt types.OrderBookHalt) error {
+	return k.setDataToStore(ctx, types.CreateOrderBookHaltKey(orderBookID), &halt)
+}
+
+func (k Keeper) validateOrderBookHaltIsAllowed(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	baseDenom, quoteDenom string,
+) error {
+	if sender.String() == k.authority {
+		return nil
+	}
+	// the admin of any order book denom is allowed to halt the order book
+	if err := k.assetFTKeeper.ValidateDEXOrderBookHaltIsAllowed(ctx, sender, baseDenom); err == nil {
+		return nil
+	}
+	if err := k.assetFTKeeper.ValidateDEXOrderBookHaltIsAllowed(ctx, sender, quoteDenom); err == nil {
+		return nil
+	}
+
+	return sdkerrors.Wrapf(
+		cosmoserrors.ErrUnauthorized,
+		"only governance or admin of the order book denom with the enabled order book halt feature is able to halt "+
+			"and resume the order book %s/%s",
+		baseDenom, quoteDenom,
+	)
+}
+
+func (k Keeper) haltOrderBook(ctx sdk.Context, orderBookID uint32, halt types.OrderBookHalt) error {
+	prevHalt, found, err := k.getOrderBookHalt(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	// the halt with the higher precedence can't be replaced, otherwise it might be lifted earlier by the new one
+	if found && k.getOrderBookHaltPrecedence(halt.HaltedBy) < k.getOrderBookHaltPrecedence(prevHalt.HaltedBy) {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized,
+			"the order book is halted by %q, the halt can't be replaced by %q", prevHalt.HaltedBy, halt.HaltedBy,
+		)
+	}
+	// the new halt replaces the previous one along with its delayed resume
+	if found && prevHalt.ResumeHeight > 0 {
+		if err := k.delayKeeper.RemoveExecuteAtBlock(
+			ctx, types.BuildOrderBookResumeDelayKey(orderBookID), prevHalt.ResumeHeight,
+		); err != nil {
+			return sdkerrors.Wrap(err, "failed to remove order book delayed resume")
+		}
+	}
+
+	if halt.ResumeHeight > 0 {
+		k.logger(ctx).Debug(
+			"Delaying order book resume.",
+			"orderBookID", orderBookID,
+			"height", halt.ResumeHeight,
+		)
+		if err := k.delayKeeper.ExecuteAfterBlock(
+			ctx,
+			types.BuildOrderBookResumeDelayKey(orderBookID),
+			&types.ResumeOrderBook{
+				OrderBookID: orderBookID,
+			},
+			halt.ResumeHeight,
+		); err != nil {
+			return sdkerrors.Wrap(err, "failed to create order book delayed resume")
+		}
+	}
+
+	if err := k.setDataToStore(ctx, types.CreateOrderBookHaltKey(orderBookID), &halt); err != nil {
+		return err
+	}
+
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderBookHalted{
+		BaseDenom:    orderBookData.BaseDenom,
+		QuoteDenom:   orderBookData.QuoteDenom,
+		HaltedBy:     halt.HaltedBy,
+		ResumeHeight: halt.ResumeHeight,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderBookHalted: %s", err)
+	}
+
+	return nil
+}
+
+// getOrderBookHaltPrecedence returns the precedence of the order book halt set or lifted by the address. The
+// governance halt has the highest precedence, then the circuit breaker halt set without the address, and then the
+// halt of the order book denom admin.
+func (k Keeper) getOrderBookHaltPrecedence(haltedBy string) int {
+	switch haltedBy {
+	case k.authority:
+		return orderBookHaltPrecedenceGov
+	case "":
+		return orderBookHaltPrecedenceCircuitBreaker
+	default:
+		return orderBookHaltPrecedenceAdmin
+	}
+}
+
+func (k Keeper) resumeOrderBook(ctx sdk.Context, orderBookID uint32, resumedBy string) error {
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateOrderBookHaltKey(orderBookID)); err != nil {
+		return err
+	}
+	// the circuit breaker window is started again after the resume
+	if err := k.storeService.OpenKVStore(ctx).Delete(types.CreateOrderBookPriceReferenceKey(orderBookID)); err != nil {
+		return err
+	}
+
+	// the trigger orders skipped during the halt are checked again
+	_, found, err := k.getOrderBookLastPrice(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	if found {
+		if err := k.savePendingTriggerOrderBook(ctx, orderBookID); err != nil {
+			return err
+		}
+	}
+
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderBookResumed{
+		BaseDenom:  orderBookData.BaseDenom,
+		QuoteDenom: orderBookData.QuoteDenom,
+		ResumedBy:  resumedBy,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderBookResumed: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) getOrderBookHalt(ctx sdk.Context, orderBookID uint32) (types.OrderBookHalt, bool, error) {
+	var halt types.OrderBookHalt
+	if err := k.getDataFromStore(ctx, types.CreateOrderBookHaltKey(orderBookID), &halt); err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.OrderBookHalt{}, false, nil
+		}
+		return types.OrderBookHalt{}, false, err
+	}
+
+	return halt, true, nil
+}
+
+func (k Keeper) isOrderBookHalted(ctx sdk.Context, orderBookID, invertedOrderBookID uint32) (bool, error) {
+	_, found, err := k.getOrderBookHalt(ctx, getOrderBookPairID(orderBookID, invertedOrderBookID))
+	return found, err
+}
+
+func (k Keeper) validateOrderBookIsNotHalted(
+	ctx sdk.Context,
+	orderBookID, invertedOrderBookID uint32,
+	baseDenom, quoteDenom string,
+) error {
+	halted, err := k.isOrderBookHalted(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	if halted {
+		return sdkerrors.Wrapf(types.ErrOrderBookHalted, "order book %s/%s is halted", baseDenom, quoteDenom)
+	}
+
+	return nil
+}
+
+// checkCircuitBreaker halts the order book and its inverted order book if the trade price has moved more than
+// allowed since the beginning of the circuit breaker window.
+func (k Keeper) checkCircuitBreaker(
+	ctx sdk.Context,
+	params types.Params,
+	orderBookID uint32,
+	price types.Price,
+) error {
+	if params.CircuitBreakerPriceChangeRate.IsNil() || !params.CircuitBreakerPriceChangeRate.IsPositive() {
+		return nil
+	}
+
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+	pairOrderBookID := getOrderBookPairID(orderBookID, invertedOrderBookID)
+
+	var reference types.OrderBookPriceReference
+	found := true
+	if err := k.getDataFromStore(ctx, types.CreateOrderBookPriceReferenceKey(pairOrderBookID), &reference); err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return err
+		}
+		found = false
+	}
+
+	height := ctx.BlockHeight()
+	if !found || height >= reference.Height+int64(params.CircuitBreakerWindowBlocks) {
+		return k.setDataToStore(ctx, types.CreateOrderBookPriceReferenceKey(pairOrderBookID), &types.OrderBookPriceReference{
+			OrderBookID: orderBookID,
+			Price:       price,
+			Height:      height,
+		})
+	}
+
+	referencePrice := reference.Price.Rat()
+	if reference.OrderBookID != orderBookID {
+		referencePrice = cbig.RatInv(referencePrice)
+	}
+	if !isPriceChangeExceeded(referencePrice, price.Rat(), params.CircuitBreakerPriceChangeRate) {
+		return nil
+	}
+	// the existing halt is kept, so the circuit breaker doesn't lift the indefinite halt at its resume height
+	halted, err := k.isOrderBookHalted(ctx, orderBookID, invertedOrderBookID)
+	if err != nil {
+		return err
+	}
+	if halted {
+		return nil
+	}
+
+	k.logger(ctx).Info(
+		"Circuit breaker halts the order book.",
+		"orderBookID", pairOrderBookID,
+		"referencePrice", reference.Price.String(),
+		"price", price.String(),
+	)
+
+	return k.haltOrderBook(ctx, pairOrderBookID, types.OrderBookHalt{
+		ResumeHeight: uint64(height) + params.CircuitBreakerHaltBlocks,
+	})
+}
+
+// isPriceChangeExceeded returns true if the price has changed relative to the reference price more than the rate.
+func isPriceChangeExceeded(referencePrice, price *big.Rat, rate sdkmath.LegacyDec) bool {
+	change := new(big.Rat).Sub(price, referencePrice)
+	change.Abs(change)
+
+	maxChange := cbig.RatMul(
+		referencePrice, cbig.NewRatFromBigInts(rate.BigInt(), sdkmath.LegacyOneDec().BigInt()),
+	)
+
+	return cbig.RatGT(change, maxChange)
+}
@@ -0,0 +1,305 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_HaltOrderBook(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	// the account without admin privileges can't halt the order book
+	require.ErrorIs(t, dexKeeper.HaltOrderBook(
+		sdkCtx, testSet.acc1, testSet.denom1, testSet.denom2,
+	), cosmoserrors.ErrUnauthorized)
+	// the admin can't halt the order book without the feature
+	require.ErrorIs(t, dexKeeper.HaltOrderBook(
+		sdkCtx, testSet.issuer, testSet.denom1, testSet.denom2,
+	), cosmoserrors.ErrUnauthorized)
+
+	// the admin of any order book denom with the feature can halt the order book
+	denomWithHalt, err := testApp.AssetFTKeeper.Issue(sdkCtx, assetfttypes.IssueSettings{
+		Issuer:        testSet.issuer,
+		Subunit:       "halt",
+		Symbol:        "HALT",
+		Precision:     6,
+		InitialAmount: sdkmath.NewIntWithDecimal(1, 20),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_dex_order_book_halt,
+		},
+	})
+	require.NoError(t, err)
+	require.NoError(t, dexKeeper.HaltOrderBook(sdkCtx, testSet.issuer, testSet.denom1, denomWithHalt))
+	require.NoError(t, dexKeeper.ResumeOrderBook(sdkCtx, testSet.issuer, denomWithHalt, testSet.denom1))
+
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    defaultQuantityStep,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	placeFundedOrder(t, sdkCtx, testApp, sellOrder)
+
+	// the governance can halt any order book
+	require.NoError(t, dexKeeper.HaltOrderBook(sdkCtx, govAddr, testSet.denom1, testSet.denom2))
+	for _, denoms := range [][]string{{testSet.denom1, testSet.denom2}, {testSet.denom2, testSet.denom1}} {
+		halt, halted, err := dexKeeper.GetOrderBookHalt(sdkCtx, denoms[0], denoms[1])
+		require.NoError(t, err)
+		require.True(t, halted)
+		require.Equal(t, types.OrderBookHalt{HaltedBy: govAddr.String()}, halt)
+	}
+
+	// the new orders are rejected in both order books
+	for _, order := range []types.Order{
+		{
+			Creator:     testSet.acc2.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "id2",
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		{
+			Creator:     testSet.acc2.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "id3",
+			BaseDenom:   testSet.denom2,
+			QuoteDenom:  testSet.denom1,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+	} {
+		require.ErrorIs(t, dexKeeper.PlaceOrder(sdkCtx, order), types.ErrOrderBookHalted)
+	}
+
+	// the orders can be canceled
+	require.NoError(t, dexKeeper.CancelOrder(sdkCtx, testSet.acc1, sellOrder.ID))
+
+	require.NoError(t, dexKeeper.ResumeOrderBook(sdkCtx, govAddr, testSet.denom2, testSet.denom1))
+	_, halted, err := dexKeeper.GetOrderBookHalt(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.False(t, halted)
+	require.ErrorIs(t, dexKeeper.ResumeOrderBook(
+		sdkCtx, govAddr, testSet.denom1, testSet.denom2,
+	), types.ErrInvalidInput)
+
+	sellOrder.ID = "id4"
+	placeFundedOrder(t, sdkCtx, testApp, sellOrder)
+}
+
+func TestKeeper_CircuitBreaker(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.CircuitBreakerPriceChangeRate = sdkmath.LegacyMustNewDecFromStr("0.1")
+	params.CircuitBreakerWindowBlocks = 10
+	params.CircuitBreakerHaltBlocks = 5
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	trade := func(id, price string) {
+		for _, order := range []types.Order{
+			{
+				Creator:     testSet.acc1.String(),
+				Type:        types.ORDER_TYPE_LIMIT,
+				ID:          id + "-sell",
+				BaseDenom:   testSet.denom1,
+				QuoteDenom:  testSet.denom2,
+				Price:       lo.ToPtr(types.MustNewPriceFromString(price)),
+				Quantity:    defaultQuantityStep,
+				Side:        types.SIDE_SELL,
+				TimeInForce: types.TIME_IN_FORCE_GTC,
+			},
+			{
+				Creator:     testSet.acc2.String(),
+				Type:        types.ORDER_TYPE_LIMIT,
+				ID:          id + "-buy",
+				BaseDenom:   testSet.denom1,
+				QuoteDenom:  testSet.denom2,
+				Price:       lo.ToPtr(types.MustNewPriceFromString(price)),
+				Quantity:    defaultQuantityStep,
+				Side:        types.SIDE_BUY,
+				TimeInForce: types.TIME_IN_FORCE_IOC,
+			},
+		} {
+			placeFundedOrder(t, sdkCtx, testApp, order)
+		}
+	}
+
+	// the first trade starts the window, and the price change within the limit doesn't halt the order book
+	trade("id1", "1")
+	trade("id2", "11e-1")
+	_, halted, err := dexKeeper.GetOrderBookHalt(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.False(t, halted)
+
+	// the price change over the limit halts the order book
+	trade("id3", "12e-1")
+	halt, halted, err := dexKeeper.GetOrderBookHalt(sdkCtx, testSet.denom2, testSet.denom1)
+	require.NoError(t, err)
+	require.True(t, halted)
+	require.Equal(t, types.OrderBookHalt{ResumeHeight: 6}, halt)
+
+	// the order book is resumed automatically
+	var resumeHeight int64
+	for height := int64(2); height <= 10; height++ {
+		sdkCtx = testApp.NewContextLegacy(false, cmtproto.Header{
+			Height: height,
+		})
+		_, err := testApp.BeginBlocker(sdkCtx)
+		require.NoError(t, err)
+		_, err = testApp.EndBlocker(sdkCtx)
+		require.NoError(t, err)
+
+		_, halted, err := dexKeeper.GetOrderBookHalt(sdkCtx, testSet.denom1, testSet.denom2)
+		require.NoError(t, err)
+		if !halted && resumeHeight == 0 {
+			resumeHeight = height
+		}
+	}
+	require.Equal(t, int64(7), resumeHeight)
+
+	// the new window is started after the resume
+	trade("id4", "2")
+	_, halted, err = dexKeeper.GetOrderBookHalt(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.False(t, halted)
+}
+
+func TestKeeper_HaltOrderBook_Precedence(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{
+		Height: 1,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	denomWithHalt, err := testApp.AssetFTKeeper.Issue(sdkCtx, assetfttypes.IssueSettings{
+		Issuer:        testSet.issuer,
+		Subunit:       "halt",
+		Symbol:        "HALT",
+		Precision:     6,
+		InitialAmount: sdkmath.NewIntWithDecimal(1, 20),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_dex_order_book_halt,
+		},
+	})
+	require.NoError(t, err)
+
+	// the admin can't replace or resume the governance halt
+	require.NoError(t, dexKeeper.HaltOrderBook(sdkCtx, govAddr, testSet.denom1, denomWithHalt))
+	require.ErrorIs(t, dexKeeper.HaltOrderBook(
+		sdkCtx, testSet.issuer, testSet.denom1, denomWithHalt,
+	), cosmoserrors.ErrUnauthorized)
+	require.ErrorIs(t, dexKeeper.ResumeOrderBook(
+		sdkCtx, testSet.issuer, testSet.denom1, denomWithHalt,
+	), cosmoserrors.ErrUnauthorized)
+	halt, halted, err := dexKeeper.GetOrderBookHalt(sdkCtx, testSet.denom1, denomWithHalt)
+	require.NoError(t, err)
+	require.True(t, halted)
+	require.Equal(t, types.OrderBookHalt{HaltedBy: govAddr.String()}, halt)
+
+	// the governance can replace and resume the admin halt
+	require.NoError(t, dexKeeper.ResumeOrderBook(sdkCtx, govAddr, testSet.denom1, denomWithHalt))
+	require.NoError(t, dexKeeper.HaltOrderBook(sdkCtx, testSet.issuer, testSet.denom1, denomWithHalt))
+	require.NoError(t, dexKeeper.HaltOrderBook(sdkCtx, govAddr, testSet.denom1, denomWithHalt))
+	require.ErrorIs(t, dexKeeper.ResumeOrderBook(
+		sdkCtx, testSet.issuer, testSet.denom1, denomWithHalt,
+	), cosmoserrors.ErrUnauthorized)
+	require.NoError(t, dexKeeper.ResumeOrderBook(sdkCtx, govAddr, testSet.denom1, denomWithHalt))
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.CircuitBreakerPriceChangeRate = sdkmath.LegacyMustNewDecFromStr("0.1")
+	params.CircuitBreakerWindowBlocks = 10
+	params.CircuitBreakerHaltBlocks = 5
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	for _, trade := range []struct {
+		id    string
+		price string
+	}{
+		{id: "id1", price: "1"},
+		{id: "id2", price: "12e-1"},
+	} {
+		placeFundedOrder(t, sdkCtx, testApp, types.Order{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          trade.id + "-sell",
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  denomWithHalt,
+			Price:       lo.ToPtr(types.MustNewPriceFromString(trade.price)),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		})
+		placeFundedOrder(t, sdkCtx, testApp, types.Order{
+			Creator:     testSet.acc2.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          trade.id + "-buy",
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  denomWithHalt,
+			Price:       lo.ToPtr(types.MustNewPriceFromString(trade.price)),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_IOC,
+		})
+	}
+	circuitBreakerHalt := types.OrderBookHalt{ResumeHeight: 6}
+	halt, halted, err = dexKeeper.GetOrderBookHalt(sdkCtx, testSet.denom1, denomWithHalt)
+	require.NoError(t, err)
+	require.True(t, halted)
+	require.Equal(t, circuitBreakerHalt, halt)
+
+	// the admin can't replace or resume the circuit breaker halt
+	require.ErrorIs(t, dexKeeper.HaltOrderBook(
+		sdkCtx, testSet.issuer, denomWithHalt, testSet.denom1,
+	), cosmoserrors.ErrUnauthorized)
+	require.ErrorIs(t, dexKeeper.ResumeOrderBook(
+		sdkCtx, testSet.issuer, denomWithHalt, testSet.denom1,
+	), cosmoserrors.ErrUnauthorized)
+	halt, halted, err = dexKeeper.GetOrderBookHalt(sdkCtx, testSet.denom1, denomWithHalt)
+	require.NoError(t, err)
+	require.True(t, halted)
+	require.Equal(t, circuitBreakerHalt, halt)
+
+	// the governance can resume the circuit breaker halt
+	require.NoError(t, dexKeeper.ResumeOrderBook(sdkCtx, govAddr, denomWithHalt, testSet.denom1))
+	_, halted, err = dexKeeper.GetOrderBookHalt(sdkCtx, testSet.denom1, denomWithHalt)
+	require.NoError(t, err)
+	require.False(t, halted)
+}
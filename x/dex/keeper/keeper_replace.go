@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// orderLimits is the balance locked and expected to receive by the order.
+type orderLimits struct {
+	LockedCoins           sdk.Coins
+	ExpectedToReceiveCoin sdk.Coin
+}
+
+// ReplaceOrder cancels the order and places the new one, the limits of the replaced order are netted with the limits
+// of the new order, so only the difference is locked or unlocked. If the new order has the same price and the reduced
+// quantity, the order is amended in place keeping the order sequence.
+func (k Keeper) ReplaceOrder(ctx sdk.Context, oldOrderID string, order types.Order) error {
+	k.logger(ctx).Debug("Replacing order.", "oldOrderID", oldOrderID, "order", order)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.validateOrder(ctx, params, order); err != nil {
+		return err
+	}
+
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+	}
+
+	accNumber, err := k.getAccountNumber(ctx, creator)
+	if err != nil {
+		return err
+	}
+
+	oldOrderSequence, err := k.getOrderSequenceByID(ctx, accNumber, oldOrderID)
+	if err != nil {
+		return err
+	}
+
+	// the ID of the replaced order is already reserved
+	if order.ID != oldOrderID {
+		if err := k.reserveOrderID(ctx, accNumber, order.ID); err != nil {
+			return err
+		}
+		// validate duplicated order ID
+		_, err = k.getOrderSequenceByID(ctx, accNumber, order.ID)
+		if err != nil {
+			if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+				return err
+			}
+		} else {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "order with the id %q is already created", order.ID)
+		}
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderReplaced{
+		Creator:     order.Creator,
+		OldID:       oldOrderID,
+		OldSequence: oldOrderSequence,
+		ID:          order.ID,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderReplaced: %s", err)
+	}
+
+	// the orders kept out of the order book are replaced by closing and placing the new order
+	_, isOffBookOrder, err := k.findOffBookOrder(ctx, oldOrderSequence)
+	if err != nil {
+		return err
+	}
+	if !isOffBookOrder {
+		oldOrder, oldRecord, err := k.getOrderWithRecordByAddressAndID(ctx, creator, oldOrderID)
+		if err != nil {
+			return err
+		}
+		amended, err := k.amendOrder(ctx, creator, oldOrder, oldRecord, order)
+		if err != nil {
+			return err
+		}
+		if amended {
+			return nil
+		}
+	}
+
+	releasedLimits, err := k.closeOrder(ctx, creator, oldOrderID)
+	if err != nil {
+		return err
+	}
+
+	return k.placeValidOrder(
+		ctx,
+		params,
+		newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer),
+		accNumber,
+		order,
+		releasedLimits,
+	)
+}
+
+// amendOrder updates the order in the order book in place if the new order has the same price and the reduced
+// quantity, and returns false if the order can't be amended.
+func (k Keeper) amendOrder(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	oldOrder types.Order,
+	oldRecord types.OrderBookRecord,
+	order types.Order,
+) (bool, error) {
+	// the iceberg and trigger orders are always replaced since their settings can't be amended
+	if oldOrder.VisibleQuantity != nil ||
+		order.VisibleQuantity != nil ||
+		order.Trigger != nil ||
+		order.Type != types.ORDER_TYPE_LIMIT ||
+		(order.TimeInForce != types.TIME_IN_FORCE_GTC && order.TimeInForce != types.TIME_IN_FORCE_POST_ONLY) ||
+		order.BaseDenom != oldOrder.BaseDenom ||
+		order.QuoteDenom != oldOrder.QuoteDenom ||
+		order.Side != oldOrder.Side ||
+		order.SelfTradePrevention != oldOrder.SelfTradePrevention ||
+		!order.Price.Equal(*oldOrder.Price) ||
+		!order.Quantity.LT(oldRecord.RemainingBaseQuantity) {
+		return false, nil
+	}
+
+	lockedCoin, err := order.ComputeLimitOrderLockedBalance()
+	if err != nil {
+		return false, err
+	}
+	expectedToReceiveCoin, err := types.ComputeLimitOrderExpectedToReceiveBalance(
+		order.Side, order.BaseDenom, order.QuoteDenom, order.Quantity, *order.Price,
+	)
+	if err != nil {
+		return false, err
+	}
+	oldExpectedToReceiveCoin, err := types.ComputeLimitOrderExpectedToReceiveBalance(
+		oldOrder.Side, oldOrder.BaseDenom, oldOrder.QuoteDenom, oldRecord.RemainingBaseQuantity, *oldOrder.Price,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	lockedDiff := oldRecord.RemainingSpendableBalance.Sub(lockedCoin.Amount)
+	expectedToReceiveDiff := oldExpectedToReceiveCoin.Amount.Sub(expectedToReceiveCoin.Amount)
+	if lockedDiff.IsNegative() || expectedToReceiveDiff.IsNegative() {
+		return false, nil
+	}
+
+	k.logger(ctx).Debug("Amending order.", "oldOrder", oldOrder.String(), "order", order.String())
+
+	if oldOrder.GoodTil != nil {
+		if err := k.removeGoodTilDelay(ctx, *oldOrder.GoodTil, oldRecord.OrderSequence); err != nil {
+			return false, err
+		}
+	}
+	if err := k.removeOrderIDToSequence(ctx, oldRecord.AccountNumber, oldRecord.OrderID); err != nil {
+		return false, err
+	}
+
+	// the reserve is kept locked for the amended order
+	order.Sequence = oldRecord.OrderSequence
+	order.Reserve = oldOrder.Reserve
+	record := oldRecord
+	record.OrderID = order.ID
+	record.RemainingBaseQuantity = order.Quantity
+	record.RemainingSpendableBalance = lockedCoin.Amount
+	if err := k.saveOrderWithOrderBookRecord(ctx, order, record); err != nil {
+		return false, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderCreated{
+		Creator:                   order.Creator,
+		ID:                        order.ID,
+		Sequence:                  order.Sequence,
+		RemainingBaseQuantity:     record.RemainingBaseQuantity,
+		RemainingSpendableBalance: record.RemainingSpendableBalance,
+	}); err != nil {
+		return false, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventOrderCreated: %s", err)
+	}
+
+	return true, k.assetFTKeeper.DEXDecreaseLimits(
+		ctx,
+		creator,
+		sdk.NewCoins(sdk.NewCoin(lockedCoin.Denom, lockedDiff)),
+		sdk.NewCoin(expectedToReceiveCoin.Denom, expectedToReceiveDiff),
+	)
+}
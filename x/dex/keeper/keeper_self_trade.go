@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// isSelfTrade returns true if the taker order matches the maker order of the same account and the self-trade
+// prevention is enabled for the taker order.
+func isSelfTrade(takerOrder types.Order, takerRecord, makerRecord *types.OrderBookRecord) bool {
+	return takerOrder.SelfTradePrevention != types.SELF_TRADE_PREVENTION_UNSPECIFIED &&
+		takerRecord.AccountNumber == makerRecord.AccountNumber
+}
+
+// preventSelfTrade applies the self-trade prevention mode of the taker order instead of the matching, and returns
+// true if the taker order is closed.
+func (k Keeper) preventSelfTrade(
+	ctx sdk.Context,
+	cachedAccKeeper cachedAccountKeeper,
+	mr *MatchingResult,
+	requeuer orderBookRecordRequeuer,
+	takerRecord, makerRecord *types.OrderBookRecord,
+	takerOrder types.Order,
+) (bool, error) {
+	k.logger(ctx).Debug(
+		"Preventing self-trade.",
+		"mode", takerOrder.SelfTradePrevention.String(),
+		"takerRecord", takerRecord.String(),
+		"makerRecord", makerRecord.String(),
+	)
+
+	makerAddr, err := cachedAccKeeper.getAccountAddressWithCache(ctx, makerRecord.AccountNumber)
+	if err != nil {
+		return false, err
+	}
+
+	switch takerOrder.SelfTradePrevention {
+	case types.SELF_TRADE_PREVENTION_CANCEL_NEWEST:
+		return true, nil
+	case types.SELF_TRADE_PREVENTION_CANCEL_OLDEST:
+		return false, k.cancelSelfTradeMakerRecord(ctx, mr, makerAddr, makerRecord, takerOrder)
+	case types.SELF_TRADE_PREVENTION_CANCEL_BOTH:
+		return true, k.cancelSelfTradeMakerRecord(ctx, mr, makerAddr, makerRecord, takerOrder)
+	case types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL:
+		return k.decrementSelfTradeRecords(ctx, mr, requeuer, makerAddr, takerRecord, makerRecord, takerOrder)
+	default:
+		return false, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "unsupported self-trade prevention: %s", takerOrder.SelfTradePrevention.String(),
+		)
+	}
+}
+
+// decrementSelfTradeRecords decreases both records by the quantities they would trade without the execution. The
+// iceberg maker record with the used up visible quantity is refilled from its hidden quantity, and the maker record is
+// canceled only if its remaining quantity can't be executed anymore.
+func (k Keeper) decrementSelfTradeRecords(
+	ctx sdk.Context,
+	mr *MatchingResult,
+	requeuer orderBookRecordRequeuer,
+	makerAddr sdk.AccAddress,
+	takerRecord, makerRecord *types.OrderBookRecord,
+	takerOrder types.Order,
+) (bool, error) {
+	isMakerInverted := takerRecord.Side == makerRecord.Side
+	trade, closeResult := match(
+		newMatchingOBRecord(takerRecord, false),
+		newMatchingOBRecord(makerRecord, isMakerInverted),
+	)
+
+	originalMakerRecord := *makerRecord
+	reduceMatchedRecords(takerRecord, makerRecord, trade, isMakerInverted)
+	takerIsClosed := closeResult == closeTaker || closeResult == closeBoth
+
+	// the reduced record is saved unless it's refilled
+	reducedMakerRecord := makerRecord
+	if closeResult == closeMaker || closeResult == closeBoth || !isOrderRecordExecutableAsMaker(makerRecord) {
+		refilled, err := k.refillIcebergRecord(ctx, mr, requeuer, makerAddr, makerRecord)
+		if err != nil {
+			return false, err
+		}
+		if !refilled {
+			// nothing is executed, so the maker is canceled with the balance locked before the decrement
+			return takerIsClosed, k.cancelSelfTradeMakerRecord(ctx, mr, makerAddr, &originalMakerRecord, takerOrder)
+		}
+		reducedMakerRecord = nil
+	}
+
+	expectedToReceiveBefore, err := types.ComputeLimitOrderExpectedToReceiveAmount(
+		originalMakerRecord.Side, originalMakerRecord.GetTotalRemainingBaseQuantity(), originalMakerRecord.Price,
+	)
+	if err != nil {
+		return false, err
+	}
+	expectedToReceiveAfter, err := types.ComputeLimitOrderExpectedToReceiveAmount(
+		makerRecord.Side, makerRecord.GetTotalRemainingBaseQuantity(), makerRecord.Price,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	makerSpendsDenom, makerReceivesDenom := takerOrder.GetReceiveDenom(), takerOrder.GetSpendDenom()
+	mr.ReduceSelfTradeRecord(
+		makerAddr,
+		reducedMakerRecord,
+		false,
+		sdk.NewCoins(sdk.NewCoin(
+			makerSpendsDenom,
+			originalMakerRecord.RemainingSpendableBalance.Sub(makerRecord.RemainingSpendableBalance),
+		)),
+		sdk.NewCoin(makerReceivesDenom, expectedToReceiveBefore.Sub(expectedToReceiveAfter)),
+	)
+
+	return takerIsClosed, nil
+}
+
+func (k Keeper) cancelSelfTradeMakerRecord(
+	ctx sdk.Context,
+	mr *MatchingResult,
+	makerAddr sdk.AccAddress,
+	makerRecord *types.OrderBookRecord,
+	takerOrder types.Order,
+) error {
+	lockedCoins, expectedToReceiveCoin, err := k.getMakerLockedAndExpectedToReceiveCoins(
+		ctx,
+		makerRecord,
+		takerOrder.GetReceiveDenom(),
+		takerOrder.GetSpendDenom(),
+	)
+	if err != nil {
+		return err
+	}
+	mr.ReduceSelfTradeRecord(makerAddr, makerRecord, true, lockedCoins, expectedToReceiveCoin)
+
+	return nil
+}
+
+func (k Keeper) applySelfTradeReductions(ctx sdk.Context, mr *MatchingResult) error {
+	for _, reduction := range mr.SelfTradeReductions {
+		switch {
+		case reduction.Record == nil:
+			// the refilled record is saved by the refill
+		case reduction.Cancel:
+			if err := k.removeOrderByRecord(ctx, reduction.Address, *reduction.Record); err != nil {
+				return err
+			}
+		default:
+			if err := k.saveOrderBookRecord(ctx, *reduction.Record); err != nil {
+				return err
+			}
+		}
+
+		if err := k.assetFTKeeper.DEXDecreaseLimits(
+			ctx, reduction.Address, reduction.LockedCoins, reduction.ExpectedToReceiveCoin,
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_SelfTradePrevention(t *testing.T) {
+	tests := []struct {
+		name                string
+		selfTradePrevention types.SelfTradePrevention
+		takerQuantity       int64
+		// the remaining base quantities of the orders, zero means that the order isn't in the order book
+		wantMaker1Remaining int64
+		wantMaker2Remaining int64
+		wantTakerRemaining  int64
+		// the amount of denom1 received by the taker account from the other account
+		wantTakerReceived int64
+	}{
+		{
+			name:                "unspecified",
+			selfTradePrevention: types.SELF_TRADE_PREVENTION_UNSPECIFIED,
+			takerQuantity:       150_000,
+			wantMaker1Remaining: 0,
+			wantMaker2Remaining: 50_000,
+			wantTakerRemaining:  0,
+			wantTakerReceived:   50_000,
+		},
+		{
+			name:                "cancel_newest",
+			selfTradePrevention: types.SELF_TRADE_PREVENTION_CANCEL_NEWEST,
+			takerQuantity:       150_000,
+			wantMaker1Remaining: 100_000,
+			wantMaker2Remaining: 100_000,
+			wantTakerRemaining:  0,
+			wantTakerReceived:   0,
+		},
+		{
+			name:                "cancel_oldest",
+			selfTradePrevention: types.SELF_TRADE_PREVENTION_CANCEL_OLDEST,
+			takerQuantity:       250_000,
+			wantMaker1Remaining: 0,
+			wantMaker2Remaining: 0,
+			wantTakerRemaining:  150_000,
+			wantTakerReceived:   100_000,
+		},
+		{
+			name:                "cancel_both",
+			selfTradePrevention: types.SELF_TRADE_PREVENTION_CANCEL_BOTH,
+			takerQuantity:       150_000,
+			wantMaker1Remaining: 0,
+			wantMaker2Remaining: 100_000,
+			wantTakerRemaining:  0,
+			wantTakerReceived:   0,
+		},
+		{
+			name:                "decrement_and_cancel_maker",
+			selfTradePrevention: types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL,
+			takerQuantity:       150_000,
+			wantMaker1Remaining: 0,
+			wantMaker2Remaining: 50_000,
+			wantTakerRemaining:  0,
+			wantTakerReceived:   50_000,
+		},
+		{
+			name:                "decrement_and_cancel_taker",
+			selfTradePrevention: types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL,
+			takerQuantity:       40_000,
+			wantMaker1Remaining: 60_000,
+			wantMaker2Remaining: 100_000,
+			wantTakerRemaining:  0,
+			wantTakerReceived:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testApp := simapp.New()
+			sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+			testSet := genTestSet(t, sdkCtx, testApp)
+
+			dexKeeper := testApp.DEXKeeper
+			assetFTKeeper := testApp.AssetFTKeeper
+
+			acc1 := testSet.acc1
+			acc2 := testSet.acc2
+			testApp.MintAndSendCoin(t, sdkCtx, acc1, sdk.NewCoins(
+				sdk.NewInt64Coin(testSet.denom1, 100_000),
+				sdk.NewInt64Coin(testSet.denom2, 125_000),
+			))
+			testApp.MintAndSendCoin(t, sdkCtx, acc2, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 100_000)))
+			for range 2 {
+				fundOrderReserve(t, testApp, sdkCtx, acc1)
+			}
+			fundOrderReserve(t, testApp, sdkCtx, acc2)
+
+			newSellOrder := func(acc sdk.AccAddress, id string) types.Order {
+				return types.Order{
+					Creator:     acc.String(),
+					Type:        types.ORDER_TYPE_LIMIT,
+					ID:          id,
+					BaseDenom:   testSet.denom1,
+					QuoteDenom:  testSet.denom2,
+					Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+					Quantity:    sdkmath.NewInt(100_000),
+					Side:        types.SIDE_SELL,
+					TimeInForce: types.TIME_IN_FORCE_GTC,
+				}
+			}
+			require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, newSellOrder(acc1, "maker1")))
+			require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, newSellOrder(acc2, "maker2")))
+
+			require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+				Creator:             acc1.String(),
+				Type:                types.ORDER_TYPE_LIMIT,
+				ID:                  "taker",
+				BaseDenom:           testSet.denom1,
+				QuoteDenom:          testSet.denom2,
+				Price:               lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+				Quantity:            sdkmath.NewInt(tt.takerQuantity),
+				Side:                types.SIDE_BUY,
+				TimeInForce:         types.TIME_IN_FORCE_GTC,
+				SelfTradePrevention: tt.selfTradePrevention,
+			}))
+
+			assertRemaining := func(acc sdk.AccAddress, id string, wantRemaining int64) {
+				order, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, id)
+				if wantRemaining == 0 {
+					require.ErrorIs(t, err, types.ErrRecordNotFound, id)
+					return
+				}
+				require.NoError(t, err)
+				require.Equal(t, sdkmath.NewInt(wantRemaining).String(), order.RemainingBaseQuantity.String(), id)
+			}
+			assertRemaining(acc1, "maker1", tt.wantMaker1Remaining)
+			assertRemaining(acc2, "maker2", tt.wantMaker2Remaining)
+			assertRemaining(acc1, "taker", tt.wantTakerRemaining)
+
+			// the remaining balances of the canceled or decreased orders are unlocked
+			require.Equal(
+				t,
+				sdkmath.NewInt(tt.wantMaker1Remaining).String(),
+				assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc1, testSet.denom1).Amount.String(),
+			)
+			require.Equal(
+				t,
+				sdkmath.NewInt(100_000+tt.wantTakerReceived).String(),
+				testApp.BankKeeper.GetBalance(sdkCtx, acc1, testSet.denom1).Amount.String(),
+			)
+			require.Equal(
+				t,
+				sdkmath.NewInt(tt.wantTakerReceived/2).String(),
+				testApp.BankKeeper.GetBalance(sdkCtx, acc2, testSet.denom2).Amount.String(),
+			)
+		})
+	}
+}
+
+func TestKeeper_SelfTradePrevention_IcebergMaker(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+
+	acc1 := testSet.acc1
+	acc2 := testSet.acc2
+	testApp.MintAndSendCoin(t, sdkCtx, acc1, sdk.NewCoins(
+		sdk.NewInt64Coin(testSet.denom1, 100_000),
+		sdk.NewInt64Coin(testSet.denom2, 75_000),
+	))
+	testApp.MintAndSendCoin(t, sdkCtx, acc2, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 100_000)))
+	for range 2 {
+		fundOrderReserve(t, testApp, sdkCtx, acc1)
+	}
+	fundOrderReserve(t, testApp, sdkCtx, acc2)
+
+	newSellOrder := func(acc sdk.AccAddress, id string) types.Order {
+		return types.Order{
+			Creator:     acc.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          id,
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+			Quantity:    sdkmath.NewInt(100_000),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+	}
+	icebergOrder := newSellOrder(acc1, "iceberg")
+	icebergOrder.VisibleQuantity = lo.ToPtr(sdkmath.NewInt(30_000))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, icebergOrder))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, newSellOrder(acc2, "regular")))
+
+	// the taker decrements the visible quantity of the iceberg order, the iceberg order is refilled and moved behind
+	// the regular order instead of being canceled, and then decremented again by the rest of the taker
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:             acc1.String(),
+		Type:                types.ORDER_TYPE_LIMIT,
+		ID:                  "taker",
+		BaseDenom:           testSet.denom1,
+		QuoteDenom:          testSet.denom2,
+		Price:               lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:            sdkmath.NewInt(150_000),
+		Side:                types.SIDE_BUY,
+		TimeInForce:         types.TIME_IN_FORCE_GTC,
+		SelfTradePrevention: types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL,
+	}))
+
+	events := readOrderEvents(t, sdkCtx)
+	require.Len(t, events.OrdersRefilled, 1)
+	require.Equal(t, "iceberg", events.OrdersRefilled[0].ID)
+	require.Equal(t, "30000", events.OrdersRefilled[0].RemainingVisibleQuantity.String())
+	require.Equal(t, "40000", events.OrdersRefilled[0].RemainingHiddenQuantity.String())
+
+	// the iceberg order keeps 10_000 visible and 40_000 hidden quantity
+	order, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, acc1, "iceberg")
+	require.NoError(t, err)
+	require.Equal(t, "10000", order.RemainingBaseQuantity.String())
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc2, "regular")
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc1, "taker")
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	// only the decremented quantities of the iceberg order are unlocked
+	require.Equal(t, "50000", assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc1, testSet.denom1).Amount.String())
+	require.Equal(t, "200000", testApp.BankKeeper.GetBalance(sdkCtx, acc1, testSet.denom1).Amount.String())
+	require.Equal(t, "50000", testApp.BankKeeper.GetBalance(sdkCtx, acc2, testSet.denom2).Amount.String())
+}
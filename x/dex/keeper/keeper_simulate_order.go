@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/gogoproto/proto"
+
+	cbig "github.com/CoreumFoundation/coreum/v6/pkg/math/big"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// SimulateOrder places the order in the cache context which is never committed and returns the execution result
+// built from the matching events.
+func (k Keeper) SimulateOrder(ctx sdk.Context, order types.Order) (*types.QuerySimulateOrderResponse, error) {
+	if order.Trigger != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "trigger order can't be simulated")
+	}
+	if order.ID == "" {
+		order.ID = types.SimulatedOrderID
+	}
+
+	// the cache is never written, so the simulation doesn't change the state
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	if err := k.PlaceOrder(cacheCtx, order); err != nil {
+		return nil, err
+	}
+
+	return buildSimulateOrderResponse(order, cacheCtx.EventManager().ABCIEvents())
+}
+
+func buildSimulateOrderResponse(order types.Order, events []abci.Event) (*types.QuerySimulateOrderResponse, error) {
+	res := &types.QuerySimulateOrderResponse{
+		Fills:                     make([]types.SimulatedFill, 0),
+		SpentCoin:                 sdk.NewCoin(order.GetSpendDenom(), sdkmath.ZeroInt()),
+		ReceivedCoin:              sdk.NewCoin(order.GetReceiveDenom(), sdkmath.ZeroInt()),
+		FeeCoin:                   sdk.NewCoin(order.GetReceiveDenom(), sdkmath.ZeroInt()),
+		RemainingBaseQuantity:     sdkmath.ZeroInt(),
+		RemainingSpendableBalance: sdkmath.ZeroInt(),
+	}
+
+	var (
+		placedEvent   *types.EventOrderPlaced
+		reducedEvents = make([]*types.EventOrderReduced, 0)
+	)
+	for _, evt := range events {
+		switch evt.Type {
+		case proto.MessageName(&types.EventOrderPlaced{}):
+			msg, err := parseTypedEvent[*types.EventOrderPlaced](evt)
+			if err != nil {
+				return nil, err
+			}
+			placedEvent = msg
+		case proto.MessageName(&types.EventOrderReduced{}):
+			msg, err := parseTypedEvent[*types.EventOrderReduced](evt)
+			if err != nil {
+				return nil, err
+			}
+			reducedEvents = append(reducedEvents, msg)
+		case proto.MessageName(&types.EventOrderCreated{}):
+			msg, err := parseTypedEvent[*types.EventOrderCreated](evt)
+			if err != nil {
+				return nil, err
+			}
+			if placedEvent != nil && msg.Sequence == placedEvent.Sequence {
+				res.RemainingBaseQuantity = msg.RemainingBaseQuantity
+				res.RemainingSpendableBalance = msg.RemainingSpendableBalance
+			}
+		}
+	}
+	if placedEvent == nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidState, "order placed event not found")
+	}
+
+	roundUp := order.Side == types.SIDE_BUY
+	// the average price is computed from the traded quantities since the taker received coin includes the fee
+	totalBaseQuantity, totalQuoteQuantity := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for _, evt := range reducedEvents {
+		if evt.Sequence == placedEvent.Sequence {
+			res.SpentCoin = evt.SentCoin
+			res.ReceivedCoin = evt.ReceivedCoin
+			res.FeeCoin = evt.FeeCoin
+			continue
+		}
+
+		// the maker sends what the taker receives and receives what the taker spends
+		baseQuantity, quoteQuantity := evt.SentCoin.Amount, evt.ReceivedCoin.Amount
+		if order.Side == types.SIDE_SELL {
+			baseQuantity, quoteQuantity = evt.ReceivedCoin.Amount, evt.SentCoin.Amount
+		}
+		price, err := computeSimulatedPrice(baseQuantity, quoteQuantity, roundUp)
+		if err != nil {
+			return nil, err
+		}
+		totalBaseQuantity = totalBaseQuantity.Add(baseQuantity)
+		totalQuoteQuantity = totalQuoteQuantity.Add(quoteQuantity)
+		res.Fills = append(res.Fills, types.SimulatedFill{
+			MakerCreator:  evt.Creator,
+			MakerID:       evt.ID,
+			MakerSequence: evt.Sequence,
+			Price:         price,
+			BaseQuantity:  baseQuantity,
+			QuoteQuantity: quoteQuantity,
+		})
+	}
+
+	averagePrice, err := computeSimulatedPrice(totalBaseQuantity, totalQuoteQuantity, roundUp)
+	if err != nil {
+		return nil, err
+	}
+	res.AveragePrice = averagePrice
+
+	return res, nil
+}
+
+// computeSimulatedPrice returns the price of the quote quantity per base quantity, or nil if nothing is executed.
+func computeSimulatedPrice(baseQuantity, quoteQuantity sdkmath.Int, roundUp bool) (*types.Price, error) {
+	if !baseQuantity.IsPositive() || !quoteQuantity.IsPositive() {
+		return nil, nil //nolint:nilnil // nil price means that there is no execution
+	}
+
+	price, err := types.NewPriceFromRat(
+		cbig.NewRatFromBigInts(quoteQuantity.BigInt(), baseQuantity.BigInt()), roundUp,
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to compute price: %s", err)
+	}
+
+	return &price, nil
+}
+
+func parseTypedEvent[T proto.Message](evt abci.Event) (T, error) {
+	var typedMsg T
+	msg, err := sdk.ParseTypedEvent(evt)
+	if err != nil {
+		return typedMsg, sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to parse event %s: %s", evt.Type, err)
+	}
+	typedMsg, ok := msg.(T)
+	if !ok {
+		return typedMsg, sdkerrors.Wrapf(types.ErrInvalidState, "unexpected event type %T", msg)
+	}
+
+	return typedMsg, nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_SimulateOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	maker := testSet.acc1
+	taker := testSet.acc2
+	testApp.MintAndSendCoin(t, sdkCtx, maker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 200_000)))
+	testApp.MintAndSendCoin(t, sdkCtx, taker, sdk.NewCoins(
+		sdk.NewInt64Coin(testSet.denom1, 100_000),
+		sdk.NewInt64Coin(testSet.denom2, 125_000),
+	))
+	for range 2 {
+		fundOrderReserve(t, testApp, sdkCtx, maker)
+	}
+	fundOrderReserve(t, testApp, sdkCtx, taker)
+
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     maker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+	// sells 100_000 denom1 for 4e-1 denom2 in the direct order book terms
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     maker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker2",
+		BaseDenom:   testSet.denom2,
+		QuoteDenom:  testSet.denom1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("25e-1")),
+		Quantity:    sdkmath.NewInt(40_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+	maker1Order, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, maker, "maker1")
+	require.NoError(t, err)
+	maker2Order, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, maker, "maker2")
+	require.NoError(t, err)
+
+	takerOrder := types.Order{
+		Creator:     taker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(250_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	res, err := dexKeeper.SimulateOrder(sdkCtx, takerOrder)
+	require.NoError(t, err)
+	require.Equal(t, []types.SimulatedFill{
+		{
+			MakerCreator:  maker.String(),
+			MakerID:       "maker2",
+			MakerSequence: maker2Order.Sequence,
+			Price:         lo.ToPtr(types.MustNewPriceFromString("4e-1")),
+			BaseQuantity:  sdkmath.NewInt(100_000),
+			QuoteQuantity: sdkmath.NewInt(40_000),
+		},
+		{
+			MakerCreator:  maker.String(),
+			MakerID:       "maker1",
+			MakerSequence: maker1Order.Sequence,
+			Price:         lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+			BaseQuantity:  sdkmath.NewInt(100_000),
+			QuoteQuantity: sdkmath.NewInt(50_000),
+		},
+	}, res.Fills)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom2, 90_000).String(), res.SpentCoin.String())
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 200_000).String(), res.ReceivedCoin.String())
+	require.Equal(t, types.MustNewPriceFromString("45e-2").String(), res.AveragePrice.String())
+	require.Equal(t, sdkmath.NewInt(50_000).String(), res.RemainingBaseQuantity.String())
+	require.Equal(t, sdkmath.NewInt(25_000).String(), res.RemainingSpendableBalance.String())
+
+	// the state is not changed
+	trades, _, err := dexKeeper.GetTrades(sdkCtx, testSet.denom1, testSet.denom2, nil)
+	require.NoError(t, err)
+	require.Empty(t, trades)
+	order, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, maker, "maker1")
+	require.NoError(t, err)
+	require.Equal(t, maker1Order, order)
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, taker, types.SimulatedOrderID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	require.Equal(
+		t,
+		sdkmath.NewInt(125_000).String(),
+		testApp.BankKeeper.GetBalance(sdkCtx, taker, testSet.denom2).Amount.String(),
+	)
+
+	// the market order without execution
+	res, err = dexKeeper.SimulateOrder(sdkCtx, types.Order{
+		Creator:     taker.String(),
+		Type:        types.ORDER_TYPE_MARKET,
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	})
+	require.NoError(t, err)
+	require.Empty(t, res.Fills)
+	require.Nil(t, res.AveragePrice)
+	require.True(t, res.SpentCoin.IsZero())
+
+	// the spendable balance is respected
+	takerOrder.Quantity = sdkmath.NewInt(300_000)
+	_, err = dexKeeper.SimulateOrder(sdkCtx, takerOrder)
+	require.ErrorIs(t, err, assetfttypes.ErrDEXInsufficientSpendableBalance)
+
+	// the trigger order can't be simulated
+	takerOrder.Trigger = &types.Trigger{
+		Type:  types.TRIGGER_TYPE_STOP_LOSS,
+		Price: types.MustNewPriceFromString("4e-1"),
+	}
+	_, err = dexKeeper.SimulateOrder(sdkCtx, takerOrder)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	// the fee doesn't change the average price
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.001")
+	params.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.01")
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+	takerOrder.Trigger = nil
+	takerOrder.Quantity = sdkmath.NewInt(250_000)
+	res, err = dexKeeper.SimulateOrder(sdkCtx, takerOrder)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom2, 90_000).String(), res.SpentCoin.String())
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 200_000).String(), res.ReceivedCoin.String())
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 2_000).String(), res.FeeCoin.String())
+	require.Equal(t, types.MustNewPriceFromString("45e-2").String(), res.AveragePrice.String())
+}
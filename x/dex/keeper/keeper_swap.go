@@ -0,0 +1,407 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// SwapExactIn swaps the exact input amount through the route order books with the IOC market orders, and returns the
+// hops execution results. The swap is atomic, and it is rejected if the received amount is less than the min amount
+// out.
+func (k Keeper) SwapExactIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []string,
+	amountIn, minAmountOut sdkmath.Int,
+) ([]types.SwapHop, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the swap is executed in the cache context to revert the executed hops if the next hop fails
+	cacheCtx, writeCache := ctx.CacheContext()
+	hops, err := k.swapExactIn(
+		cacheCtx, params, newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer), sender, route, amountIn,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, receivedCoin := types.NewSwapCoins(hops)
+	if receivedCoin.Amount.LT(minAmountOut) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"received amount %s is less than the min amount out %s",
+			receivedCoin.Amount, minAmountOut,
+		)
+	}
+	writeCache()
+
+	return hops, nil
+}
+
+// SwapExactOut swaps the input amount required to receive the output amount through the route order books with the
+// IOC market orders, and returns the hops execution results. The input amount is computed by the read-only walk of the
+// route order books, and the swap is executed once with it. The received amount might exceed the output amount
+// because of the quantity step rounding. The swap is atomic, and it is rejected if the required input amount exceeds
+// the max amount in, or if the executed swap receives less than the output amount.
+func (k Keeper) SwapExactOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []string,
+	amountOut, maxAmountIn sdkmath.Int,
+) ([]types.SwapHop, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := types.ValidateSwapRoute(route); err != nil {
+		return nil, err
+	}
+
+	amountIn, err := k.computeSwapAmountIn(ctx, params, route, amountOut)
+	if err != nil {
+		return nil, err
+	}
+	spendableBalance, err := k.assetFTKeeper.GetSpendableBalance(ctx, sender, route[0])
+	if err != nil {
+		return nil, err
+	}
+	if amountIn.GT(sdkmath.MinInt(maxAmountIn, spendableBalance.Amount)) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"amount out %s%s requires the amount in %s%s, which exceeds the max amount in %s%s or the spendable balance",
+			amountOut, route[len(route)-1], amountIn, route[0], maxAmountIn, route[0],
+		)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	hops, err := k.swapExactIn(
+		cacheCtx, params, newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer), sender, route, amountIn,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// the walk doesn't apply the asset ft rules to the executed hops, so the executed swap is checked anyway
+	_, receivedCoin := types.NewSwapCoins(hops)
+	if receivedCoin.Amount.LT(amountOut) {
+		return nil, sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"received amount %s is less than the amount out %s",
+			receivedCoin.Amount, amountOut,
+		)
+	}
+	writeCache()
+
+	return hops, nil
+}
+
+// SimulateSwap executes the swap in the cache context which is never committed and returns the execution result. The
+// exact input swap is simulated if the amount in is set, otherwise the exact output swap limited by the sender
+// spendable balance is simulated.
+func (k Keeper) SimulateSwap(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	route []string,
+	amountIn, amountOut sdkmath.Int,
+) (*types.QuerySimulateSwapResponse, error) {
+	if err := types.ValidateSwapRoute(route); err != nil {
+		return nil, err
+	}
+
+	// the cache is never written, so the simulation doesn't change the state
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	var (
+		hops []types.SwapHop
+		err  error
+	)
+	if !amountIn.IsNil() {
+		hops, err = k.SwapExactIn(cacheCtx, sender, route, amountIn, sdkmath.ZeroInt())
+	} else {
+		var spendableBalance sdk.Coin
+		spendableBalance, err = k.assetFTKeeper.GetSpendableBalance(cacheCtx, sender, route[0])
+		if err != nil {
+			return nil, err
+		}
+		hops, err = k.SwapExactOut(cacheCtx, sender, route, amountOut, spendableBalance.Amount)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	spentCoin, receivedCoin := types.NewSwapCoins(hops)
+	return &types.QuerySimulateSwapResponse{
+		SpentCoin:    spentCoin,
+		ReceivedCoin: receivedCoin,
+		Hops:         hops,
+	}, nil
+}
+
+func (k Keeper) swapExactIn(
+	ctx sdk.Context,
+	params types.Params,
+	cachedAccKeeper cachedAccountKeeper,
+	sender sdk.AccAddress,
+	route []string,
+	amountIn sdkmath.Int,
+) ([]types.SwapHop, error) {
+	if err := types.ValidateSwapRoute(route); err != nil {
+		return nil, err
+	}
+
+	spendableBalance, err := k.assetFTKeeper.GetSpendableBalance(ctx, sender, route[0])
+	if err != nil {
+		return nil, err
+	}
+	if spendableBalance.Amount.LT(amountIn) {
+		return nil, sdkerrors.Wrapf(
+			cosmoserrors.ErrInsufficientFunds,
+			"spendable balance %s is less than the amount in %s%s",
+			spendableBalance, amountIn, route[0],
+		)
+	}
+
+	hops := make([]types.SwapHop, 0, len(route)-1)
+	amount := amountIn
+	for i := 0; i < len(route)-1; i++ {
+		hop, err := k.swapHop(ctx, params, cachedAccKeeper, sender, i, route[i], route[i+1], amount)
+		if err != nil {
+			return nil, err
+		}
+		hops = append(hops, hop)
+		// the next hop spends the amount received by the current hop
+		amount = hop.ReceivedCoin.Amount
+	}
+
+	return hops, nil
+}
+
+// swapHop sells the amount of the input denom rounded down to the quantity step with the IOC market order, the
+// remainder is kept on the sender balance.
+func (k Keeper) swapHop(
+	ctx sdk.Context,
+	params types.Params,
+	cachedAccKeeper cachedAccountKeeper,
+	sender sdk.AccAddress,
+	hopIndex int,
+	denomIn, denomOut string,
+	amountIn sdkmath.Int,
+) (types.SwapHop, error) {
+	if err := k.validateDenomPair(ctx, denomIn, denomOut); err != nil {
+		return types.SwapHop{}, err
+	}
+
+	quantityStep, err := k.getSwapQuantityStep(ctx, params, denomIn)
+	if err != nil {
+		return types.SwapHop{}, err
+	}
+	quantity := amountIn.Sub(amountIn.Mod(quantityStep))
+	if !quantity.IsPositive() {
+		return types.SwapHop{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"swap amount %s%s is less than the quantity step %s",
+			amountIn, denomIn, quantityStep,
+		)
+	}
+
+	order := types.Order{
+		Creator:     sender.String(),
+		Type:        types.ORDER_TYPE_MARKET,
+		ID:          types.BuildSwapOrderID(hopIndex),
+		BaseDenom:   denomIn,
+		QuoteDenom:  denomOut,
+		Quantity:    quantity,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}
+	if err := k.validateOrder(ctx, params, order); err != nil {
+		return types.SwapHop{}, err
+	}
+
+	accNumber, err := k.getAccountNumber(ctx, sender)
+	if err != nil {
+		return types.SwapHop{}, err
+	}
+	orderBookID, invertedOrderBookID, err := k.getOrGenOrderBookIDs(ctx, denomIn, denomOut)
+	if err != nil {
+		return types.SwapHop{}, err
+	}
+	if err := k.validateOrderBookIsOpen(ctx, orderBookID, invertedOrderBookID, denomIn, denomOut); err != nil {
+		return types.SwapHop{}, err
+	}
+	if err := k.validateOrderBookIsNotInBatchAuction(
+		ctx, orderBookID, invertedOrderBookID, denomIn, denomOut,
+	); err != nil {
+		return types.SwapHop{}, err
+	}
+
+	// the bank balances are used since the spendable balances depend on the frozen and locked balances and might
+	// change by an amount different from the moved coins
+	balanceInBefore := k.bankKeeper.GetBalance(ctx, sender, denomIn)
+	balanceOutBefore := k.bankKeeper.GetBalance(ctx, sender, denomOut)
+
+	// the market order is never saved, so its ID isn't reserved
+	if err := k.matchOrder(
+		ctx, params, cachedAccKeeper, accNumber, orderBookID, invertedOrderBookID, order, orderLimits{},
+	); err != nil {
+		return types.SwapHop{}, err
+	}
+
+	balanceInAfter := k.bankKeeper.GetBalance(ctx, sender, denomIn)
+	balanceOutAfter := k.bankKeeper.GetBalance(ctx, sender, denomOut)
+
+	hop := types.SwapHop{
+		SpentCoin:    sdk.NewCoin(denomIn, balanceInBefore.Amount.Sub(balanceInAfter.Amount)),
+		ReceivedCoin: sdk.NewCoin(denomOut, balanceOutAfter.Amount.Sub(balanceOutBefore.Amount)),
+	}
+	if !hop.ReceivedCoin.IsPositive() {
+		return types.SwapHop{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "there is no liquidity to swap %s to %s", denomIn, denomOut,
+		)
+	}
+
+	return hop, nil
+}
+
+// computeSwapAmountIn computes the input amount required to receive the output amount through the route, walking the
+// hops from the last one back to the first one without changing the state.
+func (k Keeper) computeSwapAmountIn(
+	ctx sdk.Context,
+	params types.Params,
+	route []string,
+	amountOut sdkmath.Int,
+) (sdkmath.Int, error) {
+	amount := amountOut
+	for i := len(route) - 2; i >= 0; i-- {
+		amountIn, err := k.computeSwapHopAmountIn(ctx, params, route[i], route[i+1], amount)
+		if err != nil {
+			return sdkmath.Int{}, err
+		}
+		// the previous hop must receive the input amount of the current hop
+		amount = amountIn
+	}
+
+	return amount, nil
+}
+
+// computeSwapHopAmountIn walks the makers of the direct and inverted order books in the matching order, and returns
+// the input amount of the hop market order, rounded up to the quantity step, which receives the output amount after
+// the taker fee. The hidden quantity of the iceberg maker is counted at its price, since it's refilled and matched by
+// the same taker.
+func (k Keeper) computeSwapHopAmountIn(
+	ctx sdk.Context,
+	params types.Params,
+	denomIn, denomOut string,
+	amountOut sdkmath.Int,
+) (sdkmath.Int, error) {
+	noLiquidityErr := sdkerrors.Wrapf(
+		types.ErrInvalidInput, "there is no liquidity to receive %s%s swapping %s", amountOut, denomOut, denomIn,
+	)
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, denomIn, denomOut)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return sdkmath.Int{}, noLiquidityErr
+		}
+		return sdkmath.Int{}, err
+	}
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	feeRates, err := k.getOrderBookFeeRates(ctx, params, orderBookID)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	quantityStep, err := k.getSwapQuantityStep(ctx, params, denomIn)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	mf, err := k.NewMatchingFinder(ctx, orderBookID, invertedOrderBookID, types.Order{
+		Type:       types.ORDER_TYPE_MARKET,
+		BaseDenom:  denomIn,
+		QuoteDenom: denomOut,
+		Side:       types.SIDE_SELL,
+	})
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	defer func() {
+		if err := mf.Close(); err != nil {
+			k.logger(ctx).Error(err.Error())
+		}
+	}()
+
+	spent, received := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for {
+		makerRecord, matches, err := mf.Next()
+		if err != nil {
+			return sdkmath.Int{}, err
+		}
+		if !matches {
+			return sdkmath.Int{}, noLiquidityErr
+		}
+
+		makerRecord.RemainingBaseQuantity = makerRecord.GetTotalRemainingBaseQuantity()
+		makerRecordForMatching := newMatchingOBRecord(&makerRecord, makerRecord.Side == types.SIDE_SELL)
+		// the taker with the maker quantity executes the maker fully
+		trade, _ := match(OBRecord{
+			Side:         sellOrderSide,
+			Price:        marketOrderPrice,
+			BaseQuantity: makerRecordForMatching.BaseQuantity,
+			SpendBalance: makerRecordForMatching.BaseQuantity,
+		}, makerRecordForMatching)
+		tradeReceived := sdk.NewCoin(denomOut, sdkmath.NewIntFromBigInt(trade.TakerReceives))
+		tradeReceived = tradeReceived.Sub(computeFee(tradeReceived, feeRates.TakerFeeRate))
+		if received.Add(tradeReceived.Amount).LT(amountOut) {
+			spent = spent.Add(sdkmath.NewIntFromBigInt(trade.TakerSpends))
+			received = received.Add(tradeReceived.Amount)
+			continue
+		}
+
+		// the maker is executed partially by the integer number of the price denominators
+		quoteQuantity := computeSwapGrossAmount(sdk.NewCoin(denomOut, amountOut.Sub(received)), feeRates.TakerFeeRate)
+		n := ceilQuo(quoteQuantity.Amount, sdkmath.NewIntFromBigInt(trade.Price.Num()))
+		spent = spent.Add(n.Mul(sdkmath.NewIntFromBigInt(trade.Price.Denom())))
+
+		return ceilQuo(spent, quantityStep).Mul(quantityStep), nil
+	}
+}
+
+// computeSwapGrossAmount returns the minimal coin which covers the net coin after the fee is deducted from it.
+func computeSwapGrossAmount(netCoin sdk.Coin, feeRate sdkmath.LegacyDec) sdk.Coin {
+	if !feeRate.IsPositive() {
+		return netCoin
+	}
+	grossCoin := sdk.NewCoin(
+		netCoin.Denom,
+		sdkmath.LegacyNewDecFromInt(netCoin.Amount).Quo(sdkmath.LegacyOneDec().Sub(feeRate)).Ceil().TruncateInt(),
+	)
+	// the decimal division is rounded, so the result is adjusted to cover the net coin
+	for grossCoin.Sub(computeFee(grossCoin, feeRate)).IsLT(netCoin) {
+		grossCoin = grossCoin.AddAmount(sdkmath.OneInt())
+	}
+
+	return grossCoin
+}
+
+func ceilQuo(a, b sdkmath.Int) sdkmath.Int {
+	return a.Add(b).SubRaw(1).Quo(b)
+}
+
+func (k Keeper) getSwapQuantityStep(ctx sdk.Context, params types.Params, denom string) (sdkmath.Int, error) {
+	baseURA, err := k.getAssetFTUnifiedRefAmount(ctx, denom, params.DefaultUnifiedRefAmount)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+	quantityStep, _ := ComputeQuantityStep(baseURA.BigInt(), params.QuantityStepExponent-sdkmath.LegacyPrecision)
+
+	return sdkmath.NewIntFromBigInt(quantityStep), nil
+}
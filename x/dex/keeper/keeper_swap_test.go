@@ -0,0 +1,280 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_Swap(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	quantity := defaultQuantityStep
+
+	// the liquidity to swap denom1 to denom2 at the price 1, and denom2 to denom3 at the price 2
+	for _, order := range []types.Order{
+		{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "id1",
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    quantity.MulRaw(10),
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "id2",
+			BaseDenom:   testSet.denom2,
+			QuoteDenom:  testSet.denom3,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+			Quantity:    quantity.MulRaw(10),
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+	} {
+		placeFundedOrder(t, sdkCtx, testApp, order)
+	}
+
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc2, sdk.NewCoins(sdk.NewCoin(testSet.denom1, quantity.MulRaw(5))))
+	route := []string{testSet.denom1, testSet.denom2, testSet.denom3}
+
+	// the swap receiving less than the min amount out is rejected
+	_, err := dexKeeper.SwapExactIn(sdkCtx, testSet.acc2, route, quantity, quantity.MulRaw(2).AddRaw(1))
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+	requireSpendableBalance(t, sdkCtx, testApp, testSet.acc2, sdk.NewCoin(testSet.denom1, quantity.MulRaw(5)))
+	requireSpendableBalance(t, sdkCtx, testApp, testSet.acc2, sdk.NewCoin(testSet.denom2, sdkmath.ZeroInt()))
+
+	// the swap through the order book without liquidity is rejected
+	_, err = dexKeeper.SwapExactIn(
+		sdkCtx, testSet.acc2, []string{testSet.denom1, testSet.denom3}, quantity, quantity,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	// the simulation doesn't change the state
+	simulation, err := dexKeeper.SimulateSwap(sdkCtx, testSet.acc2, route, quantity, sdkmath.Int{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QuerySimulateSwapResponse{
+		SpentCoin:    sdk.NewCoin(testSet.denom1, quantity),
+		ReceivedCoin: sdk.NewCoin(testSet.denom3, quantity.MulRaw(2)),
+		Hops: []types.SwapHop{
+			{
+				SpentCoin:    sdk.NewCoin(testSet.denom1, quantity),
+				ReceivedCoin: sdk.NewCoin(testSet.denom2, quantity),
+			},
+			{
+				SpentCoin:    sdk.NewCoin(testSet.denom2, quantity),
+				ReceivedCoin: sdk.NewCoin(testSet.denom3, quantity.MulRaw(2)),
+			},
+		},
+	}, simulation)
+	requireSpendableBalance(t, sdkCtx, testApp, testSet.acc2, sdk.NewCoin(testSet.denom1, quantity.MulRaw(5)))
+
+	// the exact input swap
+	hops, err := dexKeeper.SwapExactIn(sdkCtx, testSet.acc2, route, quantity, quantity.MulRaw(2))
+	require.NoError(t, err)
+	require.Equal(t, simulation.Hops, hops)
+	requireSpendableBalance(t, sdkCtx, testApp, testSet.acc2, sdk.NewCoin(testSet.denom1, quantity.MulRaw(4)))
+	requireSpendableBalance(t, sdkCtx, testApp, testSet.acc2, sdk.NewCoin(testSet.denom3, quantity.MulRaw(2)))
+
+	// the exact output swap with the insufficient max amount in is rejected
+	_, err = dexKeeper.SwapExactOut(sdkCtx, testSet.acc2, route, quantity.MulRaw(4), quantity)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+
+	// the exact output swap spends the minimal amount in
+	hops, err = dexKeeper.SwapExactOut(sdkCtx, testSet.acc2, route, quantity.MulRaw(4), quantity.MulRaw(3))
+	require.NoError(t, err)
+	spentCoin, receivedCoin := types.NewSwapCoins(hops)
+	require.Equal(t, sdk.NewCoin(testSet.denom1, quantity.MulRaw(2)).String(), spentCoin.String())
+	require.Equal(t, sdk.NewCoin(testSet.denom3, quantity.MulRaw(4)).String(), receivedCoin.String())
+	requireSpendableBalance(t, sdkCtx, testApp, testSet.acc2, sdk.NewCoin(testSet.denom1, quantity.MulRaw(2)))
+	requireSpendableBalance(t, sdkCtx, testApp, testSet.acc2, sdk.NewCoin(testSet.denom3, quantity.MulRaw(6)))
+}
+
+func TestKeeper_Swap_FrozenDenom(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	quantity := defaultQuantityStep
+
+	frozenDenom, err := testApp.AssetFTKeeper.Issue(sdkCtx, assetfttypes.IssueSettings{
+		Issuer:        testSet.issuer,
+		Subunit:       "frozen",
+		Symbol:        "FROZEN",
+		Precision:     6,
+		InitialAmount: sdkmath.NewIntWithDecimal(1, 20),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_freezing,
+		},
+	})
+	require.NoError(t, err)
+
+	// the liquidity to swap the frozen denom to denom2 at the price 1, and denom2 to the frozen denom at the price 2
+	for _, order := range []types.Order{
+		{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "id1",
+			BaseDenom:   frozenDenom,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    quantity.MulRaw(10),
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "id2",
+			BaseDenom:   frozenDenom,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+			Quantity:    quantity.MulRaw(10),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+	} {
+		placeFundedOrder(t, sdkCtx, testApp, order)
+	}
+
+	// the part of the input denom balance is frozen
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc2, sdk.NewCoins(sdk.NewCoin(frozenDenom, quantity.MulRaw(5))))
+	require.NoError(t, testApp.AssetFTKeeper.Freeze(
+		sdkCtx, testSet.issuer, testSet.acc2, sdk.NewCoin(frozenDenom, quantity.MulRaw(2)), nil,
+	))
+
+	hops, err := dexKeeper.SwapExactIn(
+		sdkCtx, testSet.acc2, []string{frozenDenom, testSet.denom2}, quantity.MulRaw(3), quantity.MulRaw(3),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []types.SwapHop{
+		{
+			SpentCoin:    sdk.NewCoin(frozenDenom, quantity.MulRaw(3)),
+			ReceivedCoin: sdk.NewCoin(testSet.denom2, quantity.MulRaw(3)),
+		},
+	}, hops)
+	requireSpendableBalance(t, sdkCtx, testApp, testSet.acc2, sdk.NewCoin(frozenDenom, sdkmath.ZeroInt()))
+	requireSpendableBalance(t, sdkCtx, testApp, testSet.acc2, sdk.NewCoin(testSet.denom2, quantity.MulRaw(3)))
+
+	// the received coins are frozen, so the spendable balance of the output denom isn't changed by the swap
+	require.NoError(t, testApp.AssetFTKeeper.Freeze(
+		sdkCtx, testSet.issuer, testSet.acc2, sdk.NewCoin(frozenDenom, quantity.MulRaw(10)), nil,
+	))
+	hops, err = dexKeeper.SwapExactIn(
+		sdkCtx, testSet.acc2, []string{testSet.denom2, frozenDenom}, quantity.MulRaw(2), quantity,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []types.SwapHop{
+		{
+			SpentCoin:    sdk.NewCoin(testSet.denom2, quantity.MulRaw(2)),
+			ReceivedCoin: sdk.NewCoin(frozenDenom, quantity),
+		},
+	}, hops)
+	requireSpendableBalance(t, sdkCtx, testApp, testSet.acc2, sdk.NewCoin(frozenDenom, sdkmath.ZeroInt()))
+	requireSpendableBalance(t, sdkCtx, testApp, testSet.acc2, sdk.NewCoin(testSet.denom2, quantity))
+}
+
+func TestKeeper_SwapExactOut_MinimalAmountIn(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, cmtproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	quantity := defaultQuantityStep
+
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.003")
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	// the liquidity to swap denom1 to denom2 at the prices 1 and 9e-1 of the direct order book, including the iceberg
+	// order, and at the price 8e-1 of the inverted order book
+	icebergOrder := types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "iceberg",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("9e-1")),
+		Quantity:    quantity.MulRaw(10),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	icebergOrder.VisibleQuantity = lo.ToPtr(quantity.MulRaw(2))
+	for _, order := range []types.Order{
+		{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "direct",
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    quantity.MulRaw(3),
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+		icebergOrder,
+		{
+			Creator:     testSet.acc1.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "inverted",
+			BaseDenom:   testSet.denom2,
+			QuoteDenom:  testSet.denom1,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("125e-2")),
+			Quantity:    quantity.MulRaw(10),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		},
+	} {
+		placeFundedOrder(t, sdkCtx, testApp, order)
+	}
+
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc2, sdk.NewCoins(sdk.NewCoin(testSet.denom1, quantity.MulRaw(100))))
+	route := []string{testSet.denom1, testSet.denom2}
+	// the amount out is received from all the makers
+	amountOut := quantity.MulRaw(14).AddRaw(1)
+
+	simulation, err := dexKeeper.SimulateSwap(sdkCtx, testSet.acc2, route, sdkmath.Int{}, amountOut)
+	require.NoError(t, err)
+	require.True(t, simulation.ReceivedCoin.Amount.GTE(amountOut))
+
+	// the input amount less by the quantity step doesn't receive the amount out
+	lessSimulation, err := dexKeeper.SimulateSwap(
+		sdkCtx, testSet.acc2, route, simulation.SpentCoin.Amount.Sub(quantity), sdkmath.Int{},
+	)
+	require.NoError(t, err)
+	require.True(t, lessSimulation.ReceivedCoin.Amount.LT(amountOut))
+
+	// the swap with the simulated amount in as the max amount in is executed
+	hops, err := dexKeeper.SwapExactOut(sdkCtx, testSet.acc2, route, amountOut, simulation.SpentCoin.Amount)
+	require.NoError(t, err)
+	require.Equal(t, simulation.Hops, hops)
+
+	// the liquidity left isn't enough for the amount out
+	_, err = dexKeeper.SwapExactOut(sdkCtx, testSet.acc2, route, amountOut, quantity.MulRaw(100))
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+}
+
+func requireSpendableBalance(
+	t *testing.T, sdkCtx sdk.Context, testApp *simapp.App, acc sdk.AccAddress, expected sdk.Coin,
+) {
+	balance, err := testApp.AssetFTKeeper.GetSpendableBalance(sdkCtx, acc, expected.Denom)
+	require.NoError(t, err)
+	require.Equal(t, expected.String(), balance.String())
+}
@@ -16,20 +16,24 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/docker/distribution/uuid"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 
+	"github.com/CoreumFoundation/coreum/v6/testutil/event"
 	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
 	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/keeper"
 	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
 )
 
 type OrderPlacementEvents struct {
-	OrderPlaced   types.EventOrderPlaced
-	OrdersReduced []types.EventOrderReduced
-	OrderCreated  *types.EventOrderCreated
-	OrdersClosed  []types.EventOrderClosed
+	OrderPlaced    types.EventOrderPlaced
+	OrdersReduced  []types.EventOrderReduced
+	OrderCreated   *types.EventOrderCreated
+	OrdersClosed   []types.EventOrderClosed
+	OrdersRefilled []types.EventOrderRefilled
 }
 
 func (o OrderPlacementEvents) getOrderReduced(acc, id string) (types.EventOrderReduced, bool) {
@@ -47,9 +51,10 @@ func readOrderEvents(
 	sdkCtx sdk.Context,
 ) OrderPlacementEvents {
 	events := OrderPlacementEvents{
-		OrderCreated:  nil,
-		OrdersReduced: make([]types.EventOrderReduced, 0),
-		OrdersClosed:  make([]types.EventOrderClosed, 0),
+		OrderCreated:   nil,
+		OrdersReduced:  make([]types.EventOrderReduced, 0),
+		OrdersClosed:   make([]types.EventOrderClosed, 0),
+		OrdersRefilled: make([]types.EventOrderRefilled, 0),
 	}
 
 	for _, evt := range sdkCtx.EventManager().Events().ToABCIEvents() {
@@ -69,6 +74,8 @@ func readOrderEvents(
 			events.OrderCreated = typedEvt
 		case *types.EventOrderClosed:
 			events.OrdersClosed = append(events.OrdersClosed, *typedEvt)
+		case *types.EventOrderRefilled:
+			events.OrdersRefilled = append(events.OrdersRefilled, *typedEvt)
 		}
 	}
 
@@ -437,6 +444,8 @@ func TestKeeper_PlaceAndCancelOrder(t *testing.T) {
 			Sequence:     expectedSellOrderSequence,
 			SentCoin:     sdk.NewCoin(sellOrder.BaseDenom, sdkmath.NewIntFromUint64(1_000_000)),
 			ReceivedCoin: sdk.NewCoin(sellOrder.QuoteDenom, sdkmath.NewIntFromUint64(1_200_000)),
+			FeeCoin:      sdk.NewCoin(sellOrder.QuoteDenom, sdkmath.ZeroInt()),
+			FeeRate:      sdkmath.LegacyZeroDec(),
 		},
 		{
 			Creator:      buyOrder.Creator,
@@ -444,6 +453,8 @@ func TestKeeper_PlaceAndCancelOrder(t *testing.T) {
 			Sequence:     expectedBuyOrderSequence,
 			SentCoin:     sdk.NewCoin(buyOrder.QuoteDenom, sdkmath.NewIntFromUint64(1_200_000)),
 			ReceivedCoin: sdk.NewCoin(buyOrder.BaseDenom, sdkmath.NewIntFromUint64(1_000_000)),
+			FeeCoin:      sdk.NewCoin(buyOrder.BaseDenom, sdkmath.ZeroInt()),
+			FeeRate:      sdkmath.LegacyZeroDec(),
 		},
 	}, events.OrdersReduced)
 
@@ -470,6 +481,337 @@ func TestKeeper_PlaceAndCancelOrder(t *testing.T) {
 	require.True(t, dexExpectedToReceiveBalance.IsZero())
 }
 
+func TestKeeper_PlaceOrder_PostOnly(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	sellOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_POST_ONLY,
+	}
+	sellLockedBalance, err := sellOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(sellLockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, sellOrder))
+
+	buyOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id2",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_POST_ONLY,
+	}
+	buyLockedBalance, err := buyOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc2, sdk.NewCoins(buyLockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc2)
+
+	// the order matches the sell order
+	require.ErrorContains(
+		t,
+		dexKeeper.PlaceOrder(simapp.CopyContextWithMultiStore(sdkCtx), buyOrder),
+		"matches the order book and can't be placed as maker",
+	)
+
+	// the order doesn't match
+	buyOrder.Price = lo.ToPtr(types.MustNewPriceFromString("4e-1"))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, buyOrder))
+
+	orderBookID, err := dexKeeper.GetOrderBookIDByDenoms(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Len(t, getSorterOrderBookOrders(t, testApp, sdkCtx, orderBookID, types.SIDE_SELL), 1)
+	buyOrders := getSorterOrderBookOrders(t, testApp, sdkCtx, orderBookID, types.SIDE_BUY)
+	require.Len(t, buyOrders, 1)
+	require.Equal(t, buyOrder.Quantity.String(), buyOrders[0].RemainingBaseQuantity.String())
+}
+
+func TestKeeper_ReplaceOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+
+	acc := testSet.acc1
+	// the expected to receive balance is recorded for the denoms with the whitelisting only
+	quoteDenom := testSet.ftDenomWhitelisting1
+	require.NoError(t, assetFTKeeper.SetWhitelistedBalance(
+		sdkCtx, testSet.issuer, acc, sdk.NewInt64Coin(quoteDenom, 1_000_000),
+	))
+	sellOrder := types.Order{
+		Creator:     acc.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  quoteDenom,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	sellLockedBalance, err := sellOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, acc, sdk.NewCoins(sellLockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, acc)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, sellOrder))
+
+	// try to replace not existing order
+	require.ErrorIs(
+		t,
+		dexKeeper.ReplaceOrder(simapp.CopyContextWithMultiStore(sdkCtx), "id0", sellOrder),
+		types.ErrRecordNotFound,
+	)
+
+	// same price and reduced quantity, the order is amended in place
+	amendedOrder := sellOrder
+	amendedOrder.ID = "id2"
+	amendedOrder.Quantity = sdkmath.NewInt(600_000)
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ReplaceOrder(sdkCtx, sellOrder.ID, amendedOrder))
+	events := readOrderEvents(t, sdkCtx)
+	require.Empty(t, events.OrdersClosed)
+	require.Equal(t, types.EventOrderCreated{
+		Creator:                   amendedOrder.Creator,
+		ID:                        amendedOrder.ID,
+		Sequence:                  1,
+		RemainingBaseQuantity:     sdkmath.NewInt(600_000),
+		RemainingSpendableBalance: sdkmath.NewInt(600_000),
+	}, *events.OrderCreated)
+
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, sellOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+	gotOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, amendedOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), gotOrder.Sequence)
+
+	dexLockedBalance := assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom1)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 600_000).String(), dexLockedBalance.String())
+	dexExpectedToReceiveBalance := assetFTKeeper.GetDEXExpectedToReceivedBalance(sdkCtx, acc, quoteDenom)
+	require.Equal(t, sdk.NewInt64Coin(quoteDenom, 300_000).String(), dexExpectedToReceiveBalance.String())
+
+	// the ID is already used
+	require.ErrorIs(
+		t,
+		dexKeeper.ReplaceOrder(simapp.CopyContextWithMultiStore(sdkCtx), amendedOrder.ID, sellOrder),
+		types.ErrInvalidInput,
+	)
+
+	// new price with the same ID, the order is canceled and placed again
+	repricedOrder := amendedOrder
+	repricedOrder.Price = lo.ToPtr(types.MustNewPriceFromString("4e-1"))
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ReplaceOrder(sdkCtx, amendedOrder.ID, repricedOrder))
+	events = readOrderEvents(t, sdkCtx)
+	require.Len(t, events.OrdersClosed, 1)
+	require.Equal(t, uint64(1), events.OrdersClosed[0].Sequence)
+	require.Equal(t, uint64(2), events.OrderCreated.Sequence)
+
+	gotOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, repricedOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), gotOrder.Sequence)
+	require.Equal(t, repricedOrder.Price.String(), gotOrder.Price.String())
+
+	// the locked balance is the same, so it's not changed, and the expected to receive balance is reduced directly
+	for _, evt := range sdkCtx.EventManager().Events() {
+		require.NotEqual(t, proto.MessageName(&assetfttypes.EventDEXLockedAmountChanged{}), evt.Type)
+	}
+	expectedToReceiveEvents, err := event.FindTypedEvents[*assetfttypes.EventDEXExpectedToReceiveAmountChanged](
+		sdkCtx.EventManager().Events().ToABCIEvents(),
+	)
+	require.NoError(t, err)
+	require.Len(t, expectedToReceiveEvents, 1)
+	require.Equal(t, "300000", expectedToReceiveEvents[0].PreviousAmount.String())
+	require.Equal(t, "240000", expectedToReceiveEvents[0].CurrentAmount.String())
+
+	dexLockedBalance = assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom1)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 600_000).String(), dexLockedBalance.String())
+	dexExpectedToReceiveBalance = assetFTKeeper.GetDEXExpectedToReceivedBalance(sdkCtx, acc, quoteDenom)
+	require.Equal(t, sdk.NewInt64Coin(quoteDenom, 240_000).String(), dexExpectedToReceiveBalance.String())
+	require.Equal(t, map[string]uint64{
+		testSet.denom1: 1,
+		quoteDenom:     1,
+	}, getAccountDenomsOrdersCount(t, testApp, sdkCtx, acc))
+
+	// same price and increased quantity, the order loses the priority
+	increasedOrder := repricedOrder
+	increasedOrder.ID = "id3"
+	increasedOrder.Quantity = sdkmath.NewInt(800_000)
+	testApp.MintAndSendCoin(t, sdkCtx, acc, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 200_000)))
+	require.NoError(t, dexKeeper.ReplaceOrder(sdkCtx, repricedOrder.ID, increasedOrder))
+	gotOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, increasedOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), gotOrder.Sequence)
+	dexLockedBalance = assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom1)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 800_000).String(), dexLockedBalance.String())
+
+	// the visible quantity of the new order is kept
+	icebergOrder := increasedOrder
+	icebergOrder.ID = "id4"
+	icebergOrder.VisibleQuantity = lo.ToPtr(sdkmath.NewInt(200_000))
+	require.NoError(t, dexKeeper.ReplaceOrder(sdkCtx, increasedOrder.ID, icebergOrder))
+	gotOrder, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, icebergOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), gotOrder.Sequence)
+	require.NotNil(t, gotOrder.VisibleQuantity)
+	require.Equal(t, "200000", gotOrder.VisibleQuantity.String())
+	dexLockedBalance = assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom1)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 800_000).String(), dexLockedBalance.String())
+}
+
+func TestKeeper_QueryPostOnlyOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	queryService := keeper.NewQueryService(dexKeeper)
+
+	order := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_POST_ONLY,
+	}
+	lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(lockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+
+	orderRes, err := queryService.Order(sdkCtx, &types.QueryOrderRequest{
+		Creator: testSet.acc1.String(),
+		Id:      order.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.TIME_IN_FORCE_POST_ONLY, orderRes.Order.TimeInForce)
+
+	ordersRes, err := queryService.Orders(sdkCtx, &types.QueryOrdersRequest{
+		Creator: testSet.acc1.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, ordersRes.Orders, 1)
+	require.Equal(t, types.TIME_IN_FORCE_POST_ONLY, ordersRes.Orders[0].TimeInForce)
+
+	orderBookOrdersRes, err := queryService.OrderBookOrders(sdkCtx, &types.QueryOrderBookOrdersRequest{
+		BaseDenom:  testSet.denom1,
+		QuoteDenom: testSet.denom2,
+		Side:       types.SIDE_SELL,
+	})
+	require.NoError(t, err)
+	require.Len(t, orderBookOrdersRes.Orders, 1)
+	require.Equal(t, types.TIME_IN_FORCE_POST_ONLY, orderBookOrdersRes.Orders[0].TimeInForce)
+
+	accountsOrders, _, err := dexKeeper.GetAccountsOrders(sdkCtx, nil)
+	require.NoError(t, err)
+	require.Len(t, accountsOrders, 1)
+	require.Equal(t, types.TIME_IN_FORCE_POST_ONLY, accountsOrders[0].TimeInForce)
+}
+
+func TestKeeper_PlaceAndCancelOrders(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+
+	acc := testSet.acc1
+	order1 := types.Order{
+		Creator:     acc.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	order2 := order1
+	order2.ID = "id2"
+	order2.Price = lo.ToPtr(types.MustNewPriceFromString("6e-1"))
+	// the balance is enough for two orders only
+	order3 := order1
+	order3.ID = "id3"
+	order3.Price = lo.ToPtr(types.MustNewPriceFromString("7e-1"))
+
+	testApp.MintAndSendCoin(t, sdkCtx, acc, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 2_000_000)))
+	for range 3 {
+		fundOrderReserve(t, testApp, sdkCtx, acc)
+	}
+
+	// duplicated ID in the batch
+	order4 := order1
+	order4.Quantity = defaultQuantityStep
+	// invalid order in the batch
+	order5 := order1
+	order5.ID = "id5"
+	order5.Price = nil
+
+	results, err := dexKeeper.PlaceOrders(sdkCtx, []types.Order{order1, order2, order3, order4, order5})
+	require.NoError(t, err)
+	require.Len(t, results, 5)
+	require.Equal(t, types.BatchOrderResult{ID: order1.ID, Success: true}, results[0])
+	require.Equal(t, types.BatchOrderResult{ID: order2.ID, Success: true}, results[1])
+	require.Equal(t, order3.ID, results[2].ID)
+	require.False(t, results[2].Success)
+	require.Contains(t, results[2].Error, "insufficient funds")
+	require.Equal(t, order4.ID, results[3].ID)
+	require.False(t, results[3].Success)
+	require.Contains(t, results[3].Error, "order id already used")
+	require.Equal(t, order5.ID, results[4].ID)
+	require.False(t, results[4].Success)
+	require.Contains(t, results[4].Error, "price cannot be empty")
+
+	orderBookID, err := dexKeeper.GetOrderBookIDByDenoms(sdkCtx, testSet.denom1, testSet.denom2)
+	require.NoError(t, err)
+	require.Len(t, getSorterOrderBookOrders(t, testApp, sdkCtx, orderBookID, types.SIDE_SELL), 2)
+	dexLockedBalance := assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom1)
+	require.Equal(t, sdk.NewInt64Coin(testSet.denom1, 2_000_000).String(), dexLockedBalance.String())
+	require.Equal(t, map[string]uint64{
+		testSet.denom1: 2,
+		testSet.denom2: 2,
+	}, getAccountDenomsOrdersCount(t, testApp, sdkCtx, acc))
+
+	results, err = dexKeeper.CancelOrders(sdkCtx, acc, []string{order1.ID, order3.ID, order2.ID})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, types.BatchOrderResult{ID: order1.ID, Success: true}, results[0])
+	require.Equal(t, order3.ID, results[1].ID)
+	require.False(t, results[1].Success)
+	require.NotEmpty(t, results[1].Error)
+	require.Equal(t, types.BatchOrderResult{ID: order2.ID, Success: true}, results[2])
+
+	require.Empty(t, getSorterOrderBookOrders(t, testApp, sdkCtx, orderBookID, types.SIDE_SELL))
+	dexLockedBalance = assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, testSet.denom1)
+	require.True(t, dexLockedBalance.IsZero())
+	require.Equal(t, map[string]uint64{
+		testSet.denom1: 0,
+		testSet.denom2: 0,
+	}, getAccountDenomsOrdersCount(t, testApp, sdkCtx, acc))
+}
+
 func TestKeeper_PlaceOrder_PriceTickAndQuantityStep(t *testing.T) {
 	tests := []struct {
 		name              string
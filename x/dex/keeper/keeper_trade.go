@@ -0,0 +1,529 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/samber/lo"
+
+	cbig "github.com/CoreumFoundation/coreum/v6/pkg/math/big"
+	"github.com/CoreumFoundation/coreum/v6/pkg/store"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// GetTrades returns the recent trades of the order book pair in the terms of the canonical order book of the pair,
+// the most recent first.
+func (k Keeper) GetTrades(
+	ctx sdk.Context,
+	baseDenom, quoteDenom string,
+	pagination *query.PageRequest,
+) ([]types.Trade, *query.PageResponse, error) {
+	orderBookID, err := k.getCanonicalOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	trades, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.CreateOrderBookTradeKeyPrefix(orderBookID)),
+		// the most recent trades are returned first
+		reversePageRequest(pagination),
+		// builder
+		func(_ []byte, trade *types.Trade) (*types.Trade, error) {
+			return trade, nil
+		},
+		// constructor
+		func() *types.Trade {
+			return &types.Trade{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(trades, func(trade *types.Trade, _ int) types.Trade {
+		return *trade
+	}), pageRes, nil
+}
+
+// GetCandles returns the candles of the interval of the order book pair in the terms of the canonical order book of the
+// pair.
+func (k Keeper) GetCandles(
+	ctx sdk.Context,
+	baseDenom, quoteDenom string,
+	interval types.CandleInterval,
+	pagination *query.PageRequest,
+) ([]types.Candle, *query.PageResponse, error) {
+	if err := interval.Validate(); err != nil {
+		return nil, nil, err
+	}
+	orderBookID, err := k.getCanonicalOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	candles, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		prefix.NewStore(
+			runtime.KVStoreAdapter(moduleStore), types.CreateOrderBookCandleKeyPrefix(orderBookID, interval),
+		),
+		pagination,
+		// builder
+		func(_ []byte, candle *types.Candle) (*types.Candle, error) {
+			return candle, nil
+		},
+		// constructor
+		func() *types.Candle {
+			return &types.Candle{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(candles, func(candle *types.Candle, _ int) types.Candle {
+		return *candle
+	}), pageRes, nil
+}
+
+// GetOrderBooksTrades returns the paginated recent trades of all order books.
+func (k Keeper) GetOrderBooksTrades(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.Trade, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	trades, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.OrderBookTradeKeyPrefix),
+		pagination,
+		// builder
+		func(_ []byte, trade *types.Trade) (*types.Trade, error) {
+			return trade, nil
+		},
+		// constructor
+		func() *types.Trade {
+			return &types.Trade{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(trades, func(trade *types.Trade, _ int) types.Trade {
+		return *trade
+	}), pageRes, nil
+}
+
+// GetOrderBooksCandles returns the paginated candles of all order books and intervals.
+func (k Keeper) GetOrderBooksCandles(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.Candle, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	candles, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.OrderBookCandleKeyPrefix),
+		pagination,
+		// builder
+		func(_ []byte, candle *types.Candle) (*types.Candle, error) {
+			return candle, nil
+		},
+		// constructor
+		func() *types.Candle {
+			return &types.Candle{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(candles, func(candle *types.Candle, _ int) types.Candle {
+		return *candle
+	}), pageRes, nil
+}
+
+// ImportTrade saves the trade with its sequence and moves the order book trade sequence forward if required.
+func (k Keeper) ImportTrade(ctx sdk.Context, trade types.Trade) error {
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, trade.BaseDenom, trade.QuoteDenom)
+	if err != nil {
+		return err
+	}
+
+	sequenceKey := types.CreateOrderBookTradeSequenceKey(orderBookID)
+	var sequence gogotypes.UInt64Value
+	if err := k.getDataFromStore(ctx, sequenceKey, &sequence); err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return err
+		}
+	}
+	if trade.Sequence > sequence.Value {
+		sequence.Value = trade.Sequence
+		if err := k.setDataToStore(ctx, sequenceKey, &sequence); err != nil {
+			return err
+		}
+	}
+
+	return k.setDataToStore(ctx, types.CreateOrderBookTradeKey(orderBookID, trade.Sequence), &trade)
+}
+
+// ImportCandle saves the order book candle.
+func (k Keeper) ImportCandle(ctx sdk.Context, candle types.Candle) error {
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, candle.BaseDenom, candle.QuoteDenom)
+	if err != nil {
+		return err
+	}
+
+	return k.setDataToStore(
+		ctx, types.CreateOrderBookCandleKey(orderBookID, candle.Interval, candle.StartTime), &candle,
+	)
+}
+
+// addOrderBookTrade registers the trade executed by the taker record in the order book with the price of the order
+// book, the trade is converted to the terms of the canonical order book of the pair when it's saved.
+func addOrderBookTrade(
+	mr *MatchingResult,
+	orderBookID uint32,
+	price types.Price,
+	takerRecord *types.OrderBookRecord,
+	trade Trade,
+) error {
+	takerSide := takerRecord.Side
+	baseQuantity, quoteQuantity := trade.BaseQuantity, trade.QuoteQuantity
+	if takerRecord.OrderBookID != orderBookID {
+		var err error
+		takerSide, err = takerSide.Opposite()
+		if err != nil {
+			return err
+		}
+		baseQuantity, quoteQuantity = trade.QuoteQuantity, trade.BaseQuantity
+	}
+
+	mr.AddTrade(orderBookID, types.Trade{
+		Price:         price,
+		BaseQuantity:  sdkmath.NewIntFromBigInt(baseQuantity),
+		QuoteQuantity: sdkmath.NewIntFromBigInt(quoteQuantity),
+		TakerSide:     takerSide,
+	})
+
+	return nil
+}
+
+// SaveBlockCandles merges the candles aggregating the trades of the block into the interval candles of the order
+// books.
+func (k Keeper) SaveBlockCandles(ctx sdk.Context) error {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	orderBookIDs, err := func() ([]uint32, error) {
+		iterator := prefix.NewStore(
+			runtime.KVStoreAdapter(moduleStore), types.OrderBookBlockCandleKeyPrefix,
+		).Iterator(nil, nil)
+		defer iterator.Close()
+
+		orderBookIDs := make([]uint32, 0)
+		for ; iterator.Valid(); iterator.Next() {
+			orderBookID, err := types.DecodeOrderBookBlockCandleKey(iterator.Key())
+			if err != nil {
+				return nil, err
+			}
+			orderBookIDs = append(orderBookIDs, orderBookID)
+		}
+
+		return orderBookIDs, nil
+	}()
+	if err != nil {
+		return err
+	}
+
+	for _, orderBookID := range orderBookIDs {
+		key := types.CreateOrderBookBlockCandleKey(orderBookID)
+		var blockCandle types.Candle
+		if err := k.getDataFromStore(ctx, key, &blockCandle); err != nil {
+			return err
+		}
+		if err := k.updateCandles(ctx, orderBookID, blockCandle); err != nil {
+			return err
+		}
+		if err := moduleStore.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// saveTrades saves the trades in the canonical order book of the pair, the order book with the lower ID, so the
+// trades of both order books of the pair are kept in the same history. The trades are aggregated into the block candle
+// of the order book, merged into the interval candles at the end of the block, so the candles are written once per
+// block instead of once per trade.
+func (k Keeper) saveTrades(ctx sdk.Context, trades []OrderBookTrade) error {
+	orderBookIDToCanonicalOrderBookID := make(map[uint32]uint32)
+	orderBookIDToOrderBookData := make(map[uint32]types.OrderBookData)
+	// the slice keeps the deterministic order of the candles saving
+	candleOrderBookIDs := make([]uint32, 0)
+	orderBookIDToCandle := make(map[uint32]*types.Candle)
+	for _, item := range trades {
+		canonicalOrderBookID, ok := orderBookIDToCanonicalOrderBookID[item.OrderBookID]
+		if !ok {
+			var err error
+			canonicalOrderBookID, err = k.getCanonicalOrderBookID(ctx, item.OrderBookID)
+			if err != nil {
+				return err
+			}
+			orderBookIDToCanonicalOrderBookID[item.OrderBookID] = canonicalOrderBookID
+		}
+		orderBookData, ok := orderBookIDToOrderBookData[canonicalOrderBookID]
+		if !ok {
+			var err error
+			orderBookData, err = k.getOrderBookData(ctx, canonicalOrderBookID)
+			if err != nil {
+				return err
+			}
+			orderBookIDToOrderBookData[canonicalOrderBookID] = orderBookData
+		}
+
+		trade := item.Trade
+		if item.OrderBookID != canonicalOrderBookID {
+			var err error
+			trade, err = invertTrade(trade)
+			if err != nil {
+				return err
+			}
+		}
+		trade.BaseDenom = orderBookData.BaseDenom
+		trade.QuoteDenom = orderBookData.QuoteDenom
+		trade.Height = ctx.BlockHeight()
+		trade.Time = ctx.BlockTime()
+		if err := k.saveTrade(ctx, canonicalOrderBookID, trade); err != nil {
+			return err
+		}
+
+		tradeCandle := newTradeCandle(trade)
+		candle, ok := orderBookIDToCandle[canonicalOrderBookID]
+		if !ok {
+			candleOrderBookIDs = append(candleOrderBookIDs, canonicalOrderBookID)
+			orderBookIDToCandle[canonicalOrderBookID] = &tradeCandle
+			continue
+		}
+		mergeCandle(candle, tradeCandle)
+	}
+
+	for _, orderBookID := range candleOrderBookIDs {
+		if err := k.addBlockCandle(ctx, orderBookID, *orderBookIDToCandle[orderBookID]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) getCanonicalOrderBookIDByDenoms(ctx sdk.Context, baseDenom, quoteDenom string) (uint32, error) {
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return 0, err
+	}
+
+	return k.getCanonicalOrderBookID(ctx, orderBookID)
+}
+
+func (k Keeper) getCanonicalOrderBookID(ctx sdk.Context, orderBookID uint32) (uint32, error) {
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return 0, err
+	}
+
+	return getOrderBookPairID(orderBookID, invertedOrderBookID), nil
+}
+
+// invertTrade converts the trade to the terms of the inverted order book, the inverted price is rounded down since it
+// can't be always represented as the price precisely.
+func invertTrade(trade types.Trade) (types.Trade, error) {
+	price, err := types.NewPriceFromRat(cbig.RatInv(trade.Price.Rat()), false)
+	if err != nil {
+		return types.Trade{}, sdkerrors.Wrapf(types.ErrInvalidState, "failed to invert trade price: %s", err)
+	}
+	takerSide, err := trade.TakerSide.Opposite()
+	if err != nil {
+		return types.Trade{}, err
+	}
+
+	trade.Price = price
+	trade.BaseQuantity, trade.QuoteQuantity = trade.QuoteQuantity, trade.BaseQuantity
+	trade.TakerSide = takerSide
+
+	return trade, nil
+}
+
+func (k Keeper) saveTrade(ctx sdk.Context, orderBookID uint32, trade types.Trade) error {
+	tradeSequence, err := k.genNextUint64Sequence(ctx, types.CreateOrderBookTradeSequenceKey(orderBookID))
+	if err != nil {
+		return err
+	}
+	trade.Sequence = tradeSequence
+	if err := k.setDataToStore(ctx, types.CreateOrderBookTradeKey(orderBookID, tradeSequence), &trade); err != nil {
+		return err
+	}
+
+	// prune the oldest trade to keep the bounded number of trades
+	if tradeSequence <= types.MaxOrderBookTrades {
+		return nil
+	}
+
+	return k.storeService.OpenKVStore(ctx).Delete(
+		types.CreateOrderBookTradeKey(orderBookID, tradeSequence-types.MaxOrderBookTrades),
+	)
+}
+
+// addBlockCandle merges the candle into the block candle of the order book.
+func (k Keeper) addBlockCandle(ctx sdk.Context, orderBookID uint32, candle types.Candle) error {
+	key := types.CreateOrderBookBlockCandleKey(orderBookID)
+	var blockCandle types.Candle
+	if err := k.getDataFromStore(ctx, key, &blockCandle); err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return err
+		}
+		return k.setDataToStore(ctx, key, &candle)
+	}
+
+	// the block candle isn't merged at the end of its block, so it's merged into the interval candles before the
+	// candle of the current block is started
+	if !blockCandle.StartTime.Equal(candle.StartTime) {
+		if err := k.updateCandles(ctx, orderBookID, blockCandle); err != nil {
+			return err
+		}
+		return k.setDataToStore(ctx, key, &candle)
+	}
+
+	mergeCandle(&blockCandle, candle)
+	return k.setDataToStore(ctx, key, &blockCandle)
+}
+
+// updateCandles merges the block candle into the candles of all intervals.
+func (k Keeper) updateCandles(ctx sdk.Context, orderBookID uint32, blockCandle types.Candle) error {
+	for _, interval := range types.CandleIntervals {
+		if err := k.updateCandle(ctx, orderBookID, interval, blockCandle); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) updateCandle(
+	ctx sdk.Context,
+	orderBookID uint32,
+	interval types.CandleInterval,
+	blockCandle types.Candle,
+) error {
+	duration, err := interval.Duration()
+	if err != nil {
+		return err
+	}
+	startTime := blockCandle.StartTime.UTC().Truncate(duration)
+	key := types.CreateOrderBookCandleKey(orderBookID, interval, startTime)
+
+	var candle types.Candle
+	if err := k.getDataFromStore(ctx, key, &candle); err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return err
+		}
+		candle = blockCandle
+		candle.Interval = interval
+		candle.StartTime = startTime
+		// the new candle is opened, so the candles out of the kept window can be pruned
+		if err := k.pruneCandles(
+			ctx, orderBookID, interval, startTime.Add(-(types.MaxOrderBookCandles-1)*duration),
+		); err != nil {
+			return err
+		}
+
+		return k.setDataToStore(ctx, key, &candle)
+	}
+
+	mergeCandle(&candle, blockCandle)
+	return k.setDataToStore(ctx, key, &candle)
+}
+
+// pruneCandles removes the order book candles of the interval started before the provided time.
+func (k Keeper) pruneCandles(
+	ctx sdk.Context,
+	orderBookID uint32,
+	interval types.CandleInterval,
+	before time.Time,
+) error {
+	if before.Unix() <= 0 {
+		return nil
+	}
+
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	candlesStore := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateOrderBookCandleKeyPrefix(orderBookID, interval),
+	)
+	iterator := candlesStore.Iterator(nil, store.AppendUint64ToOrderedBytes(make([]byte, 0), uint64(before.Unix())))
+	keysToRemove := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		keysToRemove = append(keysToRemove, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keysToRemove {
+		candlesStore.Delete(key)
+	}
+
+	return nil
+}
+
+// newTradeCandle returns the candle of the single trade started at the trade time.
+func newTradeCandle(trade types.Trade) types.Candle {
+	return types.Candle{
+		BaseDenom:   trade.BaseDenom,
+		QuoteDenom:  trade.QuoteDenom,
+		StartTime:   trade.Time,
+		Open:        trade.Price,
+		High:        trade.Price,
+		Low:         trade.Price,
+		Close:       trade.Price,
+		BaseVolume:  trade.BaseQuantity,
+		QuoteVolume: trade.QuoteQuantity,
+		TradesCount: 1,
+	}
+}
+
+// mergeCandle merges the next candle of the same order book into the candle.
+func mergeCandle(candle *types.Candle, next types.Candle) {
+	if next.High.Rat().Cmp(candle.High.Rat()) > 0 {
+		candle.High = next.High
+	}
+	if next.Low.Rat().Cmp(candle.Low.Rat()) < 0 {
+		candle.Low = next.Low
+	}
+	candle.Close = next.Close
+	candle.BaseVolume = candle.BaseVolume.Add(next.BaseVolume)
+	candle.QuoteVolume = candle.QuoteVolume.Add(next.QuoteVolume)
+	candle.TradesCount += next.TradesCount
+}
+
+// reversePageRequest returns the copy of the page request with the reversed iteration order.
+func reversePageRequest(pagination *query.PageRequest) *query.PageRequest {
+	if pagination == nil {
+		return &query.PageRequest{Reverse: true}
+	}
+	reversed := *pagination
+	reversed.Reverse = !reversed.Reverse
+
+	return &reversed
+}
+
+// getOrderBookPairID returns the ID the data shared by the order book and its inverted order book is kept for.
+func getOrderBookPairID(orderBookID, invertedOrderBookID uint32) uint32 {
+	// the order book IDs are generated in pairs, so the lower ID is the same for both order books
+	return min(orderBookID, invertedOrderBookID)
+}
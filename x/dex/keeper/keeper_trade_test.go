@@ -0,0 +1,353 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_TradesAndCandles(t *testing.T) {
+	testApp := simapp.New()
+	firstTradesTime := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   firstTradesTime,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	maker := testSet.acc1
+	taker := testSet.acc2
+	testApp.MintAndSendCoin(t, sdkCtx, maker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 1_200_000)))
+	testApp.MintAndSendCoin(t, sdkCtx, taker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 400_000)))
+	for range 2 {
+		fundOrderReserve(t, testApp, sdkCtx, maker)
+	}
+	for range 3 {
+		fundOrderReserve(t, testApp, sdkCtx, taker)
+	}
+
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     maker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+
+	// no trades in the order book
+	trades, _, err := dexKeeper.GetTrades(sdkCtx, testSet.denom1, testSet.denom2, nil)
+	require.NoError(t, err)
+	require.Empty(t, trades)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     taker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(200_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+	// the taker order from the inverted order book, the trade is recorded in the canonical order book of the pair
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     taker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker2",
+		BaseDenom:   testSet.denom2,
+		QuoteDenom:  testSet.denom1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+
+	// the candles are saved at the end of the block
+	candles, _, err := dexKeeper.GetCandles(
+		sdkCtx, testSet.denom1, testSet.denom2, types.CANDLE_INTERVAL_1H, nil,
+	)
+	require.NoError(t, err)
+	require.Empty(t, candles)
+	require.NoError(t, dexKeeper.SaveBlockCandles(sdkCtx))
+
+	secondTradesTime := firstTradesTime.Add(time.Hour)
+	sdkCtx = sdkCtx.WithBlockHeight(101).WithBlockTime(secondTradesTime)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     maker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker2",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("4e-1")),
+		Quantity:    sdkmath.NewInt(200_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     taker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker3",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(400_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+	require.NoError(t, dexKeeper.SaveBlockCandles(sdkCtx))
+
+	newTrade := func(
+		sequence uint64, price string, baseQuantity, quoteQuantity int64, height int64, tradeTime time.Time,
+	) types.Trade {
+		return types.Trade{
+			Sequence:      sequence,
+			BaseDenom:     testSet.denom1,
+			QuoteDenom:    testSet.denom2,
+			Price:         types.MustNewPriceFromString(price),
+			BaseQuantity:  sdkmath.NewInt(baseQuantity),
+			QuoteQuantity: sdkmath.NewInt(quoteQuantity),
+			TakerSide:     types.SIDE_BUY,
+			Height:        height,
+			Time:          tradeTime,
+		}
+	}
+	// the most recent trades first
+	expectedTrades := []types.Trade{
+		newTrade(4, "5e-1", 200_000, 100_000, 101, secondTradesTime),
+		newTrade(3, "4e-1", 200_000, 80_000, 101, secondTradesTime),
+		newTrade(2, "5e-1", 200_000, 100_000, 100, firstTradesTime),
+		newTrade(1, "5e-1", 200_000, 100_000, 100, firstTradesTime),
+	}
+
+	trades, _, err = dexKeeper.GetTrades(sdkCtx, testSet.denom1, testSet.denom2, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedTrades, trades)
+
+	// the most recent trade
+	trades, _, err = dexKeeper.GetTrades(sdkCtx, testSet.denom1, testSet.denom2, &query.PageRequest{
+		Limit: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedTrades[:1], trades)
+
+	// the oldest trades first
+	trades, _, err = dexKeeper.GetTrades(sdkCtx, testSet.denom1, testSet.denom2, &query.PageRequest{
+		Reverse: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, lo.Reverse(append([]types.Trade{}, expectedTrades...)), trades)
+
+	// the trades of the pair are returned in the terms of the canonical order book for both denom orders
+	trades, _, err = dexKeeper.GetTrades(sdkCtx, testSet.denom2, testSet.denom1, nil)
+	require.NoError(t, err)
+	require.Equal(t, expectedTrades, trades)
+
+	candles, _, err = dexKeeper.GetCandles(
+		sdkCtx, testSet.denom1, testSet.denom2, types.CANDLE_INTERVAL_1H, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []types.Candle{
+		{
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Interval:    types.CANDLE_INTERVAL_1H,
+			StartTime:   time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+			Open:        types.MustNewPriceFromString("5e-1"),
+			High:        types.MustNewPriceFromString("5e-1"),
+			Low:         types.MustNewPriceFromString("5e-1"),
+			Close:       types.MustNewPriceFromString("5e-1"),
+			BaseVolume:  sdkmath.NewInt(400_000),
+			QuoteVolume: sdkmath.NewInt(200_000),
+			TradesCount: 2,
+		},
+		{
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Interval:    types.CANDLE_INTERVAL_1H,
+			StartTime:   time.Date(2024, 1, 1, 11, 0, 0, 0, time.UTC),
+			Open:        types.MustNewPriceFromString("4e-1"),
+			High:        types.MustNewPriceFromString("5e-1"),
+			Low:         types.MustNewPriceFromString("4e-1"),
+			Close:       types.MustNewPriceFromString("5e-1"),
+			BaseVolume:  sdkmath.NewInt(400_000),
+			QuoteVolume: sdkmath.NewInt(180_000),
+			TradesCount: 2,
+		},
+	}, candles)
+
+	candles, _, err = dexKeeper.GetCandles(
+		sdkCtx, testSet.denom1, testSet.denom2, types.CANDLE_INTERVAL_1D, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, []types.Candle{
+		{
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Interval:    types.CANDLE_INTERVAL_1D,
+			StartTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			Open:        types.MustNewPriceFromString("5e-1"),
+			High:        types.MustNewPriceFromString("5e-1"),
+			Low:         types.MustNewPriceFromString("4e-1"),
+			Close:       types.MustNewPriceFromString("5e-1"),
+			BaseVolume:  sdkmath.NewInt(800_000),
+			QuoteVolume: sdkmath.NewInt(380_000),
+			TradesCount: 4,
+		},
+	}, candles)
+
+	// invalid interval
+	_, _, err = dexKeeper.GetCandles(
+		sdkCtx, testSet.denom1, testSet.denom2, types.CANDLE_INTERVAL_UNSPECIFIED, nil,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidInput)
+}
+
+func TestKeeper_TradesFromInvertedOrderBook(t *testing.T) {
+	testApp := simapp.New()
+	tradeTime := time.Date(2024, 1, 1, 10, 30, 0, 0, time.UTC)
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   tradeTime,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	maker := testSet.acc1
+	taker := testSet.acc2
+	testApp.MintAndSendCoin(t, sdkCtx, maker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 100_000)))
+	testApp.MintAndSendCoin(t, sdkCtx, taker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 200_000)))
+	fundOrderReserve(t, testApp, sdkCtx, maker)
+	fundOrderReserve(t, testApp, sdkCtx, taker)
+
+	// the maker order is placed to the inverted order book of the pair
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     maker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker",
+		BaseDenom:   testSet.denom2,
+		QuoteDenom:  testSet.denom1,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+		Quantity:    sdkmath.NewInt(100_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     taker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "taker",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("5e-1")),
+		Quantity:    sdkmath.NewInt(200_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+
+	// the trade is recorded in the terms of the canonical order book
+	expectedTrades := []types.Trade{
+		{
+			Sequence:      1,
+			BaseDenom:     testSet.denom1,
+			QuoteDenom:    testSet.denom2,
+			Price:         types.MustNewPriceFromString("5e-1"),
+			BaseQuantity:  sdkmath.NewInt(200_000),
+			QuoteQuantity: sdkmath.NewInt(100_000),
+			TakerSide:     types.SIDE_SELL,
+			Height:        100,
+			Time:          tradeTime,
+		},
+	}
+	for _, denoms := range [][2]string{
+		{testSet.denom1, testSet.denom2},
+		{testSet.denom2, testSet.denom1},
+	} {
+		trades, _, err := dexKeeper.GetTrades(sdkCtx, denoms[0], denoms[1], nil)
+		require.NoError(t, err)
+		require.Equal(t, expectedTrades, trades)
+	}
+}
+
+func TestKeeper_TradesAndCandlesPruning(t *testing.T) {
+	testApp := simapp.New()
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   startTime,
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	maker := testSet.acc1
+	taker := testSet.acc2
+	tradesCount := types.MaxOrderBookTrades + 10
+	testApp.MintAndSendCoin(t, sdkCtx, maker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom1, 10_000_000_000)))
+	testApp.MintAndSendCoin(t, sdkCtx, taker, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 10_000_000_000)))
+	fundOrderReserve(t, testApp, sdkCtx, maker)
+	for range tradesCount {
+		fundOrderReserve(t, testApp, sdkCtx, taker)
+	}
+
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     maker.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+		Quantity:    sdkmath.NewInt(10_000_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}))
+
+	// one trade per minute
+	for i := range tradesCount {
+		sdkCtx = sdkCtx.WithBlockTime(startTime.Add(time.Duration(i) * time.Minute))
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+			Creator:     taker.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          fmt.Sprintf("taker%d", i),
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1")),
+			Quantity:    sdkmath.NewInt(1_000_000),
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}))
+		require.NoError(t, dexKeeper.SaveBlockCandles(sdkCtx))
+	}
+
+	trades, _, err := dexKeeper.GetTrades(sdkCtx, testSet.denom1, testSet.denom2, &query.PageRequest{
+		Limit: types.MaxOrderBookTrades + 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, trades, types.MaxOrderBookTrades)
+	require.Equal(t, uint64(tradesCount), trades[0].Sequence)
+	require.Equal(t, uint64(tradesCount-types.MaxOrderBookTrades+1), trades[len(trades)-1].Sequence)
+
+	candles, _, err := dexKeeper.GetCandles(
+		sdkCtx, testSet.denom1, testSet.denom2, types.CANDLE_INTERVAL_1M, &query.PageRequest{
+			Limit: types.MaxOrderBookCandles + 1,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, candles, types.MaxOrderBookCandles)
+	require.Equal(t, startTime.Add(time.Duration(tradesCount-types.MaxOrderBookCandles)*time.Minute), candles[0].StartTime)
+}
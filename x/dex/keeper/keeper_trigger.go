@@ -0,0 +1,823 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogotypes "github.com/cosmos/gogoproto/types"
+	"github.com/samber/lo"
+
+	cbig "github.com/CoreumFoundation/coreum/v6/pkg/math/big"
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+const (
+	// maxTriggerOrdersActivationsPerBlock is the max number of trigger orders activated in one block. The orders left
+	// are activated in the next blocks.
+	maxTriggerOrdersActivationsPerBlock = 100
+	// maxTriggerOrderRetriesPerBlock is the max number of the failed trigger orders returned for the activation in one
+	// block.
+	maxTriggerOrderRetriesPerBlock = 100
+	// maxTriggerOrderRetryBackoffExponent limits the delay of the failed trigger order retry to 2^10 blocks.
+	maxTriggerOrderRetryBackoffExponent = 10
+	// triggerOrderActivationGasLimit is the max gas consumed by the activation of one trigger order, the order
+	// exceeding it is canceled.
+	triggerOrderActivationGasLimit = 5_000_000
+	// triggerOrdersActivationGasLimitPerBlock is the max gas consumed by the trigger orders activated in one block. The
+	// orders left are activated in the next blocks.
+	triggerOrdersActivationGasLimitPerBlock = 50_000_000
+)
+
+// SaveTriggerOrder saves the trigger order.
+func (k Keeper) SaveTriggerOrder(ctx sdk.Context, accNumber uint64, orderBookID uint32, order types.Order) error {
+	return k.saveTriggerOrder(ctx, accNumber, orderBookID, order)
+}
+
+// ActivateTriggerOrders activates the trigger orders of the order books with the changed last price.
+func (k Keeper) ActivateTriggerOrders(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the failed orders are kept out of the trigger index until their retry height, so they don't consume the
+	// activations of the other orders in every block
+	if err := k.returnTriggerOrderRetries(ctx); err != nil {
+		return err
+	}
+
+	blockGasMeter := storetypes.NewGasMeter(triggerOrdersActivationGasLimitPerBlock)
+	activationsLeft := maxTriggerOrdersActivationsPerBlock
+	for activationsLeft > 0 && blockGasMeter.GasRemaining() >= triggerOrderActivationGasLimit {
+		orderBookID, found, err := k.getFirstPendingTriggerOrderBook(ctx)
+		if err != nil {
+			return err
+		}
+		if !found {
+			break
+		}
+		// the order book is marked as pending again if the activated orders change the last price
+		if err := k.removePendingTriggerOrderBook(ctx, orderBookID); err != nil {
+			return err
+		}
+
+		// the order book is marked as pending again when it's resumed
+		invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+		if err != nil {
+			return err
+		}
+		halted, err := k.isOrderBookHalted(ctx, orderBookID, invertedOrderBookID)
+		if err != nil {
+			return err
+		}
+		if halted {
+			continue
+		}
+		// the trigger orders of the closed order book are canceled
+		closed, err := k.isOrderBookClosed(ctx, orderBookID, invertedOrderBookID)
+		if err != nil {
+			return err
+		}
+		if closed {
+			continue
+		}
+		// the trigger orders of the batch auction order book are activated once the batch auction is disabled
+		batchAuction, err := k.isOrderBookInBatchAuction(ctx, orderBookID, invertedOrderBookID)
+		if err != nil {
+			return err
+		}
+		if batchAuction {
+			continue
+		}
+
+		activated, err := k.activateOrderBookTriggerOrders(ctx, params, blockGasMeter, orderBookID, activationsLeft)
+		if err != nil {
+			return err
+		}
+		activationsLeft -= activated
+	}
+
+	return nil
+}
+
+func (k Keeper) placeTriggerOrder(
+	ctx sdk.Context,
+	params types.Params,
+	accNumber uint64,
+	orderBookID uint32,
+	order types.Order,
+	releasedLimits orderLimits,
+) error {
+	k.logger(ctx).Debug("Placing trigger order.", "order", order.String())
+
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+	}
+
+	orderSequence, err := k.genNextOrderSequence(ctx)
+	if err != nil {
+		return err
+	}
+	order.Sequence = orderSequence
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderPlaced{
+		Creator:  order.Creator,
+		ID:       order.ID,
+		Sequence: order.Sequence,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderPlaced: %s", err)
+	}
+
+	if err := k.incrementAccountDenomsOrdersCounter(
+		ctx,
+		accNumber,
+		params.MaxOrdersPerDenom,
+		order.Denoms(),
+	); err != nil {
+		return err
+	}
+
+	lockedCoin, expectedToReceiveCoin, err := computeTriggerOrderLimits(order)
+	if err != nil {
+		return err
+	}
+	order.RemainingBaseQuantity = order.Quantity
+	order.RemainingSpendableBalance = lockedCoin.Amount
+	if params.OrderReserve.IsPositive() {
+		order.Reserve = params.OrderReserve
+	}
+
+	if err := k.saveTriggerOrder(ctx, accNumber, orderBookID, order); err != nil {
+		return err
+	}
+
+	return k.lockOffBookOrderLimits(ctx, creator, order, lockedCoin, expectedToReceiveCoin, releasedLimits)
+}
+
+// lockOffBookOrderLimits locks the balances of the order kept out of the order book, and releases the limits of the
+// replaced order to it.
+func (k Keeper) lockOffBookOrderLimits(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	order types.Order,
+	lockedCoin, expectedToReceiveCoin sdk.Coin,
+	releasedLimits orderLimits,
+) error {
+	actions := assetfttypes.NewDEXActions(newDEXOrder(creator, order))
+	actions.AddCreatorExpectedToSpend(lockedCoin)
+	actions.AddCreatorExpectedToReceive(expectedToReceiveCoin)
+	if lockedCoin.IsPositive() {
+		actions.AddIncreaseLocked(creator, lockedCoin)
+	}
+	if expectedToReceiveCoin.IsPositive() {
+		actions.AddIncreaseExpectedToReceive(creator, expectedToReceiveCoin)
+	}
+	if order.Reserve.IsPositive() {
+		actions.AddIncreaseLocked(creator, order.Reserve)
+	}
+	actions.ReleaseCreatorLimits(releasedLimits.LockedCoins, releasedLimits.ExpectedToReceiveCoin)
+
+	// the call to smart contract is the last call here to avoid reentrancy vulnerability.
+	return k.assetFTKeeper.DEXExecuteActions(ctx, actions)
+}
+
+// activateOrderBookTriggerOrders activates the triggered orders of the order book and its inverted order book, and
+// returns the number of the processed orders. The activation stops once the block gas budget can't cover the next
+// activation.
+func (k Keeper) activateOrderBookTriggerOrders(
+	ctx sdk.Context,
+	params types.Params,
+	blockGasMeter storetypes.GasMeter,
+	orderBookID uint32,
+	limit int,
+) (int, error) {
+	lastPrice, found, err := k.getOrderBookLastPrice(ctx, orderBookID)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, nil
+	}
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return 0, err
+	}
+
+	activated := 0
+	for _, item := range []struct {
+		orderBookID uint32
+		lastPrice   *big.Rat
+	}{
+		{orderBookID: orderBookID, lastPrice: lastPrice},
+		{orderBookID: invertedOrderBookID, lastPrice: cbig.RatInv(lastPrice)},
+	} {
+		for _, direction := range []types.TriggerDirection{types.TriggerDirectionUp, types.TriggerDirectionDown} {
+			orderSequences, err := k.getTriggeredOrderSequences(
+				ctx, item.orderBookID, direction, item.lastPrice, limit-activated,
+			)
+			if err != nil {
+				return 0, err
+			}
+			for _, orderSequence := range orderSequences {
+				if err := k.activateTriggerOrder(ctx, params, blockGasMeter, orderSequence); err != nil {
+					return 0, err
+				}
+				activated++
+				if blockGasMeter.GasRemaining() < triggerOrderActivationGasLimit {
+					// mark the order book to continue in the next block
+					return activated, k.savePendingTriggerOrderBook(ctx, orderBookID)
+				}
+				// the activated order might trip the circuit breaker, then the rest is activated after the resume
+				halted, err := k.isOrderBookHalted(ctx, orderBookID, invertedOrderBookID)
+				if err != nil {
+					return 0, err
+				}
+				if halted {
+					return activated, nil
+				}
+			}
+			if activated == limit {
+				// mark the order book to continue in the next block
+				return activated, k.savePendingTriggerOrderBook(ctx, orderBookID)
+			}
+		}
+	}
+
+	return activated, nil
+}
+
+func (k Keeper) getTriggeredOrderSequences(
+	ctx sdk.Context,
+	orderBookID uint32,
+	direction types.TriggerDirection,
+	lastPrice *big.Rat,
+	limit int,
+) ([]uint64, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	store := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.CreateTriggerOrderBookDirectionKey(orderBookID, direction),
+	)
+
+	// the up triggers are activated starting from the lowest price, and the down triggers from the highest
+	var iterator storetypes.Iterator
+	if direction == types.TriggerDirectionUp {
+		iterator = store.Iterator(nil, nil)
+	} else {
+		iterator = store.ReverseIterator(nil, nil)
+	}
+	defer iterator.Close()
+
+	orderSequences := make([]uint64, 0)
+	for ; iterator.Valid() && len(orderSequences) < limit; iterator.Next() {
+		price, orderSequence, err := types.DecodeOrderBookSideRecordKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		cmp := price.Rat().Cmp(lastPrice)
+		if (direction == types.TriggerDirectionUp && cmp > 0) ||
+			(direction == types.TriggerDirectionDown && cmp < 0) {
+			break
+		}
+		orderSequences = append(orderSequences, orderSequence)
+	}
+
+	return orderSequences, nil
+}
+
+// activateTriggerOrder executes the triggered order, or cancels it if the execution fails or exceeds the activation
+// gas limit. If the cancellation fails as well, the order is kept with its locked balances and moved out of the
+// trigger index to retry it after the backoff. The gas consumed by the execution is charged to the block gas meter.
+func (k Keeper) activateTriggerOrder(
+	ctx sdk.Context,
+	params types.Params,
+	blockGasMeter storetypes.GasMeter,
+	orderSequence uint64,
+) error {
+	order, err := k.getTriggerOrder(ctx, orderSequence)
+	if err != nil {
+		return err
+	}
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+	}
+
+	// the order is executed in the cache context to cancel it if the execution fails
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasConsumed, err := runWithGasLimit(cacheCtx, triggerOrderActivationGasLimit, func(ctx sdk.Context) error {
+		return k.executeTriggerOrder(ctx, params, creator, order)
+	})
+	blockGasMeter.ConsumeGas(min(gasConsumed, blockGasMeter.GasRemaining()), "trigger order activation")
+	if err != nil {
+		k.logger(ctx).Info("Failed to execute trigger order, cancelling.", "order", order.String(), "err", err)
+		return k.cancelTriggerOrderOrDelayRetry(ctx, creator, order)
+	}
+	writeCache()
+
+	return nil
+}
+
+// runWithGasLimit runs the function with the gas meter limited to the provided gas, and returns the consumed gas. The
+// out of gas panic is returned as the error.
+func runWithGasLimit(
+	ctx sdk.Context,
+	gasLimit storetypes.Gas,
+	fn func(ctx sdk.Context) error,
+) (gasConsumed storetypes.Gas, err error) {
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	defer func() {
+		gasConsumed = gasMeter.GasConsumedToLimit()
+		if recoveryObj := recover(); recoveryObj != nil {
+			outOfGas, ok := recoveryObj.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(recoveryObj)
+			}
+			err = sdkerrors.Wrapf(cosmoserrors.ErrOutOfGas, "out of gas in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	return 0, fn(ctx.WithGasMeter(gasMeter))
+}
+
+// cancelTriggerOrderOrDelayRetry cancels the trigger order, and if the cancellation fails, keeps the order with its
+// locked balances and moves it out of the trigger index to retry it after the backoff.
+func (k Keeper) cancelTriggerOrderOrDelayRetry(ctx sdk.Context, creator sdk.AccAddress, order types.Order) error {
+	// the cancellation is executed in the cache context to retry the order instead of halting the chain
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.cancelTriggerOrder(cacheCtx, creator, order); err != nil {
+		k.logger(ctx).Error(
+			"Failed to cancel trigger order, retrying after the backoff.", "order", order.String(), "err", err,
+		)
+		return k.delayTriggerOrderRetry(ctx, order)
+	}
+	writeCache()
+
+	return nil
+}
+
+// delayTriggerOrderRetry moves the trigger order out of the trigger index and schedules its return, the retry delay
+// is doubled with every failed attempt.
+func (k Keeper) delayTriggerOrderRetry(ctx sdk.Context, order types.Order) error {
+	key, err := k.createTriggerOrderBookRecordKey(ctx, order)
+	if err != nil {
+		return err
+	}
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	if err := moduleStore.Delete(key); err != nil {
+		return err
+	}
+
+	attemptsKey := types.CreateTriggerOrderRetryAttemptsKey(order.Sequence)
+	var attempts gogotypes.UInt32Value
+	if err := k.getDataFromStore(ctx, attemptsKey, &attempts); err != nil {
+		if !sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return err
+		}
+	}
+	attempts.Value++
+	if err := k.setUint32Value(ctx, attemptsKey, attempts.Value); err != nil {
+		return err
+	}
+
+	height := uint64(ctx.BlockHeight()) + 1<<min(attempts.Value-1, maxTriggerOrderRetryBackoffExponent)
+	return moduleStore.Set(types.CreateTriggerOrderRetryKey(height, order.Sequence), types.StoreTrue)
+}
+
+// returnTriggerOrderRetries returns the failed trigger orders with the reached retry height to the trigger index, and
+// marks their order books as pending to activate them. The orders of the closed order books are canceled instead, and
+// the orders of the halted order books are kept in the trigger index until the order book is resumed.
+func (k Keeper) returnTriggerOrderRetries(ctx sdk.Context) error {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	orderSequences, err := func() ([]uint64, error) {
+		iterator := prefix.NewStore(
+			runtime.KVStoreAdapter(moduleStore), types.TriggerOrderRetryKeyPrefix,
+		).Iterator(nil, nil)
+		defer iterator.Close()
+
+		orderSequences := make([]uint64, 0)
+		for ; iterator.Valid() && len(orderSequences) < maxTriggerOrderRetriesPerBlock; iterator.Next() {
+			height, orderSequence, err := types.DecodeTriggerOrderRetryKey(iterator.Key())
+			if err != nil {
+				return nil, err
+			}
+			if height > uint64(ctx.BlockHeight()) {
+				break
+			}
+			if err := moduleStore.Delete(types.CreateTriggerOrderRetryKey(height, orderSequence)); err != nil {
+				return nil, err
+			}
+			orderSequences = append(orderSequences, orderSequence)
+		}
+
+		return orderSequences, nil
+	}()
+	if err != nil {
+		return err
+	}
+
+	for _, orderSequence := range orderSequences {
+		order, found, err := k.findTriggerOrder(ctx, orderSequence)
+		if err != nil {
+			return err
+		}
+		// the order is canceled before the retry
+		if !found {
+			continue
+		}
+		orderBookID, err := k.getOrderBookIDByDenoms(ctx, order.BaseDenom, order.QuoteDenom)
+		if err != nil {
+			return err
+		}
+		invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+		if err != nil {
+			return err
+		}
+
+		closed, err := k.isOrderBookClosed(ctx, orderBookID, invertedOrderBookID)
+		if err != nil {
+			return err
+		}
+		if closed {
+			creator, err := sdk.AccAddressFromBech32(order.Creator)
+			if err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidInput, "invalid address: %s", order.Creator)
+			}
+			if err := k.cancelTriggerOrderOrDelayRetry(ctx, creator, order); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := k.saveTriggerOrderBookRecord(ctx, orderBookID, order); err != nil {
+			return err
+		}
+		// the order book is marked as pending when it's resumed
+		halted, err := k.isOrderBookHalted(ctx, orderBookID, invertedOrderBookID)
+		if err != nil {
+			return err
+		}
+		if halted {
+			continue
+		}
+		if err := k.savePendingTriggerOrderBook(ctx, orderBookID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) executeTriggerOrder(
+	ctx sdk.Context,
+	params types.Params,
+	creator sdk.AccAddress,
+	order types.Order,
+) error {
+	k.logger(ctx).Debug("Executing trigger order.", "order", order.String())
+
+	accNumber, err := k.getAccountNumber(ctx, creator)
+	if err != nil {
+		return err
+	}
+
+	if err := k.removeTriggerOrder(ctx, accNumber, order); err != nil {
+		return err
+	}
+
+	// the limits of the trigger order are released to the activated order, so the balances aren't checked and the
+	// velocity allowance isn't consumed twice
+	releasedLimits, err := computeTriggerOrderLimitsWithReserve(order)
+	if err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderTriggered{
+		Creator:  order.Creator,
+		ID:       order.ID,
+		Sequence: order.Sequence,
+	}); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrIO, "failed to emit event EventOrderTriggered: %s", err)
+	}
+
+	// convert the order to the regular order, the new sequence is generated for it by the matching
+	order.Trigger = nil
+	order.Sequence = 0
+	order.RemainingBaseQuantity = sdkmath.Int{}
+	order.RemainingSpendableBalance = sdkmath.Int{}
+	order.Reserve = sdk.Coin{}
+
+	if err := k.validateOrder(ctx, params, order); err != nil {
+		return err
+	}
+
+	orderBookID, invertedOrderBookID, err := k.getOrGenOrderBookIDs(ctx, order.BaseDenom, order.QuoteDenom)
+	if err != nil {
+		return err
+	}
+
+	return k.matchOrder(
+		ctx,
+		params,
+		newCachedAccountKeeper(k.accountKeeper, k.accountQueryServer),
+		accNumber,
+		orderBookID,
+		invertedOrderBookID,
+		order,
+		releasedLimits,
+	)
+}
+
+func (k Keeper) cancelTriggerOrder(ctx sdk.Context, creator sdk.AccAddress, order types.Order) error {
+	limits, err := k.closeOffBookOrder(ctx, creator, order)
+	if err != nil {
+		return err
+	}
+
+	return k.decreaseOrderLimits(ctx, creator, limits)
+}
+
+func (k Keeper) saveTriggerOrder(ctx sdk.Context, accNumber uint64, orderBookID uint32, order types.Order) error {
+	if err := k.saveTriggerOrderBookRecord(ctx, orderBookID, order); err != nil {
+		return err
+	}
+
+	if err := k.setDataToStore(ctx, types.CreateTriggerOrderKey(order.Sequence), &order); err != nil {
+		return err
+	}
+
+	return k.saveOffBookOrderIndexes(ctx, accNumber, order)
+}
+
+func (k Keeper) removeTriggerOrder(ctx sdk.Context, accNumber uint64, order types.Order) error {
+	key, err := k.createTriggerOrderBookRecordKey(ctx, order)
+	if err != nil {
+		return err
+	}
+
+	// the retry record of the order is skipped at its height once the order is removed
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	if err := moduleStore.Delete(key); err != nil {
+		return err
+	}
+	if err := moduleStore.Delete(types.CreateTriggerOrderKey(order.Sequence)); err != nil {
+		return err
+	}
+	if err := moduleStore.Delete(types.CreateTriggerOrderRetryAttemptsKey(order.Sequence)); err != nil {
+		return err
+	}
+
+	return k.removeOffBookOrderIndexes(ctx, accNumber, order)
+}
+
+func (k Keeper) saveTriggerOrderBookRecord(ctx sdk.Context, orderBookID uint32, order types.Order) error {
+	direction, err := order.TriggerDirection()
+	if err != nil {
+		return err
+	}
+	key, err := types.CreateTriggerOrderBookRecordKey(orderBookID, direction, order.Trigger.Price, order.Sequence)
+	if err != nil {
+		return err
+	}
+
+	return k.storeService.OpenKVStore(ctx).Set(key, types.StoreTrue)
+}
+
+func (k Keeper) createTriggerOrderBookRecordKey(ctx sdk.Context, order types.Order) ([]byte, error) {
+	orderBookID, err := k.getOrderBookIDByDenoms(ctx, order.BaseDenom, order.QuoteDenom)
+	if err != nil {
+		return nil, err
+	}
+	direction, err := order.TriggerDirection()
+	if err != nil {
+		return nil, err
+	}
+
+	return types.CreateTriggerOrderBookRecordKey(orderBookID, direction, order.Trigger.Price, order.Sequence)
+}
+
+func (k Keeper) getTriggerOrder(ctx sdk.Context, orderSequence uint64) (types.Order, error) {
+	var val types.Order
+	if err := k.getDataFromStore(ctx, types.CreateTriggerOrderKey(orderSequence), &val); err != nil {
+		return types.Order{}, sdkerrors.Wrapf(err, "failed to get trigger order, orderSequence: %d", orderSequence)
+	}
+	return val, nil
+}
+
+// findTriggerOrder returns the trigger order by sequence and false if the order is not a trigger order.
+func (k Keeper) findTriggerOrder(ctx sdk.Context, orderSequence uint64) (types.Order, bool, error) {
+	order, err := k.getTriggerOrder(ctx, orderSequence)
+	if err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.Order{}, false, nil
+		}
+		return types.Order{}, false, err
+	}
+
+	return order, true, nil
+}
+
+func (k Keeper) setOrderBookLastPrice(ctx sdk.Context, orderBookID uint32, price types.Price) error {
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return err
+	}
+
+	// the last price is kept for one of the order books in pair only, since the inverted price can't be represented
+	// as the Price precisely
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	if err := moduleStore.Delete(types.CreateOrderBookLastPriceKey(invertedOrderBookID)); err != nil {
+		return err
+	}
+	if err := k.setDataToStore(
+		ctx, types.CreateOrderBookLastPriceKey(orderBookID), &gogotypes.StringValue{Value: price.String()},
+	); err != nil {
+		return err
+	}
+
+	return k.savePendingTriggerOrderBook(ctx, orderBookID)
+}
+
+// GetOrderBookLastPrices returns the paginated order books last trade prices.
+func (k Keeper) GetOrderBookLastPrices(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.OrderBookLastPriceWithID, *query.PageResponse, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	lastPrices, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		prefix.NewStore(runtime.KVStoreAdapter(moduleStore), types.OrderBookLastPriceKeyPrefix),
+		pagination,
+		// builder
+		func(key []byte, record *gogotypes.StringValue) (*types.OrderBookLastPriceWithID, error) {
+			orderBookID, err := types.DecodeOrderBookLastPriceKey(key)
+			if err != nil {
+				return nil, err
+			}
+			price, err := types.NewPriceFromString(record.Value)
+			if err != nil {
+				return nil, sdkerrors.Wrapf(types.ErrInvalidState, "invalid last price: %s", err)
+			}
+
+			return &types.OrderBookLastPriceWithID{
+				OrderBookID: orderBookID,
+				Price:       price,
+			}, nil
+		},
+		// constructor
+		func() *gogotypes.StringValue {
+			return &gogotypes.StringValue{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return lo.Map(lastPrices, func(data *types.OrderBookLastPriceWithID, _ int) types.OrderBookLastPriceWithID {
+		return *data
+	}), pageRes, nil
+}
+
+// ImportOrderBookLastPrice saves the order book last trade price, the order book is marked to evaluate its trigger
+// orders in the next block.
+func (k Keeper) ImportOrderBookLastPrice(ctx sdk.Context, orderBookID uint32, price types.Price) error {
+	return k.setOrderBookLastPrice(ctx, orderBookID, price)
+}
+
+// getOrderBookLastPrice returns the order book last price and false if there were no trades in the order book.
+func (k Keeper) getOrderBookLastPrice(ctx sdk.Context, orderBookID uint32) (*big.Rat, bool, error) {
+	price, found, err := k.findOrderBookLastPrice(ctx, orderBookID)
+	if err != nil {
+		return nil, false, err
+	}
+	if found {
+		return price.Rat(), true, nil
+	}
+
+	invertedOrderBookID, err := k.getInvertedOrderBookID(ctx, orderBookID)
+	if err != nil {
+		return nil, false, err
+	}
+	price, found, err = k.findOrderBookLastPrice(ctx, invertedOrderBookID)
+	if err != nil {
+		return nil, false, err
+	}
+	if found {
+		return cbig.RatInv(price.Rat()), true, nil
+	}
+
+	return nil, false, nil
+}
+
+func (k Keeper) findOrderBookLastPrice(ctx sdk.Context, orderBookID uint32) (types.Price, bool, error) {
+	var val gogotypes.StringValue
+	if err := k.getDataFromStore(ctx, types.CreateOrderBookLastPriceKey(orderBookID), &val); err != nil {
+		if sdkerrors.IsOf(err, types.ErrRecordNotFound) {
+			return types.Price{}, false, nil
+		}
+		return types.Price{}, false, err
+	}
+
+	price, err := types.NewPriceFromString(val.Value)
+	if err != nil {
+		return types.Price{}, false, sdkerrors.Wrapf(types.ErrInvalidState, "invalid last price: %s", err)
+	}
+
+	return price, true, nil
+}
+
+func (k Keeper) getInvertedOrderBookID(ctx sdk.Context, orderBookID uint32) (uint32, error) {
+	orderBookData, err := k.getOrderBookData(ctx, orderBookID)
+	if err != nil {
+		return 0, err
+	}
+
+	return k.getOrderBookIDByDenoms(ctx, orderBookData.QuoteDenom, orderBookData.BaseDenom)
+}
+
+func (k Keeper) savePendingTriggerOrderBook(ctx sdk.Context, orderBookID uint32) error {
+	return k.storeService.OpenKVStore(ctx).Set(types.CreatePendingTriggerOrderBookKey(orderBookID), types.StoreTrue)
+}
+
+func (k Keeper) removePendingTriggerOrderBook(ctx sdk.Context, orderBookID uint32) error {
+	return k.storeService.OpenKVStore(ctx).Delete(types.CreatePendingTriggerOrderBookKey(orderBookID))
+}
+
+func (k Keeper) getFirstPendingTriggerOrderBook(ctx sdk.Context) (uint32, bool, error) {
+	moduleStore := k.storeService.OpenKVStore(ctx)
+	iterator := prefix.NewStore(
+		runtime.KVStoreAdapter(moduleStore), types.PendingTriggerOrderBookKeyPrefix,
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false, nil
+	}
+
+	orderBookID, err := types.DecodePendingTriggerOrderBookKey(iterator.Key())
+	if err != nil {
+		return 0, false, err
+	}
+
+	return orderBookID, true, nil
+}
+
+// computeTriggerOrderLimitsWithReserve returns the limits of the trigger order including its reserve.
+func computeTriggerOrderLimitsWithReserve(order types.Order) (orderLimits, error) {
+	lockedCoin, expectedToReceiveCoin, err := computeTriggerOrderLimits(order)
+	if err != nil {
+		return orderLimits{}, err
+	}
+
+	lockedCoins := sdk.NewCoins(lockedCoin)
+	// unlock the reserve if present
+	if order.Reserve.IsPositive() {
+		lockedCoins = lockedCoins.Add(order.Reserve)
+	}
+
+	return orderLimits{
+		LockedCoins:           lockedCoins,
+		ExpectedToReceiveCoin: expectedToReceiveCoin,
+	}, nil
+}
+
+// computeTriggerOrderLimits returns the coins locked and expected to receive by the trigger order.
+func computeTriggerOrderLimits(order types.Order) (sdk.Coin, sdk.Coin, error) {
+	switch order.Type {
+	case types.ORDER_TYPE_LIMIT:
+		lockedCoin, err := order.ComputeLimitOrderLockedBalance()
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		expectedToReceiveCoin, err := types.ComputeLimitOrderExpectedToReceiveBalance(
+			order.Side, order.BaseDenom, order.QuoteDenom, order.Quantity, *order.Price,
+		)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		return lockedCoin, expectedToReceiveCoin, nil
+	case types.ORDER_TYPE_MARKET:
+		// the market buy trigger order is rejected by the validation, so the sell quantity is locked, and nothing is
+		// expected to receive
+		if order.Side != types.SIDE_SELL {
+			return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(
+				types.ErrInvalidInput, "unexpected market trigger order side: %s", order.Side.String(),
+			)
+		}
+		return sdk.NewCoin(order.GetSpendDenom(), order.Quantity),
+			sdk.NewCoin(order.GetReceiveDenom(), sdkmath.ZeroInt()),
+			nil
+	default:
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(
+			types.ErrInvalidInput, "unexpected order type: %s", order.Type.String(),
+		)
+	}
+}
@@ -0,0 +1,567 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestKeeper_PlaceAndCancelTriggerOrder(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+
+	acc := testSet.acc1
+	order := types.Order{
+		Creator:     acc.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "id1",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("8e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+		Trigger: &types.Trigger{
+			Type:  types.TRIGGER_TYPE_STOP_LOSS,
+			Price: types.MustNewPriceFromString("9e-1"),
+		},
+	}
+	lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, acc, sdk.NewCoins(lockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, acc)
+
+	// try to place the order with invalid trigger price tick
+	orderWithInvalidTriggerPrice := order
+	orderWithInvalidTriggerPrice.Trigger = &types.Trigger{
+		Type:  types.TRIGGER_TYPE_STOP_LOSS,
+		Price: types.MustNewPriceFromString("9000000001e-10"),
+	}
+	require.ErrorIs(
+		t,
+		dexKeeper.PlaceOrder(simapp.CopyContextWithMultiStore(sdkCtx), orderWithInvalidTriggerPrice),
+		types.ErrInvalidInput,
+	)
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	events := readOrderEvents(t, sdkCtx)
+	expectedSequence := uint64(1)
+	require.Equal(t, types.EventOrderPlaced{
+		Creator:  order.Creator,
+		ID:       order.ID,
+		Sequence: expectedSequence,
+	}, events.OrderPlaced)
+	// the order isn't created in the order book
+	require.Nil(t, events.OrderCreated)
+
+	dexLockedBalance := assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, lockedBalance.Denom)
+	require.Equal(t, lockedBalance.String(), dexLockedBalance.String())
+	require.Equal(t, map[string]uint64{
+		testSet.denom1: 1,
+		testSet.denom2: 1,
+	}, getAccountDenomsOrdersCount(t, testApp, sdkCtx, acc))
+
+	storedOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, order.ID)
+	require.NoError(t, err)
+	require.Equal(t, order.Trigger, storedOrder.Trigger)
+	require.Equal(t, expectedSequence, storedOrder.Sequence)
+	require.Equal(t, order.Quantity.String(), storedOrder.RemainingBaseQuantity.String())
+	require.Equal(t, lockedBalance.Amount.String(), storedOrder.RemainingSpendableBalance.String())
+
+	orders, _, err := dexKeeper.GetOrders(sdkCtx, acc, nil)
+	require.NoError(t, err)
+	require.Equal(t, []types.Order{storedOrder}, orders)
+
+	// the order isn't in the order book
+	orderBookOrders, _, err := dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_SELL, nil,
+	)
+	require.NoError(t, err)
+	require.Empty(t, orderBookOrders)
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.CancelOrder(sdkCtx, acc, order.ID))
+	events = readOrderEvents(t, sdkCtx)
+	require.Equal(t, []types.EventOrderClosed{
+		{
+			Creator:                   order.Creator,
+			ID:                        order.ID,
+			Sequence:                  expectedSequence,
+			RemainingBaseQuantity:     order.Quantity,
+			RemainingSpendableBalance: lockedBalance.Amount,
+		},
+	}, events.OrdersClosed)
+
+	dexLockedBalance = assetFTKeeper.GetDEXLockedBalance(sdkCtx, acc, lockedBalance.Denom)
+	require.True(t, dexLockedBalance.IsZero())
+	dexExpectedToReceiveBalance := assetFTKeeper.GetDEXExpectedToReceivedBalance(sdkCtx, acc, testSet.denom2)
+	require.True(t, dexExpectedToReceiveBalance.IsZero())
+	require.Equal(t, map[string]uint64{
+		testSet.denom1: 0,
+		testSet.denom2: 0,
+	}, getAccountDenomsOrdersCount(t, testApp, sdkCtx, acc))
+
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, acc, order.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+}
+
+func TestKeeper_ActivateTriggerOrders(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+
+	stopLossOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "stop-loss",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("8e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+		Trigger: &types.Trigger{
+			Type:  types.TRIGGER_TYPE_STOP_LOSS,
+			Price: types.MustNewPriceFromString("9e-1"),
+		},
+	}
+	takeProfitOrder := stopLossOrder
+	takeProfitOrder.ID = "take-profit"
+	takeProfitOrder.Price = lo.ToPtr(types.MustNewPriceFromString("11e-1"))
+	takeProfitOrder.Trigger = &types.Trigger{
+		Type:  types.TRIGGER_TYPE_TAKE_PROFIT,
+		Price: types.MustNewPriceFromString("1"),
+	}
+	for _, order := range []types.Order{stopLossOrder, takeProfitOrder} {
+		lockedBalance, err := order.ComputeLimitOrderLockedBalance()
+		require.NoError(t, err)
+		testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(lockedBalance))
+		fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+		require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, order))
+	}
+
+	// no trades, nothing to activate
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	orderBookOrders, _, err := dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_SELL, nil,
+	)
+	require.NoError(t, err)
+	require.Empty(t, orderBookOrders)
+
+	// trade with the 9e-1 price
+	makerOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("9e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	makerLockedBalance, err := makerOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc2, sdk.NewCoins(makerLockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc2)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, makerOrder))
+
+	takerOrder := types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_MARKET,
+		ID:          "taker",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc3, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 900_000)))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, takerOrder))
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	events := readOrderEvents(t, sdkCtx)
+	require.NotNil(t, events.OrderCreated)
+	require.Equal(t, stopLossOrder.ID, events.OrderCreated.ID)
+
+	// the stop-loss order is activated and saved to the order book
+	orderBookOrders, _, err = dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_SELL, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, orderBookOrders, 1)
+	require.Equal(t, stopLossOrder.ID, orderBookOrders[0].ID)
+	require.Nil(t, orderBookOrders[0].Trigger)
+
+	// the take-profit order is still inactive
+	storedOrder, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, takeProfitOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, takeProfitOrder.Trigger, storedOrder.Trigger)
+
+	// nothing is left to activate
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	require.Nil(t, readOrderEvents(t, sdkCtx).OrderCreated)
+}
+
+func TestKeeper_ActivateTriggerOrders_RetryFailedCancellation(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	stopLossOrder, lockedBalance, creatorAccount := placeFailingTriggerOrder(t, sdkCtx, testApp, testSet)
+
+	// the order is kept with its locked balance and retried in the next block, and then after the doubled delay
+	for _, height := range []int64{100, 101} {
+		sdkCtx = sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+		events := readOrderEvents(t, sdkCtx)
+		require.Nil(t, events.OrderCreated)
+		require.Empty(t, events.OrdersClosed)
+		require.Equal(t, lockedBalance.String(),
+			testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, lockedBalance.Denom).String())
+	}
+
+	// the order isn't retried before the retry height even if the execution succeeds
+	testApp.AccountKeeper.SetAccount(sdkCtx, creatorAccount)
+	sdkCtx = sdkCtx.WithBlockHeight(102).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	require.Nil(t, readOrderEvents(t, sdkCtx).OrderCreated)
+
+	// the order is activated at the retry height
+	sdkCtx = sdkCtx.WithBlockHeight(103).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	events := readOrderEvents(t, sdkCtx)
+	require.NotNil(t, events.OrderCreated)
+	require.Equal(t, stopLossOrder.ID, events.OrderCreated.ID)
+	orderBookOrders, _, err := dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_SELL, nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, orderBookOrders, 1)
+	require.Equal(t, stopLossOrder.ID, orderBookOrders[0].ID)
+}
+
+func TestKeeper_ActivateTriggerOrders_RetryClosedOrderBook(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	stopLossOrder, lockedBalance, creatorAccount := placeFailingTriggerOrder(t, sdkCtx, testApp, testSet)
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	require.Nil(t, readOrderEvents(t, sdkCtx).OrderCreated)
+
+	// the order book is closed while the order waits for the retry
+	require.NoError(t, dexKeeper.CloseOrderBook(sdkCtx, govAddr.String(), testSet.denom1, testSet.denom2))
+	testApp.AccountKeeper.SetAccount(sdkCtx, creatorAccount)
+
+	// the returned order is canceled instead of being activated
+	sdkCtx = sdkCtx.WithBlockHeight(101).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	events := readOrderEvents(t, sdkCtx)
+	require.Nil(t, events.OrderCreated)
+	require.Len(t, events.OrdersClosed, 1)
+	require.Equal(t, stopLossOrder.ID, events.OrdersClosed[0].ID)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, lockedBalance.Denom).IsZero())
+	_, err := dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, stopLossOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+}
+
+func TestKeeper_ActivateTriggerOrders_RetryHaltedOrderBook(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	govAddr := authtypes.NewModuleAddress(govtypes.ModuleName)
+	stopLossOrder, lockedBalance, creatorAccount := placeFailingTriggerOrder(t, sdkCtx, testApp, testSet)
+
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	require.Nil(t, readOrderEvents(t, sdkCtx).OrderCreated)
+
+	// the order book is halted while the order waits for the retry
+	require.NoError(t, dexKeeper.HaltOrderBook(sdkCtx, govAddr, testSet.denom1, testSet.denom2))
+	testApp.AccountKeeper.SetAccount(sdkCtx, creatorAccount)
+
+	// the returned order is kept with its locked balance until the order book is resumed
+	for _, height := range []int64{101, 102} {
+		sdkCtx = sdkCtx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+		events := readOrderEvents(t, sdkCtx)
+		require.Nil(t, events.OrderCreated)
+		require.Empty(t, events.OrdersClosed)
+		require.Equal(t, lockedBalance.String(),
+			testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, lockedBalance.Denom).String())
+	}
+
+	// the order is activated after the resume
+	require.NoError(t, dexKeeper.ResumeOrderBook(sdkCtx, govAddr, testSet.denom1, testSet.denom2))
+	sdkCtx = sdkCtx.WithBlockHeight(103).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	events := readOrderEvents(t, sdkCtx)
+	require.NotNil(t, events.OrderCreated)
+	require.Equal(t, stopLossOrder.ID, events.OrderCreated.ID)
+}
+
+func TestKeeper_ActivateTriggerOrders_GasLimit(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MaxOrdersPerDenom = 1000
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	// the stop-loss order matching all the makers exceeds the activation gas limit
+	const makersCount = 200
+	stopLossOrder := newStopLossOrder(testSet, "stop-loss", defaultQuantityStep.MulRaw(makersCount))
+	placeFundedOrder(t, sdkCtx, testApp, stopLossOrder)
+	tradeWithTriggerPrice(t, sdkCtx, testApp, testSet)
+	placeTriggeredOrdersMakers(t, sdkCtx, testApp, testSet, makersCount)
+
+	// the order is canceled and the makers are kept
+	sdkCtx = sdkCtx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	events := readOrderEvents(t, sdkCtx)
+	require.Nil(t, events.OrderCreated)
+	require.Len(t, events.OrdersClosed, 1)
+	require.Equal(t, stopLossOrder.ID, events.OrdersClosed[0].ID)
+	require.True(t, testApp.AssetFTKeeper.GetDEXLockedBalance(sdkCtx, testSet.acc1, testSet.denom1).IsZero())
+	_, err = dexKeeper.GetOrderByAddressAndID(sdkCtx, testSet.acc1, stopLossOrder.ID)
+	require.ErrorIs(t, err, types.ErrRecordNotFound)
+
+	orderBookOrders, _, err := dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_BUY, &query.PageRequest{Limit: query.PaginationMaxLimit},
+	)
+	require.NoError(t, err)
+	require.Len(t, orderBookOrders, makersCount)
+}
+
+func TestKeeper_ActivateTriggerOrders_BlockGasLimit(t *testing.T) {
+	testApp := simapp.New()
+	sdkCtx := testApp.NewContextLegacy(false, tmproto.Header{
+		Height: 100,
+		Time:   time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	})
+	testSet := genTestSet(t, sdkCtx, testApp)
+
+	dexKeeper := testApp.DEXKeeper
+	params, err := dexKeeper.GetParams(sdkCtx)
+	require.NoError(t, err)
+	params.MaxOrdersPerDenom = 2000
+	require.NoError(t, dexKeeper.SetParams(sdkCtx, params))
+
+	// each stop-loss order fits the activation gas limit, but all of them exceed the block gas limit
+	const (
+		ordersCount         = 14
+		makersPerOrderCount = 120
+	)
+	for i := range ordersCount {
+		placeFundedOrder(t, sdkCtx, testApp, newStopLossOrder(
+			testSet, fmt.Sprintf("stop-loss-%d", i), defaultQuantityStep.MulRaw(makersPerOrderCount),
+		))
+	}
+	tradeWithTriggerPrice(t, sdkCtx, testApp, testSet)
+	placeTriggeredOrdersMakers(t, sdkCtx, testApp, testSet, ordersCount*makersPerOrderCount)
+
+	triggerOrdersCountFn := func() int {
+		orders, _, err := dexKeeper.GetOrders(sdkCtx, testSet.acc1, nil)
+		require.NoError(t, err)
+		return len(lo.Filter(orders, func(order types.Order, _ int) bool {
+			return order.Trigger != nil
+		}))
+	}
+	require.Equal(t, ordersCount, triggerOrdersCountFn())
+
+	// the orders left are activated in the next block
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	ordersLeft := triggerOrdersCountFn()
+	require.Positive(t, ordersLeft)
+	require.Less(t, ordersLeft, ordersCount)
+
+	sdkCtx = sdkCtx.WithBlockHeight(101)
+	require.NoError(t, dexKeeper.ActivateTriggerOrders(sdkCtx))
+	require.Zero(t, triggerOrdersCountFn())
+
+	// all the orders are executed against the makers
+	orderBookOrders, _, err := dexKeeper.GetOrderBookOrders(
+		sdkCtx, testSet.denom1, testSet.denom2, types.SIDE_BUY, nil,
+	)
+	require.NoError(t, err)
+	require.Empty(t, orderBookOrders)
+}
+
+func newStopLossOrder(testSet TestSet, id string, quantity sdkmath.Int) types.Order {
+	return types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          id,
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("8e-1")),
+		Quantity:    quantity,
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+		Trigger: &types.Trigger{
+			Type:  types.TRIGGER_TYPE_STOP_LOSS,
+			Price: types.MustNewPriceFromString("9e-1"),
+		},
+	}
+}
+
+// tradeWithTriggerPrice executes the trade with the 9e-1 price triggering the stop-loss orders.
+func tradeWithTriggerPrice(t *testing.T, sdkCtx sdk.Context, testApp *simapp.App, testSet TestSet) {
+	placeFundedOrder(t, sdkCtx, testApp, types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("9e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	})
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc3, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 900_000)))
+	require.NoError(t, testApp.DEXKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_MARKET,
+		ID:          "taker",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}))
+}
+
+// placeTriggeredOrdersMakers places the buy orders matched by the triggered stop-loss orders.
+func placeTriggeredOrdersMakers(t *testing.T, sdkCtx sdk.Context, testApp *simapp.App, testSet TestSet, count int) {
+	for i := range count {
+		placeFundedOrder(t, sdkCtx, testApp, types.Order{
+			Creator:     testSet.acc2.String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          fmt.Sprintf("buy-%d", i),
+			BaseDenom:   testSet.denom1,
+			QuoteDenom:  testSet.denom2,
+			Price:       lo.ToPtr(types.MustNewPriceFromString("85e-2")),
+			Quantity:    defaultQuantityStep,
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		})
+	}
+}
+
+// placeFailingTriggerOrder places the stop-loss order triggered by the trade, and removes its creator account, so both
+// the activation and the cancellation of the order fail.
+func placeFailingTriggerOrder(
+	t *testing.T,
+	sdkCtx sdk.Context,
+	testApp *simapp.App,
+	testSet TestSet,
+) (types.Order, sdk.Coin, sdk.AccountI) {
+	dexKeeper := testApp.DEXKeeper
+
+	stopLossOrder := types.Order{
+		Creator:     testSet.acc1.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "stop-loss",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("8e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+		Trigger: &types.Trigger{
+			Type:  types.TRIGGER_TYPE_STOP_LOSS,
+			Price: types.MustNewPriceFromString("9e-1"),
+		},
+	}
+	lockedBalance, err := stopLossOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc1, sdk.NewCoins(lockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc1)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, stopLossOrder))
+
+	// trade with the 9e-1 price
+	makerOrder := types.Order{
+		Creator:     testSet.acc2.String(),
+		Type:        types.ORDER_TYPE_LIMIT,
+		ID:          "maker",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Price:       lo.ToPtr(types.MustNewPriceFromString("9e-1")),
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_SELL,
+		TimeInForce: types.TIME_IN_FORCE_GTC,
+	}
+	makerLockedBalance, err := makerOrder.ComputeLimitOrderLockedBalance()
+	require.NoError(t, err)
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc2, sdk.NewCoins(makerLockedBalance))
+	fundOrderReserve(t, testApp, sdkCtx, testSet.acc2)
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, makerOrder))
+
+	testApp.MintAndSendCoin(t, sdkCtx, testSet.acc3, sdk.NewCoins(sdk.NewInt64Coin(testSet.denom2, 900_000)))
+	require.NoError(t, dexKeeper.PlaceOrder(sdkCtx, types.Order{
+		Creator:     testSet.acc3.String(),
+		Type:        types.ORDER_TYPE_MARKET,
+		ID:          "taker",
+		BaseDenom:   testSet.denom1,
+		QuoteDenom:  testSet.denom2,
+		Quantity:    sdkmath.NewInt(1_000_000),
+		Side:        types.SIDE_BUY,
+		TimeInForce: types.TIME_IN_FORCE_IOC,
+	}))
+
+	// both the execution and the cancellation fail without the creator account
+	creatorAccount := testApp.AccountKeeper.GetAccount(sdkCtx, testSet.acc1)
+	testApp.AccountKeeper.RemoveAccount(sdkCtx, creatorAccount)
+
+	return stopLossOrder, lockedBalance, creatorAccount
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v1 "github.com/CoreumFoundation/coreum/v6/x/dex/migrations/v1"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper        Keeper
+	accountKeeper types.AccountKeeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper, accountKeeper types.AccountKeeper) Migrator {
+	return Migrator{
+		keeper:        keeper,
+		accountKeeper: accountKeeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v1.MigrateParams(ctx, m.keeper, m.accountKeeper)
+}
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -15,9 +17,28 @@ var _ types.MsgServer = MsgServer{}
 // MsgKeeper defines subscope of keeper methods required by msg service.
 type MsgKeeper interface {
 	UpdateParams(ctx sdk.Context, authority string, params types.Params) error
+	UpdateOrderBookFeeRates(
+		ctx sdk.Context, authority, baseDenom, quoteDenom string, feeRates *types.OrderBookFeeRates,
+	) error
+	WithdrawFees(ctx sdk.Context, authority string, recipient sdk.AccAddress, amount sdk.Coins) error
 	PlaceOrder(ctx sdk.Context, order types.Order) error
 	CancelOrder(ctx sdk.Context, acc sdk.AccAddress, orderID string) error
+	ReplaceOrder(ctx sdk.Context, oldOrderID string, order types.Order) error
 	CancelOrdersByDenom(ctx sdk.Context, admin, acc sdk.AccAddress, denom string) error
+	PlaceOrders(ctx sdk.Context, orders []types.Order) ([]types.BatchOrderResult, error)
+	CancelOrders(ctx sdk.Context, acc sdk.AccAddress, orderIDs []string) ([]types.BatchOrderResult, error)
+	SetCancelAllAfter(ctx sdk.Context, acc sdk.AccAddress, timeout time.Duration, denoms []string) error
+	HaltOrderBook(ctx sdk.Context, sender sdk.AccAddress, baseDenom, quoteDenom string) error
+	ResumeOrderBook(ctx sdk.Context, sender sdk.AccAddress, baseDenom, quoteDenom string) error
+	CloseOrderBook(ctx sdk.Context, authority, baseDenom, quoteDenom string) error
+	ReopenOrderBook(ctx sdk.Context, authority, baseDenom, quoteDenom string) error
+	SetOrderBookBatchAuction(ctx sdk.Context, authority, baseDenom, quoteDenom string, enabled bool) error
+	SwapExactIn(
+		ctx sdk.Context, sender sdk.AccAddress, route []string, amountIn, minAmountOut sdkmath.Int,
+	) ([]types.SwapHop, error)
+	SwapExactOut(
+		ctx sdk.Context, sender sdk.AccAddress, route []string, amountOut, maxAmountIn sdkmath.Int,
+	) ([]types.SwapHop, error)
 }
 
 // MsgServer serves grpc tx requests for dex module.
@@ -41,6 +62,34 @@ func (ms MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 	return &types.EmptyResponse{}, nil
 }
 
+// UpdateOrderBookFeeRates is a governance operation that sets or removes the order book fee rates.
+func (ms MsgServer) UpdateOrderBookFeeRates(
+	goCtx context.Context,
+	req *types.MsgUpdateOrderBookFeeRates,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateOrderBookFeeRates(
+		sdk.UnwrapSDKContext(goCtx), req.Authority, req.BaseDenom, req.QuoteDenom, req.FeeRates,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// WithdrawFees is a governance operation that withdraws the trading fees collected by the DEX fee collector.
+func (ms MsgServer) WithdrawFees(goCtx context.Context, req *types.MsgWithdrawFees) (*types.EmptyResponse, error) {
+	recipient, err := sdk.AccAddressFromBech32(req.Recipient)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid recipient")
+	}
+
+	if err := ms.keeper.WithdrawFees(sdk.UnwrapSDKContext(goCtx), req.Authority, recipient, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // PlaceOrder places an order on orderbook.
 func (ms MsgServer) PlaceOrder(ctx context.Context, msg *types.MsgPlaceOrder) (*types.EmptyResponse, error) {
 	order, err := types.NewOrderFromMsgPlaceOrder(*msg)
@@ -64,6 +113,19 @@ func (ms MsgServer) CancelOrder(ctx context.Context, msg *types.MsgCancelOrder)
 	return &types.EmptyResponse{}, ms.keeper.CancelOrder(sdk.UnwrapSDKContext(ctx), sender, msg.ID)
 }
 
+// ReplaceOrder cancels the order and places the new one.
+func (ms MsgServer) ReplaceOrder(ctx context.Context, msg *types.MsgReplaceOrder) (*types.EmptyResponse, error) {
+	order, err := types.NewOrderFromMsgReplaceOrder(*msg)
+	if err != nil {
+		return nil, err
+	}
+	if err := ms.keeper.ReplaceOrder(sdk.UnwrapSDKContext(ctx), msg.OldID, order); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // CancelOrdersByDenom cancels all orders by denom and account.
 func (ms MsgServer) CancelOrdersByDenom(
 	ctx context.Context, msg *types.MsgCancelOrdersByDenom,
@@ -80,3 +142,146 @@ func (ms MsgServer) CancelOrdersByDenom(
 
 	return &types.EmptyResponse{}, ms.keeper.CancelOrdersByDenom(sdk.UnwrapSDKContext(ctx), sender, acc, msg.Denom)
 }
+
+// PlaceOrders places the batch of orders on orderbook.
+func (ms MsgServer) PlaceOrders(ctx context.Context, msg *types.MsgPlaceOrders) (*types.MsgPlaceOrdersResponse, error) {
+	results, err := ms.keeper.PlaceOrders(sdk.UnwrapSDKContext(ctx), types.NewOrdersFromMsgPlaceOrders(*msg))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPlaceOrdersResponse{Results: results}, nil
+}
+
+// CancelOrders cancels the batch of orders and unlock locked balances.
+func (ms MsgServer) CancelOrders(
+	ctx context.Context, msg *types.MsgCancelOrders,
+) (*types.MsgCancelOrdersResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	results, err := ms.keeper.CancelOrders(sdk.UnwrapSDKContext(ctx), sender, msg.IDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelOrdersResponse{Results: results}, nil
+}
+
+// SetCancelAllAfter schedules the cancellation of all sender orders after the timeout.
+func (ms MsgServer) SetCancelAllAfter(
+	ctx context.Context, msg *types.MsgSetCancelAllAfter,
+) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	return &types.EmptyResponse{}, ms.keeper.SetCancelAllAfter(
+		sdk.UnwrapSDKContext(ctx), sender, msg.Timeout, msg.Denoms,
+	)
+}
+
+// HaltOrderBook halts the trading in the order book.
+func (ms MsgServer) HaltOrderBook(ctx context.Context, msg *types.MsgHaltOrderBook) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	return &types.EmptyResponse{}, ms.keeper.HaltOrderBook(
+		sdk.UnwrapSDKContext(ctx), sender, msg.BaseDenom, msg.QuoteDenom,
+	)
+}
+
+// ResumeOrderBook resumes the trading in the halted order book.
+func (ms MsgServer) ResumeOrderBook(ctx context.Context, msg *types.MsgResumeOrderBook) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	return &types.EmptyResponse{}, ms.keeper.ResumeOrderBook(
+		sdk.UnwrapSDKContext(ctx), sender, msg.BaseDenom, msg.QuoteDenom,
+	)
+}
+
+// CloseOrderBook is a governance operation that closes the order book and cancels all its orders.
+func (ms MsgServer) CloseOrderBook(goCtx context.Context, req *types.MsgCloseOrderBook) (*types.EmptyResponse, error) {
+	if err := ms.keeper.CloseOrderBook(
+		sdk.UnwrapSDKContext(goCtx), req.Authority, req.BaseDenom, req.QuoteDenom,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ReopenOrderBook is a governance operation that reopens the closed order book.
+func (ms MsgServer) ReopenOrderBook(
+	goCtx context.Context,
+	req *types.MsgReopenOrderBook,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.ReopenOrderBook(
+		sdk.UnwrapSDKContext(goCtx), req.Authority, req.BaseDenom, req.QuoteDenom,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetOrderBookBatchAuction is a governance operation that enables or disables the batch auction mode of the order
+// book.
+func (ms MsgServer) SetOrderBookBatchAuction(
+	goCtx context.Context,
+	req *types.MsgSetOrderBookBatchAuction,
+) (*types.EmptyResponse, error) {
+	if err := ms.keeper.SetOrderBookBatchAuction(
+		sdk.UnwrapSDKContext(goCtx), req.Authority, req.BaseDenom, req.QuoteDenom, req.Enabled,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SwapExactIn swaps the exact input amount through the route order books.
+func (ms MsgServer) SwapExactIn(ctx context.Context, msg *types.MsgSwapExactIn) (*types.MsgSwapResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	hops, err := ms.keeper.SwapExactIn(sdk.UnwrapSDKContext(ctx), sender, msg.Route, msg.AmountIn, msg.MinAmountOut)
+	if err != nil {
+		return nil, err
+	}
+
+	spentCoin, receivedCoin := types.NewSwapCoins(hops)
+	return &types.MsgSwapResponse{
+		SpentCoin:    spentCoin,
+		ReceivedCoin: receivedCoin,
+	}, nil
+}
+
+// SwapExactOut swaps the minimal input amount required to receive the output amount through the route order books.
+func (ms MsgServer) SwapExactOut(ctx context.Context, msg *types.MsgSwapExactOut) (*types.MsgSwapResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender")
+	}
+
+	hops, err := ms.keeper.SwapExactOut(sdk.UnwrapSDKContext(ctx), sender, msg.Route, msg.AmountOut, msg.MaxAmountIn)
+	if err != nil {
+		return nil, err
+	}
+
+	spentCoin, receivedCoin := types.NewSwapCoins(hops)
+	return &types.MsgSwapResponse{
+		SpentCoin:    spentCoin,
+		ReceivedCoin: receivedCoin,
+	}, nil
+}
@@ -8,4 +8,6 @@ import (
 var (
 	//go:embed dex-reentrancy-poc/artifacts/dex_reentrancy_poc.wasm
 	DexReentrancyPocWasm []byte
+	//go:embed dex/artifacts/dex.wasm
+	DexWasm []byte
 )
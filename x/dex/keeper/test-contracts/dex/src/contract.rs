@@ -0,0 +1,86 @@
+use crate::error::ContractError;
+use crate::msg::{
+    CoreumMsg, CoreumQueries, DEXMsg, DEXQuery, ExecuteMsg, InstantiateMsg, QueryMsg,
+};
+use cosmwasm_std::{
+    entry_point, to_json_vec, Binary, ContractResult, Deps, DepsMut, Env, MessageInfo,
+    QueryRequest, Response, StdError, StdResult, SystemResult,
+};
+use cw2::set_contract_version;
+
+// version info for migration info
+const CONTRACT_NAME: &str = env!("CARGO_PKG_NAME");
+const CONTRACT_VERSION: &str = env!("CARGO_PKG_VERSION");
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn instantiate(
+    deps: DepsMut<CoreumQueries>,
+    _env: Env,
+    info: MessageInfo,
+    _msg: InstantiateMsg,
+) -> Result<Response<CoreumMsg>, ContractError> {
+    set_contract_version(deps.storage, CONTRACT_NAME, CONTRACT_VERSION)?;
+
+    Ok(Response::new()
+        .add_attribute("method", "instantiate")
+        .add_attribute("owner", info.sender))
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn execute(
+    _deps: DepsMut<CoreumQueries>,
+    _env: Env,
+    _info: MessageInfo,
+    msg: ExecuteMsg,
+) -> Result<Response<CoreumMsg>, ContractError> {
+    let (method, dex_msg) = match msg {
+        ExecuteMsg::PlaceOrder { order } => ("place_order", DEXMsg::PlaceOrder(order)),
+        ExecuteMsg::CancelOrder { id } => ("cancel_order", DEXMsg::CancelOrder { id }),
+        ExecuteMsg::CancelOrdersByDenom { account, denom } => (
+            "cancel_orders_by_denom",
+            DEXMsg::CancelOrdersByDenom { account, denom },
+        ),
+    };
+
+    Ok(Response::new()
+        .add_attribute("method", method)
+        .add_message(CoreumMsg::DEX(dex_msg)))
+}
+
+#[cfg_attr(not(feature = "library"), entry_point)]
+pub fn query(deps: Deps<CoreumQueries>, _env: Env, msg: QueryMsg) -> StdResult<Binary> {
+    let dex_query = match msg {
+        QueryMsg::Params {} => DEXQuery::Params {},
+        QueryMsg::Order { creator, id } => DEXQuery::Order { creator, id },
+        QueryMsg::Orders { creator } => DEXQuery::Orders { creator },
+        QueryMsg::OrderBooks {} => DEXQuery::OrderBooks {},
+        QueryMsg::OrderBookOrders {
+            base_denom,
+            quote_denom,
+            side,
+        } => DEXQuery::OrderBookOrders {
+            base_denom,
+            quote_denom,
+            side,
+        },
+        QueryMsg::AccountDenomOrdersCount { account, denom } => {
+            DEXQuery::AccountDenomOrdersCount { account, denom }
+        }
+    };
+
+    query_dex(deps, dex_query)
+}
+
+// the response of the chain is returned as is, so the caller receives the JSON of the dex query response
+fn query_dex(deps: Deps<CoreumQueries>, dex_query: DEXQuery) -> StdResult<Binary> {
+    let request = to_json_vec(&QueryRequest::Custom(CoreumQueries::DEX(dex_query)))?;
+    match deps.querier.raw_query(&request) {
+        SystemResult::Err(err) => Err(StdError::generic_err(format!(
+            "querier system error: {err}"
+        ))),
+        SystemResult::Ok(ContractResult::Err(err)) => Err(StdError::generic_err(format!(
+            "querier contract error: {err}"
+        ))),
+        SystemResult::Ok(ContractResult::Ok(res)) => Ok(res),
+    }
+}
@@ -0,0 +1,3 @@
+pub mod contract;
+pub mod error;
+pub mod msg;
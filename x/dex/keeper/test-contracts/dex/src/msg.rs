@@ -0,0 +1,106 @@
+use cosmwasm_schema::cw_serde;
+use cosmwasm_std::{CustomMsg, CustomQuery};
+use schemars::JsonSchema;
+use serde::{Deserialize, Serialize};
+
+#[cw_serde]
+pub struct InstantiateMsg {}
+
+#[cw_serde]
+pub enum ExecuteMsg {
+    PlaceOrder { order: PlaceOrder },
+    CancelOrder { id: String },
+    CancelOrdersByDenom { account: String, denom: String },
+}
+
+#[cw_serde]
+pub enum QueryMsg {
+    Params {},
+    Order {
+        creator: String,
+        id: String,
+    },
+    Orders {
+        creator: String,
+    },
+    OrderBooks {},
+    OrderBookOrders {
+        base_denom: String,
+        quote_denom: String,
+        side: i32,
+    },
+    AccountDenomOrdersCount {
+        account: String,
+        denom: String,
+    },
+}
+
+// The sender is set by the chain, so it isn't the part of the message.
+#[cw_serde]
+pub struct PlaceOrder {
+    #[serde(rename = "type")]
+    pub order_type: i32,
+    pub id: String,
+    pub base_denom: String,
+    pub quote_denom: String,
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub price: Option<String>,
+    pub quantity: String,
+    pub side: i32,
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub good_til: Option<GoodTil>,
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub time_in_force: Option<i32>,
+}
+
+#[cw_serde]
+pub struct GoodTil {
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub good_til_block_height: Option<u64>,
+    #[serde(skip_serializing_if = "Option::is_none")]
+    pub good_til_block_time: Option<String>,
+}
+
+// the custom messages and queries keep the names of the chain types, so they aren't renamed to snake case
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub enum CoreumMsg {
+    DEX(DEXMsg),
+}
+
+impl CustomMsg for CoreumMsg {}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub enum DEXMsg {
+    PlaceOrder(PlaceOrder),
+    CancelOrder { id: String },
+    CancelOrdersByDenom { account: String, denom: String },
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub enum CoreumQueries {
+    DEX(DEXQuery),
+}
+
+impl CustomQuery for CoreumQueries {}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq, JsonSchema)]
+pub enum DEXQuery {
+    Params {},
+    Order {
+        creator: String,
+        id: String,
+    },
+    Orders {
+        creator: String,
+    },
+    OrderBooks {},
+    OrderBookOrders {
+        base_denom: String,
+        quote_denom: String,
+        side: i32,
+    },
+    AccountDenomOrdersCount {
+        account: String,
+        denom: String,
+    },
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// AccountKeeper specifies methods of the account keeper required by the migration.
+type AccountKeeper interface {
+	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
+}
+
+// Keeper specifies methods of the keeper required by the migration.
+type Keeper interface {
+	GetParams(ctx sdk.Context) (types.Params, error)
+	SetParams(ctx sdk.Context, params types.Params) error
+}
+
+// MigrateParams sets the zero default trading fee rates, the default circuit breaker and batch auction params and
+// creates the fee collector module account.
+func MigrateParams(ctx sdk.Context, keeper Keeper, accountKeeper AccountKeeper) error {
+	params, err := keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MakerFeeRate.IsNil() {
+		params.MakerFeeRate = sdkmath.LegacyZeroDec()
+	}
+	if params.TakerFeeRate.IsNil() {
+		params.TakerFeeRate = sdkmath.LegacyZeroDec()
+	}
+	if params.CircuitBreakerPriceChangeRate.IsNil() {
+		defaultParams := types.DefaultParams()
+		params.CircuitBreakerPriceChangeRate = defaultParams.CircuitBreakerPriceChangeRate
+		params.CircuitBreakerWindowBlocks = defaultParams.CircuitBreakerWindowBlocks
+		params.CircuitBreakerHaltBlocks = defaultParams.CircuitBreakerHaltBlocks
+	}
+	if params.MaxBatchAuctionOrders == 0 {
+		params.MaxBatchAuctionOrders = types.DefaultParams().MaxBatchAuctionOrders
+	}
+	if err := keeper.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	// the call creates the module account if it doesn't exist
+	accountKeeper.GetModuleAccount(ctx, types.FeeCollectorName)
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/CoreumFoundation/coreum/v6/testutil/simapp"
+	v1 "github.com/CoreumFoundation/coreum/v6/x/dex/migrations/v1"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContextLegacy(false, tmproto.Header{
+		Time:    time.Now(),
+		AppHash: []byte("some-hash"),
+	})
+
+	keeper := testApp.DEXKeeper
+	accountKeeper := testApp.AccountKeeper
+
+	// the params of the version 1 without the fees, circuit breaker and batch auction params
+	params, err := keeper.GetParams(ctx)
+	requireT.NoError(err)
+	params.MaxOrdersPerDenom = 200
+	ctx.KVStore(testApp.GetKey(types.StoreKey)).Set(types.ParamsKey, marshalV1Params(t, params))
+	params, err = keeper.GetParams(ctx)
+	requireT.NoError(err)
+	requireT.True(params.MakerFeeRate.IsNil())
+	requireT.True(params.CircuitBreakerPriceChangeRate.IsNil())
+	requireT.Zero(params.MaxBatchAuctionOrders)
+
+	// the version 1 doesn't have the fee collector module account
+	feeCollectorAddress := authtypes.NewModuleAddress(types.FeeCollectorName)
+	if account := accountKeeper.GetAccount(ctx, feeCollectorAddress); account != nil {
+		accountKeeper.RemoveAccount(ctx, account)
+	}
+	requireT.Nil(accountKeeper.GetAccount(ctx, feeCollectorAddress))
+
+	requireT.NoError(v1.MigrateParams(ctx, keeper, accountKeeper))
+
+	migratedParams, err := keeper.GetParams(ctx)
+	requireT.NoError(err)
+	requireT.NoError(migratedParams.ValidateBasic())
+	defaultParams := types.DefaultParams()
+	requireT.True(migratedParams.MakerFeeRate.IsZero())
+	requireT.True(migratedParams.TakerFeeRate.IsZero())
+	requireT.Equal(
+		defaultParams.CircuitBreakerPriceChangeRate.String(), migratedParams.CircuitBreakerPriceChangeRate.String(),
+	)
+	requireT.Equal(defaultParams.CircuitBreakerWindowBlocks, migratedParams.CircuitBreakerWindowBlocks)
+	requireT.Equal(defaultParams.CircuitBreakerHaltBlocks, migratedParams.CircuitBreakerHaltBlocks)
+	requireT.Equal(defaultParams.MaxBatchAuctionOrders, migratedParams.MaxBatchAuctionOrders)
+	// the params of the version 1 are kept
+	requireT.Equal(uint64(200), migratedParams.MaxOrdersPerDenom)
+
+	account := accountKeeper.GetAccount(ctx, feeCollectorAddress)
+	requireT.NotNil(account)
+	_, ok := account.(authtypes.ModuleAccountI)
+	requireT.True(ok)
+
+	// the set params are not changed by the repeated migration
+	migratedParams.MakerFeeRate = sdkmath.LegacyMustNewDecFromStr("0.001")
+	migratedParams.MaxBatchAuctionOrders = 10
+	requireT.NoError(keeper.SetParams(ctx, migratedParams))
+	requireT.NoError(v1.MigrateParams(ctx, keeper, accountKeeper))
+	params, err = keeper.GetParams(ctx)
+	requireT.NoError(err)
+	requireT.Equal(migratedParams, params)
+}
+
+// marshalV1Params marshals the params without the fields added after the version 1.
+func marshalV1Params(t *testing.T, params types.Params) []byte {
+	bz, err := params.Marshal()
+	require.NoError(t, err)
+
+	v1Bz := make([]byte, 0, len(bz))
+	for len(bz) > 0 {
+		fieldNumber, wireType, tagLen := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, tagLen, 0)
+		valueLen := protowire.ConsumeFieldValue(fieldNumber, wireType, bz[tagLen:])
+		require.GreaterOrEqual(t, valueLen, 0)
+		// the fields from 1 to 5 are defined by the version 1
+		if fieldNumber <= 5 {
+			v1Bz = append(v1Bz, bz[:tagLen+valueLen]...)
+		}
+		bz = bz[tagLen+valueLen:]
+	}
+
+	return v1Bz
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/v6/x/dex/client/cli"
 	"github.com/CoreumFoundation/coreum/v6/x/dex/keeper"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/simulation"
 	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
 )
 
@@ -28,7 +29,8 @@ var (
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -107,6 +109,7 @@ type AppModule struct {
 
 	keeper        keeper.Keeper
 	accountKeeper types.AccountKeeper
+	assetFTKeeper types.AssetFTKeeper
 }
 
 // NewAppModule returns the new instance of the AppModule.
@@ -114,11 +117,13 @@ func NewAppModule(
 	cdc codec.Codec,
 	keeper keeper.Keeper,
 	accountKeeper types.AccountKeeper,
+	assetFTKeeper types.AssetFTKeeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(cdc),
 		keeper:         keeper,
 		accountKeeper:  accountKeeper,
+		assetFTKeeper:  assetFTKeeper,
 	}
 }
 
@@ -138,10 +143,17 @@ func (am AppModule) IsOnePerModuleType() {}
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryService(am.keeper))
+
+	m := keeper.NewMigrator(am.keeper, am.accountKeeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(errors.Errorf("can't register module %s migrations, err: %s", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the module's invariants.
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the module's genesis initialization It returns
 // no validator updates.
@@ -159,7 +171,20 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// EndBlock returns the end blocker for the dex module. It clears the batch auctions, activates the trigger orders and
+// merges the candles of the block trades into the interval candles.
+func (am AppModule) EndBlock(c context.Context) error {
+	ctx := sdk.UnwrapSDKContext(c)
+	if err := am.keeper.ClearBatchAuctions(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.ActivateTriggerOrders(ctx); err != nil {
+		return err
+	}
+	return am.keeper.SaveBlockCandles(ctx)
+}
 
 // AppModuleSimulation functions
 
@@ -171,5 +196,11 @@ func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 
 // WeightedOperations returns the all the dex module operations with their respective weights.
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+	return simulation.NewOperationFactory(
+		simState.AppParams,
+		simState.Cdc,
+		am.accountKeeper,
+		am.assetFTKeeper,
+		am.keeper,
+	).WeightedOperations()
 }
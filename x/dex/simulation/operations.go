@@ -0,0 +1,425 @@
+package simulation
+
+import (
+	"math/big"
+	"math/rand"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/samber/lo"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/keeper"
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+// Message types.
+var (
+	TypeMsgPlaceOrder          = sdk.MsgTypeURL(&types.MsgPlaceOrder{})
+	TypeMsgCancelOrder         = sdk.MsgTypeURL(&types.MsgCancelOrder{})
+	TypeMsgCancelOrdersByDenom = sdk.MsgTypeURL(&types.MsgCancelOrdersByDenom{})
+)
+
+// Simulation operation weights constants.
+const (
+	OpWeightMsgPlaceOrder               = "op_weight_msg_place_order"
+	OpWeightMsgCancelOrder              = "op_weight_msg_cancel_order"
+	OpWeightMsgCancelOrdersByDenom      = "op_weight_msg_cancel_orders_by_denom"
+	DefaultWeightMsgPlaceOrder          = 100
+	DefaultWeightMsgCancelOrder         = 30
+	DefaultWeightMsgCancelOrdersByDenom = 5
+)
+
+const (
+	// issuersCount is the number of the first simulation accounts issuing the traded tokens, the issuers are limited
+	// to let the orders of the different accounts meet in the same order books.
+	issuersCount = 3
+	// issuerTokensCount is the number of the tokens issued by each issuer.
+	issuerTokensCount = 3
+)
+
+// tokenFeatures are the features of the traded tokens.
+var tokenFeatures = []assetfttypes.Feature{
+	assetfttypes.Feature_freezing,
+	assetfttypes.Feature_whitelisting,
+	assetfttypes.Feature_dex_order_cancellation,
+}
+
+// OperationFactory creates simulation messages.
+type OperationFactory struct {
+	appParams     simtypes.AppParams
+	cdc           codec.JSONCodec
+	ak            types.AccountKeeper
+	assetFTKeeper types.AssetFTKeeper
+	dexKeeper     keeper.Keeper
+}
+
+// NewOperationFactory returns new instance of the OperationFactory.
+func NewOperationFactory(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	assetFTKeeper types.AssetFTKeeper,
+	dexKeeper keeper.Keeper,
+) *OperationFactory {
+	return &OperationFactory{
+		appParams:     appParams,
+		cdc:           cdc,
+		ak:            ak,
+		assetFTKeeper: assetFTKeeper,
+		dexKeeper:     dexKeeper,
+	}
+}
+
+// WeightedOperations returns all the operations from the module with their respective weights.
+func (op *OperationFactory) WeightedOperations() simulation.WeightedOperations {
+	// make the weights updatable by the simulation
+	var (
+		weightMsgPlaceOrder          int
+		weightMsgCancelOrder         int
+		weightMsgCancelOrdersByDenom int
+	)
+	op.appParams.GetOrGenerate(OpWeightMsgPlaceOrder, &weightMsgPlaceOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgPlaceOrder = DefaultWeightMsgPlaceOrder
+		},
+	)
+	op.appParams.GetOrGenerate(OpWeightMsgCancelOrder, &weightMsgCancelOrder, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelOrder = DefaultWeightMsgCancelOrder
+		},
+	)
+	op.appParams.GetOrGenerate(OpWeightMsgCancelOrdersByDenom, &weightMsgCancelOrdersByDenom, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelOrdersByDenom = DefaultWeightMsgCancelOrdersByDenom
+		},
+	)
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgPlaceOrder,
+			op.simulateMsgPlaceOrder,
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelOrder,
+			op.simulateMsgCancelOrder,
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCancelOrdersByDenom,
+			op.simulateMsgCancelOrdersByDenom,
+		),
+	}
+}
+
+func (op *OperationFactory) simulateMsgPlaceOrder(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	accs []simtypes.Account, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	if len(accs) <= issuersCount {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgPlaceOrder, "not enough accounts"), nil, nil
+	}
+
+	issuerAcc := accs[r.Intn(issuersCount)]
+	tokens, err := op.getTradedTokens(ctx, issuerAcc.Address)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgPlaceOrder, "can't get tokens"), nil, err
+	}
+	// issue the tokens first
+	if len(tokens) < issuerTokensCount {
+		issueMsg := randomIssueMsg(r, issuerAcc.Address)
+		if err := op.sendMsgs(ctx, r, chainID, app, issuerAcc, issueMsg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgPlaceOrder, "can't issue token"), nil, nil
+		}
+		return simtypes.NewOperationMsg(issueMsg, false, ""), nil, nil
+	}
+
+	// the issuers don't trade
+	traderAcc := accs[issuersCount+r.Intn(len(accs)-issuersCount)]
+	if op.ak.GetAccount(ctx, traderAcc.Address) == nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgPlaceOrder, "account not found"), nil, nil
+	}
+
+	r.Shuffle(len(tokens), func(i, j int) {
+		tokens[i], tokens[j] = tokens[j], tokens[i]
+	})
+	msg, spendCoin, ok := op.randomPlaceOrderMsg(ctx, r, traderAcc.Address, tokens[0].Denom, tokens[1].Denom)
+	if !ok {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgPlaceOrder, "invalid order"), nil, nil
+	}
+
+	// the issuer funds the trader and allows to receive the tokens
+	fundMsgs := fundTraderMsgs(r, issuerAcc.Address, traderAcc.Address, spendCoin, tokens[0].Denom, tokens[1].Denom)
+	if err := op.sendMsgs(ctx, r, chainID, app, issuerAcc, fundMsgs...); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgPlaceOrder, "can't fund trader"), nil, nil
+	}
+
+	// the order might be rejected by the balance, reserve or asset ft checks
+	if err := op.sendMsgs(ctx, r, chainID, app, traderAcc, msg); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgPlaceOrder, "order rejected"), nil, nil
+	}
+
+	return simtypes.NewOperationMsg(msg, false, ""), nil, nil
+}
+
+func (op *OperationFactory) simulateMsgCancelOrder(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	accs []simtypes.Account, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	creatorAcc, _ := simtypes.RandomAcc(r, accs)
+	orders, _, err := op.dexKeeper.GetOrders(ctx, creatorAcc.Address, &query.PageRequest{})
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelOrder, "can't get orders"), nil, err
+	}
+	if len(orders) == 0 {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelOrder, "no orders"), nil, nil
+	}
+
+	msg := &types.MsgCancelOrder{
+		Sender: creatorAcc.Address.String(),
+		ID:     orders[r.Intn(len(orders))].ID,
+	}
+	if err := op.sendMsgs(ctx, r, chainID, app, creatorAcc, msg); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelOrder, "invalid cancellation"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, false, ""), nil, nil
+}
+
+func (op *OperationFactory) simulateMsgCancelOrdersByDenom(
+	r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+	accs []simtypes.Account, chainID string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	creatorAcc, _ := simtypes.RandomAcc(r, accs)
+	orders, _, err := op.dexKeeper.GetOrders(ctx, creatorAcc.Address, &query.PageRequest{})
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelOrdersByDenom, "can't get orders"), nil, err
+	}
+	if len(orders) == 0 {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelOrdersByDenom, "no orders"), nil, nil
+	}
+
+	denoms := orders[r.Intn(len(orders))].Denoms()
+	denom := denoms[r.Intn(len(denoms))]
+	token, err := op.assetFTKeeper.GetToken(ctx, denom)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelOrdersByDenom, "can't get token"), nil, err
+	}
+	if !lo.Contains(token.Features, assetfttypes.Feature_dex_order_cancellation) {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelOrdersByDenom, "cancellation is disabled"), nil, nil
+	}
+	adminAddr, err := sdk.AccAddressFromBech32(token.Admin)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelOrdersByDenom, "no admin"), nil, nil
+	}
+	adminAcc, found := simtypes.FindAccount(accs, adminAddr)
+	if !found {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelOrdersByDenom, "admin not found"), nil, nil
+	}
+
+	msg := &types.MsgCancelOrdersByDenom{
+		Sender:  adminAcc.Address.String(),
+		Account: creatorAcc.Address.String(),
+		Denom:   denom,
+	}
+	if err := op.sendMsgs(ctx, r, chainID, app, adminAcc, msg); err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, TypeMsgCancelOrdersByDenom, "invalid cancellation"), nil, err
+	}
+
+	return simtypes.NewOperationMsg(msg, false, ""), nil, nil
+}
+
+func (op *OperationFactory) getTradedTokens(ctx sdk.Context, issuer sdk.AccAddress) ([]assetfttypes.Token, error) {
+	tokens, _, err := op.assetFTKeeper.GetIssuerTokens(ctx, issuer, &query.PageRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return lo.Filter(tokens, func(token assetfttypes.Token, _ int) bool {
+		return lo.Every(token.Features, tokenFeatures)
+	}), nil
+}
+
+func (op *OperationFactory) randomPlaceOrderMsg(
+	ctx sdk.Context,
+	r *rand.Rand,
+	creator sdk.AccAddress,
+	baseDenom, quoteDenom string,
+	// msg, spend coin, ok
+) (*types.MsgPlaceOrder, sdk.Coin, bool) {
+	orderBookParams, err := op.dexKeeper.GetOrderBookParams(ctx, baseDenom, quoteDenom)
+	if err != nil {
+		return nil, sdk.Coin{}, false
+	}
+
+	msg := &types.MsgPlaceOrder{
+		Sender:     creator.String(),
+		Type:       types.ORDER_TYPE_LIMIT,
+		ID:         simtypes.RandStringOfLength(r, 20),
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+		Quantity:   orderBookParams.QuantityStep.MulRaw(int64(simtypes.RandIntBetween(r, 1, 1000))),
+		Side:       randomSide(r),
+	}
+
+	if r.Intn(5) == 0 {
+		msg.Type = types.ORDER_TYPE_MARKET
+		msg.TimeInForce = types.TIME_IN_FORCE_IOC
+	} else {
+		// the prices are close to each other to let the orders match
+		price, err := types.NewPriceFromRat(
+			new(big.Rat).Mul(
+				orderBookParams.PriceTick.Rat(), big.NewRat(int64(simtypes.RandIntBetween(r, 90, 110)), 1),
+			),
+			false,
+		)
+		if err != nil {
+			return nil, sdk.Coin{}, false
+		}
+		msg.Price = &price
+		msg.TimeInForce = randomLimitTimeInForce(r)
+		if msg.TimeInForce == types.TIME_IN_FORCE_GTC || msg.TimeInForce == types.TIME_IN_FORCE_POST_ONLY {
+			msg.GoodTil = randomGoodTil(ctx, r)
+		}
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdk.Coin{}, false
+	}
+
+	spendAmount := msg.Quantity
+	if msg.Side == types.SIDE_BUY {
+		// the market buy order spends up to the quote balance
+		spendAmount = simtypes.RandomAmount(r, msg.Quantity.MulRaw(2)).AddRaw(1)
+		if msg.Type == types.ORDER_TYPE_LIMIT {
+			order, err := types.NewOrderFromMsgPlaceOrder(*msg)
+			if err != nil {
+				return nil, sdk.Coin{}, false
+			}
+			lockedCoin, err := order.ComputeLimitOrderLockedBalance()
+			if err != nil {
+				return nil, sdk.Coin{}, false
+			}
+			spendAmount = lockedCoin.Amount
+		}
+	}
+	spendDenom := baseDenom
+	if msg.Side == types.SIDE_BUY {
+		spendDenom = quoteDenom
+	}
+
+	return msg, sdk.NewCoin(spendDenom, spendAmount), true
+}
+
+func (op *OperationFactory) sendMsgs(
+	ctx sdk.Context,
+	r *rand.Rand,
+	chainID string,
+	app *baseapp.BaseApp,
+	sender simtypes.Account,
+	msgs ...sdk.Msg,
+) error {
+	account := op.ak.GetAccount(ctx, sender.Address)
+	txGen := moduletestutil.MakeTestEncodingConfig().TxConfig
+	tx, err := simtestutil.GenSignedMockTx(
+		r,
+		txGen,
+		msgs,
+		sdk.Coins{},
+		simtestutil.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		sender.PrivKey,
+	)
+	if err != nil {
+		return err
+	}
+	_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func randomIssueMsg(r *rand.Rand, issuer sdk.AccAddress) *assetfttypes.MsgIssue {
+	return &assetfttypes.MsgIssue{
+		Issuer:        issuer.String(),
+		Symbol:        "dex" + simtypes.RandStringOfLength(r, 10),
+		Subunit:       "dex" + strings.ToLower(simtypes.RandStringOfLength(r, 10)),
+		Precision:     uint32(simtypes.RandIntBetween(r, 1, 20)),
+		InitialAmount: sdkmath.NewIntWithDecimal(1, 30),
+		Features:      tokenFeatures,
+	}
+}
+
+// fundTraderMsgs returns the messages which send the coin to spend to the trader, allow it to receive the traded
+// tokens and randomly freeze a part of the sent coin.
+func fundTraderMsgs(
+	r *rand.Rand, issuer, trader sdk.AccAddress, spendCoin sdk.Coin, denoms ...string,
+) []sdk.Msg {
+	msgs := make([]sdk.Msg, 0, len(denoms)+2)
+	for _, denom := range denoms {
+		msgs = append(msgs, &assetfttypes.MsgSetWhitelistedLimit{
+			Sender:  issuer.String(),
+			Account: trader.String(),
+			Coin:    sdk.NewCoin(denom, sdkmath.NewIntWithDecimal(1, 30)),
+		})
+	}
+	msgs = append(msgs, &banktypes.MsgSend{
+		FromAddress: issuer.String(),
+		ToAddress:   trader.String(),
+		Amount:      sdk.NewCoins(spendCoin),
+	})
+	if r.Intn(10) == 0 {
+		msgs = append(msgs, &assetfttypes.MsgFreeze{
+			Sender:  issuer.String(),
+			Account: trader.String(),
+			Coin:    sdk.NewCoin(spendCoin.Denom, simtypes.RandomAmount(r, spendCoin.Amount)),
+		})
+	}
+
+	return msgs
+}
+
+func randomSide(r *rand.Rand) types.Side {
+	if r.Intn(2) == 0 {
+		return types.SIDE_BUY
+	}
+
+	return types.SIDE_SELL
+}
+
+func randomLimitTimeInForce(r *rand.Rand) types.TimeInForce {
+	return []types.TimeInForce{
+		types.TIME_IN_FORCE_GTC,
+		types.TIME_IN_FORCE_IOC,
+		types.TIME_IN_FORCE_FOK,
+		types.TIME_IN_FORCE_POST_ONLY,
+	}[r.Intn(4)]
+}
+
+func randomGoodTil(ctx sdk.Context, r *rand.Rand) *types.GoodTil {
+	switch r.Intn(3) {
+	case 0:
+		return &types.GoodTil{
+			GoodTilBlockHeight: uint64(ctx.BlockHeight()) + uint64(simtypes.RandIntBetween(r, 1, 100)),
+		}
+	case 1:
+		return &types.GoodTil{
+			GoodTilBlockTime: lo.ToPtr(
+				ctx.BlockTime().Add(time.Duration(simtypes.RandIntBetween(r, 1, 3600)) * time.Second),
+			),
+		}
+	default:
+		return nil
+	}
+}
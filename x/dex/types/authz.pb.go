@@ -0,0 +1,931 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/dex/v1/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PlaceOrderAuthorization allows the grantee to place the orders on behalf of the granter within the limits.
+type PlaceOrderAuthorization struct {
+	// order_books is the list of the order books the orders are allowed in, any order book is allowed if empty.
+	OrderBooks []AuthorizedOrderBook `protobuf:"bytes,1,rep,name=order_books,json=orderBooks,proto3" json:"order_books"`
+	// denoms is the list of the denoms the orders are allowed to trade, the order is allowed if both its denoms are in
+	// the list, any denom is allowed if empty.
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// sides is the list of the allowed order sides, any side is allowed if empty.
+	Sides []Side `protobuf:"varint,3,rep,packed,name=sides,proto3,enum=coreum.dex.v1.Side" json:"sides,omitempty"`
+	// max_order_spend is the max amount of the coin a single order is allowed to spend, the order spending the coin
+	// not in the list is rejected, any amount is allowed if empty.
+	MaxOrderSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=max_order_spend,json=maxOrderSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_order_spend"`
+	// spend_limit is the cumulative amount of the coins the orders are allowed to spend, it is decreased by every
+	// placed order, the order spending the coin not in the list is rejected, any amount is allowed if empty.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// expiration is the time after which the orders aren't allowed, the authorization doesn't expire if not set.
+	Expiration *time.Time `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *PlaceOrderAuthorization) Reset()         { *m = PlaceOrderAuthorization{} }
+func (m *PlaceOrderAuthorization) String() string { return proto.CompactTextString(m) }
+func (*PlaceOrderAuthorization) ProtoMessage()    {}
+func (*PlaceOrderAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cc44f7ddc8c324c, []int{0}
+}
+func (m *PlaceOrderAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlaceOrderAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlaceOrderAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlaceOrderAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceOrderAuthorization.Merge(m, src)
+}
+func (m *PlaceOrderAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlaceOrderAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceOrderAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaceOrderAuthorization proto.InternalMessageInfo
+
+func (m *PlaceOrderAuthorization) GetOrderBooks() []AuthorizedOrderBook {
+	if m != nil {
+		return m.OrderBooks
+	}
+	return nil
+}
+
+func (m *PlaceOrderAuthorization) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *PlaceOrderAuthorization) GetSides() []Side {
+	if m != nil {
+		return m.Sides
+	}
+	return nil
+}
+
+func (m *PlaceOrderAuthorization) GetMaxOrderSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxOrderSpend
+	}
+	return nil
+}
+
+func (m *PlaceOrderAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *PlaceOrderAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+// AuthorizedOrderBook is the order book the orders are allowed in.
+type AuthorizedOrderBook struct {
+	// base_denom is order book base denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is order book quote denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+}
+
+func (m *AuthorizedOrderBook) Reset()         { *m = AuthorizedOrderBook{} }
+func (m *AuthorizedOrderBook) String() string { return proto.CompactTextString(m) }
+func (*AuthorizedOrderBook) ProtoMessage()    {}
+func (*AuthorizedOrderBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3cc44f7ddc8c324c, []int{1}
+}
+func (m *AuthorizedOrderBook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorizedOrderBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorizedOrderBook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorizedOrderBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorizedOrderBook.Merge(m, src)
+}
+func (m *AuthorizedOrderBook) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorizedOrderBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorizedOrderBook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorizedOrderBook proto.InternalMessageInfo
+
+func (m *AuthorizedOrderBook) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *AuthorizedOrderBook) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PlaceOrderAuthorization)(nil), "coreum.dex.v1.PlaceOrderAuthorization")
+	proto.RegisterType((*AuthorizedOrderBook)(nil), "coreum.dex.v1.AuthorizedOrderBook")
+}
+
+func init() { proto.RegisterFile("coreum/dex/v1/authz.proto", fileDescriptor_3cc44f7ddc8c324c) }
+
+var fileDescriptor_3cc44f7ddc8c324c = []byte{
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x9b, 0xb4, 0x52, 0x36, 0x2a, 0x08, 0x17, 0x81, 0x1b, 0x84, 0x13, 0xe5, 0x64, 0x2a,
+	0x75, 0x57, 0x09, 0x82, 0x03, 0x27, 0x48, 0x51, 0x25, 0x04, 0x12, 0xc8, 0x85, 0x0b, 0x97, 0xc8,
+	0x8e, 0x97, 0x64, 0x95, 0xd8, 0xe3, 0x7a, 0xd7, 0x91, 0xe9, 0x27, 0x70, 0xea, 0x17, 0x70, 0x46,
+	0x9c, 0x7a, 0xe0, 0x23, 0x2a, 0x4e, 0x3d, 0x72, 0xa2, 0x28, 0x39, 0xf4, 0x37, 0xd0, 0x8e, 0xd7,
+	0xa2, 0xad, 0xe0, 0xc8, 0x25, 0xf1, 0xcc, 0x9b, 0xd9, 0x79, 0xfb, 0xde, 0x2c, 0xd9, 0x1e, 0x43,
+	0xc6, 0xf3, 0x98, 0x45, 0xbc, 0x60, 0x8b, 0x3e, 0x0b, 0x72, 0x35, 0x3d, 0xa2, 0x69, 0x06, 0x0a,
+	0xec, 0xcd, 0x12, 0xa2, 0x11, 0x2f, 0xe8, 0xa2, 0xdf, 0xbe, 0x15, 0xc4, 0x22, 0x01, 0x86, 0xbf,
+	0x65, 0x45, 0xfb, 0x5a, 0x33, 0x64, 0x11, 0xcf, 0x0c, 0xe4, 0x8e, 0x41, 0xc6, 0x20, 0x59, 0x18,
+	0x48, 0xce, 0x16, 0xfd, 0x90, 0xab, 0xa0, 0xcf, 0xc6, 0x20, 0x92, 0x3f, 0xad, 0x1a, 0x1f, 0x61,
+	0xc4, 0xca, 0xc0, 0x40, 0xb7, 0x27, 0x30, 0x81, 0x32, 0xaf, 0xbf, 0x4c, 0xb6, 0x33, 0x01, 0x98,
+	0xcc, 0x39, 0xc3, 0x28, 0xcc, 0x3f, 0x30, 0x25, 0x62, 0x2e, 0x55, 0x10, 0xa7, 0x65, 0x41, 0xef,
+	0x73, 0x83, 0xdc, 0x7d, 0x33, 0x0f, 0xc6, 0xfc, 0xb5, 0xa6, 0xf1, 0x2c, 0x57, 0x53, 0xc8, 0xc4,
+	0x51, 0xa0, 0x04, 0x24, 0xf6, 0x0b, 0xd2, 0x42, 0x72, 0xa3, 0x10, 0x60, 0x26, 0x1d, 0xab, 0x5b,
+	0xf7, 0x5a, 0x83, 0x1e, 0xbd, 0x72, 0x41, 0x5a, 0xb5, 0xf0, 0x08, 0x4f, 0x18, 0x02, 0xcc, 0x86,
+	0x8d, 0xd3, 0x9f, 0x9d, 0x9a, 0x4f, 0xa0, 0x4a, 0x48, 0xfb, 0x0e, 0xd9, 0x88, 0x78, 0x02, 0xb1,
+	0x74, 0xd6, 0xba, 0x75, 0xaf, 0xe9, 0x9b, 0xc8, 0x7e, 0x40, 0xd6, 0xa5, 0x88, 0xb8, 0x74, 0xea,
+	0xdd, 0xba, 0x77, 0x63, 0xb0, 0x75, 0xed, 0xf0, 0x03, 0x11, 0x71, 0xbf, 0xac, 0xb0, 0x0b, 0x72,
+	0x33, 0x0e, 0x8a, 0x51, 0xc9, 0x48, 0xa6, 0x3c, 0x89, 0x9c, 0x06, 0x32, 0xda, 0xa6, 0x46, 0x08,
+	0xad, 0x1a, 0x35, 0xaa, 0xd1, 0x3d, 0x10, 0xc9, 0xf0, 0x91, 0x26, 0xf2, 0xf5, 0xbc, 0xe3, 0x4d,
+	0x84, 0x9a, 0xe6, 0x21, 0x1d, 0x43, 0x6c, 0x54, 0x33, 0x7f, 0xbb, 0x32, 0x9a, 0x31, 0xf5, 0x31,
+	0xe5, 0x12, 0x1b, 0xe4, 0x97, 0x8b, 0x93, 0x9d, 0x9a, 0xbf, 0x19, 0x07, 0x05, 0xde, 0xe6, 0x40,
+	0x8f, 0xb1, 0x0f, 0x49, 0x0b, 0xe7, 0x8d, 0xe6, 0x22, 0x16, 0xca, 0x59, 0xff, 0x4f, 0x53, 0x09,
+	0x0e, 0x79, 0xa5, 0x67, 0xd8, 0x4f, 0x09, 0xe1, 0x45, 0x2a, 0x32, 0x34, 0xc2, 0xd9, 0xe8, 0x5a,
+	0x5e, 0x6b, 0xd0, 0xa6, 0xa5, 0x99, 0xb4, 0x32, 0x93, 0xbe, 0xad, 0xcc, 0x1c, 0x36, 0x8e, 0xcf,
+	0x3b, 0x96, 0x7f, 0xa9, 0xe7, 0xc9, 0xfe, 0xf7, 0x6f, 0xbb, 0x3d, 0x43, 0xb1, 0xdc, 0xcf, 0x8a,
+	0xe3, 0x15, 0x93, 0x3f, 0x5d, 0x9c, 0xec, 0xdc, 0xd3, 0x9b, 0xf8, 0x8f, 0x25, 0xe8, 0xbd, 0x23,
+	0x5b, 0x7f, 0xb1, 0xd8, 0xbe, 0x4f, 0x88, 0xbe, 0xf8, 0x08, 0x7d, 0x74, 0xac, 0xae, 0xe5, 0x35,
+	0xfd, 0xa6, 0xce, 0x3c, 0xd7, 0x09, 0xbb, 0x43, 0x5a, 0x87, 0x39, 0xa8, 0x0a, 0x5f, 0x43, 0x9c,
+	0x60, 0x0a, 0x0b, 0x86, 0x2f, 0x4f, 0x97, 0xae, 0x75, 0xb6, 0x74, 0xad, 0x5f, 0x4b, 0xd7, 0x3a,
+	0x5e, 0xb9, 0xb5, 0xb3, 0x95, 0x5b, 0xfb, 0xb1, 0x72, 0x6b, 0xef, 0xfb, 0x97, 0x54, 0xdb, 0xc3,
+	0x6d, 0xd8, 0x87, 0x3c, 0x89, 0x90, 0x0d, 0x33, 0x4f, 0x67, 0xf1, 0x98, 0x15, 0xf8, 0x7e, 0x50,
+	0xc4, 0x70, 0x03, 0x15, 0x79, 0xf8, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x93, 0x71, 0x26, 0x5f, 0x97,
+	0x03, 0x00, 0x00,
+}
+
+func (m *PlaceOrderAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlaceOrderAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlaceOrderAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MaxOrderSpend) > 0 {
+		for iNdEx := len(m.MaxOrderSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxOrderSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Sides) > 0 {
+		dAtA3 := make([]byte, len(m.Sides)*10)
+		var j2 int
+		for _, num := range m.Sides {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintAuthz(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.OrderBooks) > 0 {
+		for iNdEx := len(m.OrderBooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuthorizedOrderBook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorizedOrderBook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorizedOrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PlaceOrderAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderBooks) > 0 {
+		for _, e := range m.OrderBooks {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.Sides) > 0 {
+		l = 0
+		for _, e := range m.Sides {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	if len(m.MaxOrderSpend) > 0 {
+		for _, e := range m.MaxOrderSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *AuthorizedOrderBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PlaceOrderAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlaceOrderAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlaceOrderAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBooks = append(m.OrderBooks, AuthorizedOrderBook{})
+			if err := m.OrderBooks[len(m.OrderBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Side
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Side(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sides = append(m.Sides, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Sides) == 0 {
+					m.Sides = make([]Side, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Side
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Side(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sides = append(m.Sides, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sides", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOrderSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxOrderSpend = append(m.MaxOrderSpend, types.Coin{})
+			if err := m.MaxOrderSpend[len(m.MaxOrderSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuthorizedOrderBook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorizedOrderBook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorizedOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates the scheduled cancellation.
+func (c CancelAllAfter) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(c.Account); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", c.Account)
+	}
+
+	if c.Deadline.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "deadline must be set")
+	}
+
+	return validateCancelAllAfterDenoms(c.Denoms)
+}
+
+func validateCancelAllAfterDenoms(denoms []string) error {
+	usedDenoms := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid denom: %s", denom)
+		}
+		if _, ok := usedDenoms[denom]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate denom: %s", denom)
+		}
+		usedDenoms[denom] = struct{}{}
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/gogoproto/proto"
 )
 
@@ -14,6 +15,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	)
 	registry.RegisterImplementations((*proto.Message)(nil),
 		&CancelGoodTil{},
+		&CancelAll{},
+		&ResumeOrderBook{},
+		&CloseOrderBook{},
+	)
+	registry.RegisterImplementations(
+		(*authz.Authorization)(nil),
+		&PlaceOrderAuthorization{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
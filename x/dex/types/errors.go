@@ -13,4 +13,8 @@ var (
 	ErrInvalidState = sdkerrors.Register(ModuleName, 3, "invalid state")
 	// ErrRecordNotFound is returned when record is not found in the store.
 	ErrRecordNotFound = sdkerrors.Register(ModuleName, 4, "record not found")
+	// ErrOrderBookHalted is returned when the order is placed to the halted order book.
+	ErrOrderBookHalted = sdkerrors.Register(ModuleName, 5, "order book is halted")
+	// ErrOrderBookClosed is returned when the order is placed to the closed order book.
+	ErrOrderBookClosed = sdkerrors.Register(ModuleName, 6, "order book is closed")
 )
@@ -89,6 +89,70 @@ func (m *EventOrderPlaced) GetSequence() uint64 {
 	return 0
 }
 
+// EventOrderTriggered is emitted when the order trigger is activated, and the order is sent to the order book.
+type EventOrderTriggered struct {
+	// creator is order creator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is unique order ID.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// sequence is the sequence the order had before activation.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *EventOrderTriggered) Reset()         { *m = EventOrderTriggered{} }
+func (m *EventOrderTriggered) String() string { return proto.CompactTextString(m) }
+func (*EventOrderTriggered) ProtoMessage()    {}
+func (*EventOrderTriggered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{1}
+}
+func (m *EventOrderTriggered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderTriggered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderTriggered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderTriggered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderTriggered.Merge(m, src)
+}
+func (m *EventOrderTriggered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderTriggered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderTriggered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderTriggered proto.InternalMessageInfo
+
+func (m *EventOrderTriggered) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventOrderTriggered) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *EventOrderTriggered) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 // EventOrderReduced is emitted when the order is reduced during the matching.
 type EventOrderReduced struct {
 	// creator is order creator address.
@@ -101,13 +165,17 @@ type EventOrderReduced struct {
 	SentCoin github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=sent_coin,json=sentCoin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"sent_coin"`
 	// received_coin is coin received during matching.
 	ReceivedCoin github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=received_coin,json=receivedCoin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"received_coin"`
+	// fee_coin is the part of the received coin charged as the trading fee.
+	FeeCoin github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=fee_coin,json=feeCoin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"fee_coin"`
+	// fee_rate is the fee rate applied to the received coin.
+	FeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=fee_rate,json=feeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_rate"`
 }
 
 func (m *EventOrderReduced) Reset()         { *m = EventOrderReduced{} }
 func (m *EventOrderReduced) String() string { return proto.CompactTextString(m) }
 func (*EventOrderReduced) ProtoMessage()    {}
 func (*EventOrderReduced) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cecfe712f14d2a81, []int{1}
+	return fileDescriptor_cecfe712f14d2a81, []int{2}
 }
 func (m *EventOrderReduced) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -175,7 +243,7 @@ func (m *EventOrderCreated) Reset()         { *m = EventOrderCreated{} }
 func (m *EventOrderCreated) String() string { return proto.CompactTextString(m) }
 func (*EventOrderCreated) ProtoMessage()    {}
 func (*EventOrderCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cecfe712f14d2a81, []int{2}
+	return fileDescriptor_cecfe712f14d2a81, []int{3}
 }
 func (m *EventOrderCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +311,7 @@ func (m *EventOrderClosed) Reset()         { *m = EventOrderClosed{} }
 func (m *EventOrderClosed) String() string { return proto.CompactTextString(m) }
 func (*EventOrderClosed) ProtoMessage()    {}
 func (*EventOrderClosed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cecfe712f14d2a81, []int{3}
+	return fileDescriptor_cecfe712f14d2a81, []int{4}
 }
 func (m *EventOrderClosed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -293,190 +361,612 @@ func (m *EventOrderClosed) GetSequence() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*EventOrderPlaced)(nil), "coreum.dex.v1.EventOrderPlaced")
-	proto.RegisterType((*EventOrderReduced)(nil), "coreum.dex.v1.EventOrderReduced")
-	proto.RegisterType((*EventOrderCreated)(nil), "coreum.dex.v1.EventOrderCreated")
-	proto.RegisterType((*EventOrderClosed)(nil), "coreum.dex.v1.EventOrderClosed")
+// EventOrderRefilled is emitted when the visible quantity of the iceberg order is filled and refilled from the hidden
+// remainder, the refilled order is moved to the back of its price level.
+type EventOrderRefilled struct {
+	// creator is order creator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// id is unique order ID.
+	ID string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// sequence is unique order sequence.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// remaining_visible_quantity is the remaining quantity of the order shown in the order book after the refill.
+	RemainingVisibleQuantity cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=remaining_visible_quantity,json=remainingVisibleQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_visible_quantity"`
+	// remaining_hidden_quantity is the remaining quantity of the order not shown in the order book after the refill.
+	RemainingHiddenQuantity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining_hidden_quantity,json=remainingHiddenQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_hidden_quantity"`
 }
 
-func init() { proto.RegisterFile("coreum/dex/v1/event.proto", fileDescriptor_cecfe712f14d2a81) }
-
-var fileDescriptor_cecfe712f14d2a81 = []byte{
-	// 430 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x86, 0x37, 0xa1, 0x2d, 0xd4, 0xa2, 0x12, 0x44, 0x14, 0xd2, 0x22, 0xb2, 0x55, 0x2e, 0xf4,
-	0x42, 0xac, 0x15, 0x12, 0x0f, 0x90, 0x00, 0x52, 0x05, 0x12, 0x10, 0xe0, 0x82, 0x84, 0x82, 0x63,
-	0x8f, 0x52, 0xab, 0x89, 0xbd, 0xb5, 0x9d, 0x68, 0xfb, 0x00, 0xdc, 0x39, 0xc1, 0x2b, 0xf5, 0xd8,
-	0x23, 0xe2, 0xb0, 0x42, 0xd9, 0x17, 0x41, 0x4e, 0xb2, 0xdb, 0x72, 0x43, 0x68, 0x8f, 0x3d, 0xc5,
-	0x13, 0xfb, 0xff, 0xec, 0xf9, 0x35, 0x33, 0x68, 0x8f, 0x4a, 0x05, 0x75, 0x85, 0x19, 0xcc, 0x70,
-	0x33, 0xc1, 0xd0, 0x80, 0x30, 0xd1, 0x54, 0x49, 0x23, 0xbd, 0x9d, 0x7e, 0x2b, 0x62, 0x30, 0x8b,
-	0x9a, 0xc9, 0xfe, 0xbd, 0x42, 0x16, 0xb2, 0xdb, 0xc1, 0x76, 0xd5, 0x1f, 0x0a, 0xbf, 0xa0, 0x3b,
-	0x2f, 0xac, 0xe6, 0x8d, 0x62, 0xa0, 0xde, 0x96, 0x84, 0x02, 0xf3, 0x7c, 0x74, 0x93, 0x2a, 0x20,
-	0x46, 0x2a, 0xdf, 0x39, 0x70, 0x0e, 0xb7, 0xd3, 0x65, 0xe8, 0xdd, 0x47, 0x2e, 0x67, 0xbe, 0x6b,
-	0x7f, 0xc6, 0x5b, 0xed, 0x7c, 0xec, 0x1e, 0x3d, 0x4f, 0x5d, 0xce, 0xbc, 0x7d, 0x74, 0x4b, 0xc3,
-	0x69, 0x0d, 0x82, 0x82, 0x7f, 0xe3, 0xc0, 0x39, 0xdc, 0x48, 0x57, 0x71, 0xf8, 0xd5, 0x45, 0x77,
-	0x2f, 0xaf, 0x48, 0x81, 0xd5, 0x6b, 0xbf, 0xc3, 0x7b, 0x8d, 0xb6, 0x35, 0x08, 0x93, 0x51, 0xc9,
-	0x85, 0xbf, 0xd1, 0x49, 0xf1, 0xf9, 0x7c, 0x3c, 0xfa, 0x35, 0x1f, 0x3f, 0x2e, 0xb8, 0x39, 0xae,
-	0xf3, 0x88, 0xca, 0x0a, 0x53, 0xa9, 0x2b, 0xa9, 0x87, 0xcf, 0x13, 0xcd, 0x4e, 0xb0, 0x39, 0x9b,
-	0x82, 0x8e, 0x12, 0xc9, 0x85, 0xa5, 0x09, 0x63, 0x57, 0xde, 0x07, 0xb4, 0xa3, 0x80, 0x02, 0x6f,
-	0x80, 0xf5, 0xc4, 0xcd, 0xff, 0x23, 0xde, 0x5e, 0x52, 0x6c, 0x14, 0xfe, 0xf8, 0xcb, 0x87, 0xc4,
-	0x66, 0xbb, 0x76, 0x1f, 0x3e, 0xa2, 0x07, 0x0a, 0x2a, 0xc2, 0x05, 0x17, 0x45, 0x96, 0x13, 0x0d,
-	0xd9, 0x69, 0x4d, 0x84, 0xe1, 0xe6, 0x6c, 0x70, 0xe5, 0xd1, 0x90, 0xc3, 0x6e, 0xff, 0x62, 0xcd,
-	0x4e, 0x22, 0x2e, 0x71, 0x45, 0xcc, 0x71, 0x74, 0x24, 0x4c, 0xba, 0xbb, 0x52, 0xc7, 0x44, 0xc3,
-	0xbb, 0x41, 0xeb, 0x7d, 0x46, 0x0f, 0x2f, 0xb1, 0x7a, 0x0a, 0x82, 0x91, 0xbc, 0x84, 0x2c, 0x27,
-	0x25, 0xb1, 0xaf, 0xd8, 0xfc, 0x17, 0xf4, 0xde, 0x8a, 0xf0, 0x7e, 0x09, 0x88, 0x7b, 0x7d, 0xf8,
-	0xdd, 0xbd, 0x5a, 0x84, 0x49, 0x29, 0xf5, 0xb5, 0x31, 0x56, 0x1f, 0xbf, 0x3a, 0x6f, 0x03, 0xe7,
-	0xa2, 0x0d, 0x9c, 0xdf, 0x6d, 0xe0, 0x7c, 0x5b, 0x04, 0xa3, 0x8b, 0x45, 0x30, 0xfa, 0xb9, 0x08,
-	0x46, 0x9f, 0x26, 0x57, 0x6a, 0x30, 0xe9, 0xda, 0xfc, 0xa5, 0xac, 0x05, 0x23, 0x86, 0x4b, 0x81,
-	0x87, 0x91, 0xd0, 0x3c, 0xc3, 0xb3, 0x6e, 0x2e, 0x74, 0x25, 0x99, 0x6f, 0x75, 0x0d, 0xff, 0xf4,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x68, 0xa1, 0xfb, 0xe7, 0x32, 0x04, 0x00, 0x00,
+func (m *EventOrderRefilled) Reset()         { *m = EventOrderRefilled{} }
+func (m *EventOrderRefilled) String() string { return proto.CompactTextString(m) }
+func (*EventOrderRefilled) ProtoMessage()    {}
+func (*EventOrderRefilled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{5}
+}
+func (m *EventOrderRefilled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderRefilled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderRefilled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderRefilled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderRefilled.Merge(m, src)
+}
+func (m *EventOrderRefilled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderRefilled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderRefilled.DiscardUnknown(m)
 }
 
-func (m *EventOrderPlaced) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventOrderRefilled proto.InternalMessageInfo
+
+func (m *EventOrderRefilled) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOrderPlaced) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOrderRefilled) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
 }
 
-func (m *EventOrderPlaced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
+func (m *EventOrderRefilled) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+// EventOrderReplaced is emitted when the order is replaced with the new one.
+type EventOrderReplaced struct {
+	// creator is order creator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// old_id is the ID of the replaced order.
+	OldID string `protobuf:"bytes,2,opt,name=old_id,json=oldId,proto3" json:"old_id,omitempty"`
+	// old_sequence is the sequence of the replaced order, the new order keeps it if the order is amended in place.
+	OldSequence uint64 `protobuf:"varint,3,opt,name=old_sequence,json=oldSequence,proto3" json:"old_sequence,omitempty"`
+	// id is the ID of the new order.
+	ID string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *EventOrderReplaced) Reset()         { *m = EventOrderReplaced{} }
+func (m *EventOrderReplaced) String() string { return proto.CompactTextString(m) }
+func (*EventOrderReplaced) ProtoMessage()    {}
+func (*EventOrderReplaced) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{6}
+}
+func (m *EventOrderReplaced) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderReplaced) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderReplaced.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventOrderReplaced) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderReplaced.Merge(m, src)
+}
+func (m *EventOrderReplaced) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderReplaced) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderReplaced.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderReplaced proto.InternalMessageInfo
+
+func (m *EventOrderReplaced) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventOrderReduced) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOrderReplaced) GetOldID() string {
+	if m != nil {
+		return m.OldID
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventOrderReduced) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOrderReplaced) GetOldSequence() uint64 {
+	if m != nil {
+		return m.OldSequence
+	}
+	return 0
 }
 
-func (m *EventOrderReduced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ReceivedCoin.Size()
-		i -= size
-		if _, err := m.ReceivedCoin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+func (m *EventOrderReplaced) GetID() string {
+	if m != nil {
+		return m.ID
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.SentCoin.Size()
-		i -= size
-		if _, err := m.SentCoin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return ""
+}
+
+// EventOrderBookHalted is emitted when the trading in the order book and its inverted order book is halted.
+type EventOrderBookHalted struct {
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// halted_by is the address of the account halted the order book, empty if the order book is halted by the circuit
+	// breaker.
+	HaltedBy string `protobuf:"bytes,3,opt,name=halted_by,json=haltedBy,proto3" json:"halted_by,omitempty"`
+	// resume_height is the height after which the order book is resumed automatically, zero if the order book is halted
+	// until it's resumed manually.
+	ResumeHeight uint64 `protobuf:"varint,4,opt,name=resume_height,json=resumeHeight,proto3" json:"resume_height,omitempty"`
+}
+
+func (m *EventOrderBookHalted) Reset()         { *m = EventOrderBookHalted{} }
+func (m *EventOrderBookHalted) String() string { return proto.CompactTextString(m) }
+func (*EventOrderBookHalted) ProtoMessage()    {}
+func (*EventOrderBookHalted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{7}
+}
+func (m *EventOrderBookHalted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderBookHalted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderBookHalted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.Sequence != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *EventOrderBookHalted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderBookHalted.Merge(m, src)
+}
+func (m *EventOrderBookHalted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderBookHalted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderBookHalted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderBookHalted proto.InternalMessageInfo
+
+func (m *EventOrderBookHalted) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventOrderBookHalted) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventOrderBookHalted) GetHaltedBy() string {
+	if m != nil {
+		return m.HaltedBy
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventOrderCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOrderBookHalted) GetResumeHeight() uint64 {
+	if m != nil {
+		return m.ResumeHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventOrderCreated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventOrderBookResumed is emitted when the trading in the order book and its inverted order book is resumed.
+type EventOrderBookResumed struct {
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// resumed_by is the address of the account resumed the order book, empty if the order book is resumed
+	// automatically.
+	ResumedBy string `protobuf:"bytes,3,opt,name=resumed_by,json=resumedBy,proto3" json:"resumed_by,omitempty"`
 }
 
-func (m *EventOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RemainingSpendableBalance.Size()
-		i -= size
-		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventOrderBookResumed) Reset()         { *m = EventOrderBookResumed{} }
+func (m *EventOrderBookResumed) String() string { return proto.CompactTextString(m) }
+func (*EventOrderBookResumed) ProtoMessage()    {}
+func (*EventOrderBookResumed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{8}
+}
+func (m *EventOrderBookResumed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderBookResumed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderBookResumed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.RemainingBaseQuantity.Size()
-		i -= size
-		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *EventOrderBookResumed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderBookResumed.Merge(m, src)
+}
+func (m *EventOrderBookResumed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderBookResumed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderBookResumed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderBookResumed proto.InternalMessageInfo
+
+func (m *EventOrderBookResumed) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EventOrderBookResumed) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *EventOrderBookResumed) GetResumedBy() string {
+	if m != nil {
+		return m.ResumedBy
+	}
+	return ""
+}
+
+// EventOrderBookClosed is emitted when the order book and its inverted order book are closed.
+type EventOrderBookClosed struct {
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+}
+
+func (m *EventOrderBookClosed) Reset()         { *m = EventOrderBookClosed{} }
+func (m *EventOrderBookClosed) String() string { return proto.CompactTextString(m) }
+func (*EventOrderBookClosed) ProtoMessage()    {}
+func (*EventOrderBookClosed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{9}
+}
+func (m *EventOrderBookClosed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderBookClosed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderBookClosed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
+}
+func (m *EventOrderBookClosed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderBookClosed.Merge(m, src)
+}
+func (m *EventOrderBookClosed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderBookClosed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderBookClosed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderBookClosed proto.InternalMessageInfo
+
+func (m *EventOrderBookClosed) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EventOrderBookClosed) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+// EventOrderBookReopened is emitted when the closed order book and its inverted order book are reopened.
+type EventOrderBookReopened struct {
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+}
+
+func (m *EventOrderBookReopened) Reset()         { *m = EventOrderBookReopened{} }
+func (m *EventOrderBookReopened) String() string { return proto.CompactTextString(m) }
+func (*EventOrderBookReopened) ProtoMessage()    {}
+func (*EventOrderBookReopened) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{10}
+}
+func (m *EventOrderBookReopened) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderBookReopened) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderBookReopened.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderBookReopened) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderBookReopened.Merge(m, src)
+}
+func (m *EventOrderBookReopened) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderBookReopened) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderBookReopened.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderBookReopened proto.InternalMessageInfo
+
+func (m *EventOrderBookReopened) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EventOrderBookReopened) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+// EventOrderBookBatchAuctionUpdated is emitted when the batch auction mode of the order book and its inverted order
+// book is enabled or disabled.
+type EventOrderBookBatchAuctionUpdated struct {
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// enabled defines whether the batch auction mode is enabled.
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *EventOrderBookBatchAuctionUpdated) Reset()         { *m = EventOrderBookBatchAuctionUpdated{} }
+func (m *EventOrderBookBatchAuctionUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventOrderBookBatchAuctionUpdated) ProtoMessage()    {}
+func (*EventOrderBookBatchAuctionUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{11}
+}
+func (m *EventOrderBookBatchAuctionUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderBookBatchAuctionUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderBookBatchAuctionUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderBookBatchAuctionUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderBookBatchAuctionUpdated.Merge(m, src)
+}
+func (m *EventOrderBookBatchAuctionUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderBookBatchAuctionUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderBookBatchAuctionUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderBookBatchAuctionUpdated proto.InternalMessageInfo
+
+func (m *EventOrderBookBatchAuctionUpdated) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EventOrderBookBatchAuctionUpdated) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *EventOrderBookBatchAuctionUpdated) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// EventOrderBookBatchAuctionCleared is emitted when the orders collected during the block are cleared at the single
+// clearing price.
+type EventOrderBookBatchAuctionCleared struct {
+	// base_denom is the base denom of the order book the clearing price is defined for.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the quote denom of the order book the clearing price is defined for.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// price is the clearing price.
+	Price Price `protobuf:"bytes,3,opt,name=price,proto3,customtype=Price" json:"price"`
+}
+
+func (m *EventOrderBookBatchAuctionCleared) Reset()         { *m = EventOrderBookBatchAuctionCleared{} }
+func (m *EventOrderBookBatchAuctionCleared) String() string { return proto.CompactTextString(m) }
+func (*EventOrderBookBatchAuctionCleared) ProtoMessage()    {}
+func (*EventOrderBookBatchAuctionCleared) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cecfe712f14d2a81, []int{12}
+}
+func (m *EventOrderBookBatchAuctionCleared) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderBookBatchAuctionCleared) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderBookBatchAuctionCleared.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderBookBatchAuctionCleared) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderBookBatchAuctionCleared.Merge(m, src)
+}
+func (m *EventOrderBookBatchAuctionCleared) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderBookBatchAuctionCleared) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderBookBatchAuctionCleared.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderBookBatchAuctionCleared proto.InternalMessageInfo
+
+func (m *EventOrderBookBatchAuctionCleared) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *EventOrderBookBatchAuctionCleared) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventOrderPlaced)(nil), "coreum.dex.v1.EventOrderPlaced")
+	proto.RegisterType((*EventOrderTriggered)(nil), "coreum.dex.v1.EventOrderTriggered")
+	proto.RegisterType((*EventOrderReduced)(nil), "coreum.dex.v1.EventOrderReduced")
+	proto.RegisterType((*EventOrderCreated)(nil), "coreum.dex.v1.EventOrderCreated")
+	proto.RegisterType((*EventOrderClosed)(nil), "coreum.dex.v1.EventOrderClosed")
+	proto.RegisterType((*EventOrderRefilled)(nil), "coreum.dex.v1.EventOrderRefilled")
+	proto.RegisterType((*EventOrderReplaced)(nil), "coreum.dex.v1.EventOrderReplaced")
+	proto.RegisterType((*EventOrderBookHalted)(nil), "coreum.dex.v1.EventOrderBookHalted")
+	proto.RegisterType((*EventOrderBookResumed)(nil), "coreum.dex.v1.EventOrderBookResumed")
+	proto.RegisterType((*EventOrderBookClosed)(nil), "coreum.dex.v1.EventOrderBookClosed")
+	proto.RegisterType((*EventOrderBookReopened)(nil), "coreum.dex.v1.EventOrderBookReopened")
+	proto.RegisterType((*EventOrderBookBatchAuctionUpdated)(nil), "coreum.dex.v1.EventOrderBookBatchAuctionUpdated")
+	proto.RegisterType((*EventOrderBookBatchAuctionCleared)(nil), "coreum.dex.v1.EventOrderBookBatchAuctionCleared")
+}
+
+func init() { proto.RegisterFile("coreum/dex/v1/event.proto", fileDescriptor_cecfe712f14d2a81) }
+
+var fileDescriptor_cecfe712f14d2a81 = []byte{
+	// 782 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x96, 0x4b, 0x6f, 0xeb, 0x44,
+	0x14, 0xc7, 0xe3, 0xdc, 0xbc, 0x3c, 0xb7, 0x95, 0xc0, 0xb4, 0xc5, 0x6d, 0xd5, 0xa4, 0x75, 0x17,
+	0x74, 0x43, 0xac, 0x0a, 0x89, 0x25, 0x12, 0x4e, 0x40, 0x0d, 0x54, 0x6a, 0x71, 0x1f, 0xe2, 0x21,
+	0x64, 0x26, 0x9e, 0x53, 0x67, 0x54, 0x67, 0x26, 0xb5, 0xc7, 0x51, 0xb3, 0x60, 0x8f, 0xc4, 0x06,
+	0x36, 0x80, 0xf8, 0x44, 0x5d, 0x76, 0x89, 0x58, 0x44, 0x28, 0xfd, 0x22, 0x68, 0xc6, 0x4e, 0x9c,
+	0x06, 0x09, 0x4a, 0x9b, 0xe5, 0x5d, 0x25, 0x3e, 0x33, 0xe7, 0x77, 0xfe, 0xf3, 0x1f, 0x9f, 0xf1,
+	0xa0, 0x4d, 0x9f, 0x47, 0x90, 0xf4, 0x6d, 0x02, 0xb7, 0xf6, 0xf0, 0xd0, 0x86, 0x21, 0x30, 0xd1,
+	0x1c, 0x44, 0x5c, 0x70, 0x63, 0x35, 0x1d, 0x6a, 0x12, 0xb8, 0x6d, 0x0e, 0x0f, 0xb7, 0xd6, 0x02,
+	0x1e, 0x70, 0x35, 0x62, 0xcb, 0x7f, 0xe9, 0x24, 0xeb, 0x3b, 0xf4, 0xd6, 0x27, 0x32, 0xe7, 0x24,
+	0x22, 0x10, 0x9d, 0x86, 0xd8, 0x07, 0x62, 0x98, 0xa8, 0xea, 0x47, 0x80, 0x05, 0x8f, 0x4c, 0x6d,
+	0x57, 0x3b, 0xd0, 0xdd, 0xe9, 0xa3, 0xb1, 0x81, 0x8a, 0x94, 0x98, 0x45, 0x19, 0x74, 0x2a, 0x93,
+	0x71, 0xa3, 0xd8, 0x69, 0xbb, 0x45, 0x4a, 0x8c, 0x2d, 0x54, 0x8b, 0xe1, 0x26, 0x01, 0xe6, 0x83,
+	0xf9, 0x6a, 0x57, 0x3b, 0x28, 0xb9, 0xb3, 0x67, 0xcb, 0x47, 0xef, 0xe4, 0x15, 0xce, 0x23, 0x1a,
+	0x04, 0x10, 0x2d, 0xbd, 0xc8, 0xcf, 0xaf, 0xd0, 0xdb, 0x79, 0x15, 0x17, 0x48, 0xb2, 0xf4, 0x85,
+	0x18, 0xc7, 0x48, 0x8f, 0x81, 0x09, 0xcf, 0xe7, 0x94, 0x99, 0x25, 0x95, 0x6a, 0xdf, 0x8d, 0x1b,
+	0x85, 0x3f, 0xc7, 0x8d, 0xf7, 0x02, 0x2a, 0x7a, 0x49, 0xb7, 0xe9, 0xf3, 0xbe, 0xed, 0xf3, 0xb8,
+	0xcf, 0xe3, 0xec, 0xe7, 0xfd, 0x98, 0x5c, 0xdb, 0x62, 0x34, 0x80, 0xb8, 0xd9, 0xe2, 0x94, 0x49,
+	0x1a, 0x13, 0xf2, 0x9f, 0x71, 0x8e, 0x56, 0x23, 0xf0, 0x81, 0x0e, 0x81, 0xa4, 0xc4, 0xf2, 0xf3,
+	0x88, 0x2b, 0x53, 0x8a, 0xa2, 0x7e, 0x86, 0x6a, 0x57, 0x00, 0x29, 0xb0, 0xf2, 0x3c, 0x60, 0xf5,
+	0x0a, 0x40, 0xb1, 0x3e, 0x4a, 0x59, 0x11, 0x16, 0x60, 0x56, 0x15, 0x6b, 0x3f, 0x63, 0x6d, 0xa7,
+	0x99, 0x31, 0xb9, 0x6e, 0x52, 0x6e, 0xf7, 0xb1, 0xe8, 0x35, 0x8f, 0x21, 0xc0, 0xfe, 0xa8, 0x0d,
+	0xbe, 0xca, 0x77, 0xb1, 0x00, 0xeb, 0xd7, 0xe2, 0xfc, 0x9e, 0xb4, 0xa4, 0xf3, 0x4b, 0xdf, 0x93,
+	0x0b, 0xf4, 0x6e, 0x04, 0x7d, 0x4c, 0x19, 0x65, 0x81, 0xd7, 0xc5, 0x31, 0x78, 0x37, 0x09, 0x66,
+	0x82, 0x8a, 0x51, 0xb6, 0x43, 0x3b, 0x99, 0xe4, 0xf5, 0x7f, 0x4a, 0xee, 0x30, 0xe1, 0xae, 0xcf,
+	0xb2, 0x1d, 0x1c, 0xc3, 0x17, 0x59, 0xae, 0xf1, 0x2d, 0xda, 0xce, 0xb1, 0xf1, 0x00, 0x18, 0xc1,
+	0xdd, 0x10, 0xbc, 0x2e, 0x0e, 0xb1, 0x54, 0x51, 0x7e, 0x0a, 0x7a, 0x73, 0x46, 0x38, 0x9b, 0x02,
+	0x9c, 0x34, 0xdf, 0xfa, 0xa5, 0x38, 0xdf, 0x75, 0xad, 0x90, 0xc7, 0x6f, 0x8c, 0x51, 0xc6, 0xfc,
+	0x5e, 0x44, 0xc6, 0x7c, 0x1b, 0x5f, 0xd1, 0x30, 0x5c, 0xba, 0x35, 0xdf, 0xa0, 0xad, 0x7c, 0x0d,
+	0x43, 0x1a, 0x53, 0xb9, 0x82, 0xff, 0xe7, 0x8e, 0x39, 0x03, 0x5c, 0xa6, 0xf9, 0x33, 0x83, 0xbe,
+	0x42, 0xf9, 0xf2, 0xbc, 0x1e, 0x25, 0x04, 0x58, 0xce, 0x7e, 0x92, 0x3d, 0xf9, 0xbe, 0x1d, 0xa9,
+	0xf4, 0x29, 0xda, 0xfa, 0x51, 0x7b, 0x6c, 0xce, 0xe0, 0xbf, 0x4e, 0xeb, 0x5d, 0x54, 0xe1, 0x21,
+	0xf1, 0x66, 0x06, 0xe9, 0x93, 0x71, 0xa3, 0x7c, 0x12, 0x92, 0x4e, 0xdb, 0x2d, 0xf3, 0x90, 0x74,
+	0x88, 0xb1, 0x87, 0x56, 0xe4, 0x8c, 0x05, 0xab, 0x5e, 0xf3, 0x90, 0x9c, 0x4d, 0xdd, 0x4a, 0x1d,
+	0x2e, 0x2d, 0x3a, 0x6c, 0xfd, 0xa6, 0xa1, 0xb5, 0x5c, 0x8d, 0xc3, 0xf9, 0xf5, 0x11, 0x0e, 0x65,
+	0x83, 0xef, 0x20, 0xa4, 0xde, 0x37, 0x02, 0x8c, 0xf7, 0x33, 0x49, 0xba, 0x8c, 0xb4, 0x65, 0xc0,
+	0x68, 0xa0, 0xd7, 0x37, 0x09, 0x17, 0xd3, 0x71, 0xa5, 0xcc, 0x45, 0x2a, 0x94, 0x4e, 0xd8, 0x46,
+	0x7a, 0x4f, 0x91, 0xbc, 0xee, 0x48, 0x09, 0xd2, 0xdd, 0x5a, 0x1a, 0x70, 0x46, 0xc6, 0xbe, 0x3c,
+	0x35, 0xe3, 0xa4, 0x0f, 0x5e, 0x0f, 0x68, 0xd0, 0x13, 0x4a, 0x58, 0x49, 0x1e, 0x82, 0x32, 0x78,
+	0xa4, 0x62, 0xd6, 0x10, 0xad, 0x3f, 0x56, 0xe6, 0xaa, 0xd1, 0x97, 0x4b, 0xdb, 0x41, 0x28, 0x2d,
+	0x34, 0xa7, 0x4d, 0xcf, 0x22, 0xce, 0xc8, 0xba, 0x5c, 0x74, 0x24, 0xeb, 0xec, 0x17, 0x96, 0xb5,
+	0xbe, 0x44, 0x1b, 0x8b, 0xeb, 0xe1, 0x03, 0x60, 0x4b, 0x20, 0x7f, 0x8f, 0xf6, 0x1e, 0x93, 0x1d,
+	0x2c, 0xfc, 0xde, 0xc7, 0x89, 0x2f, 0x28, 0x67, 0x17, 0x03, 0x82, 0x97, 0xb1, 0xa1, 0x26, 0xaa,
+	0x02, 0x93, 0x5d, 0x4e, 0x94, 0x65, 0x35, 0x77, 0xfa, 0x68, 0xfd, 0xa0, 0xfd, 0x5b, 0xfd, 0x56,
+	0x08, 0x38, 0x5a, 0x42, 0xfd, 0x7d, 0x54, 0x1e, 0x44, 0x34, 0x7b, 0xbb, 0x75, 0x67, 0x35, 0x6b,
+	0xbf, 0xf2, 0xa9, 0x0c, 0xba, 0xe9, 0x98, 0xf3, 0xf9, 0xdd, 0xa4, 0xae, 0xdd, 0x4f, 0xea, 0xda,
+	0x5f, 0x93, 0xba, 0xf6, 0xd3, 0x43, 0xbd, 0x70, 0xff, 0x50, 0x2f, 0xfc, 0xf1, 0x50, 0x2f, 0x7c,
+	0x7d, 0x38, 0xf7, 0xe1, 0x6c, 0xa9, 0x1b, 0xd5, 0xa7, 0x3c, 0x61, 0x04, 0x4b, 0x89, 0x76, 0x76,
+	0xfb, 0x1a, 0x7e, 0x68, 0xdf, 0xaa, 0x2b, 0x98, 0xfa, 0x8e, 0x76, 0x2b, 0xea, 0x6e, 0xf5, 0xc1,
+	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x45, 0x30, 0x0c, 0x99, 0x9d, 0x09, 0x00, 0x00,
+}
+
+func (m *EventOrderPlaced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderPlaced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderPlaced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Sequence != 0 {
 		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
 		i--
@@ -499,7 +989,7 @@ func (m *EventOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderClosed) Marshal() (dAtA []byte, err error) {
+func (m *EventOrderTriggered) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -509,36 +999,16 @@ func (m *EventOrderClosed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOrderClosed) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventOrderTriggered) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOrderClosed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventOrderTriggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.RemainingSpendableBalance.Size()
-		i -= size
-		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.RemainingBaseQuantity.Size()
-		i -= size
-		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
 	if m.Sequence != 0 {
 		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
 		i--
@@ -561,116 +1031,2187 @@ func (m *EventOrderClosed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventOrderReduced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventOrderPlaced) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+
+func (m *EventOrderReduced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderReduced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeRate.Size()
+		i -= size
+		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FeeCoin.Size()
+		i -= size
+		if _, err := m.FeeCoin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ReceivedCoin.Size()
+		i -= size
+		if _, err := m.ReceivedCoin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SentCoin.Size()
+		i -= size
+		if _, err := m.SentCoin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if m.Sequence != 0 {
-		n += 1 + sovEvent(uint64(m.Sequence))
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderReduced) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventOrderCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	{
+		size := m.RemainingSpendableBalance.Size()
+		i -= size
+		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RemainingBaseQuantity.Size()
+		i -= size
+		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if m.Sequence != 0 {
-		n += 1 + sovEvent(uint64(m.Sequence))
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderClosed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderClosed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderClosed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingSpendableBalance.Size()
+		i -= size
+		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RemainingBaseQuantity.Size()
+		i -= size
+		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderRefilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderRefilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderRefilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingHiddenQuantity.Size()
+		i -= size
+		if _, err := m.RemainingHiddenQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RemainingVisibleQuantity.Size()
+		i -= size
+		if _, err := m.RemainingVisibleQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderReplaced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderReplaced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderReplaced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OldSequence != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.OldSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OldID) > 0 {
+		i -= len(m.OldID)
+		copy(dAtA[i:], m.OldID)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OldID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderBookHalted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderBookHalted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderBookHalted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResumeHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ResumeHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.HaltedBy) > 0 {
+		i -= len(m.HaltedBy)
+		copy(dAtA[i:], m.HaltedBy)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.HaltedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderBookResumed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderBookResumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderBookResumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResumedBy) > 0 {
+		i -= len(m.ResumedBy)
+		copy(dAtA[i:], m.ResumedBy)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ResumedBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderBookClosed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderBookClosed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderBookClosed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderBookReopened) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderBookReopened) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderBookReopened) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderBookBatchAuctionUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderBookBatchAuctionUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderBookBatchAuctionUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderBookBatchAuctionCleared) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderBookBatchAuctionCleared) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderBookBatchAuctionCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventOrderPlaced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *EventOrderTriggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *EventOrderReduced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = m.SentCoin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ReceivedCoin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FeeCoin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FeeRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventOrderCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RemainingSpendableBalance.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventOrderClosed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RemainingSpendableBalance.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventOrderRefilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvent(uint64(m.Sequence))
+	}
+	l = m.RemainingVisibleQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RemainingHiddenQuantity.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventOrderReplaced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.OldID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.OldSequence != 0 {
+		n += 1 + sovEvent(uint64(m.OldSequence))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderBookHalted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.HaltedBy)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ResumeHeight != 0 {
+		n += 1 + sovEvent(uint64(m.ResumeHeight))
+	}
+	return n
+}
+
+func (m *EventOrderBookResumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.ResumedBy)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderBookClosed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderBookReopened) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderBookBatchAuctionUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventOrderBookBatchAuctionCleared) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderPlaced: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderPlaced: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderTriggered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderTriggered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderTriggered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderReduced: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderReduced: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SentCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderClosed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderClosed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderRefilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderRefilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderRefilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingVisibleQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingVisibleQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingHiddenQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingHiddenQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.SentCoin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ReceivedCoin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
 
-func (m *EventOrderCreated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovEvent(uint64(m.Sequence))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.RemainingBaseQuantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.RemainingSpendableBalance.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return nil
 }
-
-func (m *EventOrderClosed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Sequence != 0 {
-		n += 1 + sovEvent(uint64(m.Sequence))
+func (m *EventOrderReplaced) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderReplaced: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderReplaced: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldSequence", wireType)
+			}
+			m.OldSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.RemainingBaseQuantity.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.RemainingSpendableBalance.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
+func (m *EventOrderBookHalted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -693,15 +3234,47 @@ func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderPlaced: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderBookHalted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderPlaced: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderBookHalted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -729,11 +3302,11 @@ func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -761,13 +3334,13 @@ func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.HaltedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeHeight", wireType)
 			}
-			m.Sequence = 0
+			m.ResumeHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -777,7 +3350,7 @@ func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				m.ResumeHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -803,7 +3376,7 @@ func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
+func (m *EventOrderBookResumed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -826,15 +3399,15 @@ func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderReduced: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderBookResumed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderReduced: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderBookResumed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -862,11 +3435,11 @@ func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -894,64 +3467,11 @@ func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
-			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SentCoin", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SentCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -979,9 +3499,7 @@ func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ResumedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1004,7 +3522,7 @@ func (m *EventOrderReduced) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
+func (m *EventOrderBookClosed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1027,15 +3545,15 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderBookClosed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderBookClosed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1063,11 +3581,11 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1095,30 +3613,61 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Sequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
 			}
-		case 4:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderBookReopened) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderBookReopened: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderBookReopened: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1146,13 +3695,11 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1180,9 +3727,7 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1205,7 +3750,7 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
+func (m *EventOrderBookBatchAuctionUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1228,15 +3773,15 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderClosed: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderBookBatchAuctionUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderClosed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderBookBatchAuctionUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1264,11 +3809,11 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1296,13 +3841,13 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			m.Sequence = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1312,14 +3857,65 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Sequence |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderBookBatchAuctionCleared) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderBookBatchAuctionCleared: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderBookBatchAuctionCleared: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1347,13 +3943,43 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1381,7 +4007,7 @@ func (m *EventOrderClosed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
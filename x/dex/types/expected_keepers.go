@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -14,6 +15,7 @@ import (
 // AccountKeeper defines the expected account keeper interface.
 type AccountKeeper interface {
 	GetAccount(ctx context.Context, address sdk.AccAddress) sdk.AccountI
+	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
 }
 
 // AccountQueryServer defines the expected account query server interface.
@@ -23,14 +25,36 @@ type AccountQueryServer interface {
 	) (*authtypes.QueryAccountAddressByIDResponse, error)
 }
 
+// BankKeeper defines the expected bank keeper interface.
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoinsFromModuleToAccount(
+		ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+	) error
+}
+
 // AssetFTKeeper represents required methods of asset ft keeper.
 type AssetFTKeeper interface {
+	GetToken(ctx sdk.Context, denom string) (dextypes.Token, error)
+	GetIssuerTokens(
+		ctx sdk.Context, issuer sdk.AccAddress, pagination *query.PageRequest,
+	) ([]dextypes.Token, *query.PageResponse, error)
 	DEXExecuteActions(ctx sdk.Context, actions dextypes.DEXActions) error
-	DEXDecreaseLimits(ctx sdk.Context, addr sdk.AccAddress, lockedCoin sdk.Coins, expectedToReceiveCoin sdk.Coin) error
+	DEXDecreaseLimits(
+		ctx sdk.Context, addr sdk.AccAddress, lockedCoin sdk.Coins, expectedToReceiveCoin sdk.Coin,
+	) error
 	GetSpendableBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error)
 	GetDEXSettings(ctx sdk.Context, denom string) (dextypes.DEXSettings, error)
 	ValidateDEXCancelOrdersByDenomIsAllowed(ctx sdk.Context, addr sdk.AccAddress, denom string) error
+	ValidateDEXOrderBookHaltIsAllowed(ctx sdk.Context, addr sdk.AccAddress, denom string) error
 	HasSupply(ctx context.Context, denom string) bool
+	GetAccountsDEXLockedBalances(
+		ctx sdk.Context, pagination *query.PageRequest,
+	) ([]dextypes.Balance, *query.PageResponse, error)
+	GetAccountsDEXExpectedToReceiveBalances(
+		ctx sdk.Context, pagination *query.PageRequest,
+	) ([]dextypes.Balance, *query.PageResponse, error)
+	ShouldRecordDEXExpectedToReceiveBalance(ctx sdk.Context, denom string) (bool, error)
 }
 
 // DelayKeeper defines methods required from the delay keeper.
@@ -19,16 +19,149 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 	denoms := make(map[string]struct{})
+	orderBookIDs := make(map[uint32]struct{})
+	orderBookDenoms := make(map[[2]string]struct{})
 	for _, ob := range gs.OrderBooks {
 		denoms[ob.Data.BaseDenom] = struct{}{}
 		denoms[ob.Data.QuoteDenom] = struct{}{}
+		orderBookIDs[ob.ID] = struct{}{}
+		orderBookDenoms[[2]string{ob.Data.BaseDenom, ob.Data.QuoteDenom}] = struct{}{}
+	}
+	usedLastPriceOrderBookIDs := make(map[uint32]struct{})
+	for _, obLastPrice := range gs.OrderBookLastPrices {
+		if _, ok := orderBookIDs[obLastPrice.OrderBookID]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "order book %d does not exist", obLastPrice.OrderBookID)
+		}
+		if _, ok := usedLastPriceOrderBookIDs[obLastPrice.OrderBookID]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate order book %d last price", obLastPrice.OrderBookID)
+		}
+		usedLastPriceOrderBookIDs[obLastPrice.OrderBookID] = struct{}{}
+	}
+	type tradeKey struct {
+		baseDenom, quoteDenom string
+		sequence              uint64
+	}
+	usedTrades := make(map[tradeKey]struct{})
+	for _, trade := range gs.Trades {
+		if err := trade.Validate(); err != nil {
+			return err
+		}
+		if _, ok := orderBookDenoms[[2]string{trade.BaseDenom, trade.QuoteDenom}]; !ok {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "order book %s/%s of the trade does not exist", trade.BaseDenom, trade.QuoteDenom,
+			)
+		}
+		key := tradeKey{baseDenom: trade.BaseDenom, quoteDenom: trade.QuoteDenom, sequence: trade.Sequence}
+		if _, ok := usedTrades[key]; ok {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput,
+				"duplicate order book %s/%s trade sequence %d", trade.BaseDenom, trade.QuoteDenom, trade.Sequence,
+			)
+		}
+		usedTrades[key] = struct{}{}
+	}
+	type candleKey struct {
+		baseDenom, quoteDenom string
+		interval              CandleInterval
+		startTime             int64
+	}
+	usedCandles := make(map[candleKey]struct{})
+	for _, candle := range gs.Candles {
+		if err := candle.Validate(); err != nil {
+			return err
+		}
+		if _, ok := orderBookDenoms[[2]string{candle.BaseDenom, candle.QuoteDenom}]; !ok {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "order book %s/%s of the candle does not exist", candle.BaseDenom, candle.QuoteDenom,
+			)
+		}
+		key := candleKey{
+			baseDenom:  candle.BaseDenom,
+			quoteDenom: candle.QuoteDenom,
+			interval:   candle.Interval,
+			startTime:  candle.StartTime.Unix(),
+		}
+		if _, ok := usedCandles[key]; ok {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput,
+				"duplicate order book %s/%s %s candle %s",
+				candle.BaseDenom, candle.QuoteDenom, candle.Interval, candle.StartTime,
+			)
+		}
+		usedCandles[key] = struct{}{}
+	}
+	usedFeeRatesOrderBookIDs := make(map[uint32]struct{})
+	for _, obFeeRates := range gs.OrderBooksFeeRates {
+		if _, ok := orderBookIDs[obFeeRates.OrderBookID]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "order book %d does not exist", obFeeRates.OrderBookID)
+		}
+		if _, ok := usedFeeRatesOrderBookIDs[obFeeRates.OrderBookID]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate order book %d fee rates", obFeeRates.OrderBookID)
+		}
+		usedFeeRatesOrderBookIDs[obFeeRates.OrderBookID] = struct{}{}
+
+		if err := obFeeRates.FeeRates.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := gs.AccumulatedFees.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid accumulated fees: %s", err)
+	}
+	usedCancelAllAfterAccounts := make(map[string]struct{})
+	for _, cancelAllAfter := range gs.CancelAllAfters {
+		if _, ok := usedCancelAllAfterAccounts[cancelAllAfter.Account]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate account %s cancel all after", cancelAllAfter.Account)
+		}
+		usedCancelAllAfterAccounts[cancelAllAfter.Account] = struct{}{}
+
+		if err := cancelAllAfter.Validate(); err != nil {
+			return err
+		}
+	}
+	usedHaltOrderBookIDs := make(map[uint32]struct{})
+	for _, obHalt := range gs.OrderBookHalts {
+		if _, ok := orderBookIDs[obHalt.OrderBookID]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "order book %d does not exist", obHalt.OrderBookID)
+		}
+		if _, ok := usedHaltOrderBookIDs[obHalt.OrderBookID]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate order book %d halt", obHalt.OrderBookID)
+		}
+		usedHaltOrderBookIDs[obHalt.OrderBookID] = struct{}{}
+
+		if err := obHalt.Halt.Validate(); err != nil {
+			return err
+		}
+	}
+	usedClosedOrderBookIDs := make(map[uint32]struct{})
+	for _, orderBookID := range gs.ClosedOrderBookIDs {
+		if _, ok := orderBookIDs[orderBookID]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "order book %d does not exist", orderBookID)
+		}
+		if _, ok := usedClosedOrderBookIDs[orderBookID]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate closed order book %d", orderBookID)
+		}
+		usedClosedOrderBookIDs[orderBookID] = struct{}{}
+	}
+	usedBatchAuctionOrderBookIDs := make(map[uint32]struct{})
+	for _, orderBookID := range gs.BatchAuctionOrderBookIDs {
+		if _, ok := orderBookIDs[orderBookID]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "order book %d does not exist", orderBookID)
+		}
+		if _, ok := usedBatchAuctionOrderBookIDs[orderBookID]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate batch auction order book %d", orderBookID)
+		}
+		usedBatchAuctionOrderBookIDs[orderBookID] = struct{}{}
 	}
 	usedSequence := make(map[uint64]struct{})
+	triggerOrderSequences := make(map[uint64]struct{})
 	for _, order := range gs.Orders {
 		if _, ok := usedSequence[order.Sequence]; ok {
 			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate order sequence %d", order.Sequence)
 		}
 		usedSequence[order.Sequence] = struct{}{}
+		if order.Trigger != nil {
+			triggerOrderSequences[order.Sequence] = struct{}{}
+		}
 
 		if _, ok := denoms[order.BaseDenom]; !ok {
 			return sdkerrors.Wrapf(ErrInvalidInput, "base denom %s does not exist in order books", order.BaseDenom)
@@ -46,6 +179,19 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 	}
+	usedBatchAuctionOrderSequences := make(map[uint64]struct{})
+	for _, orderSequence := range gs.BatchAuctionOrderSequences {
+		if _, ok := usedSequence[orderSequence]; !ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "batch auction order %d does not exist", orderSequence)
+		}
+		if _, ok := triggerOrderSequences[orderSequence]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "batch auction order %d is a trigger order", orderSequence)
+		}
+		if _, ok := usedBatchAuctionOrderSequences[orderSequence]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicate batch auction order %d", orderSequence)
+		}
+		usedBatchAuctionOrderSequences[orderSequence] = struct{}{}
+	}
 
 	return nil
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -33,6 +35,27 @@ type GenesisState struct {
 	OrderSequence              uint64                    `protobuf:"varint,4,opt,name=order_sequence,json=orderSequence,proto3" json:"order_sequence,omitempty"`
 	AccountsDenomsOrdersCounts []AccountDenomOrdersCount `protobuf:"bytes,5,rep,name=accounts_denoms_orders_counts,json=accountsDenomsOrdersCounts,proto3" json:"accounts_denoms_orders_counts"`
 	ReservedOrderIds           [][]byte                  `protobuf:"bytes,6,rep,name=reserved_order_ids,json=reservedOrderIds,proto3" json:"reserved_order_ids,omitempty"`
+	// order_book_last_prices is the list of the order books last trade prices the trigger orders are activated by.
+	OrderBookLastPrices []OrderBookLastPriceWithID `protobuf:"bytes,7,rep,name=order_book_last_prices,json=orderBookLastPrices,proto3" json:"order_book_last_prices"`
+	// trades is the list of the order books recent trades.
+	Trades []Trade `protobuf:"bytes,8,rep,name=trades,proto3" json:"trades"`
+	// candles is the list of the order books candles.
+	Candles []Candle `protobuf:"bytes,9,rep,name=candles,proto3" json:"candles"`
+	// order_books_fee_rates is the list of the order books fee rates overriding the default fee rates.
+	OrderBooksFeeRates []OrderBookFeeRatesWithID `protobuf:"bytes,10,rep,name=order_books_fee_rates,json=orderBooksFeeRates,proto3" json:"order_books_fee_rates"`
+	// accumulated_fees is the total amount of the fees charged by the DEX.
+	AccumulatedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=accumulated_fees,json=accumulatedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accumulated_fees"`
+	// cancel_all_afters is the list of the scheduled cancellations of the account orders.
+	CancelAllAfters []CancelAllAfter `protobuf:"bytes,12,rep,name=cancel_all_afters,json=cancelAllAfters,proto3" json:"cancel_all_afters"`
+	// order_book_halts is the list of the order books trading halts.
+	OrderBookHalts []OrderBookHaltWithID `protobuf:"bytes,13,rep,name=order_book_halts,json=orderBookHalts,proto3" json:"order_book_halts"`
+	// closed_order_book_ids is the list of the closed order books IDs.
+	ClosedOrderBookIDs []uint32 `protobuf:"varint,14,rep,packed,name=closed_order_book_ids,json=closedOrderBookIds,proto3" json:"closed_order_book_ids,omitempty"`
+	// batch_auction_order_book_ids is the list of the order books IDs in the batch auction mode.
+	BatchAuctionOrderBookIDs []uint32 `protobuf:"varint,15,rep,packed,name=batch_auction_order_book_ids,json=batchAuctionOrderBookIds,proto3" json:"batch_auction_order_book_ids,omitempty"`
+	// batch_auction_order_sequences is the list of the sequences of the orders queued by the batch auctions and pending
+	// for the clearing.
+	BatchAuctionOrderSequences []uint64 `protobuf:"varint,16,rep,packed,name=batch_auction_order_sequences,json=batchAuctionOrderSequences,proto3" json:"batch_auction_order_sequences,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +133,76 @@ func (m *GenesisState) GetReservedOrderIds() [][]byte {
 	return nil
 }
 
+func (m *GenesisState) GetOrderBookLastPrices() []OrderBookLastPriceWithID {
+	if m != nil {
+		return m.OrderBookLastPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrderBooksFeeRates() []OrderBookFeeRatesWithID {
+	if m != nil {
+		return m.OrderBooksFeeRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccumulatedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AccumulatedFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCancelAllAfters() []CancelAllAfter {
+	if m != nil {
+		return m.CancelAllAfters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOrderBookHalts() []OrderBookHaltWithID {
+	if m != nil {
+		return m.OrderBookHalts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClosedOrderBookIDs() []uint32 {
+	if m != nil {
+		return m.ClosedOrderBookIDs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatchAuctionOrderBookIDs() []uint32 {
+	if m != nil {
+		return m.BatchAuctionOrderBookIDs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatchAuctionOrderSequences() []uint64 {
+	if m != nil {
+		return m.BatchAuctionOrderSequences
+	}
+	return nil
+}
+
 // OrderBookDataWithID is a order book data with it's corresponding ID.
 type OrderBookDataWithID struct {
 	// id is order book ID.
@@ -165,6 +258,164 @@ func (m *OrderBookDataWithID) GetData() OrderBookData {
 	return OrderBookData{}
 }
 
+// OrderBookLastPriceWithID is a order book last trade price with it's corresponding order book ID.
+type OrderBookLastPriceWithID struct {
+	// order_book_id is order book ID.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// price is order book last trade price.
+	Price Price `protobuf:"bytes,2,opt,name=price,proto3,customtype=Price" json:"price"`
+}
+
+func (m *OrderBookLastPriceWithID) Reset()         { *m = OrderBookLastPriceWithID{} }
+func (m *OrderBookLastPriceWithID) String() string { return proto.CompactTextString(m) }
+func (*OrderBookLastPriceWithID) ProtoMessage()    {}
+func (*OrderBookLastPriceWithID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d24a0566883c25, []int{2}
+}
+func (m *OrderBookLastPriceWithID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookLastPriceWithID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookLastPriceWithID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookLastPriceWithID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookLastPriceWithID.Merge(m, src)
+}
+func (m *OrderBookLastPriceWithID) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookLastPriceWithID) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookLastPriceWithID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookLastPriceWithID proto.InternalMessageInfo
+
+func (m *OrderBookLastPriceWithID) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
+}
+
+// OrderBookFeeRatesWithID is a order book fee rates with it's corresponding order book ID.
+type OrderBookFeeRatesWithID struct {
+	// order_book_id is order book ID.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// fee_rates is order book fee rates.
+	FeeRates OrderBookFeeRates `protobuf:"bytes,2,opt,name=fee_rates,json=feeRates,proto3" json:"fee_rates"`
+}
+
+func (m *OrderBookFeeRatesWithID) Reset()         { *m = OrderBookFeeRatesWithID{} }
+func (m *OrderBookFeeRatesWithID) String() string { return proto.CompactTextString(m) }
+func (*OrderBookFeeRatesWithID) ProtoMessage()    {}
+func (*OrderBookFeeRatesWithID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d24a0566883c25, []int{3}
+}
+func (m *OrderBookFeeRatesWithID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookFeeRatesWithID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookFeeRatesWithID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookFeeRatesWithID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookFeeRatesWithID.Merge(m, src)
+}
+func (m *OrderBookFeeRatesWithID) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookFeeRatesWithID) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookFeeRatesWithID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookFeeRatesWithID proto.InternalMessageInfo
+
+func (m *OrderBookFeeRatesWithID) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
+}
+
+func (m *OrderBookFeeRatesWithID) GetFeeRates() OrderBookFeeRates {
+	if m != nil {
+		return m.FeeRates
+	}
+	return OrderBookFeeRates{}
+}
+
+// OrderBookHaltWithID is a order book halt with it's corresponding order book ID.
+type OrderBookHaltWithID struct {
+	// order_book_id is order book ID.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// halt is order book halt.
+	Halt OrderBookHalt `protobuf:"bytes,2,opt,name=halt,proto3" json:"halt"`
+}
+
+func (m *OrderBookHaltWithID) Reset()         { *m = OrderBookHaltWithID{} }
+func (m *OrderBookHaltWithID) String() string { return proto.CompactTextString(m) }
+func (*OrderBookHaltWithID) ProtoMessage()    {}
+func (*OrderBookHaltWithID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a9d24a0566883c25, []int{4}
+}
+func (m *OrderBookHaltWithID) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookHaltWithID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookHaltWithID.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookHaltWithID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookHaltWithID.Merge(m, src)
+}
+func (m *OrderBookHaltWithID) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookHaltWithID) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookHaltWithID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookHaltWithID proto.InternalMessageInfo
+
+func (m *OrderBookHaltWithID) GetOrderBookID() uint32 {
+	if m != nil {
+		return m.OrderBookID
+	}
+	return 0
+}
+
+func (m *OrderBookHaltWithID) GetHalt() OrderBookHalt {
+	if m != nil {
+		return m.Halt
+	}
+	return OrderBookHalt{}
+}
+
 // AccountDenomOrderCount is a count of orders per account and denom.
 type AccountDenomOrdersCount struct {
 	AccountNumber uint64 `protobuf:"varint,1,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
@@ -176,7 +427,7 @@ func (m *AccountDenomOrdersCount) Reset()         { *m = AccountDenomOrdersCount
 func (m *AccountDenomOrdersCount) String() string { return proto.CompactTextString(m) }
 func (*AccountDenomOrdersCount) ProtoMessage()    {}
 func (*AccountDenomOrdersCount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a9d24a0566883c25, []int{2}
+	return fileDescriptor_a9d24a0566883c25, []int{5}
 }
 func (m *AccountDenomOrdersCount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,43 +480,74 @@ func (m *AccountDenomOrdersCount) GetOrdersCount() uint64 {
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.dex.v1.GenesisState")
 	proto.RegisterType((*OrderBookDataWithID)(nil), "coreum.dex.v1.OrderBookDataWithID")
+	proto.RegisterType((*OrderBookLastPriceWithID)(nil), "coreum.dex.v1.OrderBookLastPriceWithID")
+	proto.RegisterType((*OrderBookFeeRatesWithID)(nil), "coreum.dex.v1.OrderBookFeeRatesWithID")
+	proto.RegisterType((*OrderBookHaltWithID)(nil), "coreum.dex.v1.OrderBookHaltWithID")
 	proto.RegisterType((*AccountDenomOrdersCount)(nil), "coreum.dex.v1.AccountDenomOrdersCount")
 }
 
 func init() { proto.RegisterFile("coreum/dex/v1/genesis.proto", fileDescriptor_a9d24a0566883c25) }
 
 var fileDescriptor_a9d24a0566883c25 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4f, 0x8b, 0xd3, 0x40,
-	0x14, 0x6f, 0xfe, 0x6c, 0xc0, 0x69, 0x2b, 0x32, 0x56, 0x8d, 0x51, 0xb3, 0xb5, 0xa0, 0xf4, 0x20,
-	0x09, 0xed, 0xc2, 0xde, 0xed, 0x16, 0xa5, 0x08, 0x2a, 0xd9, 0x83, 0xe0, 0x25, 0x4c, 0x33, 0x43,
-	0x37, 0x2c, 0xc9, 0xab, 0x99, 0x49, 0xe9, 0x7e, 0x03, 0x8f, 0x7e, 0xac, 0x3d, 0xee, 0xd1, 0xd3,
-	0x22, 0xed, 0x17, 0x91, 0xbc, 0x99, 0xc2, 0x76, 0xa9, 0xde, 0x66, 0xde, 0xfb, 0xfd, 0x99, 0xf7,
-	0x9b, 0x47, 0x5e, 0x64, 0x50, 0x89, 0xba, 0x88, 0xb9, 0x58, 0xc7, 0xab, 0x51, 0xbc, 0x10, 0xa5,
-	0x90, 0xb9, 0x8c, 0x96, 0x15, 0x28, 0xa0, 0x5d, 0xdd, 0x8c, 0xb8, 0x58, 0x47, 0xab, 0x51, 0xf0,
-	0x7c, 0x1f, 0x0b, 0x15, 0x17, 0x95, 0x46, 0x06, 0xc1, 0x7e, 0x6b, 0xc9, 0x2a, 0x56, 0x18, 0x95,
-	0xa0, 0xb7, 0x80, 0x05, 0xe0, 0x31, 0x6e, 0x4e, 0xba, 0x3a, 0xf8, 0xe9, 0x90, 0xce, 0x47, 0xed,
-	0x76, 0xae, 0x98, 0x12, 0xf4, 0x84, 0x78, 0x9a, 0xe6, 0x5b, 0x7d, 0x6b, 0xd8, 0x1e, 0x3f, 0x89,
-	0xf6, 0xdc, 0xa3, 0xaf, 0xd8, 0x9c, 0xb8, 0xd7, 0xb7, 0xc7, 0xad, 0xc4, 0x40, 0xe9, 0x8c, 0xb4,
-	0xf1, 0x19, 0xe9, 0x1c, 0xe0, 0x52, 0xfa, 0x76, 0xdf, 0x19, 0xb6, 0xc7, 0x83, 0x7b, 0xcc, 0x2f,
-	0x0d, 0x62, 0x02, 0x70, 0x39, 0x65, 0x8a, 0x7d, 0xcb, 0xd5, 0xc5, 0x6c, 0x6a, 0x64, 0x08, 0xec,
-	0x5a, 0x92, 0x8e, 0x89, 0x87, 0x37, 0xe9, 0x3b, 0xa8, 0xd2, 0x3b, 0xa8, 0x62, 0xec, 0x35, 0x92,
-	0xbe, 0x21, 0x0f, 0xb5, 0xbd, 0x14, 0x3f, 0x6a, 0x51, 0x66, 0xc2, 0x77, 0xfb, 0xd6, 0xd0, 0x4d,
-	0xba, 0x58, 0x3d, 0x37, 0x45, 0x0a, 0xe4, 0x15, 0xcb, 0x32, 0xa8, 0x4b, 0x25, 0x53, 0x2e, 0x4a,
-	0x28, 0x64, 0xaa, 0x05, 0x52, 0x5d, 0xf4, 0x8f, 0xd0, 0xf1, 0xed, 0x3d, 0xc7, 0xf7, 0x9a, 0x33,
-	0x6d, 0x18, 0xe8, 0x2e, 0xcf, 0x9a, 0xbb, 0x79, 0x43, 0xb0, 0x93, 0xc4, 0xbe, 0xbc, 0x03, 0x90,
-	0xf4, 0x1d, 0xa1, 0x95, 0x90, 0xa2, 0x5a, 0x09, 0xae, 0x9d, 0xd2, 0x9c, 0x4b, 0xdf, 0xeb, 0x3b,
-	0xc3, 0x4e, 0xf2, 0x68, 0xd7, 0x41, 0xc6, 0x8c, 0xcb, 0x81, 0x20, 0x8f, 0x0f, 0x44, 0x44, 0x9f,
-	0x12, 0x3b, 0xe7, 0xf8, 0x19, 0xdd, 0x89, 0xb7, 0xb9, 0x3d, 0xb6, 0x67, 0xd3, 0xc4, 0xce, 0x39,
-	0x3d, 0x25, 0x2e, 0x67, 0x8a, 0xf9, 0x36, 0x7e, 0xd3, 0xcb, 0xff, 0x85, 0x6d, 0x9e, 0x8a, 0xf8,
-	0xc1, 0x15, 0x79, 0xf6, 0x8f, 0x89, 0x9a, 0x1c, 0xcd, 0x34, 0x69, 0x59, 0x17, 0x73, 0x51, 0xa1,
-	0xad, 0x9b, 0x74, 0x4d, 0xf5, 0x33, 0x16, 0x69, 0x8f, 0x1c, 0x61, 0x7c, 0x68, 0xfd, 0x20, 0xd1,
-	0x17, 0xfa, 0x9a, 0x74, 0xee, 0xa6, 0xe9, 0x3b, 0x48, 0xd5, 0x7b, 0x61, 0x12, 0xfb, 0x74, 0xbd,
-	0x09, 0xad, 0x9b, 0x4d, 0x68, 0xfd, 0xd9, 0x84, 0xd6, 0xaf, 0x6d, 0xd8, 0xba, 0xd9, 0x86, 0xad,
-	0xdf, 0xdb, 0xb0, 0xf5, 0x7d, 0xb4, 0xc8, 0xd5, 0x45, 0x3d, 0x8f, 0x32, 0x28, 0xe2, 0x33, 0x1c,
-	0xe4, 0x03, 0xd4, 0x25, 0x67, 0x2a, 0x87, 0x32, 0x36, 0x4b, 0xbd, 0x3a, 0x8d, 0xd7, 0xb8, 0xd9,
-	0xea, 0x6a, 0x29, 0xe4, 0xdc, 0xc3, 0x05, 0x3e, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x12,
-	0x37, 0x71, 0x3b, 0x03, 0x00, 0x00,
+	// 918 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0x8e, 0x63, 0xc7, 0xbb, 0x69, 0xc7, 0x49, 0xe8, 0x4d, 0xb2, 0xbd, 0x26, 0xb1, 0x8d, 0x11,
+	0xe0, 0x03, 0xcc, 0xe0, 0x44, 0xe4, 0xee, 0x1f, 0x05, 0x2c, 0x10, 0xbb, 0x9a, 0x45, 0x42, 0x42,
+	0x48, 0xa3, 0x76, 0x4f, 0x27, 0x19, 0x65, 0x3c, 0x1d, 0xa6, 0xda, 0x56, 0xf6, 0xca, 0x13, 0x20,
+	0xf1, 0x16, 0xbc, 0x05, 0xb7, 0x3d, 0xee, 0x11, 0x71, 0x30, 0xc8, 0x79, 0x91, 0x55, 0xff, 0x4c,
+	0x3c, 0xe3, 0x38, 0xbb, 0xd2, 0x9e, 0xec, 0xa9, 0xfa, 0xea, 0xab, 0xea, 0xaf, 0xab, 0xaa, 0xd1,
+	0xc7, 0x4c, 0x24, 0x7c, 0x32, 0x76, 0x03, 0x7e, 0xe3, 0x4e, 0x3b, 0xee, 0x05, 0x8f, 0x39, 0x84,
+	0xe0, 0x5c, 0x27, 0x42, 0x0a, 0x5c, 0x35, 0x4e, 0x27, 0xe0, 0x37, 0xce, 0xb4, 0x53, 0x7b, 0x96,
+	0xc7, 0x8a, 0x24, 0xe0, 0x89, 0x41, 0xd6, 0x6a, 0x79, 0xd7, 0x35, 0x4d, 0xe8, 0xd8, 0xb2, 0x2c,
+	0x87, 0xc9, 0x84, 0x06, 0xdc, 0xba, 0xea, 0x4c, 0xc0, 0x58, 0x80, 0x3b, 0xa2, 0xc0, 0xdd, 0x69,
+	0x67, 0xc4, 0x25, 0xed, 0xb8, 0x4c, 0x84, 0xb1, 0xf5, 0xef, 0x5d, 0x88, 0x0b, 0xa1, 0xff, 0xba,
+	0xea, 0x9f, 0xb1, 0xb6, 0xfe, 0xde, 0x44, 0x5b, 0xdf, 0x9a, 0x42, 0x5f, 0x4a, 0x2a, 0x39, 0x3e,
+	0x41, 0x65, 0x93, 0x91, 0x14, 0x9a, 0x85, 0x76, 0xe5, 0x78, 0xdf, 0xc9, 0x15, 0xee, 0xbc, 0xd0,
+	0xce, 0x5e, 0xe9, 0xf5, 0xac, 0xb1, 0xe6, 0x59, 0x28, 0x1e, 0xa2, 0x8a, 0x3e, 0x81, 0x3f, 0x12,
+	0xe2, 0x0a, 0xc8, 0x7a, 0xb3, 0xd8, 0xae, 0x1c, 0xb7, 0x96, 0x22, 0x9f, 0x2b, 0x44, 0x4f, 0x88,
+	0xab, 0x01, 0x95, 0xf4, 0xe7, 0x50, 0x5e, 0x0e, 0x07, 0x96, 0x06, 0x89, 0xd4, 0x05, 0xf8, 0x18,
+	0x95, 0xf5, 0x17, 0x90, 0xa2, 0x66, 0xd9, 0x5b, 0xc9, 0x62, 0xd3, 0x1b, 0x24, 0xfe, 0x0c, 0x6d,
+	0x9b, 0xf4, 0xc0, 0x7f, 0x9b, 0xf0, 0x98, 0x71, 0x52, 0x6a, 0x16, 0xda, 0x25, 0xaf, 0xaa, 0xad,
+	0x2f, 0xad, 0x11, 0x0b, 0x74, 0x44, 0x19, 0x13, 0x93, 0x58, 0x82, 0x1f, 0xf0, 0x58, 0x8c, 0xc1,
+	0x37, 0x04, 0xbe, 0x31, 0x92, 0x0d, 0x9d, 0xf1, 0xf3, 0xa5, 0x8c, 0x5d, 0x13, 0x33, 0x50, 0x11,
+	0x3a, 0x3b, 0xf4, 0xd5, 0xb7, 0xad, 0xa1, 0x96, 0x52, 0x6a, 0x3f, 0x64, 0x00, 0x80, 0xbf, 0x44,
+	0x38, 0xe1, 0xc0, 0x93, 0x29, 0x0f, 0x4c, 0x26, 0x3f, 0x0c, 0x80, 0x94, 0x9b, 0xc5, 0xf6, 0x96,
+	0xb7, 0x9b, 0x7a, 0x74, 0xc4, 0x30, 0x00, 0x3c, 0x42, 0x07, 0x0b, 0x11, 0xfd, 0x88, 0x82, 0xf4,
+	0xaf, 0x93, 0x90, 0x71, 0x20, 0x8f, 0x74, 0x5d, 0x5f, 0x3c, 0xa4, 0xe7, 0x0f, 0x14, 0xe4, 0x0b,
+	0x85, 0xcc, 0x89, 0xfa, 0x44, 0xdc, 0xf3, 0x6b, 0x75, 0x75, 0xcf, 0x00, 0x79, 0xbc, 0x52, 0xdd,
+	0x9f, 0x94, 0x33, 0x55, 0xd7, 0x20, 0xf1, 0x37, 0xe8, 0x11, 0xa3, 0x71, 0x10, 0x71, 0x20, 0x9b,
+	0x3a, 0x68, 0xb9, 0x25, 0xfa, 0xda, 0x6b, 0xa3, 0x52, 0x2c, 0xf6, 0xd1, 0x7e, 0xa6, 0x27, 0xfc,
+	0x73, 0xce, 0xfd, 0x84, 0x4a, 0x0e, 0x04, 0xad, 0x54, 0xf9, 0xee, 0x34, 0x67, 0x9c, 0x7b, 0x0a,
+	0x97, 0x3b, 0x0c, 0x5e, 0x74, 0x48, 0xea, 0xc7, 0x53, 0xb4, 0x4b, 0x19, 0x9b, 0x8c, 0x27, 0x11,
+	0x95, 0x3c, 0x50, 0x09, 0x80, 0x54, 0x34, 0xf7, 0x33, 0xc7, 0xcc, 0x82, 0xa3, 0x66, 0xc1, 0xb1,
+	0xb3, 0xe0, 0xf4, 0x45, 0x18, 0xf7, 0xbe, 0x56, 0x74, 0x7f, 0xfd, 0xd7, 0x68, 0x5f, 0x84, 0xf2,
+	0x72, 0x32, 0x72, 0x98, 0x18, 0xbb, 0x76, 0x70, 0xcc, 0xcf, 0x57, 0x10, 0x5c, 0xb9, 0xf2, 0xd5,
+	0x35, 0x07, 0x1d, 0x00, 0xde, 0x4e, 0x26, 0xc9, 0x19, 0xe7, 0x80, 0x9f, 0xa3, 0x8f, 0x18, 0x8d,
+	0x19, 0x8f, 0x7c, 0x1a, 0x45, 0x3e, 0x3d, 0x97, 0xaa, 0x59, 0xb7, 0x74, 0xe2, 0xa3, 0xfb, 0xca,
+	0x30, 0x1e, 0x75, 0xa3, 0xa8, 0xab, 0x50, 0xf6, 0x2c, 0x3b, 0x2c, 0x67, 0x05, 0xec, 0xa1, 0xdd,
+	0xcc, 0xc5, 0x5f, 0xd2, 0x48, 0x02, 0xa9, 0xbe, 0x7b, 0x84, 0xbe, 0xa3, 0x91, 0xcc, 0x09, 0xb4,
+	0x2d, 0xb2, 0x2e, 0x35, 0x91, 0xfb, 0x2c, 0x12, 0x70, 0xd7, 0x78, 0x9a, 0x5a, 0x75, 0xdf, 0x76,
+	0xb3, 0xd8, 0xae, 0xf6, 0x0e, 0xe6, 0xb3, 0x06, 0xee, 0x6b, 0xc0, 0x1d, 0xe7, 0x70, 0x00, 0x1e,
+	0x66, 0x4b, 0xb6, 0x00, 0xf0, 0xaf, 0xe8, 0x70, 0x44, 0x25, 0xbb, 0xf4, 0xe9, 0x84, 0xc9, 0x50,
+	0xc4, 0xcb, 0x8c, 0x3b, 0x9a, 0xf1, 0x70, 0x3e, 0x6b, 0x90, 0x9e, 0xc2, 0x75, 0x0d, 0x2c, 0xc7,
+	0x4b, 0x46, 0x2b, 0x3d, 0x01, 0xe0, 0x2e, 0x3a, 0x5a, 0xc5, 0x9e, 0x4e, 0x32, 0x90, 0xdd, 0x66,
+	0xb1, 0x5d, 0xf2, 0x6a, 0xf7, 0x08, 0xd2, 0xb1, 0x86, 0x16, 0x47, 0x4f, 0x56, 0xec, 0x16, 0x7c,
+	0x80, 0xd6, 0xc3, 0x40, 0x6f, 0xb1, 0x6a, 0xaf, 0x3c, 0x9f, 0x35, 0xd6, 0x87, 0x03, 0x6f, 0x3d,
+	0x0c, 0xf0, 0x29, 0x2a, 0x05, 0x54, 0x52, 0xb2, 0xae, 0xf7, 0xdb, 0xe1, 0xbb, 0xb6, 0x94, 0x15,
+	0x57, 0xe3, 0x5b, 0x12, 0x91, 0x87, 0x46, 0x0e, 0x9f, 0xa0, 0x6a, 0x4e, 0x15, 0x9b, 0x76, 0x67,
+	0x3e, 0x6b, 0x54, 0x32, 0x42, 0x78, 0x15, 0xb1, 0x38, 0x3b, 0xfe, 0x14, 0x6d, 0xe8, 0x01, 0xd7,
+	0x95, 0x6c, 0xf6, 0xaa, 0x2a, 0xd7, 0xbf, 0xb3, 0xc6, 0x86, 0x26, 0xf6, 0x8c, 0xaf, 0xf5, 0x67,
+	0x01, 0x3d, 0x7d, 0x60, 0x36, 0x3e, 0x2c, 0x6b, 0x1f, 0x6d, 0x2e, 0x66, 0xd1, 0x68, 0xd0, 0x7c,
+	0xdf, 0x2c, 0x5a, 0x1d, 0x1e, 0x9f, 0xdb, 0xef, 0xd6, 0xef, 0x85, 0x8c, 0xe6, 0x8b, 0x66, 0xfc,
+	0xb0, 0x8a, 0x4e, 0x51, 0x49, 0x35, 0xfd, 0xfb, 0x2e, 0x44, 0xa5, 0x49, 0x2f, 0x44, 0xe1, 0x5b,
+	0xaf, 0xd0, 0xd3, 0x07, 0x76, 0xb3, 0x7a, 0x11, 0xec, 0x5e, 0xf6, 0xe3, 0xc9, 0x78, 0xc4, 0x13,
+	0x5d, 0x48, 0xc9, 0xab, 0x5a, 0xeb, 0x8f, 0xda, 0x88, 0xf7, 0xd0, 0x86, 0x7e, 0x08, 0xcc, 0x0d,
+	0x78, 0xe6, 0x03, 0x7f, 0x82, 0xb6, 0xb2, 0xef, 0x02, 0x29, 0xea, 0x50, 0x53, 0xb2, 0xdd, 0xfd,
+	0xdf, 0xbf, 0x9e, 0xd7, 0x0b, 0x6f, 0xe6, 0xf5, 0xc2, 0xff, 0xf3, 0x7a, 0xe1, 0x8f, 0xdb, 0xfa,
+	0xda, 0x9b, 0xdb, 0xfa, 0xda, 0x3f, 0xb7, 0xf5, 0xb5, 0x5f, 0x3a, 0x99, 0xc5, 0xd2, 0xd7, 0x07,
+	0x39, 0x13, 0x93, 0x38, 0xa0, 0xaa, 0x6f, 0x5d, 0xfb, 0x7a, 0x4f, 0x4f, 0xdd, 0x1b, 0xfd, 0x84,
+	0xeb, 0x3d, 0x33, 0x2a, 0xeb, 0xa7, 0xf8, 0xe4, 0x6d, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8c, 0xe8,
+	0x8a, 0xd2, 0x40, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -288,6 +570,160 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BatchAuctionOrderSequences) > 0 {
+		dAtA2 := make([]byte, len(m.BatchAuctionOrderSequences)*10)
+		var j1 int
+		for _, num := range m.BatchAuctionOrderSequences {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.BatchAuctionOrderBookIDs) > 0 {
+		dAtA4 := make([]byte, len(m.BatchAuctionOrderBookIDs)*10)
+		var j3 int
+		for _, num := range m.BatchAuctionOrderBookIDs {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.ClosedOrderBookIDs) > 0 {
+		dAtA6 := make([]byte, len(m.ClosedOrderBookIDs)*10)
+		var j5 int
+		for _, num := range m.ClosedOrderBookIDs {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintGenesis(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.OrderBookHalts) > 0 {
+		for iNdEx := len(m.OrderBookHalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBookHalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.CancelAllAfters) > 0 {
+		for iNdEx := len(m.CancelAllAfters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CancelAllAfters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AccumulatedFees) > 0 {
+		for iNdEx := len(m.AccumulatedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulatedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.OrderBooksFeeRates) > 0 {
+		for iNdEx := len(m.OrderBooksFeeRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBooksFeeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.OrderBookLastPrices) > 0 {
+		for iNdEx := len(m.OrderBookLastPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBookLastPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.ReservedOrderIds) > 0 {
 		for iNdEx := len(m.ReservedOrderIds) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ReservedOrderIds[iNdEx])
@@ -395,7 +831,7 @@ func (m *OrderBookDataWithID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountDenomOrdersCount) Marshal() (dAtA []byte, err error) {
+func (m *OrderBookLastPriceWithID) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -405,46 +841,160 @@ func (m *AccountDenomOrdersCount) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountDenomOrdersCount) MarshalTo(dAtA []byte) (int, error) {
+func (m *OrderBookLastPriceWithID) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountDenomOrdersCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OrderBookLastPriceWithID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OrdersCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.OrdersCount))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	if m.AccountNumber != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AccountNumber))
+	i--
+	dAtA[i] = 0x12
+	if m.OrderBookID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OrderBookID))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OrderBookFeeRatesWithID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookFeeRatesWithID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookFeeRatesWithID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeRates.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.OrderBookID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookHaltWithID) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookHaltWithID) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookHaltWithID) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Halt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.OrderBookID != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountDenomOrdersCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountDenomOrdersCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountDenomOrdersCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrdersCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.OrdersCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AccountNumber != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
@@ -481,6 +1031,69 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.OrderBookLastPrices) > 0 {
+		for _, e := range m.OrderBookLastPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrderBooksFeeRates) > 0 {
+		for _, e := range m.OrderBooksFeeRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccumulatedFees) > 0 {
+		for _, e := range m.AccumulatedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CancelAllAfters) > 0 {
+		for _, e := range m.CancelAllAfters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OrderBookHalts) > 0 {
+		for _, e := range m.OrderBookHalts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClosedOrderBookIDs) > 0 {
+		l = 0
+		for _, e := range m.ClosedOrderBookIDs {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.BatchAuctionOrderBookIDs) > 0 {
+		l = 0
+		for _, e := range m.BatchAuctionOrderBookIDs {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.BatchAuctionOrderSequences) > 0 {
+		l = 0
+		for _, e := range m.BatchAuctionOrderSequences {
+			l += sovGenesis(uint64(e))
+		}
+		n += 2 + sovGenesis(uint64(l)) + l
+	}
 	return n
 }
 
@@ -498,6 +1111,48 @@ func (m *OrderBookDataWithID) Size() (n int) {
 	return n
 }
 
+func (m *OrderBookLastPriceWithID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovGenesis(uint64(m.OrderBookID))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OrderBookFeeRatesWithID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovGenesis(uint64(m.OrderBookID))
+	}
+	l = m.FeeRates.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OrderBookHaltWithID) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovGenesis(uint64(m.OrderBookID))
+	}
+	l = m.Halt.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *AccountDenomOrdersCount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -738,61 +1393,11 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			m.ReservedOrderIds = append(m.ReservedOrderIds, make([]byte, postIndex-iNdEx))
 			copy(m.ReservedOrderIds[len(m.ReservedOrderIds)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *OrderBookDataWithID) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: OrderBookDataWithID: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OrderBookDataWithID: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookLastPrices", wireType)
 			}
-			m.ID = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -802,14 +1407,29 @@ func (m *OrderBookDataWithID) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ID |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookLastPrices = append(m.OrderBookLastPrices, OrderBookLastPriceWithID{})
+			if err := m.OrderBookLastPrices[len(m.OrderBookLastPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -836,7 +1456,815 @@ func (m *OrderBookDataWithID) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBooksFeeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBooksFeeRates = append(m.OrderBooksFeeRates, OrderBookFeeRatesWithID{})
+			if err := m.OrderBooksFeeRates[len(m.OrderBooksFeeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedFees = append(m.AccumulatedFees, types.Coin{})
+			if err := m.AccumulatedFees[len(m.AccumulatedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelAllAfters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelAllAfters = append(m.CancelAllAfters, CancelAllAfter{})
+			if err := m.CancelAllAfters[len(m.CancelAllAfters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookHalts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBookHalts = append(m.OrderBookHalts, OrderBookHaltWithID{})
+			if err := m.OrderBookHalts[len(m.OrderBookHalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ClosedOrderBookIDs = append(m.ClosedOrderBookIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ClosedOrderBookIDs) == 0 {
+					m.ClosedOrderBookIDs = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ClosedOrderBookIDs = append(m.ClosedOrderBookIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedOrderBookIDs", wireType)
+			}
+		case 15:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BatchAuctionOrderBookIDs = append(m.BatchAuctionOrderBookIDs, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BatchAuctionOrderBookIDs) == 0 {
+					m.BatchAuctionOrderBookIDs = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BatchAuctionOrderBookIDs = append(m.BatchAuctionOrderBookIDs, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuctionOrderBookIDs", wireType)
+			}
+		case 16:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BatchAuctionOrderSequences = append(m.BatchAuctionOrderSequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BatchAuctionOrderSequences) == 0 {
+					m.BatchAuctionOrderSequences = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BatchAuctionOrderSequences = append(m.BatchAuctionOrderSequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuctionOrderSequences", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookDataWithID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookDataWithID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookDataWithID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookLastPriceWithID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookLastPriceWithID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookLastPriceWithID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookFeeRatesWithID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookFeeRatesWithID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookFeeRatesWithID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRates.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookHaltWithID) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookHaltWithID: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookHaltWithID: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Halt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3,6 +3,7 @@ package types
 import (
 	"crypto/sha256"
 	"fmt"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 
@@ -15,6 +16,9 @@ const (
 
 	// StoreKey defines the primary module store key.
 	StoreKey = ModuleName
+
+	// FeeCollectorName defines the name of the module account collecting the trading fees.
+	FeeCollectorName = "dex_fee_collector"
 )
 
 // Store key prefixes.
@@ -43,6 +47,60 @@ var (
 	// ReserveOrderIDKeyPrefix defines the key prefix for all order ids used by an account to ensure
 	// global uniqueness per account.
 	ReserveOrderIDKeyPrefix = []byte{0x11}
+	// TriggerOrderKeyPrefix defines the key prefix for the trigger order.
+	TriggerOrderKeyPrefix = []byte{0x12}
+	// TriggerOrderBookRecordKeyPrefix defines the key prefix for the trigger order book record.
+	TriggerOrderBookRecordKeyPrefix = []byte{0x13}
+	// OrderBookLastPriceKeyPrefix defines the key prefix for the order book last price.
+	OrderBookLastPriceKeyPrefix = []byte{0x14}
+	// PendingTriggerOrderBookKeyPrefix defines the key prefix for the order books with the last price changed,
+	// and the trigger orders pending for the check.
+	PendingTriggerOrderBookKeyPrefix = []byte{0x15}
+	// OrderBookTradeSequenceKeyPrefix defines the key prefix for the order book trade sequence.
+	OrderBookTradeSequenceKeyPrefix = []byte{0x16}
+	// OrderBookTradeKeyPrefix defines the key prefix for the order book trade.
+	OrderBookTradeKeyPrefix = []byte{0x17}
+	// OrderBookCandleKeyPrefix defines the key prefix for the order book candle.
+	OrderBookCandleKeyPrefix = []byte{0x18}
+	// OrderBookFeeRatesKeyPrefix defines the key prefix for the order book fee rates.
+	OrderBookFeeRatesKeyPrefix = []byte{0x19}
+	// AccumulatedFeeKeyPrefix defines the key prefix for the accumulated fee of the denom.
+	AccumulatedFeeKeyPrefix = []byte{0x1a}
+	// CancelAllAfterKeyPrefix defines the key prefix for the account scheduled orders cancellation.
+	CancelAllAfterKeyPrefix = []byte{0x1b}
+	// OrderBookHaltKeyPrefix defines the key prefix for the order book halt.
+	OrderBookHaltKeyPrefix = []byte{0x1c}
+	// OrderBookPriceReferenceKeyPrefix defines the key prefix for the order book circuit breaker price reference.
+	OrderBookPriceReferenceKeyPrefix = []byte{0x1d}
+	// OrderBookClosedKeyPrefix defines the key prefix for the closed order book.
+	OrderBookClosedKeyPrefix = []byte{0x1e}
+	// OrderBookBatchAuctionKeyPrefix defines the key prefix for the order book in the batch auction mode.
+	OrderBookBatchAuctionKeyPrefix = []byte{0x1f}
+	// BatchAuctionOrderKeyPrefix defines the key prefix for the order queued by the batch auction.
+	BatchAuctionOrderKeyPrefix = []byte{0x20}
+	// BatchAuctionOrderBookRecordKeyPrefix defines the key prefix for the batch auction queue record of the order
+	// pending for the clearing.
+	BatchAuctionOrderBookRecordKeyPrefix = []byte{0x21}
+	// BatchAuctionOrderCountKeyPrefix defines the key prefix for the number of the orders queued by the batch auction
+	// of the order book.
+	BatchAuctionOrderCountKeyPrefix = []byte{0x22}
+	// BatchAuctionClearingCursorKey defines the key for the order book the next batch auctions clearing starts from.
+	BatchAuctionClearingCursorKey = []byte{0x23}
+	// TriggerOrderRetryKeyPrefix defines the key prefix for the trigger order failing both the activation and the
+	// cancellation, scheduled for the retry at the block height.
+	TriggerOrderRetryKeyPrefix = []byte{0x24}
+	// TriggerOrderRetryAttemptsKeyPrefix defines the key prefix for the number of the failed trigger order activation
+	// attempts.
+	TriggerOrderRetryAttemptsKeyPrefix = []byte{0x25}
+	// CancelAllFailedOrderKeyPrefix defines the key prefix for the account order failing the scheduled cancellation,
+	// skipped by the next batches of the cancellation.
+	CancelAllFailedOrderKeyPrefix = []byte{0x26}
+	// ClosedOrderBookFailedOrderKeyPrefix defines the key prefix for the closed order book order failing the
+	// cancellation, skipped by the next batches of the cancellation.
+	ClosedOrderBookFailedOrderKeyPrefix = []byte{0x27}
+	// OrderBookBlockCandleKeyPrefix defines the key prefix for the order book candle aggregating the trades of the
+	// current block, merged into the interval candles at the end of the block.
+	OrderBookBlockCandleKeyPrefix = []byte{0x28}
 )
 
 // StoreTrue keeps a value used by stores to indicate that key is present.
@@ -168,6 +226,321 @@ func CreateOrderBookSideKey(orderBookID uint32, side Side) []byte {
 	return store.JoinKeys(OrderBookRecordKeyPrefix, key)
 }
 
+// CreateTriggerOrderKey creates trigger order key.
+func CreateTriggerOrderKey(orderSequence uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, orderSequence)
+	return store.JoinKeys(TriggerOrderKeyPrefix, key)
+}
+
+// CreateTriggerOrderBookRecordKey creates trigger order book record key with fixed key length to support the correct
+// ordering by the trigger price.
+func CreateTriggerOrderBookRecordKey(
+	orderBookID uint32, direction TriggerDirection, price Price, orderSequence uint64,
+) ([]byte, error) {
+	return CreateOrderBookSideRecordKey(CreateTriggerOrderBookDirectionKey(orderBookID, direction), price, orderSequence)
+}
+
+// CreateTriggerOrderBookDirectionKey creates trigger order book direction key.
+func CreateTriggerOrderBookDirectionKey(orderBookID uint32, direction TriggerDirection) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	key = store.AppendUint8ToOrderedBytes(key, uint8(direction))
+
+	return store.JoinKeys(TriggerOrderBookRecordKeyPrefix, key)
+}
+
+// CreateOrderBookLastPriceKey creates order book last price key.
+func CreateOrderBookLastPriceKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(OrderBookLastPriceKeyPrefix, key)
+}
+
+// DecodeOrderBookLastPriceKey decodes order book last price key and returns the order book ID.
+func DecodeOrderBookLastPriceKey(key []byte) (uint32, error) {
+	orderBookID, _, err := store.ReadOrderedBytesToUint32(key)
+	if err != nil {
+		return 0, err
+	}
+	return orderBookID, nil
+}
+
+// CreatePendingTriggerOrderBookKey creates pending trigger order book key.
+func CreatePendingTriggerOrderBookKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(PendingTriggerOrderBookKeyPrefix, key)
+}
+
+// DecodePendingTriggerOrderBookKey decodes pending trigger order book key and returns the order book ID.
+func DecodePendingTriggerOrderBookKey(key []byte) (uint32, error) {
+	orderBookID, _, err := store.ReadOrderedBytesToUint32(key)
+	if err != nil {
+		return 0, err
+	}
+	return orderBookID, nil
+}
+
+// CreateOrderBookTradeSequenceKey creates order book trade sequence key.
+func CreateOrderBookTradeSequenceKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(OrderBookTradeSequenceKeyPrefix, key)
+}
+
+// CreateOrderBookTradeKey creates order book trade key.
+func CreateOrderBookTradeKey(orderBookID uint32, tradeSequence uint64) []byte {
+	return store.AppendUint64ToOrderedBytes(CreateOrderBookTradeKeyPrefix(orderBookID), tradeSequence)
+}
+
+// CreateOrderBookTradeKeyPrefix creates order book trade key prefix.
+func CreateOrderBookTradeKeyPrefix(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(OrderBookTradeKeyPrefix, key)
+}
+
+// CreateOrderBookBlockCandleKey creates order book block candle key.
+func CreateOrderBookBlockCandleKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(OrderBookBlockCandleKeyPrefix, key)
+}
+
+// DecodeOrderBookBlockCandleKey decodes order book block candle key and returns the order book ID.
+func DecodeOrderBookBlockCandleKey(key []byte) (uint32, error) {
+	orderBookID, _, err := store.ReadOrderedBytesToUint32(key)
+	if err != nil {
+		return 0, err
+	}
+	return orderBookID, nil
+}
+
+// CreateOrderBookCandleKey creates order book candle key with fixed key length to support the correct ordering
+// by the candle start time.
+func CreateOrderBookCandleKey(orderBookID uint32, interval CandleInterval, startTime time.Time) []byte {
+	return store.AppendUint64ToOrderedBytes(
+		CreateOrderBookCandleKeyPrefix(orderBookID, interval), uint64(startTime.Unix()),
+	)
+}
+
+// CreateOrderBookCandleKeyPrefix creates order book candle key prefix.
+func CreateOrderBookCandleKeyPrefix(orderBookID uint32, interval CandleInterval) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	key = store.AppendUint8ToOrderedBytes(key, uint8(interval))
+	return store.JoinKeys(OrderBookCandleKeyPrefix, key)
+}
+
+// CreateOrderBookFeeRatesKey creates order book fee rates key.
+func CreateOrderBookFeeRatesKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(OrderBookFeeRatesKeyPrefix, key)
+}
+
+// DecodeOrderBookFeeRatesKey decodes order book fee rates key and returns the order book ID.
+func DecodeOrderBookFeeRatesKey(key []byte) (uint32, error) {
+	orderBookID, _, err := store.ReadOrderedBytesToUint32(key)
+	if err != nil {
+		return 0, err
+	}
+	return orderBookID, nil
+}
+
+// CreateAccumulatedFeeKey creates accumulated fee key.
+func CreateAccumulatedFeeKey(denom string) []byte {
+	return store.JoinKeys(AccumulatedFeeKeyPrefix, []byte(denom))
+}
+
+// CreateCancelAllAfterKey creates account scheduled orders cancellation key.
+func CreateCancelAllAfterKey(accNumber uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, accNumber)
+	return store.JoinKeys(CancelAllAfterKeyPrefix, key)
+}
+
+// BuildCancelAllAfterDelayKey builds the key for the cancel all after delay store.
+func BuildCancelAllAfterDelayKey(accNumber uint64) string {
+	// the string will be store the delay store and must be unique for the app
+	return fmt.Sprintf("%scaa%d", ModuleName, accNumber)
+}
+
+// CreateOrderBookHaltKey creates order book halt key.
+func CreateOrderBookHaltKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(OrderBookHaltKeyPrefix, key)
+}
+
+// DecodeOrderBookHaltKey decodes order book halt key and returns the order book ID.
+func DecodeOrderBookHaltKey(key []byte) (uint32, error) {
+	orderBookID, _, err := store.ReadOrderedBytesToUint32(key)
+	if err != nil {
+		return 0, err
+	}
+	return orderBookID, nil
+}
+
+// CreateOrderBookPriceReferenceKey creates order book circuit breaker price reference key.
+func CreateOrderBookPriceReferenceKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(OrderBookPriceReferenceKeyPrefix, key)
+}
+
+// BuildOrderBookResumeDelayKey builds the key for the order book resume delay store.
+func BuildOrderBookResumeDelayKey(orderBookID uint32) string {
+	// the string will be store the delay store and must be unique for the app
+	return fmt.Sprintf("%sobr%d", ModuleName, orderBookID)
+}
+
+// CreateOrderBookClosedKey creates closed order book key.
+func CreateOrderBookClosedKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(OrderBookClosedKeyPrefix, key)
+}
+
+// DecodeOrderBookClosedKey decodes closed order book key and returns the order book ID.
+func DecodeOrderBookClosedKey(key []byte) (uint32, error) {
+	orderBookID, _, err := store.ReadOrderedBytesToUint32(key)
+	if err != nil {
+		return 0, err
+	}
+	return orderBookID, nil
+}
+
+// CreateOrderBookBatchAuctionKey creates batch auction order book key.
+func CreateOrderBookBatchAuctionKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(OrderBookBatchAuctionKeyPrefix, key)
+}
+
+// DecodeOrderBookBatchAuctionKey decodes batch auction order book key and returns the order book ID.
+func DecodeOrderBookBatchAuctionKey(key []byte) (uint32, error) {
+	orderBookID, _, err := store.ReadOrderedBytesToUint32(key)
+	if err != nil {
+		return 0, err
+	}
+	return orderBookID, nil
+}
+
+// CreateBatchAuctionOrderKey creates batch auction order key.
+func CreateBatchAuctionOrderKey(orderSequence uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, orderSequence)
+	return store.JoinKeys(BatchAuctionOrderKeyPrefix, key)
+}
+
+// CreateBatchAuctionOrderBookKey creates the key prefix for the batch auction queue records of the order book.
+func CreateBatchAuctionOrderBookKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(BatchAuctionOrderBookRecordKeyPrefix, key)
+}
+
+// CreateBatchAuctionOrderBookRecordKey creates batch auction queue record key.
+func CreateBatchAuctionOrderBookRecordKey(orderBookID uint32, orderSequence uint64) []byte {
+	return store.AppendUint64ToOrderedBytes(CreateBatchAuctionOrderBookKey(orderBookID), orderSequence)
+}
+
+// DecodeBatchAuctionOrderBookRecordKey decodes batch auction queue record key and returns the order book ID and order
+// sequence.
+func DecodeBatchAuctionOrderBookRecordKey(key []byte) (uint32, uint64, error) {
+	orderBookID, nextKeyPart, err := store.ReadOrderedBytesToUint32(key)
+	if err != nil {
+		return 0, 0, err
+	}
+	orderSequence, _, err := store.ReadOrderedBytesToUint64(nextKeyPart)
+	if err != nil {
+		return 0, 0, err
+	}
+	return orderBookID, orderSequence, nil
+}
+
+// CreateBatchAuctionOrderCountKey creates batch auction order count key.
+func CreateBatchAuctionOrderCountKey(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(BatchAuctionOrderCountKeyPrefix, key)
+}
+
+// DecodeBatchAuctionOrderCountKey decodes batch auction order count key and returns the order book ID.
+func DecodeBatchAuctionOrderCountKey(key []byte) (uint32, error) {
+	orderBookID, _, err := store.ReadOrderedBytesToUint32(key)
+	if err != nil {
+		return 0, err
+	}
+	return orderBookID, nil
+}
+
+// CreateTriggerOrderRetryKey creates trigger order retry key.
+func CreateTriggerOrderRetryKey(height, orderSequence uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, height)
+	key = store.AppendUint64ToOrderedBytes(key, orderSequence)
+	return store.JoinKeys(TriggerOrderRetryKeyPrefix, key)
+}
+
+// DecodeTriggerOrderRetryKey decodes trigger order retry key and returns the block height and order sequence.
+func DecodeTriggerOrderRetryKey(key []byte) (uint64, uint64, error) {
+	height, nextKeyPart, err := store.ReadOrderedBytesToUint64(key)
+	if err != nil {
+		return 0, 0, err
+	}
+	orderSequence, _, err := store.ReadOrderedBytesToUint64(nextKeyPart)
+	if err != nil {
+		return 0, 0, err
+	}
+	return height, orderSequence, nil
+}
+
+// CreateTriggerOrderRetryAttemptsKey creates trigger order retry attempts key.
+func CreateTriggerOrderRetryAttemptsKey(orderSequence uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, orderSequence)
+	return store.JoinKeys(TriggerOrderRetryAttemptsKeyPrefix, key)
+}
+
+// CreateCancelAllFailedOrderKeyPrefix creates account scheduled cancellation failed order key prefix.
+func CreateCancelAllFailedOrderKeyPrefix(accNumber uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, accNumber)
+	return store.JoinKeys(CancelAllFailedOrderKeyPrefix, key)
+}
+
+// CreateClosedOrderBookFailedOrderKeyPrefix creates closed order book cancellation failed order key prefix.
+func CreateClosedOrderBookFailedOrderKeyPrefix(orderBookID uint32) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint32ToOrderedBytes(key, orderBookID)
+	return store.JoinKeys(ClosedOrderBookFailedOrderKeyPrefix, key)
+}
+
+// CreateFailedOrderKey creates cancellation failed order key from the key prefix.
+func CreateFailedOrderKey(keyPrefix []byte, orderSequence uint64) []byte {
+	key := make([]byte, 0)
+	key = store.AppendUint64ToOrderedBytes(key, orderSequence)
+	return store.JoinKeys(keyPrefix, key)
+}
+
+// DecodeFailedOrderKey decodes cancellation failed order key without the prefix and returns the order sequence.
+func DecodeFailedOrderKey(key []byte) (uint64, error) {
+	orderSequence, _, err := store.ReadOrderedBytesToUint64(key)
+	if err != nil {
+		return 0, err
+	}
+	return orderSequence, nil
+}
+
+// BuildOrderBookCloseDelayKey builds the key for the closed order book orders cancellation delay store.
+func BuildOrderBookCloseDelayKey(orderBookID uint32) string {
+	// the string will be store the delay store and must be unique for the app
+	return fmt.Sprintf("%sobc%d", ModuleName, orderBookID)
+}
+
 // BuildGoodTilBlockHeightDelayKey builds the key for the good til block height delay store.
 func BuildGoodTilBlockHeightDelayKey(orderSequence uint64) string {
 	// the string will be store the delay store and must be unique for the app
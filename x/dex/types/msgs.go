@@ -15,17 +15,43 @@ type extendedMsg interface {
 
 var (
 	_ extendedMsg = &MsgUpdateParams{}
+	_ extendedMsg = &MsgUpdateOrderBookFeeRates{}
+	_ extendedMsg = &MsgWithdrawFees{}
 	_ extendedMsg = &MsgPlaceOrder{}
 	_ extendedMsg = &MsgCancelOrder{}
+	_ extendedMsg = &MsgReplaceOrder{}
 	_ extendedMsg = &MsgCancelOrdersByDenom{}
+	_ extendedMsg = &MsgPlaceOrders{}
+	_ extendedMsg = &MsgCancelOrders{}
+	_ extendedMsg = &MsgSetCancelAllAfter{}
+	_ extendedMsg = &MsgHaltOrderBook{}
+	_ extendedMsg = &MsgResumeOrderBook{}
+	_ extendedMsg = &MsgCloseOrderBook{}
+	_ extendedMsg = &MsgReopenOrderBook{}
+	_ extendedMsg = &MsgSetOrderBookBatchAuction{}
+	_ extendedMsg = &MsgSwapExactIn{}
+	_ extendedMsg = &MsgSwapExactOut{}
 )
 
 // RegisterLegacyAminoCodec registers the amino types and interfaces.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgPlaceOrder{}, ModuleName+"/MsgPlaceOrder")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelOrder{}, ModuleName+"/MsgCancelOrder")
+	legacy.RegisterAminoMsg(cdc, &MsgReplaceOrder{}, ModuleName+"/MsgReplaceOrder")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, ModuleName+"/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateOrderBookFeeRates{}, ModuleName+"/MsgUpdateOrderBookFeeRates")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawFees{}, ModuleName+"/MsgWithdrawFees")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelOrdersByDenom{}, ModuleName+"/MsgCancelOrdersByDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgPlaceOrders{}, ModuleName+"/MsgPlaceOrders")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelOrders{}, ModuleName+"/MsgCancelOrders")
+	legacy.RegisterAminoMsg(cdc, &MsgSetCancelAllAfter{}, ModuleName+"/MsgSetCancelAllAfter")
+	legacy.RegisterAminoMsg(cdc, &MsgHaltOrderBook{}, ModuleName+"/MsgHaltOrderBook")
+	legacy.RegisterAminoMsg(cdc, &MsgResumeOrderBook{}, ModuleName+"/MsgResumeOrderBook")
+	legacy.RegisterAminoMsg(cdc, &MsgCloseOrderBook{}, ModuleName+"/MsgCloseOrderBook")
+	legacy.RegisterAminoMsg(cdc, &MsgReopenOrderBook{}, ModuleName+"/MsgReopenOrderBook")
+	legacy.RegisterAminoMsg(cdc, &MsgSetOrderBookBatchAuction{}, ModuleName+"/MsgSetOrderBookBatchAuction")
+	legacy.RegisterAminoMsg(cdc, &MsgSwapExactIn{}, ModuleName+"/MsgSwapExactIn")
+	legacy.RegisterAminoMsg(cdc, &MsgSwapExactOut{}, ModuleName+"/MsgSwapExactOut")
 }
 
 // ValidateBasic checks that message fields are valid.
@@ -37,6 +63,47 @@ func (m MsgUpdateParams) ValidateBasic() error {
 	return m.Params.ValidateBasic()
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m MsgUpdateOrderBookFeeRates) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if m.BaseDenom == "" || m.QuoteDenom == "" {
+		return sdkerrors.Wrap(ErrInvalidInput, "base and quote denoms must be set")
+	}
+
+	if m.BaseDenom == m.QuoteDenom {
+		return sdkerrors.Wrap(ErrInvalidInput, "base and quote denoms must be different")
+	}
+
+	if m.FeeRates == nil {
+		return nil
+	}
+
+	return m.FeeRates.Validate()
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgWithdrawFees) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid amount: %s", err)
+	}
+	if m.Amount.IsZero() {
+		return sdkerrors.Wrap(ErrInvalidInput, "amount must be positive")
+	}
+
+	return nil
+}
+
 // ValidateBasic validates the message.
 func (m MsgPlaceOrder) ValidateBasic() error {
 	if _, err := NewOrderFromMsgPlaceOrder(m); err != nil {
@@ -55,6 +122,19 @@ func (m MsgCancelOrder) ValidateBasic() error {
 	return validateOrderID(m.ID)
 }
 
+// ValidateBasic validates the message.
+func (m MsgReplaceOrder) ValidateBasic() error {
+	if err := validateOrderID(m.OldID); err != nil {
+		return err
+	}
+
+	if _, err := NewOrderFromMsgReplaceOrder(m); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ValidateBasic validates the message.
 func (m MsgCancelOrdersByDenom) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
@@ -71,3 +151,151 @@ func (m MsgCancelOrdersByDenom) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic validates the message.
+func (m MsgPlaceOrders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	// the orders are validated on the placement to report the result of each order
+	return validateBatchSize(len(m.Orders))
+}
+
+// ValidateBasic validates the message.
+func (m MsgCancelOrders) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if err := validateBatchSize(len(m.IDs)); err != nil {
+		return err
+	}
+
+	for _, id := range m.IDs {
+		if err := validateOrderID(id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic validates the message.
+func (m MsgSetCancelAllAfter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if m.Timeout < 0 {
+		return sdkerrors.Wrapf(ErrInvalidInput, "timeout %s can't be negative", m.Timeout)
+	}
+
+	return validateCancelAllAfterDenoms(m.Denoms)
+}
+
+// ValidateBasic validates the message.
+func (m MsgHaltOrderBook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	return validateOrderBookDenoms(m.BaseDenom, m.QuoteDenom)
+}
+
+// ValidateBasic validates the message.
+func (m MsgResumeOrderBook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	return validateOrderBookDenoms(m.BaseDenom, m.QuoteDenom)
+}
+
+// ValidateBasic validates the message.
+func (m MsgCloseOrderBook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateOrderBookDenoms(m.BaseDenom, m.QuoteDenom)
+}
+
+// ValidateBasic validates the message.
+func (m MsgReopenOrderBook) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateOrderBookDenoms(m.BaseDenom, m.QuoteDenom)
+}
+
+// ValidateBasic validates the message.
+func (m MsgSetOrderBookBatchAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return validateOrderBookDenoms(m.BaseDenom, m.QuoteDenom)
+}
+
+// ValidateBasic validates the message.
+func (m MsgSwapExactIn) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if err := ValidateSwapRoute(m.Route); err != nil {
+		return err
+	}
+
+	if err := validateSwapAmount("amount in", m.AmountIn); err != nil {
+		return err
+	}
+
+	if m.MinAmountOut.IsNil() || m.MinAmountOut.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "min amount out must be non-negative")
+	}
+
+	return nil
+}
+
+// ValidateBasic validates the message.
+func (m MsgSwapExactOut) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", m.Sender)
+	}
+
+	if err := ValidateSwapRoute(m.Route); err != nil {
+		return err
+	}
+
+	if err := validateSwapAmount("amount out", m.AmountOut); err != nil {
+		return err
+	}
+
+	return validateSwapAmount("max amount in", m.MaxAmountIn)
+}
+
+func validateBatchSize(size int) error {
+	if size == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "batch can't be empty")
+	}
+	if size > MaxBatchSize {
+		return sdkerrors.Wrapf(ErrInvalidInput, "batch size %d exceeds the limit %d", size, MaxBatchSize)
+	}
+
+	return nil
+}
+
+func validateOrderBookDenoms(baseDenom, quoteDenom string) error {
+	if baseDenom == "" || quoteDenom == "" {
+		return sdkerrors.Wrap(ErrInvalidInput, "base and quote denoms must be set")
+	}
+
+	if baseDenom == quoteDenom {
+		return sdkerrors.Wrap(ErrInvalidInput, "base and quote denoms must be different")
+	}
+
+	return nil
+}
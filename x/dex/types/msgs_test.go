@@ -1,8 +1,10 @@
 package types_test
 
 import (
+	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
@@ -105,6 +107,779 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 			}(),
 			wantErr: types.ErrInvalidInput,
 		},
+		{
+			name: "invalid_negative_maker_fee_rate",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.MakerFeeRate = sdkmath.LegacyMustNewDecFromStr("-0.1")
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_taker_fee_rate_one",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.TakerFeeRate = sdkmath.LegacyOneDec()
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_negative_circuit_breaker_price_change_rate",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.CircuitBreakerPriceChangeRate = sdkmath.LegacyMustNewDecFromStr("-0.1")
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_circuit_breaker_window_blocks",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.CircuitBreakerWindowBlocks = 0
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_circuit_breaker_halt_blocks",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.CircuitBreakerHaltBlocks = 0
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_max_batch_auction_orders",
+			msg: func() types.MsgUpdateParams {
+				msg := validMsg()
+				msg.Params.MaxBatchAuctionOrders = 0
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateOrderBookFeeRates_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgUpdateOrderBookFeeRates {
+		return types.MsgUpdateOrderBookFeeRates{
+			Authority:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			BaseDenom:  "denom1",
+			QuoteDenom: "denom2",
+			FeeRates: &types.OrderBookFeeRates{
+				MakerFeeRate: sdkmath.LegacyMustNewDecFromStr("0.001"),
+				TakerFeeRate: sdkmath.LegacyMustNewDecFromStr("0.002"),
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgUpdateOrderBookFeeRates
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "valid_fee_rates_removal",
+			msg: func() types.MsgUpdateOrderBookFeeRates {
+				msg := validMsg()
+				msg.FeeRates = nil
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_account",
+			msg: func() types.MsgUpdateOrderBookFeeRates {
+				msg := validMsg()
+				msg.Authority = "inv-acc"
+				return msg
+			}(),
+			wantErr: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid_empty_denom",
+			msg: func() types.MsgUpdateOrderBookFeeRates {
+				msg := validMsg()
+				msg.QuoteDenom = ""
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_same_denoms",
+			msg: func() types.MsgUpdateOrderBookFeeRates {
+				msg := validMsg()
+				msg.QuoteDenom = msg.BaseDenom
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_maker_fee_rate",
+			msg: func() types.MsgUpdateOrderBookFeeRates {
+				msg := validMsg()
+				msg.FeeRates.MakerFeeRate = sdkmath.LegacyMustNewDecFromStr("1.1")
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_taker_fee_rate",
+			msg: func() types.MsgUpdateOrderBookFeeRates {
+				msg := validMsg()
+				msg.FeeRates.TakerFeeRate = sdkmath.LegacyMustNewDecFromStr("-0.001")
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawFees_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgWithdrawFees {
+		return types.MsgWithdrawFees{
+			Authority: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Recipient: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Amount:    sdk.NewCoins(sdk.NewInt64Coin("denom1", 100), sdk.NewInt64Coin("denom2", 200)),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgWithdrawFees
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_authority",
+			msg: func() types.MsgWithdrawFees {
+				msg := validMsg()
+				msg.Authority = "inv-acc"
+				return msg
+			}(),
+			wantErr: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid_recipient",
+			msg: func() types.MsgWithdrawFees {
+				msg := validMsg()
+				msg.Recipient = "inv-acc"
+				return msg
+			}(),
+			wantErr: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid_empty_amount",
+			msg: func() types.MsgWithdrawFees {
+				msg := validMsg()
+				msg.Amount = nil
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_zero_amount",
+			msg: func() types.MsgWithdrawFees {
+				msg := validMsg()
+				msg.Amount = sdk.Coins{sdk.NewInt64Coin("denom1", 0)}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
+	// single case just to test that we call the Order.Validate
+	m := types.MsgPlaceOrder{}
+	require.Error(t, m.ValidateBasic())
+}
+
+func TestMsgCancelOrder_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgCancelOrder {
+		return types.MsgCancelOrder{
+			Sender: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			ID:     "aA09+:._-",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgCancelOrder
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_account",
+			msg: func() types.MsgCancelOrder {
+				msg := validMsg()
+				msg.Sender = "inv_acc"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_id",
+			msg: func() types.MsgCancelOrder {
+				msg := validMsg()
+				msg.ID = strings.Repeat("a", 41)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgReplaceOrder_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgReplaceOrder {
+		return types.MsgReplaceOrder{
+			Sender:      sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			OldID:       "id1",
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "id2",
+			BaseDenom:   "denom1",
+			QuoteDenom:  "denom2",
+			Price:       lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+			Quantity:    sdkmath.NewInt(100),
+			Side:        types.SIDE_SELL,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgReplaceOrder
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "valid_same_id",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.ID = msg.OldID
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_old_id",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.OldID = strings.Repeat("a", 41)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_order",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.Price = nil
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_with_settings",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.SelfTradePrevention = types.SELF_TRADE_PREVENTION_CANCEL_OLDEST
+				msg.VisibleQuantity = lo.ToPtr(sdkmath.NewInt(10))
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_visible_quantity",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.VisibleQuantity = lo.ToPtr(msg.Quantity)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_trigger",
+			msg: func() types.MsgReplaceOrder {
+				msg := validMsg()
+				msg.Trigger = &types.Trigger{
+					Type:  types.TRIGGER_TYPE_UNSPECIFIED,
+					Price: types.MustNewPriceFromString("1e-1"),
+				}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgCancelOrdersByDenom_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgCancelOrdersByDenom {
+		return types.MsgCancelOrdersByDenom{
+			Sender:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Account: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Denom:   "denom1",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgCancelOrdersByDenom
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_sender",
+			msg: func() types.MsgCancelOrdersByDenom {
+				msg := validMsg()
+				msg.Sender = "inv_sender"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_account",
+			msg: func() types.MsgCancelOrdersByDenom {
+				msg := validMsg()
+				msg.Account = "inv_sender"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_denom",
+			msg: func() types.MsgCancelOrdersByDenom {
+				msg := validMsg()
+				msg.Denom = "1@1"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgPlaceOrders_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgPlaceOrders {
+		return types.MsgPlaceOrders{
+			Sender: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Orders: []types.OrderToPlace{
+				{
+					Type:        types.ORDER_TYPE_LIMIT,
+					ID:          "id1",
+					BaseDenom:   "denom1",
+					QuoteDenom:  "denom2",
+					Price:       lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+					Quantity:    sdkmath.NewInt(100),
+					Side:        types.SIDE_SELL,
+					TimeInForce: types.TIME_IN_FORCE_GTC,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgPlaceOrders
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_sender",
+			msg: func() types.MsgPlaceOrders {
+				msg := validMsg()
+				msg.Sender = "inv_sender"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_empty_batch",
+			msg: func() types.MsgPlaceOrders {
+				msg := validMsg()
+				msg.Orders = nil
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_batch_size",
+			msg: func() types.MsgPlaceOrders {
+				msg := validMsg()
+				msg.Orders = lo.RepeatBy(types.MaxBatchSize+1, func(i int) types.OrderToPlace {
+					order := msg.Orders[0]
+					order.ID = fmt.Sprintf("id%d", i)
+					return order
+				})
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_with_invalid_order",
+			msg: func() types.MsgPlaceOrders {
+				msg := validMsg()
+				msg.Orders[0].Price = nil
+				return msg
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgCancelOrders_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgCancelOrders {
+		return types.MsgCancelOrders{
+			Sender: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			IDs:    []string{"id1", "aA09+:._-"},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgCancelOrders
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_sender",
+			msg: func() types.MsgCancelOrders {
+				msg := validMsg()
+				msg.Sender = "inv_sender"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_empty_batch",
+			msg: func() types.MsgCancelOrders {
+				msg := validMsg()
+				msg.IDs = nil
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_batch_size",
+			msg: func() types.MsgCancelOrders {
+				msg := validMsg()
+				msg.IDs = lo.RepeatBy(types.MaxBatchSize+1, func(i int) string {
+					return fmt.Sprintf("id%d", i)
+				})
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_id",
+			msg: func() types.MsgCancelOrders {
+				msg := validMsg()
+				msg.IDs[1] = strings.Repeat("a", 41)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+//nolint:lll // assertion strings
+func TestMsgSetCancelAllAfter_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgSetCancelAllAfter {
+		return types.MsgSetCancelAllAfter{
+			Sender:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Timeout: time.Minute,
+			Denoms:  []string{"denom1", "denom2"},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgSetCancelAllAfter
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "valid_without_denoms",
+			msg: func() types.MsgSetCancelAllAfter {
+				msg := validMsg()
+				msg.Denoms = nil
+				return msg
+			}(),
+		},
+		{
+			name: "valid_zero_timeout",
+			msg: func() types.MsgSetCancelAllAfter {
+				msg := validMsg()
+				msg.Timeout = 0
+				return msg
+			}(),
+		},
+		{
+			name: "invalid_sender",
+			msg: func() types.MsgSetCancelAllAfter {
+				msg := validMsg()
+				msg.Sender = "inv_sender"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_negative_timeout",
+			msg: func() types.MsgSetCancelAllAfter {
+				msg := validMsg()
+				msg.Timeout = -time.Second
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_denom",
+			msg: func() types.MsgSetCancelAllAfter {
+				msg := validMsg()
+				msg.Denoms[1] = "1"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_duplicate_denom",
+			msg: func() types.MsgSetCancelAllAfter {
+				msg := validMsg()
+				msg.Denoms[1] = msg.Denoms[0]
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgHaltOrderBook_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgHaltOrderBook {
+		return types.MsgHaltOrderBook{
+			Sender:     sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			BaseDenom:  "denom1",
+			QuoteDenom: "denom2",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgHaltOrderBook
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_sender",
+			msg: func() types.MsgHaltOrderBook {
+				msg := validMsg()
+				msg.Sender = "inv_sender"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_empty_base_denom",
+			msg: func() types.MsgHaltOrderBook {
+				msg := validMsg()
+				msg.BaseDenom = ""
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_same_denoms",
+			msg: func() types.MsgHaltOrderBook {
+				msg := validMsg()
+				msg.QuoteDenom = msg.BaseDenom
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgResumeOrderBook_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgResumeOrderBook {
+		return types.MsgResumeOrderBook{
+			Sender:     sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			BaseDenom:  "denom1",
+			QuoteDenom: "denom2",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgResumeOrderBook
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_sender",
+			msg: func() types.MsgResumeOrderBook {
+				msg := validMsg()
+				msg.Sender = "inv_sender"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_empty_quote_denom",
+			msg: func() types.MsgResumeOrderBook {
+				msg := validMsg()
+				msg.QuoteDenom = ""
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_same_denoms",
+			msg: func() types.MsgResumeOrderBook {
+				msg := validMsg()
+				msg.QuoteDenom = msg.BaseDenom
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -119,23 +894,77 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
-	// single case just to test that we call the Order.Validate
-	m := types.MsgPlaceOrder{}
-	require.Error(t, m.ValidateBasic())
+func TestMsgCloseOrderBook_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgCloseOrderBook {
+		return types.MsgCloseOrderBook{
+			Authority:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			BaseDenom:  "denom1",
+			QuoteDenom: "denom2",
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgCloseOrderBook
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_authority",
+			msg: func() types.MsgCloseOrderBook {
+				msg := validMsg()
+				msg.Authority = "invalid"
+				return msg
+			}(),
+			wantErr: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid_empty_base_denom",
+			msg: func() types.MsgCloseOrderBook {
+				msg := validMsg()
+				msg.BaseDenom = ""
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_same_denoms",
+			msg: func() types.MsgCloseOrderBook {
+				msg := validMsg()
+				msg.QuoteDenom = msg.BaseDenom
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
 }
 
-func TestMsgCancelOrder_ValidateBasic(t *testing.T) {
-	validMsg := func() types.MsgCancelOrder {
-		return types.MsgCancelOrder{
-			Sender: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
-			ID:     "aA09+:._-",
+func TestMsgReopenOrderBook_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgReopenOrderBook {
+		return types.MsgReopenOrderBook{
+			Authority:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			BaseDenom:  "denom1",
+			QuoteDenom: "denom2",
 		}
 	}
 
 	tests := []struct {
 		name    string
-		msg     types.MsgCancelOrder
+		msg     types.MsgReopenOrderBook
 		wantErr error
 	}{
 		{
@@ -143,19 +972,28 @@ func TestMsgCancelOrder_ValidateBasic(t *testing.T) {
 			msg:  validMsg(),
 		},
 		{
-			name: "invalid_account",
-			msg: func() types.MsgCancelOrder {
+			name: "invalid_authority",
+			msg: func() types.MsgReopenOrderBook {
 				msg := validMsg()
-				msg.Sender = "inv_acc"
+				msg.Authority = "invalid"
+				return msg
+			}(),
+			wantErr: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid_empty_base_denom",
+			msg: func() types.MsgReopenOrderBook {
+				msg := validMsg()
+				msg.BaseDenom = ""
 				return msg
 			}(),
 			wantErr: types.ErrInvalidInput,
 		},
 		{
-			name: "invalid_id",
-			msg: func() types.MsgCancelOrder {
+			name: "invalid_same_denoms",
+			msg: func() types.MsgReopenOrderBook {
 				msg := validMsg()
-				msg.ID = strings.Repeat("a", 41)
+				msg.QuoteDenom = msg.BaseDenom
 				return msg
 			}(),
 			wantErr: types.ErrInvalidInput,
@@ -174,18 +1012,79 @@ func TestMsgCancelOrder_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestMsgCancelOrdersByDenom_ValidateBasic(t *testing.T) {
-	validMsg := func() types.MsgCancelOrdersByDenom {
-		return types.MsgCancelOrdersByDenom{
-			Sender:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
-			Account: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
-			Denom:   "denom1",
+func TestMsgSetOrderBookBatchAuction_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgSetOrderBookBatchAuction {
+		return types.MsgSetOrderBookBatchAuction{
+			Authority:  sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			BaseDenom:  "denom1",
+			QuoteDenom: "denom2",
+			Enabled:    true,
 		}
 	}
 
 	tests := []struct {
 		name    string
-		msg     types.MsgCancelOrdersByDenom
+		msg     types.MsgSetOrderBookBatchAuction
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_authority",
+			msg: func() types.MsgSetOrderBookBatchAuction {
+				msg := validMsg()
+				msg.Authority = "invalid"
+				return msg
+			}(),
+			wantErr: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid_empty_base_denom",
+			msg: func() types.MsgSetOrderBookBatchAuction {
+				msg := validMsg()
+				msg.BaseDenom = ""
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_same_denoms",
+			msg: func() types.MsgSetOrderBookBatchAuction {
+				msg := validMsg()
+				msg.QuoteDenom = msg.BaseDenom
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgSwapExactIn_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgSwapExactIn {
+		return types.MsgSwapExactIn{
+			Sender:       sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Route:        []string{"denom1", "denom2", "denom3"},
+			AmountIn:     sdkmath.NewInt(100),
+			MinAmountOut: sdkmath.ZeroInt(),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgSwapExactIn
 		wantErr error
 	}{
 		{
@@ -194,7 +1093,7 @@ func TestMsgCancelOrdersByDenom_ValidateBasic(t *testing.T) {
 		},
 		{
 			name: "invalid_sender",
-			msg: func() types.MsgCancelOrdersByDenom {
+			msg: func() types.MsgSwapExactIn {
 				msg := validMsg()
 				msg.Sender = "inv_sender"
 				return msg
@@ -202,19 +1101,124 @@ func TestMsgCancelOrdersByDenom_ValidateBasic(t *testing.T) {
 			wantErr: types.ErrInvalidInput,
 		},
 		{
-			name: "invalid_account",
-			msg: func() types.MsgCancelOrdersByDenom {
+			name: "invalid_short_route",
+			msg: func() types.MsgSwapExactIn {
 				msg := validMsg()
-				msg.Account = "inv_sender"
+				msg.Route = []string{"denom1"}
 				return msg
 			}(),
 			wantErr: types.ErrInvalidInput,
 		},
 		{
-			name: "invalid_denom",
-			msg: func() types.MsgCancelOrdersByDenom {
+			name: "invalid_long_route",
+			msg: func() types.MsgSwapExactIn {
 				msg := validMsg()
-				msg.Denom = "1@1"
+				msg.Route = []string{"denom1", "denom2", "denom3", "denom4", "denom5", "denom6"}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_duplicated_route_denom",
+			msg: func() types.MsgSwapExactIn {
+				msg := validMsg()
+				msg.Route = []string{"denom1", "denom2", "denom1"}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_route_denom",
+			msg: func() types.MsgSwapExactIn {
+				msg := validMsg()
+				msg.Route = []string{"denom1", "1"}
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_zero_amount_in",
+			msg: func() types.MsgSwapExactIn {
+				msg := validMsg()
+				msg.AmountIn = sdkmath.ZeroInt()
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_negative_min_amount_out",
+			msg: func() types.MsgSwapExactIn {
+				msg := validMsg()
+				msg.MinAmountOut = sdkmath.NewInt(-1)
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			err := tt.msg.ValidateBasic()
+			if tt.wantErr == nil {
+				requireT.NoError(err)
+			} else {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr))
+			}
+		})
+	}
+}
+
+func TestMsgSwapExactOut_ValidateBasic(t *testing.T) {
+	validMsg := func() types.MsgSwapExactOut {
+		return types.MsgSwapExactOut{
+			Sender:      sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Route:       []string{"denom1", "denom2"},
+			AmountOut:   sdkmath.NewInt(100),
+			MaxAmountIn: sdkmath.NewInt(200),
+		}
+	}
+
+	tests := []struct {
+		name    string
+		msg     types.MsgSwapExactOut
+		wantErr error
+	}{
+		{
+			name: "valid",
+			msg:  validMsg(),
+		},
+		{
+			name: "invalid_sender",
+			msg: func() types.MsgSwapExactOut {
+				msg := validMsg()
+				msg.Sender = "inv_sender"
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_empty_route",
+			msg: func() types.MsgSwapExactOut {
+				msg := validMsg()
+				msg.Route = nil
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_zero_amount_out",
+			msg: func() types.MsgSwapExactOut {
+				msg := validMsg()
+				msg.AmountOut = sdkmath.ZeroInt()
+				return msg
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_nil_max_amount_in",
+			msg: func() types.MsgSwapExactOut {
+				msg := validMsg()
+				msg.MaxAmountIn = sdkmath.Int{}
 				return msg
 			}(),
 			wantErr: types.ErrInvalidInput,
@@ -233,7 +1237,6 @@ func TestMsgCancelOrdersByDenom_ValidateBasic(t *testing.T) {
 	}
 }
 
-//nolint:lll // assertion strings
 func TestAmino(t *testing.T) {
 	const address = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
 
@@ -248,7 +1251,29 @@ func TestAmino(t *testing.T) {
 				Authority: address,
 				Params:    types.DefaultParams(),
 			},
-			wantAminoJSON: `{"type":"dex/MsgUpdateParams","value":{"authority":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","params":{"default_unified_ref_amount":"1000000.000000000000000000","max_orders_per_denom":"100","order_reserve":{"amount":"10000000","denom":"stake"},"price_tick_exponent":-6,"quantity_step_exponent":-2}}}`,
+			wantAminoJSON: `{"type":"dex/MsgUpdateParams","value":{"authority":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","params":{"circuit_breaker_halt_blocks":"100","circuit_breaker_price_change_rate":"0.000000000000000000","circuit_breaker_window_blocks":"100","default_unified_ref_amount":"1000000.000000000000000000","maker_fee_rate":"0.000000000000000000","max_batch_auction_orders":"500","max_orders_per_denom":"100","order_reserve":{"amount":"10000000","denom":"stake"},"price_tick_exponent":-6,"quantity_step_exponent":-2,"taker_fee_rate":"0.000000000000000000"}}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgUpdateOrderBookFeeRates{}),
+			msg: &types.MsgUpdateOrderBookFeeRates{
+				Authority:  address,
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+				FeeRates: &types.OrderBookFeeRates{
+					MakerFeeRate: sdkmath.LegacyMustNewDecFromStr("0.001"),
+					TakerFeeRate: sdkmath.LegacyMustNewDecFromStr("0.002"),
+				},
+			},
+			wantAminoJSON: `{"type":"dex/MsgUpdateOrderBookFeeRates","value":{"authority":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","base_denom":"denom1","fee_rates":{"maker_fee_rate":"0.001000000000000000","taker_fee_rate":"0.002000000000000000"},"quote_denom":"denom2"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgWithdrawFees{}),
+			msg: &types.MsgWithdrawFees{
+				Authority: address,
+				Recipient: address,
+				Amount:    sdk.NewCoins(sdk.NewInt64Coin("denom1", 100)),
+			},
+			wantAminoJSON: `{"type":"dex/MsgWithdrawFees","value":{"amount":[{"amount":"100","denom":"denom1"}],"authority":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","recipient":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
 		{
 			name: sdk.MsgTypeURL(&types.MsgPlaceOrder{}),
@@ -273,6 +1298,22 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"dex/MsgCancelOrder","value":{"id":"id1","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgReplaceOrder{}),
+			msg: &types.MsgReplaceOrder{
+				Sender:      address,
+				OldID:       "id1",
+				Type:        types.ORDER_TYPE_LIMIT,
+				ID:          "id2",
+				BaseDenom:   "denom1",
+				QuoteDenom:  "denom2",
+				Price:       lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+				Quantity:    sdkmath.NewInt(100),
+				Side:        types.SIDE_SELL,
+				TimeInForce: types.TIME_IN_FORCE_GTC,
+			},
+			wantAminoJSON: `{"type":"dex/MsgReplaceOrder","value":{"base_denom":"denom1","id":"id2","old_id":"id1","price":"1e-1","quantity":"100","quote_denom":"denom2","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","side":2,"time_in_force":1,"type":1}}`,
+		},
 		{
 			name: sdk.MsgTypeURL(&types.MsgCancelOrdersByDenom{}),
 			msg: &types.MsgCancelOrdersByDenom{
@@ -282,6 +1323,108 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"dex/MsgCancelOrdersByDenom","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"denom1","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgSetCancelAllAfter{}),
+			msg: &types.MsgSetCancelAllAfter{
+				Sender:  address,
+				Timeout: time.Minute,
+				Denoms:  []string{"denom1"},
+			},
+			wantAminoJSON: `{"type":"dex/MsgSetCancelAllAfter","value":{"denoms":["denom1"],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","timeout":"60000000000"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgHaltOrderBook{}),
+			msg: &types.MsgHaltOrderBook{
+				Sender:     address,
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+			},
+			wantAminoJSON: `{"type":"dex/MsgHaltOrderBook","value":{"base_denom":"denom1","quote_denom":"denom2","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgResumeOrderBook{}),
+			msg: &types.MsgResumeOrderBook{
+				Sender:     address,
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+			},
+			wantAminoJSON: `{"type":"dex/MsgResumeOrderBook","value":{"base_denom":"denom1","quote_denom":"denom2","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgCloseOrderBook{}),
+			msg: &types.MsgCloseOrderBook{
+				Authority:  address,
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+			},
+			wantAminoJSON: `{"type":"dex/MsgCloseOrderBook","value":{"authority":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","base_denom":"denom1","quote_denom":"denom2"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgReopenOrderBook{}),
+			msg: &types.MsgReopenOrderBook{
+				Authority:  address,
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+			},
+			wantAminoJSON: `{"type":"dex/MsgReopenOrderBook","value":{"authority":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","base_denom":"denom1","quote_denom":"denom2"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgSetOrderBookBatchAuction{}),
+			msg: &types.MsgSetOrderBookBatchAuction{
+				Authority:  address,
+				BaseDenom:  "denom1",
+				QuoteDenom: "denom2",
+				Enabled:    true,
+			},
+			wantAminoJSON: `{"type":"dex/MsgSetOrderBookBatchAuction","value":{"authority":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","base_denom":"denom1","enabled":true,"quote_denom":"denom2"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgSwapExactIn{}),
+			msg: &types.MsgSwapExactIn{
+				Sender:       address,
+				Route:        []string{"denom1", "denom2"},
+				AmountIn:     sdkmath.NewInt(100),
+				MinAmountOut: sdkmath.NewInt(90),
+			},
+			wantAminoJSON: `{"type":"dex/MsgSwapExactIn","value":{"amount_in":"100","min_amount_out":"90","route":["denom1","denom2"],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgSwapExactOut{}),
+			msg: &types.MsgSwapExactOut{
+				Sender:      address,
+				Route:       []string{"denom1", "denom2"},
+				AmountOut:   sdkmath.NewInt(100),
+				MaxAmountIn: sdkmath.NewInt(110),
+			},
+			wantAminoJSON: `{"type":"dex/MsgSwapExactOut","value":{"amount_out":"100","max_amount_in":"110","route":["denom1","denom2"],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgPlaceOrders{}),
+			msg: &types.MsgPlaceOrders{
+				Sender: address,
+				Orders: []types.OrderToPlace{
+					{
+						Type:        types.ORDER_TYPE_LIMIT,
+						ID:          "id1",
+						BaseDenom:   "denom1",
+						QuoteDenom:  "denom2",
+						Price:       lo.ToPtr(types.MustNewPriceFromString("1e-1")),
+						Quantity:    sdkmath.NewInt(100),
+						Side:        types.SIDE_SELL,
+						TimeInForce: types.TIME_IN_FORCE_GTC,
+					},
+				},
+			},
+			wantAminoJSON: `{"type":"dex/MsgPlaceOrders","value":{"orders":[{"base_denom":"denom1","id":"id1","price":"1e-1","quantity":"100","quote_denom":"denom2","side":2,"time_in_force":1,"type":1}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: sdk.MsgTypeURL(&types.MsgCancelOrders{}),
+			msg: &types.MsgCancelOrders{
+				Sender: address,
+				IDs:    []string{"id1", "id2"},
+			},
+			wantAminoJSON: `{"type":"dex/MsgCancelOrders","value":{"ids":["id1","id2"],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
 	}
 
 	legacyAmino := codec.NewLegacyAmino()
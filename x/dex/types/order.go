@@ -15,6 +15,16 @@ import (
 const (
 	// maxWordLen defines the maximum word length supported by Int and Uint types.
 	maxSDKIntWordLen = sdkmath.MaxBitLen / bits.UintSize
+	// MaxBatchSize defines the maximum number of orders in the MsgPlaceOrders and MsgCancelOrders.
+	MaxBatchSize = 200
+	// DefaultOrderBookDepthLevels defines the default number of the price levels per side returned by the order book
+	// depth query.
+	DefaultOrderBookDepthLevels = 50
+	// MaxOrderBookDepthLevels defines the max number of the price levels per side returned by the order book depth
+	// query.
+	MaxOrderBookDepthLevels = 500
+	// SimulatedOrderID defines the order ID used by the order simulation if the ID isn't provided.
+	SimulatedOrderID = "simulated-order"
 )
 
 var (
@@ -26,6 +36,18 @@ func init() {
 	orderIDRegex = regexp.MustCompile(orderIDRegexStr)
 }
 
+// TriggerDirection is the direction of the last price move which activates the order trigger.
+type TriggerDirection uint8
+
+const (
+	// TriggerDirectionUp is the direction of the trigger activated when the last price is greater than or equal to the
+	// trigger price.
+	TriggerDirectionUp TriggerDirection = iota + 1
+	// TriggerDirectionDown is the direction of the trigger activated when the last price is less than or equal to the
+	// trigger price.
+	TriggerDirectionDown
+)
+
 // Opposite returns opposite side.
 func (s Side) Opposite() (Side, error) {
 	switch s {
@@ -59,20 +81,69 @@ func (t TimeInForce) Validate() error {
 	return nil
 }
 
+// Validate validates self-trade prevention.
+func (s SelfTradePrevention) Validate() error {
+	if _, exists := SelfTradePrevention_name[int32(s)]; !exists {
+		return sdkerrors.Wrapf(ErrInvalidInput, "non-existing self-trade prevention provided: %d", s)
+	}
+
+	return nil
+}
+
+// Validate validates the trigger.
+func (t Trigger) Validate() error {
+	switch t.Type {
+	case TRIGGER_TYPE_STOP_LOSS, TRIGGER_TYPE_TAKE_PROFIT:
+	default:
+		return sdkerrors.Wrapf(ErrInvalidInput, "unsupported trigger type: %s", t.Type.String())
+	}
+
+	if cbig.RatIsZero(t.Price.Rat()) {
+		return sdkerrors.Wrap(ErrInvalidInput, "trigger price must be positive")
+	}
+
+	return nil
+}
+
 // NewOrderFromMsgPlaceOrder creates and validates Order from MsgPlaceOrder.
 func NewOrderFromMsgPlaceOrder(msg MsgPlaceOrder) (Order, error) {
-	o := Order{
-		Creator:     msg.Sender,
-		Type:        msg.Type,
-		ID:          msg.ID,
-		BaseDenom:   msg.BaseDenom,
-		QuoteDenom:  msg.QuoteDenom,
-		Price:       msg.Price,
-		Quantity:    msg.Quantity,
-		Side:        msg.Side,
-		GoodTil:     msg.GoodTil,
-		TimeInForce: msg.TimeInForce,
+	o := newOrder(msg.Sender, OrderToPlace{
+		Type:                msg.Type,
+		ID:                  msg.ID,
+		BaseDenom:           msg.BaseDenom,
+		QuoteDenom:          msg.QuoteDenom,
+		Price:               msg.Price,
+		Quantity:            msg.Quantity,
+		Side:                msg.Side,
+		GoodTil:             msg.GoodTil,
+		TimeInForce:         msg.TimeInForce,
+		Trigger:             msg.Trigger,
+		SelfTradePrevention: msg.SelfTradePrevention,
+		VisibleQuantity:     msg.VisibleQuantity,
+	})
+	if err := o.Validate(); err != nil {
+		return Order{}, err
 	}
+
+	return o, nil
+}
+
+// NewOrderFromMsgReplaceOrder creates and validates the new Order from MsgReplaceOrder.
+func NewOrderFromMsgReplaceOrder(msg MsgReplaceOrder) (Order, error) {
+	o := newOrder(msg.Sender, OrderToPlace{
+		Type:                msg.Type,
+		ID:                  msg.ID,
+		BaseDenom:           msg.BaseDenom,
+		QuoteDenom:          msg.QuoteDenom,
+		Price:               msg.Price,
+		Quantity:            msg.Quantity,
+		Side:                msg.Side,
+		GoodTil:             msg.GoodTil,
+		TimeInForce:         msg.TimeInForce,
+		Trigger:             msg.Trigger,
+		SelfTradePrevention: msg.SelfTradePrevention,
+		VisibleQuantity:     msg.VisibleQuantity,
+	})
 	if err := o.Validate(); err != nil {
 		return Order{}, err
 	}
@@ -80,6 +151,36 @@ func NewOrderFromMsgPlaceOrder(msg MsgPlaceOrder) (Order, error) {
 	return o, nil
 }
 
+// NewOrdersFromMsgPlaceOrders creates the new Orders from MsgPlaceOrders. The orders are validated one by one on the
+// placement, so the invalid order is reported in its result and doesn't reject the batch.
+func NewOrdersFromMsgPlaceOrders(msg MsgPlaceOrders) []Order {
+	orders := make([]Order, 0, len(msg.Orders))
+	for _, orderToPlace := range msg.Orders {
+		orders = append(orders, newOrder(msg.Sender, orderToPlace))
+	}
+
+	return orders
+}
+
+// newOrder creates the Order of the sender from the order fields shared by the messages placing the orders.
+func newOrder(sender string, orderToPlace OrderToPlace) Order {
+	return Order{
+		Creator:             sender,
+		Type:                orderToPlace.Type,
+		ID:                  orderToPlace.ID,
+		BaseDenom:           orderToPlace.BaseDenom,
+		QuoteDenom:          orderToPlace.QuoteDenom,
+		Price:               orderToPlace.Price,
+		Quantity:            orderToPlace.Quantity,
+		Side:                orderToPlace.Side,
+		GoodTil:             orderToPlace.GoodTil,
+		TimeInForce:         orderToPlace.TimeInForce,
+		Trigger:             orderToPlace.Trigger,
+		SelfTradePrevention: orderToPlace.SelfTradePrevention,
+		VisibleQuantity:     orderToPlace.VisibleQuantity,
+	}
+}
+
 // Validate validates order object.
 //
 //nolint:funlen // breaking down this function will make it less readable.
@@ -120,6 +221,16 @@ func (o Order) Validate() error {
 		return err
 	}
 
+	if err := o.SelfTradePrevention.Validate(); err != nil {
+		return err
+	}
+
+	if o.Trigger != nil {
+		if err := o.Trigger.Validate(); err != nil {
+			return err
+		}
+	}
+
 	switch o.Type {
 	case ORDER_TYPE_LIMIT:
 		if o.GoodTil != nil {
@@ -151,6 +262,9 @@ func (o Order) Validate() error {
 		); err != nil {
 			return err
 		}
+		if err := o.validateVisibleQuantity(); err != nil {
+			return err
+		}
 	case ORDER_TYPE_MARKET:
 		if o.Price != nil {
 			return sdkerrors.Wrap(
@@ -162,12 +276,24 @@ func (o Order) Validate() error {
 				ErrInvalidInput, "good til must be nil for the market order",
 			)
 		}
+		if o.VisibleQuantity != nil {
+			return sdkerrors.Wrap(
+				ErrInvalidInput, "visible quantity must be nil for the market order",
+			)
+		}
 		if o.TimeInForce != TIME_IN_FORCE_IOC {
 			return sdkerrors.Wrap(
 				ErrInvalidInput,
 				"the market order supports only TIME_IN_FORCE_IOC time in force",
 			)
 		}
+		// the market buy order spends the balance available at the time of the execution, so nothing bounds the
+		// spent amount of the dormant order to lock
+		if o.Trigger != nil && o.Side == SIDE_BUY {
+			return sdkerrors.Wrap(
+				ErrInvalidInput, "trigger is not supported for the market buy order, use the limit buy order instead",
+			)
+		}
 	default:
 		return sdkerrors.Wrapf(
 			ErrInvalidInput, "unsupported order type : %s", o.Type.String(),
@@ -189,6 +315,24 @@ func (o Order) Validate() error {
 	return nil
 }
 
+func (o Order) validateVisibleQuantity() error {
+	if o.VisibleQuantity == nil {
+		return nil
+	}
+	if o.TimeInForce != TIME_IN_FORCE_GTC && o.TimeInForce != TIME_IN_FORCE_POST_ONLY {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput,
+			"the iceberg order supports only %s and %s time in force",
+			TIME_IN_FORCE_GTC.String(), TIME_IN_FORCE_POST_ONLY.String(),
+		)
+	}
+	if !o.VisibleQuantity.IsPositive() || !o.VisibleQuantity.LT(o.Quantity) {
+		return sdkerrors.Wrap(ErrInvalidInput, "visible quantity must be positive and less than quantity")
+	}
+
+	return nil
+}
+
 // ComputeLimitOrderLockedBalance computes the order locked balance.
 func (o Order) ComputeLimitOrderLockedBalance() (sdk.Coin, error) {
 	if o.Price == nil {
@@ -204,6 +348,28 @@ func (o Order) ComputeLimitOrderLockedBalance() (sdk.Coin, error) {
 	return locked, nil
 }
 
+// TriggerDirection returns the direction of the last price move which activates the order trigger.
+func (o Order) TriggerDirection() (TriggerDirection, error) {
+	if o.Trigger == nil {
+		return 0, sdkerrors.Wrap(ErrInvalidInput, "trigger must be not nil for the trigger direction")
+	}
+
+	// the stop loss sell and the take profit buy are activated when the price falls, and the stop loss buy and take
+	// profit sell when it rises
+	switch {
+	case o.Side == SIDE_SELL && o.Trigger.Type == TRIGGER_TYPE_STOP_LOSS,
+		o.Side == SIDE_BUY && o.Trigger.Type == TRIGGER_TYPE_TAKE_PROFIT:
+		return TriggerDirectionDown, nil
+	case o.Side == SIDE_SELL && o.Trigger.Type == TRIGGER_TYPE_TAKE_PROFIT,
+		o.Side == SIDE_BUY && o.Trigger.Type == TRIGGER_TYPE_STOP_LOSS:
+		return TriggerDirectionUp, nil
+	default:
+		return 0, sdkerrors.Wrapf(
+			ErrInvalidInput, "unsupported side %s and trigger type %s", o.Side.String(), o.Trigger.Type.String(),
+		)
+	}
+}
+
 // GetSpendDenom returns order spending denom.
 func (o Order) GetSpendDenom() string {
 	if o.Side == SIDE_BUY {
@@ -6,8 +6,8 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -107,6 +107,9 @@ const (
 	TIME_IN_FORCE_IOC TimeInForce = 2
 	// time_in_force_fok means that order must be fully executed or canceled.
 	TIME_IN_FORCE_FOK TimeInForce = 3
+	// time_in_force_post_only means that the order is placed to the order book only if it doesn't match immediately,
+	//  otherwise it is rejected. The order placed to the order book remains active as the GTC order.
+	TIME_IN_FORCE_POST_ONLY TimeInForce = 4
 )
 
 var TimeInForce_name = map[int32]string{
@@ -114,6 +117,7 @@ var TimeInForce_name = map[int32]string{
 	1: "TIME_IN_FORCE_GTC",
 	2: "TIME_IN_FORCE_IOC",
 	3: "TIME_IN_FORCE_FOK",
+	4: "TIME_IN_FORCE_POST_ONLY",
 }
 
 var TimeInForce_value = map[string]int32{
@@ -121,6 +125,7 @@ var TimeInForce_value = map[string]int32{
 	"TIME_IN_FORCE_GTC":         1,
 	"TIME_IN_FORCE_IOC":         2,
 	"TIME_IN_FORCE_FOK":         3,
+	"TIME_IN_FORCE_POST_ONLY":   4,
 }
 
 func (x TimeInForce) String() string {
@@ -131,6 +136,87 @@ func (TimeInForce) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_302bb6c9a553771c, []int{2}
 }
 
+// TriggerType is order trigger type.
+type TriggerType int32
+
+const (
+	// trigger_type_unspecified reserves the default value, to protect against unexpected settings.
+	TRIGGER_TYPE_UNSPECIFIED TriggerType = 0
+	// trigger_type_stop_loss means that the order is activated when the price moves against the order side, the sell
+	//  order is activated when the last price falls to the trigger price or below, and the buy order when it rises to
+	//  the trigger price or above.
+	TRIGGER_TYPE_STOP_LOSS TriggerType = 1
+	// trigger_type_take_profit means that the order is activated when the price moves in favor of the order side, the
+	//  sell order is activated when the last price rises to the trigger price or above, and the buy order when it falls
+	//  to the trigger price or below.
+	TRIGGER_TYPE_TAKE_PROFIT TriggerType = 2
+)
+
+var TriggerType_name = map[int32]string{
+	0: "TRIGGER_TYPE_UNSPECIFIED",
+	1: "TRIGGER_TYPE_STOP_LOSS",
+	2: "TRIGGER_TYPE_TAKE_PROFIT",
+}
+
+var TriggerType_value = map[string]int32{
+	"TRIGGER_TYPE_UNSPECIFIED": 0,
+	"TRIGGER_TYPE_STOP_LOSS":   1,
+	"TRIGGER_TYPE_TAKE_PROFIT": 2,
+}
+
+func (x TriggerType) String() string {
+	return proto.EnumName(TriggerType_name, int32(x))
+}
+
+func (TriggerType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{3}
+}
+
+// SelfTradePrevention is the mode applied when the order matches the order of the same account.
+type SelfTradePrevention int32
+
+const (
+	// self_trade_prevention_unspecified means that the self-trade prevention is disabled and the orders of the same
+	//  account are matched.
+	SELF_TRADE_PREVENTION_UNSPECIFIED SelfTradePrevention = 0
+	// self_trade_prevention_cancel_newest means that the remaining part of the new order is canceled and the order book
+	//  order is kept.
+	SELF_TRADE_PREVENTION_CANCEL_NEWEST SelfTradePrevention = 1
+	// self_trade_prevention_cancel_oldest means that the order book order is canceled and the new order matching is
+	//  continued.
+	SELF_TRADE_PREVENTION_CANCEL_OLDEST SelfTradePrevention = 2
+	// self_trade_prevention_cancel_both means that both the remaining part of the new order and the order book order are
+	//  canceled.
+	SELF_TRADE_PREVENTION_CANCEL_BOTH SelfTradePrevention = 3
+	// self_trade_prevention_decrement_and_cancel means that both orders are decreased by the quantity they would trade
+	//  without the execution, and the fully decreased orders are canceled.
+	SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL SelfTradePrevention = 4
+)
+
+var SelfTradePrevention_name = map[int32]string{
+	0: "SELF_TRADE_PREVENTION_UNSPECIFIED",
+	1: "SELF_TRADE_PREVENTION_CANCEL_NEWEST",
+	2: "SELF_TRADE_PREVENTION_CANCEL_OLDEST",
+	3: "SELF_TRADE_PREVENTION_CANCEL_BOTH",
+	4: "SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL",
+}
+
+var SelfTradePrevention_value = map[string]int32{
+	"SELF_TRADE_PREVENTION_UNSPECIFIED":          0,
+	"SELF_TRADE_PREVENTION_CANCEL_NEWEST":        1,
+	"SELF_TRADE_PREVENTION_CANCEL_OLDEST":        2,
+	"SELF_TRADE_PREVENTION_CANCEL_BOTH":          3,
+	"SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL": 4,
+}
+
+func (x SelfTradePrevention) String() string {
+	return proto.EnumName(SelfTradePrevention_name, int32(x))
+}
+
+func (SelfTradePrevention) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{4}
+}
+
 // GoodTil is a good til order settings.
 type GoodTil struct {
 	// good_til_block_height means that order remains active until a specific blockchain block height is reached.
@@ -213,6 +299,334 @@ func (m *CancelGoodTil) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CancelGoodTil proto.InternalMessageInfo
 
+// CancelAll is a cancel all account orders message for the delay router.
+type CancelAll struct {
+	// creator is orders creator address.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *CancelAll) Reset()         { *m = CancelAll{} }
+func (m *CancelAll) String() string { return proto.CompactTextString(m) }
+func (*CancelAll) ProtoMessage()    {}
+func (*CancelAll) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{2}
+}
+func (m *CancelAll) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelAll) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelAll.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelAll) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAll.Merge(m, src)
+}
+func (m *CancelAll) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelAll) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAll.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelAll proto.InternalMessageInfo
+
+// CancelAllAfter is the scheduled cancellation of the account orders.
+type CancelAllAfter struct {
+	// account is orders creator address.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// deadline is the time after which the account orders are canceled.
+	Deadline time.Time `protobuf:"bytes,2,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	// denoms is the list of denoms to cancel the orders with, all account orders are canceled if empty.
+	Denoms []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *CancelAllAfter) Reset()         { *m = CancelAllAfter{} }
+func (m *CancelAllAfter) String() string { return proto.CompactTextString(m) }
+func (*CancelAllAfter) ProtoMessage()    {}
+func (*CancelAllAfter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{3}
+}
+func (m *CancelAllAfter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelAllAfter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelAllAfter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelAllAfter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAllAfter.Merge(m, src)
+}
+func (m *CancelAllAfter) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelAllAfter) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAllAfter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelAllAfter proto.InternalMessageInfo
+
+// ResumeOrderBook is a resume order book message for the delay router.
+type ResumeOrderBook struct {
+	// order_book_id is the ID of the order book the halt is kept for.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+}
+
+func (m *ResumeOrderBook) Reset()         { *m = ResumeOrderBook{} }
+func (m *ResumeOrderBook) String() string { return proto.CompactTextString(m) }
+func (*ResumeOrderBook) ProtoMessage()    {}
+func (*ResumeOrderBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{4}
+}
+func (m *ResumeOrderBook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeOrderBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeOrderBook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeOrderBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeOrderBook.Merge(m, src)
+}
+func (m *ResumeOrderBook) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeOrderBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeOrderBook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeOrderBook proto.InternalMessageInfo
+
+// CloseOrderBook is a closed order book orders cancellation message for the delay router.
+type CloseOrderBook struct {
+	// order_book_id is the ID of the order book the closure is kept for.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+}
+
+func (m *CloseOrderBook) Reset()         { *m = CloseOrderBook{} }
+func (m *CloseOrderBook) String() string { return proto.CompactTextString(m) }
+func (*CloseOrderBook) ProtoMessage()    {}
+func (*CloseOrderBook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{5}
+}
+func (m *CloseOrderBook) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CloseOrderBook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CloseOrderBook.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CloseOrderBook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloseOrderBook.Merge(m, src)
+}
+func (m *CloseOrderBook) XXX_Size() int {
+	return m.Size()
+}
+func (m *CloseOrderBook) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloseOrderBook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloseOrderBook proto.InternalMessageInfo
+
+// OrderBookHalt is the trading halt of the order book and its inverted order book.
+type OrderBookHalt struct {
+	// halted_by is the address of the account halted the order book, empty if the order book is halted by the circuit
+	// breaker.
+	HaltedBy string `protobuf:"bytes,1,opt,name=halted_by,json=haltedBy,proto3" json:"halted_by,omitempty"`
+	// resume_height is the height after which the order book is resumed automatically, zero if the order book is halted
+	// until it's resumed manually.
+	ResumeHeight uint64 `protobuf:"varint,2,opt,name=resume_height,json=resumeHeight,proto3" json:"resume_height,omitempty"`
+}
+
+func (m *OrderBookHalt) Reset()         { *m = OrderBookHalt{} }
+func (m *OrderBookHalt) String() string { return proto.CompactTextString(m) }
+func (*OrderBookHalt) ProtoMessage()    {}
+func (*OrderBookHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{6}
+}
+func (m *OrderBookHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookHalt.Merge(m, src)
+}
+func (m *OrderBookHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookHalt proto.InternalMessageInfo
+
+// OrderBookPriceReference is the price the circuit breaker compares the order book trade prices with.
+type OrderBookPriceReference struct {
+	// order_book_id is the ID of the order book the price is set for.
+	OrderBookID uint32 `protobuf:"varint,1,opt,name=order_book_id,json=orderBookId,proto3" json:"order_book_id,omitempty"`
+	// price is the order book trade price at the beginning of the circuit breaker window.
+	Price Price `protobuf:"bytes,2,opt,name=price,proto3,customtype=Price" json:"price"`
+	// height is the height the circuit breaker window is started at.
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *OrderBookPriceReference) Reset()         { *m = OrderBookPriceReference{} }
+func (m *OrderBookPriceReference) String() string { return proto.CompactTextString(m) }
+func (*OrderBookPriceReference) ProtoMessage()    {}
+func (*OrderBookPriceReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{7}
+}
+func (m *OrderBookPriceReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookPriceReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookPriceReference.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookPriceReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookPriceReference.Merge(m, src)
+}
+func (m *OrderBookPriceReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookPriceReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookPriceReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookPriceReference proto.InternalMessageInfo
+
+// SwapHop is the execution result of the single swap route hop.
+type SwapHop struct {
+	// spent_coin is the coin spent in the hop order book.
+	SpentCoin types.Coin `protobuf:"bytes,1,opt,name=spent_coin,json=spentCoin,proto3" json:"spent_coin"`
+	// received_coin is the coin received in the hop order book.
+	ReceivedCoin types.Coin `protobuf:"bytes,2,opt,name=received_coin,json=receivedCoin,proto3" json:"received_coin"`
+}
+
+func (m *SwapHop) Reset()         { *m = SwapHop{} }
+func (m *SwapHop) String() string { return proto.CompactTextString(m) }
+func (*SwapHop) ProtoMessage()    {}
+func (*SwapHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{8}
+}
+func (m *SwapHop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapHop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwapHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapHop.Merge(m, src)
+}
+func (m *SwapHop) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapHop proto.InternalMessageInfo
+
+// Trigger is the order trigger settings.
+type Trigger struct {
+	// type is trigger type.
+	Type TriggerType `protobuf:"varint,1,opt,name=type,proto3,enum=coreum.dex.v1.TriggerType" json:"type,omitempty"`
+	// price is the order book last price which activates the order.
+	Price Price `protobuf:"bytes,2,opt,name=price,proto3,customtype=Price" json:"price"`
+}
+
+func (m *Trigger) Reset()         { *m = Trigger{} }
+func (m *Trigger) String() string { return proto.CompactTextString(m) }
+func (*Trigger) ProtoMessage()    {}
+func (*Trigger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{9}
+}
+func (m *Trigger) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trigger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trigger.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trigger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trigger.Merge(m, src)
+}
+func (m *Trigger) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trigger) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trigger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trigger proto.InternalMessageInfo
+
 // Order represents a DEX order, encapsulating both limit and market orders. It contains comprehensive information about
 // the order's state.
 type Order struct {
@@ -244,13 +658,20 @@ type Order struct {
 	TimeInForce TimeInForce `protobuf:"varint,13,opt,name=time_in_force,json=timeInForce,proto3,enum=coreum.dex.v1.TimeInForce" json:"time_in_force,omitempty"`
 	// reserve is the reserve required to save the order in the order book
 	Reserve github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,14,opt,name=reserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"reserve"`
+	// trigger is the order trigger, the order with the trigger is kept inactive until the trigger is activated.
+	Trigger *Trigger `protobuf:"bytes,15,opt,name=trigger,proto3" json:"trigger,omitempty"`
+	// self_trade_prevention is the mode applied when the order matches the order of the same account.
+	SelfTradePrevention SelfTradePrevention `protobuf:"varint,16,opt,name=self_trade_prevention,json=selfTradePrevention,proto3,enum=coreum.dex.v1.SelfTradePrevention" json:"self_trade_prevention,omitempty"`
+	// visible_quantity is the quantity of the iceberg order shown in the order book, the hidden remainder refills it
+	//  when the visible quantity is filled.
+	VisibleQuantity *cosmossdk_io_math.Int `protobuf:"bytes,17,opt,name=visible_quantity,json=visibleQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"visible_quantity,omitempty"`
 }
 
 func (m *Order) Reset()         { *m = Order{} }
 func (m *Order) String() string { return proto.CompactTextString(m) }
 func (*Order) ProtoMessage()    {}
 func (*Order) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302bb6c9a553771c, []int{2}
+	return fileDescriptor_302bb6c9a553771c, []int{10}
 }
 func (m *Order) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,13 +716,20 @@ type OrderData struct {
 	GoodTil *GoodTil `protobuf:"bytes,6,opt,name=good_til,json=goodTil,proto3" json:"good_til,omitempty"`
 	// reserve is the reserve required to save the order in the order book
 	Reserve github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,7,opt,name=reserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"reserve"`
+	// time_in_force is order time in force
+	TimeInForce TimeInForce `protobuf:"varint,8,opt,name=time_in_force,json=timeInForce,proto3,enum=coreum.dex.v1.TimeInForce" json:"time_in_force,omitempty"`
+	// visible_quantity is the quantity of the iceberg order shown in the order book.
+	VisibleQuantity *cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=visible_quantity,json=visibleQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"visible_quantity,omitempty"`
+	// priority_sequence is the sequence of the order book record key of the refilled iceberg order, the order sequence
+	//  is used if it's zero.
+	PrioritySequence uint64 `protobuf:"varint,10,opt,name=priority_sequence,json=prioritySequence,proto3" json:"priority_sequence,omitempty"`
 }
 
 func (m *OrderData) Reset()         { *m = OrderData{} }
 func (m *OrderData) String() string { return proto.CompactTextString(m) }
 func (*OrderData) ProtoMessage()    {}
 func (*OrderData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302bb6c9a553771c, []int{3}
+	return fileDescriptor_302bb6c9a553771c, []int{11}
 }
 func (m *OrderData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,7 +770,7 @@ func (m *OrderBookData) Reset()         { *m = OrderBookData{} }
 func (m *OrderBookData) String() string { return proto.CompactTextString(m) }
 func (*OrderBookData) ProtoMessage()    {}
 func (*OrderBookData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302bb6c9a553771c, []int{4}
+	return fileDescriptor_302bb6c9a553771c, []int{12}
 }
 func (m *OrderBookData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -381,13 +809,18 @@ type OrderBookRecordData struct {
 	RemainingBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining_base_quantity,json=remainingBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_base_quantity"`
 	// remaining_spendable_balance - is balance up to which user wants to spend to execute the order.
 	RemainingSpendableBalance cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=remaining_spendable_balance,json=remainingSpendableBalance,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_spendable_balance"`
+	// remaining_hidden_quantity is the remaining quantity of the iceberg order not shown in the order book.
+	RemainingHiddenQuantity *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining_hidden_quantity,json=remainingHiddenQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_hidden_quantity,omitempty"`
+	// order_sequence is the order sequence of the refilled iceberg order, the key sequence is the order sequence if
+	//  it's zero.
+	OrderSequence uint64 `protobuf:"varint,6,opt,name=order_sequence,json=orderSequence,proto3" json:"order_sequence,omitempty"`
 }
 
 func (m *OrderBookRecordData) Reset()         { *m = OrderBookRecordData{} }
 func (m *OrderBookRecordData) String() string { return proto.CompactTextString(m) }
 func (*OrderBookRecordData) ProtoMessage()    {}
 func (*OrderBookRecordData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_302bb6c9a553771c, []int{5}
+	return fileDescriptor_302bb6c9a553771c, []int{13}
 }
 func (m *OrderBookRecordData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -416,83 +849,173 @@ func (m *OrderBookRecordData) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OrderBookRecordData proto.InternalMessageInfo
 
+// PriceLevel is the aggregated order book price level.
+type PriceLevel struct {
+	// price is the price level price in the order book price terms.
+	Price Price `protobuf:"bytes,1,opt,name=price,proto3,customtype=Price" json:"price"`
+	// base_quantity is the remaining quantity of the base denom of all orders at the price level.
+	BaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=base_quantity,json=baseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"base_quantity"`
+	// orders_count is the number of orders at the price level.
+	OrdersCount uint64 `protobuf:"varint,3,opt,name=orders_count,json=ordersCount,proto3" json:"orders_count,omitempty"`
+}
+
+func (m *PriceLevel) Reset()         { *m = PriceLevel{} }
+func (m *PriceLevel) String() string { return proto.CompactTextString(m) }
+func (*PriceLevel) ProtoMessage()    {}
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{14}
+}
+func (m *PriceLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLevel.Merge(m, src)
+}
+func (m *PriceLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceLevel proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("coreum.dex.v1.Side", Side_name, Side_value)
 	proto.RegisterEnum("coreum.dex.v1.OrderType", OrderType_name, OrderType_value)
 	proto.RegisterEnum("coreum.dex.v1.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("coreum.dex.v1.TriggerType", TriggerType_name, TriggerType_value)
+	proto.RegisterEnum("coreum.dex.v1.SelfTradePrevention", SelfTradePrevention_name, SelfTradePrevention_value)
 	proto.RegisterType((*GoodTil)(nil), "coreum.dex.v1.GoodTil")
 	proto.RegisterType((*CancelGoodTil)(nil), "coreum.dex.v1.CancelGoodTil")
+	proto.RegisterType((*CancelAll)(nil), "coreum.dex.v1.CancelAll")
+	proto.RegisterType((*CancelAllAfter)(nil), "coreum.dex.v1.CancelAllAfter")
+	proto.RegisterType((*ResumeOrderBook)(nil), "coreum.dex.v1.ResumeOrderBook")
+	proto.RegisterType((*CloseOrderBook)(nil), "coreum.dex.v1.CloseOrderBook")
+	proto.RegisterType((*OrderBookHalt)(nil), "coreum.dex.v1.OrderBookHalt")
+	proto.RegisterType((*OrderBookPriceReference)(nil), "coreum.dex.v1.OrderBookPriceReference")
+	proto.RegisterType((*SwapHop)(nil), "coreum.dex.v1.SwapHop")
+	proto.RegisterType((*Trigger)(nil), "coreum.dex.v1.Trigger")
 	proto.RegisterType((*Order)(nil), "coreum.dex.v1.Order")
 	proto.RegisterType((*OrderData)(nil), "coreum.dex.v1.OrderData")
 	proto.RegisterType((*OrderBookData)(nil), "coreum.dex.v1.OrderBookData")
 	proto.RegisterType((*OrderBookRecordData)(nil), "coreum.dex.v1.OrderBookRecordData")
+	proto.RegisterType((*PriceLevel)(nil), "coreum.dex.v1.PriceLevel")
 }
 
 func init() { proto.RegisterFile("coreum/dex/v1/order.proto", fileDescriptor_302bb6c9a553771c) }
 
 var fileDescriptor_302bb6c9a553771c = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
-	0x10, 0x15, 0x65, 0xca, 0x92, 0x46, 0x91, 0xab, 0x6c, 0x6c, 0x97, 0x56, 0x61, 0x29, 0x50, 0x91,
-	0x26, 0x08, 0x5a, 0x12, 0x4a, 0x80, 0x02, 0xbd, 0xb4, 0x28, 0xf5, 0x91, 0x12, 0xb1, 0x2d, 0x97,
-	0x92, 0x0f, 0x09, 0x50, 0x10, 0x24, 0x77, 0x23, 0x2f, 0x24, 0x71, 0x65, 0x92, 0x52, 0xed, 0x7f,
-	0x50, 0x14, 0x28, 0x90, 0x73, 0xaf, 0xfd, 0x33, 0x3e, 0xe6, 0xd6, 0x22, 0x07, 0xb5, 0x95, 0xff,
-	0x48, 0xb1, 0xcb, 0x8f, 0xc8, 0x2a, 0x9a, 0xb8, 0x2d, 0x72, 0x12, 0xf7, 0xcd, 0xec, 0xbc, 0xd9,
-	0x9d, 0xf7, 0x28, 0xc2, 0x9e, 0xcb, 0x7c, 0x32, 0x9b, 0x68, 0x98, 0x9c, 0x6b, 0xf3, 0xa6, 0xc6,
-	0x7c, 0x4c, 0x7c, 0x75, 0xea, 0xb3, 0x90, 0xa1, 0x72, 0x14, 0x52, 0x31, 0x39, 0x57, 0xe7, 0xcd,
-	0x6a, 0xcd, 0x65, 0xc1, 0x84, 0x05, 0x9a, 0x63, 0x07, 0x44, 0x9b, 0x37, 0x1d, 0x12, 0xda, 0x4d,
-	0xcd, 0x65, 0xd4, 0x8b, 0xd2, 0xab, 0xdb, 0x43, 0x36, 0x64, 0xe2, 0x51, 0xe3, 0x4f, 0x31, 0x5a,
-	0x1f, 0x32, 0x36, 0x1c, 0x13, 0x4d, 0xac, 0x9c, 0xd9, 0x0b, 0x2d, 0xa4, 0x13, 0x12, 0x84, 0xf6,
-	0x64, 0x1a, 0x25, 0x34, 0x7e, 0x92, 0x20, 0xff, 0x84, 0x31, 0x3c, 0xa0, 0x63, 0xd4, 0x84, 0x9d,
-	0x21, 0x63, 0xd8, 0x0a, 0xe9, 0xd8, 0x72, 0xc6, 0xcc, 0x1d, 0x59, 0xa7, 0x84, 0x0e, 0x4f, 0x43,
-	0x45, 0xba, 0x2b, 0x3d, 0x90, 0x4d, 0x34, 0x8c, 0xf2, 0x74, 0x1e, 0xfa, 0x46, 0x44, 0x50, 0x0f,
-	0xee, 0xac, 0x6d, 0xe1, 0x04, 0x4a, 0xf6, 0xae, 0xf4, 0xa0, 0xf4, 0xa8, 0xaa, 0x46, 0xec, 0x6a,
-	0xc2, 0xae, 0x0e, 0x12, 0x76, 0x5d, 0x7e, 0xf9, 0x7b, 0x5d, 0x32, 0x2b, 0xab, 0x25, 0x79, 0xb0,
-	0x71, 0x0c, 0xe5, 0x96, 0xed, 0xb9, 0x64, 0x9c, 0x34, 0xa5, 0x40, 0xde, 0xf5, 0x89, 0x1d, 0x32,
-	0x5f, 0xb4, 0x51, 0x34, 0x93, 0x25, 0xba, 0x07, 0x5b, 0xe2, 0xbe, 0xac, 0x80, 0x9c, 0xcd, 0x88,
-	0xe7, 0x46, 0xb4, 0xb2, 0x59, 0x16, 0x68, 0x3f, 0x06, 0x1b, 0xbf, 0xe6, 0x20, 0xd7, 0xe3, 0xc8,
-	0x5b, 0x4a, 0x7d, 0x0a, 0x72, 0x78, 0x31, 0x8d, 0x0a, 0x6c, 0x3d, 0x52, 0xd4, 0x6b, 0x57, 0xaf,
-	0x8a, 0xdd, 0x83, 0x8b, 0x29, 0x31, 0x45, 0x16, 0xda, 0x85, 0x2c, 0xc5, 0xca, 0x06, 0x2f, 0xa1,
-	0x6f, 0x2e, 0x17, 0xf5, 0xac, 0xd1, 0x36, 0xb3, 0x14, 0xa3, 0x2a, 0x14, 0xd2, 0x56, 0x64, 0xd1,
-	0x4a, 0xba, 0x46, 0xfb, 0x00, 0x7c, 0x72, 0x16, 0x26, 0x1e, 0x9b, 0x28, 0x39, 0x41, 0x5f, 0xe4,
-	0x48, 0x9b, 0x03, 0xa8, 0x0e, 0xa5, 0xb3, 0x19, 0x0b, 0x93, 0xf8, 0xa6, 0x88, 0x83, 0x80, 0x92,
-	0x84, 0xdc, 0xd4, 0xa7, 0x2e, 0x51, 0xf2, 0x82, 0xb6, 0xf8, 0x7a, 0x51, 0xcf, 0x1d, 0x73, 0xc0,
-	0x8c, 0x70, 0xf4, 0x05, 0x14, 0xce, 0x66, 0xb6, 0x17, 0xd2, 0xf0, 0x42, 0x29, 0x88, 0x9c, 0xfd,
-	0xcb, 0x45, 0x3d, 0xf3, 0x7a, 0x51, 0xdf, 0x89, 0x94, 0x13, 0xe0, 0x91, 0x4a, 0x99, 0x36, 0xb1,
-	0xc3, 0x53, 0xd5, 0xf0, 0x42, 0x33, 0x4d, 0x47, 0xf7, 0x41, 0x0e, 0x28, 0x26, 0x4a, 0x51, 0x9c,
-	0xfe, 0xce, 0xda, 0xe9, 0xfb, 0x14, 0x13, 0x53, 0x24, 0xa0, 0x13, 0xf8, 0xd0, 0x27, 0x13, 0x9b,
-	0x7a, 0xd4, 0x1b, 0x5a, 0xe2, 0x38, 0x29, 0x25, 0xdc, 0x84, 0x72, 0x27, 0xdd, 0xad, 0xdb, 0x01,
-	0xf9, 0x36, 0xe1, 0xff, 0x0e, 0x3e, 0x7a, 0x53, 0x36, 0x98, 0x12, 0x0f, 0xdb, 0xce, 0x98, 0x58,
-	0x8e, 0x3d, 0xe6, 0x4a, 0x50, 0x4a, 0x37, 0x29, 0xbd, 0x97, 0x56, 0xe8, 0x27, 0x05, 0xf4, 0x68,
-	0x3f, 0x6a, 0x42, 0x21, 0xd1, 0xa8, 0x72, 0x4b, 0x08, 0x73, 0x77, 0xed, 0x88, 0xb1, 0xd6, 0xcc,
-	0x7c, 0x2c, 0x47, 0xf4, 0x25, 0x94, 0xb9, 0x8e, 0x2d, 0xea, 0x59, 0x2f, 0x98, 0xef, 0x12, 0xa5,
-	0x2c, 0xae, 0xa6, 0xba, 0xb6, 0x8f, 0x2b, 0xd6, 0xf0, 0xba, 0x3c, 0xc3, 0x2c, 0x85, 0x6f, 0x16,
-	0x08, 0x43, 0xde, 0x27, 0x01, 0xf1, 0xe7, 0x44, 0xd9, 0x12, 0x8c, 0x7b, 0x6a, 0xd4, 0xb6, 0xca,
-	0x6f, 0x4d, 0x8d, 0xed, 0xab, 0xb6, 0x18, 0xf5, 0x74, 0x2d, 0x3e, 0xd8, 0xfd, 0x21, 0x0d, 0x4f,
-	0x67, 0x8e, 0xea, 0xb2, 0x89, 0x16, 0x7b, 0x3d, 0xfa, 0xf9, 0x2c, 0xc0, 0x23, 0x8d, 0x0b, 0x2f,
-	0x10, 0x1b, 0xcc, 0xa4, 0x74, 0xe3, 0xc7, 0x0d, 0x28, 0x0a, 0x6d, 0xb6, 0xed, 0xd0, 0x46, 0x9f,
-	0x40, 0x21, 0xb2, 0x03, 0xc5, 0x91, 0xbc, 0xf5, 0xd2, 0x72, 0x51, 0xcf, 0x8b, 0x04, 0xa3, 0x6d,
-	0xe6, 0x45, 0xd0, 0xc0, 0xe8, 0x31, 0x44, 0x06, 0xb1, 0x1c, 0xc6, 0x46, 0x3c, 0x99, 0x8b, 0xbe,
-	0xac, 0x7f, 0xb0, 0x5c, 0xd4, 0x4b, 0x22, 0x59, 0x67, 0x6c, 0x64, 0xb4, 0xcd, 0x12, 0x4b, 0x17,
-	0x18, 0x7d, 0x9c, 0xc8, 0x2f, 0x52, 0x7d, 0x39, 0xee, 0xf9, 0x9f, 0x25, 0x28, 0xff, 0x37, 0x09,
-	0xe6, 0xde, 0x25, 0xc1, 0xd5, 0x61, 0x6e, 0xde, 0x6c, 0x98, 0x2b, 0xc3, 0xc8, 0xbf, 0xbf, 0x61,
-	0xf4, 0xa0, 0x9c, 0xde, 0x9e, 0x98, 0xc7, 0x75, 0xc7, 0x4b, 0xef, 0x70, 0x7c, 0x76, 0xdd, 0xf1,
-	0x8d, 0x9f, 0xb3, 0x70, 0x27, 0xad, 0x68, 0x12, 0x97, 0xf9, 0xf8, 0x5f, 0xcd, 0xf9, 0x1e, 0x6c,
-	0xd9, 0xae, 0xcb, 0x66, 0x5e, 0x68, 0x79, 0xb3, 0x89, 0x43, 0xfc, 0xe4, 0xf5, 0x18, 0xa3, 0x47,
-	0x02, 0x7c, 0x9b, 0xa7, 0x37, 0xde, 0x9f, 0xa7, 0xe5, 0xff, 0xe7, 0xe9, 0x87, 0x5f, 0x81, 0xcc,
-	0x45, 0x81, 0xb6, 0xa1, 0xd2, 0x37, 0xda, 0x1d, 0xeb, 0xe4, 0xa8, 0x7f, 0xdc, 0x69, 0x19, 0x5d,
-	0xa3, 0xd3, 0xae, 0x64, 0xd0, 0x2d, 0x28, 0x08, 0x54, 0x3f, 0x79, 0x56, 0x91, 0x50, 0x19, 0x8a,
-	0x62, 0xd5, 0xef, 0x1c, 0x1c, 0x54, 0xb2, 0x55, 0xf9, 0x87, 0x5f, 0x6a, 0x99, 0x87, 0xcf, 0x63,
-	0xeb, 0xf0, 0xd7, 0x3a, 0xaa, 0xc2, 0x6e, 0xcf, 0x6c, 0x77, 0x4c, 0x6b, 0xf0, 0xec, 0x78, 0xbd,
-	0xd6, 0x36, 0x54, 0x56, 0x62, 0x07, 0xc6, 0xa1, 0x31, 0xa8, 0x48, 0x68, 0x07, 0x6e, 0xaf, 0xa0,
-	0x87, 0x5f, 0x9b, 0x4f, 0x3b, 0x83, 0xb4, 0xf6, 0xf7, 0x50, 0x5a, 0x79, 0x33, 0xa0, 0x7d, 0xd8,
-	0x1b, 0x18, 0x87, 0x1d, 0xcb, 0x38, 0xb2, 0xba, 0x3d, 0xb3, 0xb5, 0x4e, 0xb0, 0x03, 0xb7, 0xaf,
-	0x87, 0x9f, 0x0c, 0x5a, 0x11, 0xc3, 0x75, 0xd8, 0xe8, 0xb5, 0x2a, 0xd9, 0xbf, 0xc3, 0xdd, 0xde,
-	0xd3, 0xca, 0x46, 0x44, 0xac, 0xf7, 0x2e, 0xff, 0xac, 0x65, 0x2e, 0x97, 0x35, 0xe9, 0xd5, 0xb2,
-	0x26, 0xfd, 0xb1, 0xac, 0x49, 0x2f, 0xaf, 0x6a, 0x99, 0x57, 0x57, 0xb5, 0xcc, 0x6f, 0x57, 0xb5,
-	0xcc, 0xf3, 0xe6, 0x8a, 0xa6, 0x5b, 0xc2, 0x32, 0x5d, 0x36, 0xf3, 0xb0, 0x1d, 0x52, 0xe6, 0x69,
-	0xf1, 0x77, 0xc8, 0xfc, 0x73, 0xed, 0x5c, 0x7c, 0x8c, 0x08, 0x89, 0x3b, 0x9b, 0xe2, 0x9f, 0xfb,
-	0xf1, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x1b, 0x46, 0x42, 0xa7, 0x08, 0x00, 0x00,
+	// 1539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0x1a, 0xd7,
+	0x16, 0xf7, 0x00, 0x36, 0x70, 0x30, 0x36, 0xbe, 0x8e, 0x1d, 0x4c, 0x5e, 0xc0, 0x21, 0xf2, 0x8b,
+	0x95, 0xf7, 0xde, 0xf0, 0x9c, 0x48, 0x4f, 0x7a, 0x9b, 0xb4, 0x06, 0x06, 0x7b, 0x14, 0xcc, 0x90,
+	0x61, 0x9c, 0x2a, 0x91, 0xda, 0xd1, 0xc0, 0x5c, 0xe3, 0x91, 0x87, 0xb9, 0x64, 0x66, 0xa0, 0xf1,
+	0xbe, 0x8b, 0x4a, 0x55, 0xd5, 0x2c, 0xba, 0xc8, 0xbe, 0x8b, 0xfe, 0x15, 0xdd, 0x67, 0x99, 0x65,
+	0xd5, 0x85, 0xdb, 0x3a, 0xcb, 0xfe, 0x13, 0xd5, 0xbd, 0xf3, 0x01, 0xc6, 0xc4, 0x1f, 0x89, 0xb2,
+	0xb2, 0xef, 0x39, 0xbf, 0xf3, 0x79, 0xcf, 0xf9, 0xcd, 0x05, 0xd6, 0x3a, 0xc4, 0xc6, 0x83, 0x5e,
+	0x49, 0xc7, 0x2f, 0x4b, 0xc3, 0xad, 0x12, 0xb1, 0x75, 0x6c, 0xf3, 0x7d, 0x9b, 0xb8, 0x04, 0xa5,
+	0x3d, 0x15, 0xaf, 0xe3, 0x97, 0xfc, 0x70, 0x2b, 0x97, 0xef, 0x10, 0xa7, 0x47, 0x9c, 0x52, 0x5b,
+	0x73, 0x70, 0x69, 0xb8, 0xd5, 0xc6, 0xae, 0xb6, 0x55, 0xea, 0x10, 0xc3, 0xf2, 0xe0, 0xb9, 0x1b,
+	0x5d, 0xd2, 0x25, 0xec, 0xdf, 0x12, 0xfd, 0xcf, 0x97, 0x16, 0xba, 0x84, 0x74, 0x4d, 0x5c, 0x62,
+	0xa7, 0xf6, 0xe0, 0xa0, 0xe4, 0x1a, 0x3d, 0xec, 0xb8, 0x5a, 0xaf, 0xef, 0x01, 0x8a, 0xdf, 0x73,
+	0x10, 0xdf, 0x21, 0x44, 0x57, 0x0c, 0x13, 0x6d, 0xc1, 0x4a, 0x97, 0x10, 0x5d, 0x75, 0x0d, 0x53,
+	0x6d, 0x9b, 0xa4, 0x73, 0xa4, 0x1e, 0x62, 0xa3, 0x7b, 0xe8, 0x66, 0xb9, 0x75, 0x6e, 0x33, 0x26,
+	0xa3, 0xae, 0x87, 0x2b, 0x53, 0xd5, 0x2e, 0xd3, 0x20, 0x09, 0x96, 0x27, 0x4c, 0x68, 0x80, 0x6c,
+	0x64, 0x9d, 0xdb, 0x4c, 0x3d, 0xc8, 0xf1, 0x5e, 0x74, 0x3e, 0x88, 0xce, 0x2b, 0x41, 0xf4, 0x72,
+	0xec, 0xd5, 0xef, 0x05, 0x4e, 0xce, 0x8c, 0xbb, 0xa4, 0xca, 0x62, 0x13, 0xd2, 0x15, 0xcd, 0xea,
+	0x60, 0x33, 0x48, 0x2a, 0x0b, 0xf1, 0x8e, 0x8d, 0x35, 0x97, 0xd8, 0x2c, 0x8d, 0xa4, 0x1c, 0x1c,
+	0xd1, 0x06, 0x2c, 0xb0, 0x7e, 0xa9, 0x0e, 0x7e, 0x31, 0xc0, 0x56, 0xc7, 0x0b, 0x1b, 0x93, 0xd3,
+	0x4c, 0xda, 0xf2, 0x85, 0xc5, 0x0d, 0x48, 0x7a, 0x1e, 0xb7, 0xcd, 0x0b, 0xbc, 0x15, 0xbf, 0xe1,
+	0x60, 0x21, 0xc4, 0x6d, 0x1f, 0xb8, 0xd8, 0xa6, 0x60, 0xad, 0xd3, 0x21, 0x03, 0xcb, 0x0d, 0xc0,
+	0xfe, 0x11, 0x7d, 0x0e, 0x09, 0x1d, 0x6b, 0xba, 0x69, 0x58, 0x57, 0xa9, 0x35, 0xf1, 0xe6, 0xa4,
+	0x30, 0xc3, 0xea, 0x0d, 0xad, 0xd0, 0x2a, 0xcc, 0xe9, 0xd8, 0x22, 0x3d, 0x27, 0x1b, 0x5d, 0x8f,
+	0x6e, 0x26, 0x65, 0xff, 0x54, 0xac, 0xc1, 0xa2, 0x8c, 0x9d, 0x41, 0x0f, 0x4b, 0xb4, 0x88, 0x32,
+	0x21, 0x47, 0xe8, 0x21, 0x78, 0x15, 0xa9, 0x6d, 0x42, 0x8e, 0x54, 0x43, 0x67, 0xc9, 0xa4, 0xcb,
+	0x8b, 0xa7, 0x27, 0x85, 0x54, 0x88, 0x12, 0xab, 0x72, 0x8a, 0x84, 0x07, 0xbd, 0x28, 0xc0, 0x42,
+	0xc5, 0x24, 0xce, 0xc7, 0xba, 0x79, 0x02, 0xe9, 0x50, 0xb7, 0xab, 0x99, 0x2e, 0xba, 0x05, 0xc9,
+	0x43, 0xcd, 0x74, 0xb1, 0xae, 0xb6, 0x8f, 0xfd, 0xae, 0x24, 0x3c, 0x41, 0xf9, 0x18, 0xdd, 0x85,
+	0xb4, 0xcd, 0x92, 0x0f, 0x06, 0xc7, 0xbb, 0x90, 0x79, 0x4f, 0xe8, 0x8d, 0x4c, 0xf1, 0x3b, 0x0e,
+	0x6e, 0x86, 0x3e, 0x9b, 0xb6, 0xd1, 0xc1, 0x32, 0x3e, 0xc0, 0x36, 0xbd, 0xab, 0x0f, 0xca, 0x11,
+	0xdd, 0x85, 0xd9, 0x3e, 0x75, 0xc3, 0xa2, 0x25, 0xcb, 0x69, 0xda, 0xed, 0xdf, 0x4e, 0x0a, 0xb3,
+	0x9e, 0x6f, 0x4f, 0x47, 0xfb, 0xed, 0xe7, 0x14, 0x5d, 0xe7, 0x36, 0xa3, 0xb2, 0x7f, 0x2a, 0xfe,
+	0xc0, 0x41, 0xbc, 0xf5, 0xb5, 0xd6, 0xdf, 0x25, 0x7d, 0xf4, 0x08, 0xc0, 0xe9, 0x63, 0xcb, 0x55,
+	0xe9, 0x5a, 0xb1, 0xd0, 0xa9, 0x07, 0x6b, 0xbc, 0xb7, 0x77, 0x3c, 0xdd, 0x3b, 0xde, 0xdf, 0x3b,
+	0xbe, 0x42, 0x0c, 0xab, 0x1c, 0xa3, 0x81, 0xe4, 0x24, 0x33, 0xa1, 0x02, 0x54, 0xa5, 0xe5, 0x77,
+	0xb0, 0x31, 0xc4, 0xba, 0xe7, 0x22, 0x72, 0x35, 0x17, 0xf3, 0x81, 0x15, 0x95, 0x15, 0xbf, 0x82,
+	0xb8, 0x62, 0x1b, 0xdd, 0x2e, 0xb6, 0x11, 0x0f, 0x31, 0xf7, 0xb8, 0x8f, 0x59, 0x2a, 0x0b, 0x0f,
+	0x72, 0xfc, 0x19, 0x46, 0xe0, 0x7d, 0x94, 0x72, 0xdc, 0xc7, 0x32, 0xc3, 0x5d, 0xa9, 0x13, 0xc5,
+	0x9f, 0xe3, 0x30, 0xcb, 0x7a, 0x79, 0xc1, 0x6a, 0xfd, 0xdb, 0x0f, 0x1c, 0x61, 0x81, 0xb3, 0x13,
+	0x81, 0x99, 0xf5, 0x58, 0xd8, 0x55, 0x88, 0x18, 0x3a, 0xeb, 0x6b, 0xb2, 0x3c, 0x77, 0x7a, 0x52,
+	0x88, 0x88, 0x55, 0x39, 0x62, 0xe8, 0x28, 0x07, 0x89, 0x70, 0x35, 0x63, 0x6c, 0x12, 0xc2, 0x33,
+	0xba, 0x0d, 0x40, 0xdb, 0xa1, 0xb2, 0xb1, 0xcf, 0xce, 0xb2, 0xf0, 0x49, 0x2a, 0xa9, 0x52, 0x01,
+	0x2a, 0x40, 0xea, 0xc5, 0x80, 0xb8, 0x81, 0x7e, 0x8e, 0xe9, 0x81, 0x89, 0x02, 0x80, 0x5f, 0x6a,
+	0x9c, 0x85, 0x4d, 0x9e, 0xbb, 0xf0, 0xff, 0x43, 0xe2, 0xc5, 0x40, 0xb3, 0x5c, 0xc3, 0x3d, 0xce,
+	0x26, 0x18, 0xe6, 0xb6, 0xdf, 0x8e, 0x15, 0xef, 0x3a, 0x1c, 0xfd, 0x88, 0x37, 0x48, 0xa9, 0xa7,
+	0xb9, 0x87, 0xbc, 0x68, 0xb9, 0x72, 0x08, 0x47, 0xf7, 0x20, 0xe6, 0x18, 0x3a, 0xce, 0x26, 0x59,
+	0xf5, 0xcb, 0x13, 0xd5, 0xb7, 0x0c, 0x1d, 0xcb, 0x0c, 0x80, 0xf6, 0xe1, 0xa6, 0x8d, 0x7b, 0x9a,
+	0x61, 0x19, 0x56, 0x57, 0x65, 0xe5, 0x84, 0x21, 0xe1, 0x2a, 0x21, 0x57, 0x42, 0xeb, 0xb2, 0xe6,
+	0xe0, 0x27, 0x41, 0xfc, 0x2f, 0xe1, 0xd6, 0xc8, 0x2d, 0x1d, 0x2f, 0x5d, 0x6b, 0x9b, 0x58, 0x6d,
+	0x6b, 0x26, 0xe5, 0xa7, 0x6c, 0xea, 0x2a, 0xae, 0xd7, 0x42, 0x0f, 0xad, 0xc0, 0x41, 0xd9, 0xb3,
+	0x47, 0x5b, 0x90, 0x08, 0x38, 0x3b, 0x3b, 0xcf, 0x26, 0x74, 0x75, 0xa2, 0x44, 0x9f, 0x7b, 0xe5,
+	0xb8, 0x4f, 0xcf, 0xe8, 0x11, 0xa4, 0x29, 0xaf, 0xab, 0x86, 0xa5, 0x1e, 0x10, 0xbb, 0x83, 0xb3,
+	0xe9, 0xe9, 0x13, 0x69, 0xf4, 0xb0, 0x68, 0xd5, 0x28, 0x42, 0x4e, 0xb9, 0xa3, 0x03, 0xd2, 0x21,
+	0x6e, 0x63, 0x07, 0xdb, 0x43, 0x9c, 0x5d, 0xb8, 0x6c, 0x27, 0x4a, 0x7e, 0x61, 0xf7, 0xba, 0x86,
+	0x7b, 0x38, 0x68, 0xf3, 0x1d, 0xd2, 0x2b, 0xf9, 0xdf, 0x3e, 0xef, 0xcf, 0x7f, 0x1c, 0xfd, 0xa8,
+	0x44, 0x07, 0xcf, 0x61, 0x06, 0x72, 0xe0, 0x1a, 0xfd, 0x17, 0xe2, 0xae, 0xb7, 0x13, 0xd9, 0xc5,
+	0xa9, 0x75, 0xf9, 0x1b, 0x23, 0x07, 0x30, 0xf4, 0x14, 0x56, 0x1c, 0x6c, 0x1e, 0xa8, 0xae, 0xad,
+	0xe9, 0x58, 0xed, 0xdb, 0x78, 0x88, 0x2d, 0xd7, 0x20, 0x56, 0x36, 0xc3, 0xea, 0x2b, 0x4e, 0x5e,
+	0x3d, 0x36, 0x0f, 0x14, 0x0a, 0x6d, 0x86, 0x48, 0x79, 0xd9, 0x39, 0x2f, 0x44, 0x55, 0xc8, 0x0c,
+	0x0d, 0xc7, 0xa0, 0xb7, 0x16, 0x4e, 0xc4, 0x12, 0xbb, 0xb6, 0xb5, 0xf7, 0x5f, 0xd9, 0xa2, 0x6f,
+	0x12, 0xcc, 0x41, 0xf1, 0x97, 0x18, 0x24, 0xd9, 0xae, 0x55, 0x35, 0x57, 0x43, 0xff, 0x84, 0x84,
+	0xc7, 0x8d, 0x3e, 0x2d, 0x26, 0xcb, 0xa9, 0xd3, 0x93, 0x42, 0x9c, 0x01, 0xc4, 0xaa, 0x1c, 0x67,
+	0x4a, 0x51, 0x3f, 0xcf, 0xa1, 0x91, 0xeb, 0x70, 0x68, 0xf4, 0x02, 0x0e, 0x1d, 0x5f, 0xa9, 0xd8,
+	0x87, 0xad, 0xd4, 0xec, 0x65, 0x2b, 0x35, 0x3e, 0x9c, 0x73, 0x57, 0x1b, 0xce, 0xb1, 0xe1, 0x8a,
+	0x7f, 0xba, 0xe1, 0x3a, 0xb7, 0x02, 0x89, 0xeb, 0xad, 0xc0, 0xb4, 0x91, 0x48, 0x5e, 0x77, 0x24,
+	0xd0, 0xbf, 0x60, 0xa9, 0x6f, 0x1b, 0xc4, 0x36, 0xdc, 0xe3, 0xd1, 0xb3, 0x07, 0x18, 0xb7, 0x66,
+	0x02, 0x45, 0xf8, 0xf2, 0x91, 0xc6, 0x3e, 0xde, 0x6c, 0x84, 0xce, 0x92, 0x2e, 0x77, 0x09, 0xe9,
+	0x46, 0x26, 0x49, 0xb7, 0xf8, 0x3a, 0x0a, 0xcb, 0xa1, 0x47, 0x19, 0x77, 0x88, 0xad, 0x5f, 0x6b,
+	0x34, 0x37, 0x60, 0xc1, 0x7f, 0x41, 0xa9, 0xd6, 0xa0, 0xd7, 0xc6, 0x76, 0xf0, 0x62, 0xf3, 0xa5,
+	0x0d, 0x26, 0xbc, 0x88, 0x56, 0xa3, 0x9f, 0x8e, 0x56, 0x63, 0x1f, 0x49, 0xab, 0xfb, 0x30, 0x52,
+	0xaa, 0x87, 0x86, 0xae, 0x63, 0x6b, 0x94, 0xf7, 0xec, 0x65, 0x37, 0x3d, 0xaa, 0x78, 0x97, 0x99,
+	0x86, 0x59, 0x9f, 0x7f, 0xe5, 0xce, 0x4d, 0x7b, 0xe5, 0xbe, 0xe6, 0x00, 0xd8, 0xb2, 0xd6, 0xf1,
+	0x10, 0x9b, 0xa3, 0x7d, 0xe6, 0x2e, 0xd8, 0xe7, 0x32, 0xa4, 0xcf, 0x76, 0x37, 0x72, 0x95, 0x16,
+	0xcc, 0xb7, 0xc7, 0x9b, 0x7a, 0x07, 0xe6, 0x59, 0x22, 0x8e, 0xea, 0x3d, 0x94, 0xa3, 0x2c, 0x39,
+	0x8f, 0x5b, 0x9c, 0x0a, 0x15, 0xdd, 0xff, 0x0c, 0x62, 0x74, 0xc1, 0xd1, 0x0d, 0xc8, 0xb4, 0xc4,
+	0xaa, 0xa0, 0xee, 0x37, 0x5a, 0x4d, 0xa1, 0x22, 0xd6, 0x44, 0xa1, 0x9a, 0x99, 0x41, 0xf3, 0x90,
+	0x60, 0xd2, 0xf2, 0xfe, 0xb3, 0x0c, 0x87, 0xd2, 0x90, 0x64, 0xa7, 0x96, 0x50, 0xaf, 0x67, 0x22,
+	0xb9, 0xd8, 0xb7, 0x3f, 0xe5, 0x67, 0xee, 0x3f, 0xf7, 0x69, 0x90, 0x3e, 0x39, 0x50, 0x0e, 0x56,
+	0x25, 0xb9, 0x2a, 0xc8, 0xaa, 0xf2, 0xac, 0x39, 0xe9, 0xeb, 0x06, 0x64, 0xc6, 0x74, 0x75, 0x71,
+	0x4f, 0x54, 0x32, 0x1c, 0x5a, 0x81, 0xa5, 0x31, 0xe9, 0xde, 0xb6, 0xfc, 0x58, 0x50, 0x42, 0xdf,
+	0x3f, 0x72, 0x90, 0x1a, 0xdb, 0x59, 0x74, 0x1b, 0xd6, 0x14, 0x71, 0x4f, 0x50, 0xc5, 0x86, 0x5a,
+	0x93, 0xe4, 0xca, 0x64, 0x84, 0x15, 0x58, 0x3a, 0xab, 0xde, 0x51, 0x2a, 0x5e, 0x88, 0xb3, 0x62,
+	0x51, 0xaa, 0x64, 0x22, 0xe7, 0xc5, 0x35, 0xe9, 0x71, 0x26, 0x8a, 0x6e, 0xc1, 0xcd, 0xb3, 0xe2,
+	0xa6, 0xd4, 0x52, 0x54, 0xa9, 0x51, 0x7f, 0x96, 0x89, 0xf9, 0x69, 0x1d, 0x41, 0x6a, 0xec, 0x79,
+	0x87, 0xfe, 0x01, 0x59, 0x45, 0x16, 0x77, 0x76, 0xa6, 0x97, 0x9d, 0x83, 0xd5, 0x33, 0xda, 0x96,
+	0x22, 0x35, 0xd5, 0xba, 0xd4, 0x6a, 0x65, 0xb8, 0x73, 0x96, 0xca, 0xf6, 0x63, 0x41, 0x6d, 0xca,
+	0x52, 0x4d, 0x1c, 0xf5, 0xe0, 0x2f, 0x0e, 0x96, 0xa7, 0x7c, 0xda, 0xd0, 0x06, 0xdc, 0x69, 0x09,
+	0xf5, 0x9a, 0xaa, 0xc8, 0xdb, 0x55, 0x6a, 0x24, 0x3c, 0x15, 0x1a, 0x8a, 0x28, 0x35, 0x26, 0xc2,
+	0xdf, 0x83, 0xbb, 0xd3, 0x61, 0x95, 0xed, 0x46, 0x45, 0xa8, 0xab, 0x0d, 0xe1, 0x0b, 0xa1, 0x45,
+	0x2f, 0xe2, 0x32, 0xa0, 0x54, 0xaf, 0x52, 0x60, 0xe4, 0xfd, 0x81, 0x7d, 0x60, 0x59, 0x52, 0x76,
+	0x33, 0x51, 0xc4, 0xc3, 0xfd, 0xe9, 0xb0, 0xaa, 0x50, 0x91, 0x85, 0x3d, 0xa1, 0xa1, 0xa8, 0xdb,
+	0x8d, 0xaa, 0x6f, 0x14, 0xb4, 0xb6, 0x2c, 0xbd, 0xf9, 0x33, 0x3f, 0xf3, 0xe6, 0x34, 0xcf, 0xbd,
+	0x3d, 0xcd, 0x73, 0x7f, 0x9c, 0xe6, 0xb9, 0x57, 0xef, 0xf2, 0x33, 0x6f, 0xdf, 0xe5, 0x67, 0x7e,
+	0x7d, 0x97, 0x9f, 0x79, 0xbe, 0x35, 0xf6, 0x61, 0xa8, 0x30, 0x66, 0xaf, 0x91, 0x81, 0xa5, 0x6b,
+	0xb4, 0x21, 0x25, 0xff, 0xc7, 0xfa, 0xf0, 0x7f, 0xa5, 0x97, 0xec, 0x17, 0x3b, 0xfb, 0x4e, 0xb4,
+	0xe7, 0xd8, 0x4f, 0xbe, 0x87, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xf9, 0x51, 0xb4, 0xeb, 0xcc,
+	0x0f, 0x00, 0x00,
 }
 
 func (m *GoodTil) Marshal() (dAtA []byte, err error) {
@@ -568,7 +1091,7 @@ func (m *CancelGoodTil) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Order) Marshal() (dAtA []byte, err error) {
+func (m *CancelAll) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -578,132 +1101,165 @@ func (m *Order) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+func (m *CancelAll) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CancelAll) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Reserve.Size()
-		i -= size
-		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrder(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x72
-	if m.TimeInForce != 0 {
-		i = encodeVarintOrder(dAtA, i, uint64(m.TimeInForce))
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Creator)))
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0xa
 	}
-	if m.GoodTil != nil {
-		{
-			size, err := m.GoodTil.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOrder(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelAllAfter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	{
-		size := m.RemainingSpendableBalance.Size()
-		i -= size
-		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return dAtA[:n], nil
+}
+
+func (m *CancelAllAfter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelAllAfter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintOrder(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.RemainingBaseQuantity.Size()
-		i -= size
-		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrder(dAtA, i, uint64(size))
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err2 != nil {
+		return 0, err2
 	}
+	i -= n2
+	i = encodeVarintOrder(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x52
-	if m.Side != 0 {
-		i = encodeVarintOrder(dAtA, i, uint64(m.Side))
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Account)))
 		i--
-		dAtA[i] = 0x48
-	}
-	{
-		size := m.Quantity.Size()
-		i -= size
-		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrder(dAtA, i, uint64(size))
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0x42
-	if m.Price != nil {
-		{
-			size := m.Price.Size()
-			i -= size
-			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintOrder(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeOrderBook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.QuoteDenom)))
+	return dAtA[:n], nil
+}
+
+func (m *ResumeOrderBook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeOrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderBookID))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x8
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+
+func (m *CloseOrderBook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Sequence != 0 {
-		i = encodeVarintOrder(dAtA, i, uint64(m.Sequence))
+	return dAtA[:n], nil
+}
+
+func (m *CloseOrderBook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CloseOrderBook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderBookID))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x8
 	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Type != 0 {
-		i = encodeVarintOrder(dAtA, i, uint64(m.Type))
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResumeHeight != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ResumeHeight))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.Creator)))
+	if len(m.HaltedBy) > 0 {
+		i -= len(m.HaltedBy)
+		copy(dAtA[i:], m.HaltedBy)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.HaltedBy)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OrderData) Marshal() (dAtA []byte, err error) {
+func (m *OrderBookPriceReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -713,53 +1269,21 @@ func (m *OrderData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OrderData) MarshalTo(dAtA []byte) (int, error) {
+func (m *OrderBookPriceReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OrderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OrderBookPriceReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Reserve.Size()
-		i -= size
-		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrder(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.GoodTil != nil {
-		{
-			size, err := m.GoodTil.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintOrder(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Side != 0 {
-		i = encodeVarintOrder(dAtA, i, uint64(m.Side))
+	if m.Height != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.Quantity.Size()
-		i -= size
-		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrder(dAtA, i, uint64(size))
+		dAtA[i] = 0x18
 	}
-	i--
-	dAtA[i] = 0x22
 	{
 		size := m.Price.Size()
 		i -= size
@@ -769,23 +1293,16 @@ func (m *OrderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x12
 	if m.OrderBookID != 0 {
 		i = encodeVarintOrder(dAtA, i, uint64(m.OrderBookID))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.OrderID) > 0 {
-		i -= len(m.OrderID)
-		copy(dAtA[i:], m.OrderID)
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.OrderID)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OrderBookData) Marshal() (dAtA []byte, err error) {
+func (m *SwapHop) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -795,34 +1312,40 @@ func (m *OrderBookData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OrderBookData) MarshalTo(dAtA []byte) (int, error) {
+func (m *SwapHop) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OrderBookData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SwapHop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size, err := m.ReceivedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SpentCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *OrderBookRecordData) Marshal() (dAtA []byte, err error) {
+func (m *Trigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -832,220 +1355,1468 @@ func (m *OrderBookRecordData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OrderBookRecordData) MarshalTo(dAtA []byte) (int, error) {
+func (m *Trigger) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OrderBookRecordData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Trigger) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.RemainingSpendableBalance.Size()
-		i -= size
-		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintOrder(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.RemainingBaseQuantity.Size()
+		size := m.Price.Size()
 		i -= size
-		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
-	if m.AccountNumber != 0 {
-		i = encodeVarintOrder(dAtA, i, uint64(m.AccountNumber))
+	dAtA[i] = 0x12
+	if m.Type != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Type))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.OrderID) > 0 {
-		i -= len(m.OrderID)
-		copy(dAtA[i:], m.OrderID)
-		i = encodeVarintOrder(dAtA, i, uint64(len(m.OrderID)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOrder(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GoodTil) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.GoodTilBlockHeight != 0 {
-		n += 1 + sovOrder(uint64(m.GoodTilBlockHeight))
-	}
-	if m.GoodTilBlockTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GoodTilBlockTime)
-		n += 1 + l + sovOrder(uint64(l))
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *CancelGoodTil) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
-	}
-	if m.OrderSequence != 0 {
-		n += 1 + sovOrder(uint64(m.OrderSequence))
-	}
-	return n
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Order) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	if m.VisibleQuantity != nil {
+		{
+			size := m.VisibleQuantity.Size()
+			i -= size
+			if _, err := m.VisibleQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	if m.Type != 0 {
-		n += 1 + sovOrder(uint64(m.Type))
+	if m.SelfTradePrevention != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.SelfTradePrevention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	if m.Trigger != nil {
+		{
+			size, err := m.Trigger.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	if m.Sequence != 0 {
-		n += 1 + sovOrder(uint64(m.Sequence))
+	{
+		size := m.Reserve.Size()
+		i -= size
+		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	i--
+	dAtA[i] = 0x72
+	if m.TimeInForce != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x68
 	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	if m.GoodTil != nil {
+		{
+			size, err := m.GoodTil.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	if m.Price != nil {
-		l = m.Price.Size()
-		n += 1 + l + sovOrder(uint64(l))
+	{
+		size := m.RemainingSpendableBalance.Size()
+		i -= size
+		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	l = m.Quantity.Size()
-	n += 1 + l + sovOrder(uint64(l))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.RemainingBaseQuantity.Size()
+		i -= size
+		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	if m.Side != 0 {
-		n += 1 + sovOrder(uint64(m.Side))
+		i = encodeVarintOrder(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = m.RemainingBaseQuantity.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	l = m.RemainingSpendableBalance.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	if m.GoodTil != nil {
-		l = m.GoodTil.Size()
-		n += 1 + l + sovOrder(uint64(l))
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
-	if m.TimeInForce != 0 {
-		n += 1 + sovOrder(uint64(m.TimeInForce))
+	i--
+	dAtA[i] = 0x42
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = m.Reserve.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	return n
-}
-
-func (m *OrderData) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.OrderID)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.OrderBookID != 0 {
-		n += 1 + sovOrder(uint64(m.OrderBookID))
+	if m.Sequence != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = m.Price.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	l = m.Quantity.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	if m.Side != 0 {
-		n += 1 + sovOrder(uint64(m.Side))
+	if len(m.ID) > 0 {
+		i -= len(m.ID)
+		copy(dAtA[i:], m.ID)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.ID)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.GoodTil != nil {
-		l = m.GoodTil.Size()
-		n += 1 + l + sovOrder(uint64(l))
+	if m.Type != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Reserve.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	return n
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *OrderBookData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
-	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+func (m *OrderData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *OrderBookRecordData) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrderData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrioritySequence != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.PrioritySequence))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.VisibleQuantity != nil {
+		{
+			size := m.VisibleQuantity.Size()
+			i -= size
+			if _, err := m.VisibleQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Reserve.Size()
+		i -= size
+		if _, err := m.Reserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.GoodTil != nil {
+		{
+			size, err := m.GoodTil.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Side != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.OrderBookID != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderBookID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrderID) > 0 {
+		i -= len(m.OrderID)
+		copy(dAtA[i:], m.OrderID)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.OrderID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OrderID)
-	if l > 0 {
-		n += 1 + l + sovOrder(uint64(l))
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookRecordData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookRecordData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookRecordData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderSequence != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrderSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RemainingHiddenQuantity != nil {
+		{
+			size := m.RemainingHiddenQuantity.Size()
+			i -= size
+			if _, err := m.RemainingHiddenQuantity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.RemainingSpendableBalance.Size()
+		i -= size
+		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RemainingBaseQuantity.Size()
+		i -= size
+		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.AccountNumber != 0 {
-		n += 1 + sovOrder(uint64(m.AccountNumber))
+		i = encodeVarintOrder(dAtA, i, uint64(m.AccountNumber))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.RemainingBaseQuantity.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	l = m.RemainingSpendableBalance.Size()
-	n += 1 + l + sovOrder(uint64(l))
-	return n
+	if len(m.OrderID) > 0 {
+		i -= len(m.OrderID)
+		copy(dAtA[i:], m.OrderID)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.OrderID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovOrder(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *PriceLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozOrder(x uint64) (n int) {
-	return sovOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *PriceLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrdersCount != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.OrdersCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseQuantity.Size()
+		i -= size
+		if _, err := m.BaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GoodTil) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GoodTilBlockHeight != 0 {
+		n += 1 + sovOrder(uint64(m.GoodTilBlockHeight))
+	}
+	if m.GoodTilBlockTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.GoodTilBlockTime)
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelGoodTil) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.OrderSequence != 0 {
+		n += 1 + sovOrder(uint64(m.OrderSequence))
+	}
+	return n
+}
+
+func (m *CancelAll) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelAllAfter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovOrder(uint64(l))
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovOrder(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResumeOrderBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovOrder(uint64(m.OrderBookID))
+	}
+	return n
+}
+
+func (m *CloseOrderBook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovOrder(uint64(m.OrderBookID))
+	}
+	return n
+}
+
+func (m *OrderBookHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HaltedBy)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.ResumeHeight != 0 {
+		n += 1 + sovOrder(uint64(m.ResumeHeight))
+	}
+	return n
+}
+
+func (m *OrderBookPriceReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderBookID != 0 {
+		n += 1 + sovOrder(uint64(m.OrderBookID))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovOrder(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *SwapHop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpentCoin.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.ReceivedCoin.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func (m *Trigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovOrder(uint64(m.Type))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovOrder(uint64(m.Type))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovOrder(uint64(m.Sequence))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.Side != 0 {
+		n += 1 + sovOrder(uint64(m.Side))
+	}
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.RemainingSpendableBalance.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.GoodTil != nil {
+		l = m.GoodTil.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovOrder(uint64(m.TimeInForce))
+	}
+	l = m.Reserve.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.Trigger != nil {
+		l = m.Trigger.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.SelfTradePrevention != 0 {
+		n += 2 + sovOrder(uint64(m.SelfTradePrevention))
+	}
+	if m.VisibleQuantity != nil {
+		l = m.VisibleQuantity.Size()
+		n += 2 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *OrderData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderID)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.OrderBookID != 0 {
+		n += 1 + sovOrder(uint64(m.OrderBookID))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.Side != 0 {
+		n += 1 + sovOrder(uint64(m.Side))
+	}
+	if m.GoodTil != nil {
+		l = m.GoodTil.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = m.Reserve.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.TimeInForce != 0 {
+		n += 1 + sovOrder(uint64(m.TimeInForce))
+	}
+	if m.VisibleQuantity != nil {
+		l = m.VisibleQuantity.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.PrioritySequence != 0 {
+		n += 1 + sovOrder(uint64(m.PrioritySequence))
+	}
+	return n
+}
+
+func (m *OrderBookData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *OrderBookRecordData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderID)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.AccountNumber != 0 {
+		n += 1 + sovOrder(uint64(m.AccountNumber))
+	}
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.RemainingSpendableBalance.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.RemainingHiddenQuantity != nil {
+		l = m.RemainingHiddenQuantity.Size()
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.OrderSequence != 0 {
+		n += 1 + sovOrder(uint64(m.OrderSequence))
+	}
+	return n
+}
+
+func (m *PriceLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.BaseQuantity.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	if m.OrdersCount != 0 {
+		n += 1 + sovOrder(uint64(m.OrdersCount))
+	}
+	return n
+}
+
+func sovOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrder(x uint64) (n int) {
+	return sovOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GoodTil) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GoodTil: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GoodTil: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockHeight", wireType)
+			}
+			m.GoodTilBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GoodTilBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GoodTilBlockTime == nil {
+				m.GoodTilBlockTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.GoodTilBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelGoodTil) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelGoodTil: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelGoodTil: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSequence", wireType)
+			}
+			m.OrderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelAll) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelAll: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelAll: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelAllAfter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelAllAfter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelAllAfter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeOrderBook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeOrderBook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CloseOrderBook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CloseOrderBook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CloseOrderBook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
+			}
+			m.OrderBookID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderBookID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookHalt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookHalt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HaltedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeHeight", wireType)
+			}
+			m.ResumeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumeHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GoodTil) Unmarshal(dAtA []byte) error {
+func (m *OrderBookPriceReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1068,17 +2839,17 @@ func (m *GoodTil) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GoodTil: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderBookPriceReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GoodTil: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderBookPriceReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBookID", wireType)
 			}
-			m.GoodTilBlockHeight = 0
+			m.OrderBookID = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1088,16 +2859,16 @@ func (m *GoodTil) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GoodTilBlockHeight |= uint64(b&0x7F) << shift
+				m.OrderBookID |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GoodTilBlockTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1107,28 +2878,45 @@ func (m *GoodTil) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.GoodTilBlockTime == nil {
-				m.GoodTilBlockTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.GoodTilBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -1150,7 +2938,7 @@ func (m *GoodTil) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelGoodTil) Unmarshal(dAtA []byte) error {
+func (m *SwapHop) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1173,17 +2961,17 @@ func (m *CancelGoodTil) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelGoodTil: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapHop: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelGoodTil: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapHop: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentCoin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1193,29 +2981,113 @@ func (m *CancelGoodTil) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.SpentCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			m.OrderSequence = 0
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOrder
@@ -1225,11 +3097,45 @@ func (m *CancelGoodTil) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderSequence |= uint64(b&0x7F) << shift
+				m.Type |= TriggerType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -1672,22 +3578,113 @@ func (m *Order) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Trigger == nil {
+				m.Trigger = &Trigger{}
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfTradePrevention", wireType)
+			}
+			m.SelfTradePrevention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfTradePrevention |= SelfTradePrevention(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibleQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.Int
+			m.VisibleQuantity = &v
+			if err := m.VisibleQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1948,6 +3945,80 @@ func (m *OrderData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VisibleQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.VisibleQuantity = &v
+			if err := m.VisibleQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrioritySequence", wireType)
+			}
+			m.PrioritySequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrioritySequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
@@ -2231,6 +4302,198 @@ func (m *OrderBookRecordData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingHiddenQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RemainingHiddenQuantity = &v
+			if err := m.RemainingHiddenQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderSequence", wireType)
+			}
+			m.OrderSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrdersCount", wireType)
+			}
+			m.OrdersCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrdersCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrder(dAtA[iNdEx:])
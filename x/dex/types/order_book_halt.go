@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate validates the order book halt.
+func (h OrderBookHalt) Validate() error {
+	if h.HaltedBy != "" {
+		if _, err := sdk.AccAddressFromBech32(h.HaltedBy); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid address: %s", h.HaltedBy)
+		}
+	}
+
+	return nil
+}
@@ -26,6 +26,42 @@ type OrderBookRecord struct {
 	RemainingBaseQuantity cosmossdk_io_math.Int `json:"remaining_base_quantity"`
 	// remaining_spendable_balance - is balance up to which user wants to spend to execute the order.
 	RemainingSpendableBalance cosmossdk_io_math.Int `json:"remaining_spendable_balance"`
+	// priority_sequence is the sequence of the record key of the refilled iceberg order, order_sequence is used if
+	// it's zero.
+	PrioritySequence uint64 `json:"priority_sequence,omitempty"`
+	// remaining_hidden_quantity is the remaining quantity of the iceberg order not shown in the order book.
+	RemainingHiddenQuantity cosmossdk_io_math.Int `json:"remaining_hidden_quantity"`
+}
+
+// SplitVisibleQuantity moves the remaining base quantity above the visible quantity of the iceberg order to the
+// hidden quantity.
+func (o *OrderBookRecord) SplitVisibleQuantity(visibleQuantity *cosmossdk_io_math.Int) {
+	if visibleQuantity == nil || !o.RemainingBaseQuantity.GT(*visibleQuantity) {
+		return
+	}
+	o.RemainingHiddenQuantity = o.RemainingBaseQuantity.Sub(*visibleQuantity)
+	o.RemainingBaseQuantity = *visibleQuantity
+}
+
+// GetPrioritySequence returns the sequence of the record key which defines the record priority at its price level.
+func (o *OrderBookRecord) GetPrioritySequence() uint64 {
+	if o.PrioritySequence != 0 {
+		return o.PrioritySequence
+	}
+	return o.OrderSequence
+}
+
+// HasHiddenQuantity returns true if the record has the remaining hidden quantity of the iceberg order.
+func (o *OrderBookRecord) HasHiddenQuantity() bool {
+	return !o.RemainingHiddenQuantity.IsNil() && o.RemainingHiddenQuantity.IsPositive()
+}
+
+// GetTotalRemainingBaseQuantity returns the sum of the remaining visible and hidden base quantities.
+func (o *OrderBookRecord) GetTotalRemainingBaseQuantity() cosmossdk_io_math.Int {
+	if !o.HasHiddenQuantity() {
+		return o.RemainingBaseQuantity
+	}
+	return o.RemainingBaseQuantity.Add(o.RemainingHiddenQuantity)
 }
 
 func (o *OrderBookRecord) String() string {
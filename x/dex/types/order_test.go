@@ -130,6 +130,71 @@ func TestOrder_Validate(t *testing.T) {
 			}(),
 			wantErr: types.ErrInvalidInput,
 		},
+		{
+			name: "valid_self_trade_prevention",
+			order: func() types.Order {
+				order := validOrder()
+				order.SelfTradePrevention = types.SELF_TRADE_PREVENTION_DECREMENT_AND_CANCEL
+				return order
+			}(),
+		},
+		{
+			name: "invalid_self_trade_prevention",
+			order: func() types.Order {
+				order := validOrder()
+				order.SelfTradePrevention = types.SelfTradePrevention(100)
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_visible_quantity",
+			order: func() types.Order {
+				order := validOrder()
+				order.VisibleQuantity = lo.ToPtr(sdkmath.NewInt(10))
+				return order
+			}(),
+		},
+		{
+			name: "invalid_visible_quantity_not_less_than_quantity",
+			order: func() types.Order {
+				order := validOrder()
+				order.VisibleQuantity = lo.ToPtr(sdkmath.NewInt(100))
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_visible_quantity_zero",
+			order: func() types.Order {
+				order := validOrder()
+				order.VisibleQuantity = lo.ToPtr(sdkmath.ZeroInt())
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_visible_quantity_ioc",
+			order: func() types.Order {
+				order := validOrder()
+				order.TimeInForce = types.TIME_IN_FORCE_IOC
+				order.VisibleQuantity = lo.ToPtr(sdkmath.NewInt(10))
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_visible_quantity_market",
+			order: func() types.Order {
+				order := validOrder()
+				order.Type = types.ORDER_TYPE_MARKET
+				order.Price = nil
+				order.TimeInForce = types.TIME_IN_FORCE_IOC
+				order.VisibleQuantity = lo.ToPtr(sdkmath.NewInt(10))
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
 		{
 			name: "invalid_side_unspecified",
 			order: func() types.Order {
@@ -324,6 +389,89 @@ func TestOrder_Validate(t *testing.T) {
 				return order
 			}(),
 		},
+		{
+			name: "valid_stop_loss_trigger",
+			order: func() types.Order {
+				order := validOrder()
+				order.Trigger = &types.Trigger{
+					Type:  types.TRIGGER_TYPE_STOP_LOSS,
+					Price: types.MustNewPriceFromString("2e-1"),
+				}
+				return order
+			}(),
+		},
+		{
+			name: "valid_take_profit_trigger_for_market_sell_order",
+			order: func() types.Order {
+				order := validOrder()
+				order.Type = types.ORDER_TYPE_MARKET
+				order.Side = types.SIDE_SELL
+				order.Price = nil
+				order.TimeInForce = types.TIME_IN_FORCE_IOC
+				order.Trigger = &types.Trigger{
+					Type:  types.TRIGGER_TYPE_TAKE_PROFIT,
+					Price: types.MustNewPriceFromString("2e-1"),
+				}
+				return order
+			}(),
+		},
+		{
+			name: "invalid_trigger_for_market_buy_order",
+			order: func() types.Order {
+				order := validOrder()
+				order.Type = types.ORDER_TYPE_MARKET
+				order.Price = nil
+				order.TimeInForce = types.TIME_IN_FORCE_IOC
+				order.Trigger = &types.Trigger{
+					Type:  types.TRIGGER_TYPE_STOP_LOSS,
+					Price: types.MustNewPriceFromString("2e-1"),
+				}
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_trigger_type",
+			order: func() types.Order {
+				order := validOrder()
+				order.Trigger = &types.Trigger{
+					Type:  types.TRIGGER_TYPE_UNSPECIFIED,
+					Price: types.MustNewPriceFromString("2e-1"),
+				}
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_trigger_zero_price",
+			order: func() types.Order {
+				order := validOrder()
+				order.Trigger = &types.Trigger{
+					Type: types.TRIGGER_TYPE_STOP_LOSS,
+				}
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "valid_limit_order_with_time_in_force_post_only",
+			order: func() types.Order {
+				order := validOrder()
+				order.TimeInForce = types.TIME_IN_FORCE_POST_ONLY
+				return order
+			}(),
+		},
+		{
+			name: "invalid_post_only_time_in_force_for_market_order",
+			order: func() types.Order {
+				order := validOrder()
+				order.Type = types.ORDER_TYPE_MARKET
+				order.Price = nil
+				order.TimeInForce = types.TIME_IN_FORCE_POST_ONLY
+				return order
+			}(),
+			wantErr: types.ErrInvalidInput,
+		},
 		{
 			name: "invalid_not_nil_reserve",
 			order: func() types.Order {
@@ -22,6 +22,24 @@ var (
 
 	// KeyOrderReserve represents the order reserve param key.
 	KeyOrderReserve = []byte("OrderReserve")
+
+	// KeyMakerFeeRate represents the maker fee rate param key.
+	KeyMakerFeeRate = []byte("MakerFeeRate")
+
+	// KeyTakerFeeRate represents the taker fee rate param key.
+	KeyTakerFeeRate = []byte("TakerFeeRate")
+
+	// KeyCircuitBreakerPriceChangeRate represents the circuit breaker price change rate param key.
+	KeyCircuitBreakerPriceChangeRate = []byte("CircuitBreakerPriceChangeRate")
+
+	// KeyCircuitBreakerWindowBlocks represents the circuit breaker window blocks param key.
+	KeyCircuitBreakerWindowBlocks = []byte("CircuitBreakerWindowBlocks")
+
+	// KeyCircuitBreakerHaltBlocks represents the circuit breaker halt blocks param key.
+	KeyCircuitBreakerHaltBlocks = []byte("CircuitBreakerHaltBlocks")
+
+	// KeyMaxBatchAuctionOrders represents the max batch auction orders param key.
+	KeyMaxBatchAuctionOrders = []byte("MaxBatchAuctionOrders")
 )
 
 // DefaultParams returns params with default values.
@@ -32,6 +50,13 @@ func DefaultParams() Params {
 		QuantityStepExponent:    -2,
 		MaxOrdersPerDenom:       100,
 		OrderReserve:            sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000),
+		MakerFeeRate:            sdkmath.LegacyZeroDec(),
+		TakerFeeRate:            sdkmath.LegacyZeroDec(),
+		// the circuit breaker is disabled by default
+		CircuitBreakerPriceChangeRate: sdkmath.LegacyZeroDec(),
+		CircuitBreakerWindowBlocks:    100,
+		CircuitBreakerHaltBlocks:      100,
+		MaxBatchAuctionOrders:         500,
 	}
 }
 
@@ -64,6 +89,36 @@ func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&m.OrderReserve,
 			validateOrderReserve,
 		),
+		paramtypes.NewParamSetPair(
+			KeyMakerFeeRate,
+			&m.MakerFeeRate,
+			validateFeeRate,
+		),
+		paramtypes.NewParamSetPair(
+			KeyTakerFeeRate,
+			&m.TakerFeeRate,
+			validateFeeRate,
+		),
+		paramtypes.NewParamSetPair(
+			KeyCircuitBreakerPriceChangeRate,
+			&m.CircuitBreakerPriceChangeRate,
+			validateCircuitBreakerPriceChangeRate,
+		),
+		paramtypes.NewParamSetPair(
+			KeyCircuitBreakerWindowBlocks,
+			&m.CircuitBreakerWindowBlocks,
+			validateCircuitBreakerBlocks,
+		),
+		paramtypes.NewParamSetPair(
+			KeyCircuitBreakerHaltBlocks,
+			&m.CircuitBreakerHaltBlocks,
+			validateCircuitBreakerBlocks,
+		),
+		paramtypes.NewParamSetPair(
+			KeyMaxBatchAuctionOrders,
+			&m.MaxBatchAuctionOrders,
+			validateMaxBatchAuctionOrders,
+		),
 	}
 }
 
@@ -85,7 +140,40 @@ func (m Params) ValidateBasic() error {
 		return err
 	}
 
-	return validateOrderReserve(m.OrderReserve)
+	if err := validateOrderReserve(m.OrderReserve); err != nil {
+		return err
+	}
+
+	if err := validateFeeRate(m.MakerFeeRate); err != nil {
+		return err
+	}
+
+	if err := validateFeeRate(m.TakerFeeRate); err != nil {
+		return err
+	}
+
+	if err := validateCircuitBreakerPriceChangeRate(m.CircuitBreakerPriceChangeRate); err != nil {
+		return err
+	}
+
+	if err := validateCircuitBreakerBlocks(m.CircuitBreakerWindowBlocks); err != nil {
+		return err
+	}
+
+	if err := validateCircuitBreakerBlocks(m.CircuitBreakerHaltBlocks); err != nil {
+		return err
+	}
+
+	return validateMaxBatchAuctionOrders(m.MaxBatchAuctionOrders)
+}
+
+// Validate validates the order book fee rates.
+func (m OrderBookFeeRates) Validate() error {
+	if err := validateFeeRate(m.MakerFeeRate); err != nil {
+		return err
+	}
+
+	return validateFeeRate(m.TakerFeeRate)
 }
 
 func validateDefaultUnifiedRefAmount(i interface{}) error {
@@ -158,3 +246,63 @@ func validateOrderReserve(i interface{}) error {
 
 	return nil
 }
+
+func validateFeeRate(i interface{}) error {
+	rate, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if rate.IsNil() || rate.IsNegative() || rate.GTE(sdkmath.LegacyOneDec()) {
+		return sdkerrors.Wrap(
+			ErrInvalidInput,
+			"fee rate must be greater than or equal to 0 and less than 1",
+		)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerPriceChangeRate(i interface{}) error {
+	rate, ok := i.(sdkmath.LegacyDec)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if rate.IsNil() || rate.IsNegative() {
+		return sdkerrors.Wrap(
+			ErrInvalidInput,
+			"circuit breaker price change rate must be greater than or equal to 0",
+		)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerBlocks(i interface{}) error {
+	blocks, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if blocks == 0 {
+		return sdkerrors.Wrap(
+			ErrInvalidInput,
+			"circuit breaker blocks must be positive",
+		)
+	}
+
+	return nil
+}
+
+func validateMaxBatchAuctionOrders(i interface{}) error {
+	maxOrders, ok := i.(uint64)
+	if !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid parameter type: %T", i)
+	}
+	if maxOrders == 0 {
+		return sdkerrors.Wrap(
+			ErrInvalidInput,
+			"max batch auction orders must be positive",
+		)
+	}
+
+	return nil
+}
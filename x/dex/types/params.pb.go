@@ -38,6 +38,20 @@ type Params struct {
 	MaxOrdersPerDenom uint64 `protobuf:"varint,3,opt,name=max_orders_per_denom,json=maxOrdersPerDenom,proto3" json:"max_orders_per_denom,omitempty"`
 	// order_reserve is the reserve required to save the order in the order book
 	OrderReserve github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=order_reserve,json=orderReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"order_reserve"`
+	// maker_fee_rate is the default rate of the fee charged from the coin received by the maker order
+	MakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"maker_fee_rate"`
+	// taker_fee_rate is the default rate of the fee charged from the coin received by the taker order
+	TakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee_rate"`
+	// circuit_breaker_price_change_rate is the max rate of the order book price change within the circuit breaker
+	// window, the order book is halted if the price changes more, the zero rate disables the circuit breaker
+	CircuitBreakerPriceChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=circuit_breaker_price_change_rate,json=circuitBreakerPriceChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"circuit_breaker_price_change_rate"`
+	// circuit_breaker_window_blocks is the number of blocks the circuit breaker measures the price change within
+	CircuitBreakerWindowBlocks uint64 `protobuf:"varint,9,opt,name=circuit_breaker_window_blocks,json=circuitBreakerWindowBlocks,proto3" json:"circuit_breaker_window_blocks,omitempty"`
+	// circuit_breaker_halt_blocks is the number of blocks the order book is halted for by the circuit breaker
+	CircuitBreakerHaltBlocks uint64 `protobuf:"varint,10,opt,name=circuit_breaker_halt_blocks,json=circuitBreakerHaltBlocks,proto3" json:"circuit_breaker_halt_blocks,omitempty"`
+	// max_batch_auction_orders is the maximum number of orders the batch auction of the order book can queue for the
+	// clearing
+	MaxBatchAuctionOrders uint64 `protobuf:"varint,11,opt,name=max_batch_auction_orders,json=maxBatchAuctionOrders,proto3" json:"max_batch_auction_orders,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -94,40 +108,115 @@ func (m *Params) GetMaxOrdersPerDenom() uint64 {
 	return 0
 }
 
+func (m *Params) GetCircuitBreakerWindowBlocks() uint64 {
+	if m != nil {
+		return m.CircuitBreakerWindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetCircuitBreakerHaltBlocks() uint64 {
+	if m != nil {
+		return m.CircuitBreakerHaltBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBatchAuctionOrders() uint64 {
+	if m != nil {
+		return m.MaxBatchAuctionOrders
+	}
+	return 0
+}
+
+// OrderBookFeeRates keeps the fee rates overriding the default fee rates for the order book.
+type OrderBookFeeRates struct {
+	// maker_fee_rate is the rate of the fee charged from the coin received by the maker order
+	MakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"maker_fee_rate"`
+	// taker_fee_rate is the rate of the fee charged from the coin received by the taker order
+	TakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee_rate"`
+}
+
+func (m *OrderBookFeeRates) Reset()         { *m = OrderBookFeeRates{} }
+func (m *OrderBookFeeRates) String() string { return proto.CompactTextString(m) }
+func (*OrderBookFeeRates) ProtoMessage()    {}
+func (*OrderBookFeeRates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4f339dad46d471ea, []int{1}
+}
+func (m *OrderBookFeeRates) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookFeeRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookFeeRates.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookFeeRates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookFeeRates.Merge(m, src)
+}
+func (m *OrderBookFeeRates) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookFeeRates) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookFeeRates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookFeeRates proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "coreum.dex.v1.Params")
+	proto.RegisterType((*OrderBookFeeRates)(nil), "coreum.dex.v1.OrderBookFeeRates")
 }
 
 func init() { proto.RegisterFile("coreum/dex/v1/params.proto", fileDescriptor_4f339dad46d471ea) }
 
 var fileDescriptor_4f339dad46d471ea = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x92, 0xcf, 0x6e, 0x13, 0x31,
-	0x10, 0xc6, 0xb3, 0xa5, 0xad, 0xc4, 0x42, 0x0f, 0x5d, 0x22, 0x08, 0x41, 0xda, 0x46, 0x70, 0x20,
-	0x17, 0x6c, 0x2d, 0x20, 0xee, 0xa4, 0x85, 0x0b, 0x48, 0x54, 0x0b, 0x5c, 0xb8, 0x18, 0xc7, 0x9e,
-	0xa4, 0x56, 0xb0, 0xc7, 0xd8, 0xde, 0xd5, 0xe6, 0x2d, 0x78, 0xac, 0x1e, 0x7b, 0x44, 0x1c, 0x2a,
-	0x94, 0xbc, 0x01, 0x4f, 0x80, 0x62, 0x2f, 0x7f, 0x4e, 0x3b, 0xda, 0x6f, 0xbe, 0xdf, 0xc8, 0xdf,
-	0x4c, 0x3e, 0x16, 0xe8, 0xa0, 0xd1, 0x54, 0x42, 0x47, 0xdb, 0x8a, 0x5a, 0xee, 0xb8, 0xf6, 0xc4,
-	0x3a, 0x0c, 0x58, 0x1c, 0x25, 0x8d, 0x48, 0xe8, 0x48, 0x5b, 0x8d, 0x4b, 0x81, 0x5e, 0xa3, 0xa7,
-	0x73, 0xee, 0x81, 0xb6, 0xd5, 0x1c, 0x02, 0xaf, 0xa8, 0x40, 0x65, 0x52, 0xfb, 0x78, 0xb8, 0xc4,
-	0x25, 0xc6, 0x92, 0xee, 0xaa, 0xf4, 0xf7, 0xe1, 0xaf, 0xbd, 0xfc, 0xf0, 0x3c, 0x52, 0x8b, 0xcf,
-	0xf9, 0x58, 0xc2, 0x82, 0x37, 0x5f, 0x02, 0x6b, 0x8c, 0x5a, 0x28, 0x90, 0xcc, 0xc1, 0x82, 0x71,
-	0x8d, 0x8d, 0x09, 0xa3, 0x6c, 0x92, 0x4d, 0x6f, 0xce, 0x1e, 0x5d, 0x5e, 0x9f, 0x0c, 0x7e, 0x5c,
-	0x9f, 0x3c, 0x48, 0xc3, 0xbc, 0x5c, 0x11, 0x85, 0x54, 0xf3, 0x70, 0x41, 0xde, 0xc2, 0x92, 0x8b,
-	0xf5, 0x19, 0x88, 0xfa, 0x5e, 0x8f, 0xf9, 0x98, 0x28, 0x35, 0x2c, 0x5e, 0x46, 0x46, 0x41, 0xf2,
-	0x3b, 0xd6, 0x29, 0x01, 0x2c, 0x28, 0xb1, 0x62, 0xd0, 0x59, 0x34, 0x60, 0xc2, 0x68, 0x6f, 0x92,
-	0x4d, 0x0f, 0xea, 0xe3, 0x28, 0x7d, 0x50, 0x62, 0xf5, 0xaa, 0x17, 0x8a, 0xe7, 0xf9, 0xdd, 0xaf,
-	0x0d, 0x37, 0x41, 0x85, 0x35, 0xf3, 0x01, 0xec, 0x3f, 0xcb, 0x41, 0xb4, 0x0c, 0xff, 0xa8, 0xef,
-	0x03, 0xd8, 0xbf, 0x2e, 0x9a, 0x0f, 0x35, 0xef, 0x18, 0x3a, 0x09, 0xce, 0x33, 0x0b, 0x8e, 0x49,
-	0x30, 0xa8, 0x47, 0x37, 0x26, 0xd9, 0x74, 0xbf, 0x3e, 0xd6, 0xbc, 0x7b, 0x17, 0xa5, 0x73, 0x70,
-	0x67, 0x3b, 0xa1, 0xc0, 0xfc, 0x28, 0x36, 0x33, 0x07, 0x1e, 0x5c, 0x0b, 0xa3, 0xfd, 0x49, 0x36,
-	0xbd, 0xf5, 0xf4, 0x3e, 0x49, 0x8f, 0x24, 0xbb, 0x44, 0x49, 0x9f, 0x28, 0x39, 0x45, 0x65, 0x66,
-	0xb4, 0x8f, 0xe1, 0xf1, 0x52, 0x85, 0x8b, 0x66, 0x4e, 0x04, 0x6a, 0xda, 0xc7, 0x9f, 0x3e, 0x4f,
-	0xbc, 0x5c, 0xd1, 0xb0, 0xb6, 0xe0, 0xa3, 0xa1, 0xbe, 0x1d, 0x07, 0xd4, 0x89, 0x3f, 0x7b, 0x73,
-	0xb9, 0x29, 0xb3, 0xab, 0x4d, 0x99, 0xfd, 0xdc, 0x94, 0xd9, 0xb7, 0x6d, 0x39, 0xb8, 0xda, 0x96,
-	0x83, 0xef, 0xdb, 0x72, 0xf0, 0xa9, 0xfa, 0x0f, 0x78, 0x1a, 0xd7, 0xfb, 0x1a, 0x1b, 0x23, 0x79,
-	0x50, 0x68, 0x68, 0x7f, 0x0b, 0xed, 0x0b, 0xda, 0xc5, 0x83, 0x88, 0xfc, 0xf9, 0x61, 0x5c, 0xe4,
-	0xb3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xed, 0x0a, 0x11, 0x24, 0x2b, 0x02, 0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe3, 0x7e, 0x6d, 0x3e, 0xba, 0x6d, 0x91, 0x6a, 0x0a, 0x98, 0x54, 0x75, 0x43, 0x39,
+	0x90, 0x0b, 0x5e, 0x05, 0x10, 0x9c, 0x38, 0xd4, 0x2d, 0x15, 0x08, 0x24, 0x2a, 0x03, 0x42, 0xe2,
+	0xb2, 0xac, 0xd7, 0x93, 0x64, 0xe5, 0xd8, 0x6b, 0xd6, 0xeb, 0xd4, 0x7d, 0x0b, 0x5e, 0x83, 0x2b,
+	0x4f, 0xd1, 0x63, 0x8f, 0x88, 0x43, 0x85, 0x9a, 0x17, 0x41, 0x1e, 0xbb, 0xd0, 0x54, 0x1c, 0x0a,
+	0xe2, 0xe4, 0x91, 0xff, 0xf3, 0xff, 0x8d, 0x76, 0x66, 0x34, 0xa4, 0x23, 0x94, 0x86, 0x22, 0xa1,
+	0x11, 0x94, 0x74, 0xd2, 0xa7, 0x19, 0xd7, 0x3c, 0xc9, 0xbd, 0x4c, 0x2b, 0xa3, 0xec, 0x95, 0x5a,
+	0xf3, 0x22, 0x28, 0xbd, 0x49, 0xbf, 0xe3, 0x0a, 0x95, 0x27, 0x2a, 0xa7, 0x21, 0xcf, 0x81, 0x4e,
+	0xfa, 0x21, 0x18, 0xde, 0xa7, 0x42, 0xc9, 0xb4, 0x4e, 0xef, 0xac, 0x0d, 0xd5, 0x50, 0x61, 0x48,
+	0xab, 0xa8, 0xfe, 0xbb, 0xf5, 0xa5, 0x4d, 0xda, 0xfb, 0x48, 0xb5, 0x3f, 0x90, 0x4e, 0x04, 0x03,
+	0x5e, 0x8c, 0x0d, 0x2b, 0x52, 0x39, 0x90, 0x10, 0x31, 0x0d, 0x03, 0xc6, 0x13, 0x55, 0xa4, 0xc6,
+	0xb1, 0xba, 0x56, 0x6f, 0xd1, 0xbf, 0x73, 0x74, 0xb2, 0xd9, 0xfa, 0x76, 0xb2, 0xb9, 0x5e, 0x17,
+	0xcb, 0xa3, 0xd8, 0x93, 0x8a, 0x26, 0xdc, 0x8c, 0xbc, 0x97, 0x30, 0xe4, 0xe2, 0x70, 0x17, 0x44,
+	0x70, 0xb3, 0xc1, 0xbc, 0xad, 0x29, 0x01, 0x0c, 0xb6, 0x91, 0x61, 0x7b, 0xe4, 0x5a, 0xa6, 0xa5,
+	0x00, 0x66, 0xa4, 0x88, 0x19, 0x94, 0x99, 0x4a, 0x21, 0x35, 0xce, 0x5c, 0xd7, 0xea, 0x2d, 0x04,
+	0xab, 0x28, 0xbd, 0x91, 0x22, 0x7e, 0xda, 0x08, 0xf6, 0x43, 0x72, 0xe3, 0x63, 0xc1, 0x53, 0x23,
+	0xcd, 0x21, 0xcb, 0x0d, 0x64, 0xbf, 0x2c, 0x0b, 0x68, 0x59, 0x3b, 0x53, 0x5f, 0x1b, 0xc8, 0x7e,
+	0xba, 0x28, 0x59, 0x4b, 0x78, 0xc9, 0x94, 0x8e, 0x40, 0xe7, 0x2c, 0x03, 0xcd, 0x22, 0x48, 0x55,
+	0xe2, 0xfc, 0xd7, 0xb5, 0x7a, 0xf3, 0xc1, 0x6a, 0xc2, 0xcb, 0x57, 0x28, 0xed, 0x83, 0xde, 0xad,
+	0x04, 0x5b, 0x91, 0x15, 0x4c, 0x66, 0x1a, 0x72, 0xd0, 0x13, 0x70, 0xe6, 0xbb, 0x56, 0x6f, 0xe9,
+	0xfe, 0x2d, 0xaf, 0x7e, 0xa4, 0x57, 0x75, 0xd4, 0x6b, 0x3a, 0xea, 0xed, 0x28, 0x99, 0xfa, 0xb4,
+	0x69, 0xc3, 0xdd, 0xa1, 0x34, 0xa3, 0x22, 0xf4, 0x84, 0x4a, 0x68, 0xd3, 0xfe, 0xfa, 0x73, 0x2f,
+	0x8f, 0x62, 0x6a, 0x0e, 0x33, 0xc8, 0xd1, 0x10, 0x2c, 0x63, 0x81, 0xa0, 0xe6, 0xdb, 0xcf, 0xc9,
+	0xd5, 0x84, 0xc7, 0xa0, 0xd9, 0x00, 0x80, 0x69, 0x6e, 0xc0, 0x69, 0x5f, 0xbe, 0xbb, 0xcb, 0x68,
+	0xdd, 0x03, 0x08, 0xb8, 0x41, 0x94, 0x99, 0x45, 0xfd, 0xff, 0x07, 0x28, 0x73, 0x1e, 0x95, 0x90,
+	0xdb, 0x42, 0x6a, 0x51, 0x48, 0xc3, 0x42, 0x0d, 0x08, 0xad, 0xa7, 0x25, 0x46, 0x3c, 0x1d, 0x36,
+	0xf4, 0x2b, 0x97, 0xa7, 0x6f, 0x34, 0x34, 0xbf, 0x86, 0xed, 0x57, 0xac, 0x1d, 0x44, 0x61, 0xb9,
+	0x6d, 0xb2, 0x71, 0xb1, 0xdc, 0x81, 0x4c, 0x23, 0x75, 0xc0, 0xc2, 0xb1, 0x12, 0x71, 0xee, 0x2c,
+	0xe2, 0xbc, 0x3a, 0xb3, 0x94, 0x77, 0x98, 0xe2, 0x63, 0x86, 0xfd, 0x84, 0xac, 0x5f, 0x44, 0x8c,
+	0xf8, 0xd8, 0x9c, 0x01, 0x08, 0x02, 0x9c, 0x59, 0xc0, 0x33, 0x3e, 0x36, 0x8d, 0xfd, 0x31, 0x71,
+	0xaa, 0x45, 0x09, 0xb9, 0x11, 0x23, 0xc6, 0x0b, 0x61, 0xa4, 0x4a, 0x9b, 0xb5, 0x71, 0x96, 0xd0,
+	0x7b, 0x3d, 0xe1, 0xa5, 0x5f, 0xc9, 0xdb, 0xb5, 0x5a, 0x2f, 0xce, 0xd6, 0x67, 0x8b, 0xac, 0x62,
+	0xe8, 0x2b, 0x15, 0x37, 0xed, 0xcb, 0x7f, 0x33, 0x55, 0xeb, 0xdf, 0x4d, 0x75, 0xee, 0x2f, 0xa7,
+	0xea, 0xbf, 0x38, 0x3a, 0x75, 0xad, 0xe3, 0x53, 0xd7, 0xfa, 0x7e, 0xea, 0x5a, 0x9f, 0xa6, 0x6e,
+	0xeb, 0x78, 0xea, 0xb6, 0xbe, 0x4e, 0xdd, 0xd6, 0xfb, 0xfe, 0xb9, 0xe5, 0xdd, 0xc1, 0x53, 0xb2,
+	0xa7, 0x8a, 0x34, 0xe2, 0xd5, 0x43, 0x69, 0x73, 0x77, 0x26, 0x8f, 0x68, 0x89, 0xc7, 0x07, 0x77,
+	0x39, 0x6c, 0xe3, 0xd1, 0x78, 0xf0, 0x23, 0x00, 0x00, 0xff, 0xff, 0x02, 0x89, 0x27, 0xca, 0x97,
+	0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -150,6 +239,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBatchAuctionOrders != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchAuctionOrders))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CircuitBreakerHaltBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CircuitBreakerHaltBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CircuitBreakerWindowBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CircuitBreakerWindowBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.CircuitBreakerPriceChangeRate.Size()
+		i -= size
+		if _, err := m.CircuitBreakerPriceChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.QuantityStepExponent != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.QuantityStepExponent))
 		i--
@@ -188,6 +322,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OrderBookFeeRates) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookFeeRates) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookFeeRates) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -218,6 +395,34 @@ func (m *Params) Size() (n int) {
 	if m.QuantityStepExponent != 0 {
 		n += 1 + sovParams(uint64(m.QuantityStepExponent))
 	}
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CircuitBreakerPriceChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.CircuitBreakerWindowBlocks != 0 {
+		n += 1 + sovParams(uint64(m.CircuitBreakerWindowBlocks))
+	}
+	if m.CircuitBreakerHaltBlocks != 0 {
+		n += 1 + sovParams(uint64(m.CircuitBreakerHaltBlocks))
+	}
+	if m.MaxBatchAuctionOrders != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchAuctionOrders))
+	}
+	return n
+}
+
+func (m *OrderBookFeeRates) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -380,6 +585,283 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerPriceChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerPriceChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerWindowBlocks", wireType)
+			}
+			m.CircuitBreakerWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerHaltBlocks", wireType)
+			}
+			m.CircuitBreakerHaltBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerHaltBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchAuctionOrders", wireType)
+			}
+			m.MaxBatchAuctionOrders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchAuctionOrders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookFeeRates) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookFeeRates: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookFeeRates: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,157 @@
+package types
+
+import (
+	context "context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/samber/lo"
+)
+
+var _ authz.Authorization = &PlaceOrderAuthorization{}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PlaceOrderAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgPlaceOrder{})
+}
+
+// Accept implements Authorization.Accept.
+func (a PlaceOrderAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	mPlaceOrder, ok := msg.(*MsgPlaceOrder)
+	if !ok {
+		return authz.AcceptResponse{}, cosmoserrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if a.Expiration != nil && !sdk.UnwrapSDKContext(ctx).BlockTime().Before(*a.Expiration) {
+		return authz.AcceptResponse{}, cosmoserrors.ErrUnauthorized.Wrap("authorization is expired")
+	}
+
+	if !a.isOrderBookAllowed(mPlaceOrder.BaseDenom, mPlaceOrder.QuoteDenom) {
+		return authz.AcceptResponse{}, cosmoserrors.ErrUnauthorized.Wrapf(
+			"order book %s/%s is not allowed", mPlaceOrder.BaseDenom, mPlaceOrder.QuoteDenom,
+		)
+	}
+
+	if len(a.Sides) != 0 && !lo.Contains(a.Sides, mPlaceOrder.Side) {
+		return authz.AcceptResponse{}, cosmoserrors.ErrUnauthorized.Wrapf("side %s is not allowed", mPlaceOrder.Side)
+	}
+
+	if len(a.MaxOrderSpend) == 0 && len(a.SpendLimit) == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	spendCoin, err := computeAuthorizedOrderSpend(*mPlaceOrder)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	if len(a.MaxOrderSpend) != 0 {
+		maxOrderSpend := a.MaxOrderSpend.AmountOf(spendCoin.Denom)
+		if !maxOrderSpend.IsPositive() || spendCoin.Amount.GT(maxOrderSpend) {
+			return authz.AcceptResponse{}, cosmoserrors.ErrUnauthorized.Wrapf(
+				"order spend %s is more than max order spend", spendCoin,
+			)
+		}
+	}
+
+	if len(a.SpendLimit) == 0 {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+
+	limitLeft, isNegative := a.SpendLimit.SafeSub(spendCoin)
+	if isNegative {
+		return authz.AcceptResponse{}, cosmoserrors.ErrUnauthorized.Wrapf(
+			"order spend %s is more than spend limit", spendCoin,
+		)
+	}
+
+	if limitLeft.IsZero() {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	a.SpendLimit = limitLeft
+	return authz.AcceptResponse{
+		Accept:  true,
+		Updated: &a,
+	}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PlaceOrderAuthorization) ValidateBasic() error {
+	for _, orderBook := range a.OrderBooks {
+		if err := validateAuthorizedDenom(orderBook.BaseDenom); err != nil {
+			return err
+		}
+		if err := validateAuthorizedDenom(orderBook.QuoteDenom); err != nil {
+			return err
+		}
+		if orderBook.BaseDenom == orderBook.QuoteDenom {
+			return ErrInvalidInput.Wrap("base and quote denoms must be different")
+		}
+	}
+
+	for _, denom := range a.Denoms {
+		if err := validateAuthorizedDenom(denom); err != nil {
+			return err
+		}
+	}
+
+	for _, side := range a.Sides {
+		if side != SIDE_BUY && side != SIDE_SELL {
+			return ErrInvalidInput.Wrapf("invalid side %s", side)
+		}
+	}
+
+	if len(a.MaxOrderSpend) != 0 && !a.MaxOrderSpend.IsAllPositive() {
+		return cosmoserrors.ErrInvalidCoins.Wrap("max order spend must be positive")
+	}
+
+	if len(a.SpendLimit) != 0 && !a.SpendLimit.IsAllPositive() {
+		return cosmoserrors.ErrInvalidCoins.Wrap("spend limit must be positive")
+	}
+
+	return nil
+}
+
+func (a PlaceOrderAuthorization) isOrderBookAllowed(baseDenom, quoteDenom string) bool {
+	if len(a.OrderBooks) != 0 && !lo.Contains(a.OrderBooks, AuthorizedOrderBook{
+		BaseDenom:  baseDenom,
+		QuoteDenom: quoteDenom,
+	}) {
+		return false
+	}
+
+	return len(a.Denoms) == 0 || (lo.Contains(a.Denoms, baseDenom) && lo.Contains(a.Denoms, quoteDenom))
+}
+
+// computeAuthorizedOrderSpend returns the max coin the order might spend, the market buy order spend isn't limited by
+// the order, so it can't be authorized with the spend limits.
+func computeAuthorizedOrderSpend(msg MsgPlaceOrder) (sdk.Coin, error) {
+	order, err := NewOrderFromMsgPlaceOrder(msg)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	switch order.Type {
+	case ORDER_TYPE_LIMIT:
+		return order.ComputeLimitOrderLockedBalance()
+	case ORDER_TYPE_MARKET:
+		if order.Side == SIDE_SELL {
+			return sdk.NewCoin(order.BaseDenom, order.Quantity), nil
+		}
+		return sdk.Coin{}, cosmoserrors.ErrUnauthorized.Wrap(
+			"market buy order spend can't be limited by the authorization",
+		)
+	default:
+		return sdk.Coin{}, ErrInvalidInput.Wrapf("unexpected order type: %s", order.Type)
+	}
+}
+
+func validateAuthorizedDenom(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return ErrInvalidInput.Wrapf("invalid denom %s: %s", denom, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v6/x/dex/types"
+)
+
+func TestPlaceOrderAuthorization_Accept(t *testing.T) {
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx := sdk.Context{}.WithBlockTime(blockTime)
+
+	validMsg := func() *types.MsgPlaceOrder {
+		return &types.MsgPlaceOrder{
+			Sender:      sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+			Type:        types.ORDER_TYPE_LIMIT,
+			ID:          "id1",
+			BaseDenom:   "denom1",
+			QuoteDenom:  "denom2",
+			Price:       lo.ToPtr(types.MustNewPriceFromString("2")),
+			Quantity:    sdkmath.NewInt(100),
+			Side:        types.SIDE_BUY,
+			TimeInForce: types.TIME_IN_FORCE_GTC,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		authorization types.PlaceOrderAuthorization
+		msg           sdk.Msg
+		want          authz.AcceptResponse
+		wantErr       error
+	}{
+		{
+			name:          "type_mismatch",
+			authorization: types.PlaceOrderAuthorization{},
+			msg:           &types.MsgCancelOrder{},
+			wantErr:       cosmoserrors.ErrInvalidType,
+		},
+		{
+			name:          "no_limits",
+			authorization: types.PlaceOrderAuthorization{},
+			msg:           validMsg(),
+			want:          authz.AcceptResponse{Accept: true},
+		},
+		{
+			name: "expired",
+			authorization: types.PlaceOrderAuthorization{
+				Expiration: lo.ToPtr(blockTime),
+			},
+			msg:     validMsg(),
+			wantErr: cosmoserrors.ErrUnauthorized,
+		},
+		{
+			name: "order_book_allowed",
+			authorization: types.PlaceOrderAuthorization{
+				OrderBooks: []types.AuthorizedOrderBook{{BaseDenom: "denom1", QuoteDenom: "denom2"}},
+				Expiration: lo.ToPtr(blockTime.Add(time.Second)),
+			},
+			msg:  validMsg(),
+			want: authz.AcceptResponse{Accept: true},
+		},
+		{
+			name: "inverted_order_book_not_allowed",
+			authorization: types.PlaceOrderAuthorization{
+				OrderBooks: []types.AuthorizedOrderBook{{BaseDenom: "denom2", QuoteDenom: "denom1"}},
+			},
+			msg:     validMsg(),
+			wantErr: cosmoserrors.ErrUnauthorized,
+		},
+		{
+			name: "denom_not_allowed",
+			authorization: types.PlaceOrderAuthorization{
+				Denoms: []string{"denom1", "denom3"},
+			},
+			msg:     validMsg(),
+			wantErr: cosmoserrors.ErrUnauthorized,
+		},
+		{
+			name: "side_not_allowed",
+			authorization: types.PlaceOrderAuthorization{
+				Sides: []types.Side{types.SIDE_SELL},
+			},
+			msg:     validMsg(),
+			wantErr: cosmoserrors.ErrUnauthorized,
+		},
+		{
+			name: "max_order_spend_exceeded",
+			authorization: types.PlaceOrderAuthorization{
+				MaxOrderSpend: sdk.NewCoins(sdk.NewInt64Coin("denom2", 199)),
+			},
+			msg:     validMsg(),
+			wantErr: cosmoserrors.ErrUnauthorized,
+		},
+		{
+			name: "max_order_spend_denom_not_allowed",
+			authorization: types.PlaceOrderAuthorization{
+				MaxOrderSpend: sdk.NewCoins(sdk.NewInt64Coin("denom1", 1000)),
+			},
+			msg:     validMsg(),
+			wantErr: cosmoserrors.ErrUnauthorized,
+		},
+		{
+			name: "max_order_spend_allowed",
+			authorization: types.PlaceOrderAuthorization{
+				MaxOrderSpend: sdk.NewCoins(sdk.NewInt64Coin("denom2", 200)),
+			},
+			msg:  validMsg(),
+			want: authz.AcceptResponse{Accept: true},
+		},
+		{
+			name: "spend_limit_decreased",
+			authorization: types.PlaceOrderAuthorization{
+				Sides:      []types.Side{types.SIDE_BUY},
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("denom1", 10), sdk.NewInt64Coin("denom2", 300)),
+			},
+			msg: validMsg(),
+			want: authz.AcceptResponse{
+				Accept: true,
+				Updated: &types.PlaceOrderAuthorization{
+					Sides:      []types.Side{types.SIDE_BUY},
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("denom1", 10), sdk.NewInt64Coin("denom2", 100)),
+				},
+			},
+		},
+		{
+			name: "spend_limit_exhausted",
+			authorization: types.PlaceOrderAuthorization{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("denom2", 200)),
+			},
+			msg: validMsg(),
+			want: authz.AcceptResponse{
+				Accept: true,
+				Delete: true,
+			},
+		},
+		{
+			name: "spend_limit_exceeded",
+			authorization: types.PlaceOrderAuthorization{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("denom2", 199)),
+			},
+			msg:     validMsg(),
+			wantErr: cosmoserrors.ErrUnauthorized,
+		},
+		{
+			name: "market_sell_spend_limit_decreased",
+			authorization: types.PlaceOrderAuthorization{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("denom1", 300)),
+			},
+			msg: func() *types.MsgPlaceOrder {
+				msg := validMsg()
+				msg.Type = types.ORDER_TYPE_MARKET
+				msg.Price = nil
+				msg.Side = types.SIDE_SELL
+				msg.TimeInForce = types.TIME_IN_FORCE_IOC
+				return msg
+			}(),
+			want: authz.AcceptResponse{
+				Accept: true,
+				Updated: &types.PlaceOrderAuthorization{
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("denom1", 200)),
+				},
+			},
+		},
+		{
+			name: "market_buy_spend_limit_not_allowed",
+			authorization: types.PlaceOrderAuthorization{
+				SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("denom2", 1000)),
+			},
+			msg: func() *types.MsgPlaceOrder {
+				msg := validMsg()
+				msg.Type = types.ORDER_TYPE_MARKET
+				msg.Price = nil
+				msg.TimeInForce = types.TIME_IN_FORCE_IOC
+				return msg
+			}(),
+			wantErr: cosmoserrors.ErrUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requireT := require.New(t)
+			got, err := tt.authorization.Accept(sdkCtx, tt.msg)
+			if tt.wantErr != nil {
+				requireT.True(sdkerrors.IsOf(err, tt.wantErr), err)
+				return
+			}
+			requireT.NoError(err)
+			requireT.Equal(tt.want, got)
+		})
+	}
+}
+
+func TestPlaceOrderAuthorization_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization types.PlaceOrderAuthorization
+		wantErr       error
+	}{
+		{
+			name:          "valid_empty",
+			authorization: types.PlaceOrderAuthorization{},
+		},
+		{
+			name: "valid",
+			authorization: types.PlaceOrderAuthorization{
+				OrderBooks:    []types.AuthorizedOrderBook{{BaseDenom: "denom1", QuoteDenom: "denom2"}},
+				Denoms:        []string{"denom1", "denom2"},
+				Sides:         []types.Side{types.SIDE_BUY},
+				MaxOrderSpend: sdk.NewCoins(sdk.NewInt64Coin("denom2", 10)),
+				SpendLimit:    sdk.NewCoins(sdk.NewInt64Coin("denom2", 100)),
+			},
+		},
+		{
+			name: "invalid_order_book_same_denoms",
+			authorization: types.PlaceOrderAuthorization{
+				OrderBooks: []types.AuthorizedOrderBook{{BaseDenom: "denom1", QuoteDenom: "denom1"}},
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_denom",
+			authorization: types.PlaceOrderAuthorization{
+				Denoms: []string{"1"},
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_side",
+			authorization: types.PlaceOrderAuthorization{
+				Sides: []types.Side{types.SIDE_UNSPECIFIED},
+			},
+			wantErr: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid_spend_limit",
+			authorization: types.PlaceOrderAuthorization{
+				SpendLimit: sdk.Coins{sdk.Coin{Denom: "denom1", Amount: sdkmath.ZeroInt()}},
+			},
+			wantErr: cosmoserrors.ErrInvalidCoins,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.authorization.ValidateBasic()
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.True(t, sdkerrors.IsOf(err, tt.wantErr), err)
+			}
+		})
+	}
+}
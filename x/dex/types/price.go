@@ -107,6 +107,41 @@ func NewPriceFromString(str string) (Price, error) {
 	}, nil
 }
 
+// NewPriceFromRat returns new instance of the Price with the max `MaxNumLen` significant digits from the positive rat,
+// the rat is rounded up or down if it can't be represented as the Price precisely.
+func NewPriceFromRat(rat *big.Rat, roundUp bool) (Price, error) {
+	if rat.Sign() <= 0 {
+		return Price{}, errors.Errorf("invalid price %s, must be positive", rat.String())
+	}
+
+	num, denom := rat.Num(), rat.Denom()
+	// the shift is chosen to get the `MaxNumLen` digits in the integer part of the num * 10^shift / denom
+	shift := MaxNumLen - (len(num.String()) - len(denom.String()))
+	var quo, rem *big.Int
+	for {
+		quo, rem = quoRemWithShift(num, denom, shift)
+		if len(quo.String()) <= MaxNumLen {
+			break
+		}
+		shift--
+	}
+	if roundUp && rem.Sign() != 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+
+	exp := -shift
+	ten := big.NewInt(10)
+	for new(big.Int).Rem(quo, ten).Sign() == 0 {
+		quo.Quo(quo, ten)
+		exp++
+	}
+	if exp < int(MinExp) || exp > int(MaxExp) {
+		return Price{}, errors.Errorf("invalid exponent %d, must be in the rage %d:%d", exp, MinExp, MaxExp)
+	}
+
+	return NewPrice(quo.Uint64(), int8(exp))
+}
+
 // MustNewPriceFromString creates new instance of price from string or panics.
 func MustNewPriceFromString(str string) Price {
 	price, err := NewPriceFromString(str)
@@ -265,3 +300,14 @@ func denormalizeForOrderedBytes(exp int8, num uint64) (int8, uint64, error) {
 
 	return exp, num, nil
 }
+
+// quoRemWithShift returns the quotient and remainder of the num * 10^shift / denom.
+func quoRemWithShift(num, denom *big.Int, shift int) (*big.Int, *big.Int) {
+	if shift >= 0 {
+		num = cbig.IntMul(num, cbig.IntTenToThePower(big.NewInt(int64(shift))))
+	} else {
+		denom = cbig.IntMul(denom, cbig.IntTenToThePower(big.NewInt(int64(-shift))))
+	}
+
+	return new(big.Int).QuoRem(num, denom, new(big.Int))
+}
@@ -267,6 +267,78 @@ func TestPrice_Rat(t *testing.T) {
 	}
 }
 
+func TestNewPriceFromRat(t *testing.T) {
+	tests := []struct {
+		name     string
+		rat      *big.Rat
+		roundUp  bool
+		priceStr string
+		wantErr  bool
+	}{
+		{
+			name:     "exact_int",
+			rat:      big.NewRat(2, 1),
+			priceStr: "2",
+		},
+		{
+			name:     "exact_fraction",
+			rat:      big.NewRat(1, 8),
+			roundUp:  true,
+			priceStr: "125e-3",
+		},
+		{
+			name:     "round_down",
+			rat:      big.NewRat(2, 3),
+			priceStr: "6666666666666666666e-19",
+		},
+		{
+			name:     "round_up",
+			rat:      big.NewRat(2, 3),
+			roundUp:  true,
+			priceStr: "6666666666666666667e-19",
+		},
+		{
+			name: "round_up_to_next_digit",
+			rat: cbig.NewRatFromBigInts(
+				cbig.IntSub(cbig.IntMul(big.NewInt(2), cbig.IntTenToThePower(big.NewInt(19))), big.NewInt(1)),
+				big.NewInt(2),
+			),
+			roundUp:  true,
+			priceStr: "1e19",
+		},
+		{
+			name: "inverted_min_price",
+			rat: cbig.NewRatFromBigInts(
+				cbig.IntTenToThePower(big.NewInt(100)), big.NewInt(1),
+			),
+			priceStr: "1e100",
+		},
+		{
+			name: "exponent_out_of_range",
+			rat: cbig.NewRatFromBigInts(
+				big.NewInt(1), cbig.IntTenToThePower(big.NewInt(101)),
+			),
+			wantErr: true,
+		},
+		{
+			name:    "zero",
+			rat:     big.NewRat(0, 1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := types.NewPriceFromRat(tt.rat, tt.roundUp)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.priceStr, p.String())
+		})
+	}
+}
+
 func TestPrice_Marshalling(t *testing.T) {
 	t.Parallel()
 
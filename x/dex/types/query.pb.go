@@ -7,6 +7,8 @@ import (
 	context "context"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -485,6 +487,10 @@ type QueryOrderBookParamsResponse struct {
 	BaseDenomUnifiedRefAmount cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=base_denom_unified_ref_amount,json=baseDenomUnifiedRefAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_denom_unified_ref_amount"`
 	// quote_denom_unified_ref_amount is needed to define price tick & quantity step of quote denom
 	QuoteDenomUnifiedRefAmount cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=quote_denom_unified_ref_amount,json=quoteDenomUnifiedRefAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"quote_denom_unified_ref_amount"`
+	// maker_fee_rate is the rate of the fee charged from the coin received by the maker order
+	MakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=maker_fee_rate,json=makerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"maker_fee_rate"`
+	// taker_fee_rate is the rate of the fee charged from the coin received by the taker order
+	TakerFeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=taker_fee_rate,json=takerFeeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"taker_fee_rate"`
 }
 
 func (m *QueryOrderBookParamsResponse) Reset()         { *m = QueryOrderBookParamsResponse{} }
@@ -646,6 +652,135 @@ func (m *QueryOrderBookOrdersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryOrderBookDepthRequest defines the request type for the `OrderBookDepth` query.
+type QueryOrderBookDepthRequest struct {
+	// base_denom is base order book denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order book denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// levels is the max number of price levels returned per side, the default value is used if not set.
+	Levels uint32 `protobuf:"varint,3,opt,name=levels,proto3" json:"levels,omitempty"`
+	// include_inverted defines whether the orders of the inverted order book are included in the price levels,
+	// the inverted orders prices are expressed in the order book price terms.
+	IncludeInverted bool `protobuf:"varint,4,opt,name=include_inverted,json=includeInverted,proto3" json:"include_inverted,omitempty"`
+}
+
+func (m *QueryOrderBookDepthRequest) Reset()         { *m = QueryOrderBookDepthRequest{} }
+func (m *QueryOrderBookDepthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookDepthRequest) ProtoMessage()    {}
+func (*QueryOrderBookDepthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{12}
+}
+func (m *QueryOrderBookDepthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderBookDepthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderBookDepthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrderBookDepthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookDepthRequest.Merge(m, src)
+}
+func (m *QueryOrderBookDepthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderBookDepthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookDepthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrderBookDepthRequest proto.InternalMessageInfo
+
+func (m *QueryOrderBookDepthRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *QueryOrderBookDepthRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *QueryOrderBookDepthRequest) GetLevels() uint32 {
+	if m != nil {
+		return m.Levels
+	}
+	return 0
+}
+
+func (m *QueryOrderBookDepthRequest) GetIncludeInverted() bool {
+	if m != nil {
+		return m.IncludeInverted
+	}
+	return false
+}
+
+// QueryOrderBookDepthResponse defines the response type for the `OrderBookDepth` query.
+type QueryOrderBookDepthResponse struct {
+	// bids are buy price levels sorted by price descending.
+	Bids []PriceLevel `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids"`
+	// asks are sell price levels sorted by price ascending.
+	Asks []PriceLevel `protobuf:"bytes,2,rep,name=asks,proto3" json:"asks"`
+}
+
+func (m *QueryOrderBookDepthResponse) Reset()         { *m = QueryOrderBookDepthResponse{} }
+func (m *QueryOrderBookDepthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookDepthResponse) ProtoMessage()    {}
+func (*QueryOrderBookDepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{13}
+}
+func (m *QueryOrderBookDepthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderBookDepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderBookDepthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrderBookDepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookDepthResponse.Merge(m, src)
+}
+func (m *QueryOrderBookDepthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderBookDepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookDepthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrderBookDepthResponse proto.InternalMessageInfo
+
+func (m *QueryOrderBookDepthResponse) GetBids() []PriceLevel {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *QueryOrderBookDepthResponse) GetAsks() []PriceLevel {
+	if m != nil {
+		return m.Asks
+	}
+	return nil
+}
+
 // QueryAccountDenomOrdersCountRequest defines the request type for the `AccountDenomOrdersCount` query.
 type QueryAccountDenomOrdersCountRequest struct {
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -656,7 +791,7 @@ func (m *QueryAccountDenomOrdersCountRequest) Reset()         { *m = QueryAccoun
 func (m *QueryAccountDenomOrdersCountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountDenomOrdersCountRequest) ProtoMessage()    {}
 func (*QueryAccountDenomOrdersCountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a17d94653a2124, []int{12}
+	return fileDescriptor_23a17d94653a2124, []int{14}
 }
 func (m *QueryAccountDenomOrdersCountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,7 +843,7 @@ func (m *QueryAccountDenomOrdersCountResponse) Reset()         { *m = QueryAccou
 func (m *QueryAccountDenomOrdersCountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAccountDenomOrdersCountResponse) ProtoMessage()    {}
 func (*QueryAccountDenomOrdersCountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_23a17d94653a2124, []int{13}
+	return fileDescriptor_23a17d94653a2124, []int{15}
 }
 func (m *QueryAccountDenomOrdersCountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -744,1292 +879,6253 @@ func (m *QueryAccountDenomOrdersCountResponse) GetCount() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.dex.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.dex.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryOrderRequest)(nil), "coreum.dex.v1.QueryOrderRequest")
-	proto.RegisterType((*QueryOrderResponse)(nil), "coreum.dex.v1.QueryOrderResponse")
-	proto.RegisterType((*QueryOrdersRequest)(nil), "coreum.dex.v1.QueryOrdersRequest")
-	proto.RegisterType((*QueryOrdersResponse)(nil), "coreum.dex.v1.QueryOrdersResponse")
-	proto.RegisterType((*QueryOrderBooksRequest)(nil), "coreum.dex.v1.QueryOrderBooksRequest")
-	proto.RegisterType((*QueryOrderBooksResponse)(nil), "coreum.dex.v1.QueryOrderBooksResponse")
-	proto.RegisterType((*QueryOrderBookParamsRequest)(nil), "coreum.dex.v1.QueryOrderBookParamsRequest")
-	proto.RegisterType((*QueryOrderBookParamsResponse)(nil), "coreum.dex.v1.QueryOrderBookParamsResponse")
-	proto.RegisterType((*QueryOrderBookOrdersRequest)(nil), "coreum.dex.v1.QueryOrderBookOrdersRequest")
-	proto.RegisterType((*QueryOrderBookOrdersResponse)(nil), "coreum.dex.v1.QueryOrderBookOrdersResponse")
-	proto.RegisterType((*QueryAccountDenomOrdersCountRequest)(nil), "coreum.dex.v1.QueryAccountDenomOrdersCountRequest")
-	proto.RegisterType((*QueryAccountDenomOrdersCountResponse)(nil), "coreum.dex.v1.QueryAccountDenomOrdersCountResponse")
+// QuerySimulateOrderRequest defines the request type for the `SimulateOrder` query.
+type QuerySimulateOrderRequest struct {
+	// creator is order creator's account.
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// type is order type.
+	Type OrderType `protobuf:"varint,2,opt,name=type,proto3,enum=coreum.dex.v1.OrderType" json:"type,omitempty"`
+	// id is order ID, the default simulation ID is used if not set.
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	// base_denom is base order denom.
+	BaseDenom string `protobuf:"bytes,4,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order denom.
+	QuoteDenom string `protobuf:"bytes,5,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// price is value of one unit of the base_denom expressed in terms of the quote_denom, required for the limit order.
+	Price string `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// quantity is amount of the base base_denom being traded.
+	Quantity string `protobuf:"bytes,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// side is order side.
+	Side Side `protobuf:"varint,8,opt,name=side,proto3,enum=coreum.dex.v1.Side" json:"side,omitempty"`
+	// time_in_force is order time in force.
+	TimeInForce TimeInForce `protobuf:"varint,9,opt,name=time_in_force,json=timeInForce,proto3,enum=coreum.dex.v1.TimeInForce" json:"time_in_force,omitempty"`
 }
 
-func init() { proto.RegisterFile("coreum/dex/v1/query.proto", fileDescriptor_23a17d94653a2124) }
-
-var fileDescriptor_23a17d94653a2124 = []byte{
-	// 1008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x18, 0xcd, 0xba, 0xb6, 0x21, 0x5f, 0x48, 0x11, 0x53, 0x87, 0xa4, 0x9b, 0xc4, 0x49, 0xb6, 0x25,
-	0x41, 0x69, 0xb3, 0x83, 0x1d, 0x89, 0x53, 0x0b, 0xaa, 0x13, 0x05, 0xb5, 0x20, 0x11, 0xb6, 0xf4,
-	0x82, 0x84, 0xcc, 0x7a, 0x77, 0xe2, 0xae, 0x8c, 0x77, 0x9c, 0xdd, 0xb5, 0x95, 0xc8, 0xb2, 0x90,
-	0x10, 0x07, 0x8e, 0x08, 0x4e, 0x88, 0x3f, 0x00, 0x8e, 0x5c, 0xf8, 0x1f, 0x7a, 0xaa, 0x2a, 0x71,
-	0x41, 0x1c, 0xaa, 0x2a, 0x41, 0xe2, 0xdf, 0x40, 0x3b, 0xf3, 0x39, 0xfb, 0x23, 0x6b, 0x3b, 0x04,
-	0x0e, 0xbd, 0x79, 0x67, 0xde, 0xbc, 0xf7, 0xbe, 0x37, 0x33, 0xdf, 0x18, 0xae, 0x5b, 0xdc, 0x63,
-	0xdd, 0x36, 0xb5, 0xd9, 0x11, 0xed, 0x55, 0xe8, 0x61, 0x97, 0x79, 0xc7, 0x7a, 0xc7, 0xe3, 0x01,
-	0x27, 0xb3, 0x72, 0x4a, 0xb7, 0xd9, 0x91, 0xde, 0xab, 0xa8, 0x29, 0x24, 0xf7, 0x6c, 0xe6, 0x49,
-	0xa4, 0xaa, 0x26, 0xa7, 0x3a, 0xa6, 0x67, 0xb6, 0x7d, 0x9c, 0xdb, 0xb4, 0xb8, 0xdf, 0xe6, 0x3e,
-	0x6d, 0x98, 0x3e, 0x93, 0xf4, 0xb4, 0x57, 0x69, 0xb0, 0xc0, 0x0c, 0x71, 0x4d, 0xc7, 0x35, 0x03,
-	0x87, 0xbb, 0x88, 0x5d, 0x44, 0xec, 0x10, 0x16, 0xb7, 0xa3, 0x96, 0x9a, 0xbc, 0xc9, 0xc5, 0x4f,
-	0x1a, 0xfe, 0xc2, 0xd1, 0xa5, 0x26, 0xe7, 0xcd, 0x2f, 0x19, 0x35, 0x3b, 0x0e, 0x35, 0x5d, 0x97,
-	0x07, 0x82, 0x0f, 0xc5, 0xb5, 0x12, 0x90, 0x4f, 0x42, 0x8a, 0x7d, 0xe1, 0xc8, 0x60, 0x87, 0x5d,
-	0xe6, 0x07, 0xda, 0x03, 0xb8, 0x96, 0x18, 0xf5, 0x3b, 0xdc, 0xf5, 0x19, 0xd9, 0x86, 0xa2, 0x74,
-	0xbe, 0xa0, 0xac, 0x2a, 0x6f, 0xcf, 0x54, 0xe7, 0xf4, 0x44, 0x00, 0xba, 0x84, 0xd7, 0xf2, 0x4f,
-	0x9e, 0xaf, 0x4c, 0x19, 0x08, 0xd5, 0xee, 0xc2, 0x1b, 0x82, 0xeb, 0xe3, 0x30, 0x0e, 0x14, 0x20,
-	0x0b, 0xf0, 0x8a, 0xe5, 0x31, 0x33, 0xe0, 0x9e, 0xa0, 0x9a, 0x36, 0x86, 0x9f, 0xe4, 0x2a, 0xe4,
-	0x1c, 0x7b, 0x21, 0x27, 0x06, 0x73, 0x8e, 0xad, 0xed, 0xa1, 0x41, 0x5c, 0x8e, 0x4e, 0xde, 0x81,
-	0x82, 0x88, 0x17, 0x8d, 0x94, 0x52, 0x46, 0x04, 0x18, 0x7d, 0x48, 0xa0, 0xd6, 0x8b, 0xf3, 0xf8,
-	0x93, 0x7d, 0xec, 0x01, 0x44, 0xe9, 0x0b, 0x3f, 0x33, 0xd5, 0x75, 0x5d, 0xc6, 0xaf, 0x87, 0x5b,
-	0xa5, 0xcb, 0xe8, 0x71, 0xab, 0xf4, 0x7d, 0xb3, 0xc9, 0x90, 0xd5, 0x88, 0xad, 0xd4, 0xbe, 0x57,
-	0x30, 0xcb, 0xa1, 0x30, 0x56, 0x50, 0x85, 0xa2, 0x30, 0x16, 0x66, 0x79, 0x65, 0x42, 0x09, 0x88,
-	0x24, 0x1f, 0x64, 0x78, 0xda, 0x98, 0xe8, 0x49, 0x0a, 0x26, 0x4c, 0x7d, 0x01, 0x6f, 0x46, 0x9e,
-	0x6a, 0x9c, 0xb7, 0xce, 0x02, 0x49, 0x96, 0xad, 0x5c, 0xba, 0xec, 0x9f, 0x15, 0x98, 0x3f, 0x27,
-	0x81, 0xa5, 0xef, 0xc0, 0x8c, 0x28, 0xa8, 0xde, 0x08, 0x87, 0xb1, 0xfe, 0xa5, 0xcc, 0xfa, 0x39,
-	0x6f, 0xed, 0x9a, 0x81, 0x89, 0x39, 0x00, 0x3f, 0x23, 0xfb, 0xff, 0xb2, 0xf8, 0x1c, 0x16, 0x93,
-	0x46, 0x13, 0x57, 0x81, 0x2c, 0x03, 0x84, 0x6c, 0x75, 0x9b, 0xb9, 0xbc, 0x8d, 0x87, 0x64, 0x3a,
-	0x1c, 0xd9, 0x0d, 0x07, 0xc8, 0x0a, 0xcc, 0x1c, 0x76, 0x79, 0x30, 0x9c, 0x97, 0xe7, 0x16, 0xc4,
-	0x90, 0x00, 0x68, 0x2f, 0x72, 0xb0, 0x94, 0xcd, 0x8f, 0x69, 0xdc, 0x06, 0xe8, 0x78, 0x8e, 0xc5,
-	0xea, 0x81, 0x63, 0xb5, 0xa4, 0x40, 0x6d, 0x36, 0x2c, 0xf7, 0xcf, 0xe7, 0x2b, 0x85, 0xfd, 0x70,
-	0xc6, 0x98, 0x16, 0x80, 0x4f, 0x1d, 0xab, 0x45, 0x6a, 0x30, 0x7b, 0xd8, 0x35, 0xdd, 0xc0, 0x09,
-	0x8e, 0xeb, 0x7e, 0xc0, 0x3a, 0x52, 0xb1, 0xb6, 0x8c, 0x0b, 0xe6, 0x64, 0x00, 0xbe, 0xdd, 0xd2,
-	0x1d, 0x4e, 0xdb, 0x66, 0xf0, 0x58, 0xbf, 0xef, 0x06, 0xc6, 0x6b, 0xc3, 0x35, 0x0f, 0x03, 0xd6,
-	0x21, 0x0c, 0x96, 0xa3, 0x92, 0xea, 0x5d, 0xd7, 0x39, 0x70, 0x98, 0x5d, 0xf7, 0xd8, 0x41, 0xdd,
-	0x6c, 0xf3, 0xae, 0x1b, 0x2c, 0x5c, 0x11, 0x9c, 0x37, 0x90, 0x73, 0xf1, 0x3c, 0xe7, 0x47, 0xac,
-	0x69, 0x5a, 0xc7, 0xbb, 0xcc, 0x32, 0xae, 0x9f, 0x45, 0xf1, 0x48, 0xf2, 0x18, 0xec, 0xe0, 0x9e,
-	0x60, 0x21, 0x4d, 0x28, 0xc7, 0xa2, 0xc9, 0xd2, 0xc9, 0x5f, 0x5c, 0x47, 0x8d, 0x22, 0x4d, 0x0b,
-	0x69, 0x4f, 0x95, 0xf4, 0x16, 0x26, 0x2f, 0xf9, 0x7f, 0xdc, 0x42, 0xb2, 0x01, 0x79, 0xdf, 0xb1,
-	0x99, 0x88, 0xe5, 0x6a, 0xf5, 0x5a, 0xea, 0xa0, 0x3e, 0x74, 0x6c, 0x66, 0x08, 0x40, 0xea, 0xf2,
-	0xe4, 0x2f, 0x7d, 0x79, 0x7e, 0x52, 0xd2, 0x67, 0xe6, 0x65, 0x6a, 0x1e, 0x8f, 0xe0, 0x86, 0x30,
-	0x77, 0xcf, 0xb2, 0xc2, 0xf8, 0x45, 0x46, 0xd2, 0xdf, 0x4e, 0xf8, 0x1d, 0x6b, 0xad, 0xa6, 0x44,
-	0x0c, 0x5b, 0x2b, 0x7e, 0x92, 0x12, 0x14, 0xe2, 0x51, 0xcb, 0x0f, 0xed, 0x0e, 0xdc, 0x1c, 0x4f,
-	0x8b, 0xb5, 0x97, 0xa0, 0x10, 0xb1, 0xe6, 0x0d, 0xf9, 0x51, 0xfd, 0xe5, 0x55, 0x28, 0x88, 0xe5,
-	0xc4, 0x87, 0xa2, 0xbc, 0x61, 0x64, 0x2d, 0x95, 0xca, 0xf9, 0x87, 0x4e, 0xd5, 0xc6, 0x41, 0xa4,
-	0xa0, 0xa6, 0x7d, 0xfb, 0xf7, 0xaf, 0x9b, 0xca, 0xd7, 0xbf, 0xff, 0xf5, 0x43, 0x6e, 0x9e, 0xcc,
-	0xd1, 0xac, 0x97, 0x9c, 0x7c, 0x05, 0x05, 0xe1, 0x95, 0xac, 0x66, 0x11, 0xc6, 0x9f, 0x3e, 0x75,
-	0x6d, 0x0c, 0x02, 0x15, 0x2b, 0x91, 0xe2, 0x3a, 0xb9, 0x49, 0x33, 0xfe, 0x56, 0xf8, 0xb4, 0x8f,
-	0x0f, 0xd5, 0x80, 0xf6, 0x1d, 0x7b, 0x40, 0x06, 0x50, 0x94, 0x61, 0x91, 0xd1, 0xfc, 0xe3, 0xab,
-	0x4e, 0x1e, 0x31, 0xed, 0x76, 0xe4, 0x61, 0x8d, 0xac, 0x4c, 0xf0, 0x40, 0xbe, 0x51, 0x00, 0xa2,
-	0x4e, 0x4f, 0xde, 0x1a, 0x29, 0x10, 0x7f, 0x6c, 0xd4, 0xf5, 0x49, 0x30, 0xf4, 0xb2, 0x11, 0x79,
-	0x59, 0x22, 0x6a, 0x96, 0x97, 0x2d, 0xf1, 0x94, 0x90, 0x1f, 0x15, 0x78, 0x3d, 0xd5, 0x67, 0xc9,
-	0xe6, 0x58, 0x91, 0xe4, 0x71, 0xb8, 0x75, 0x21, 0x2c, 0xba, 0xda, 0x8a, 0x5c, 0x69, 0x64, 0x75,
-	0xa4, 0xab, 0x2d, 0x3c, 0x22, 0xbf, 0xc5, 0xbd, 0xe1, 0x5e, 0x8d, 0xf7, 0x96, 0xdc, 0xb4, 0x5b,
-	0x17, 0xc2, 0xa2, 0xb7, 0xfb, 0x91, 0xb7, 0xf7, 0xc8, 0x9d, 0xd1, 0x89, 0xd1, 0x7e, 0xd4, 0x19,
-	0x07, 0xb4, 0x1f, 0xeb, 0x83, 0x03, 0xdc, 0x64, 0xf2, 0x54, 0x81, 0xf9, 0x11, 0x77, 0x92, 0x54,
-	0xb3, 0x3c, 0x8d, 0xef, 0x0b, 0xea, 0xf6, 0xbf, 0x5a, 0x83, 0xf5, 0x3c, 0x88, 0xea, 0x79, 0x9f,
-	0xdc, 0x4d, 0xd5, 0x83, 0x7d, 0xc5, 0xa7, 0x7d, 0xfc, 0x35, 0xa0, 0xa2, 0x04, 0x9f, 0xf6, 0x13,
-	0xa5, 0x6c, 0x89, 0xc9, 0xda, 0x87, 0x4f, 0x4e, 0xca, 0xca, 0xb3, 0x93, 0xb2, 0xf2, 0xe2, 0xa4,
-	0xac, 0x7c, 0x77, 0x5a, 0x9e, 0x7a, 0x76, 0x5a, 0x9e, 0xfa, 0xe3, 0xb4, 0x3c, 0xf5, 0x59, 0xa5,
-	0xe9, 0x04, 0x8f, 0xbb, 0x0d, 0xdd, 0xe2, 0x6d, 0xba, 0x23, 0x24, 0xf6, 0x78, 0xd7, 0xb5, 0x45,
-	0xdb, 0x1b, 0x6a, 0xf6, 0xde, 0xa5, 0x47, 0x42, 0x38, 0x38, 0xee, 0x30, 0xbf, 0x51, 0x14, 0x7f,
-	0xa3, 0xb7, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0x35, 0xdd, 0x21, 0x1c, 0x26, 0x0c, 0x00, 0x00,
+func (m *QuerySimulateOrderRequest) Reset()         { *m = QuerySimulateOrderRequest{} }
+func (m *QuerySimulateOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateOrderRequest) ProtoMessage()    {}
+func (*QuerySimulateOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{16}
+}
+func (m *QuerySimulateOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateOrderRequest.Merge(m, src)
+}
+func (m *QuerySimulateOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateOrderRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySimulateOrderRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of x/dex module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Order queries order by creator and ID.
-	Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error)
-	// Orders queries creator orders.
-	Orders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
-	// OrderBooks queries order books.
-	OrderBooks(ctx context.Context, in *QueryOrderBooksRequest, opts ...grpc.CallOption) (*QueryOrderBooksResponse, error)
-	// OrderBookParams queries order book params.
-	OrderBookParams(ctx context.Context, in *QueryOrderBookParamsRequest, opts ...grpc.CallOption) (*QueryOrderBookParamsResponse, error)
-	// OrderBookOrders queries order book orders.
-	OrderBookOrders(ctx context.Context, in *QueryOrderBookOrdersRequest, opts ...grpc.CallOption) (*QueryOrderBookOrdersResponse, error)
-	// AccountDenomOrdersCount queries account denom orders count.
-	AccountDenomOrdersCount(ctx context.Context, in *QueryAccountDenomOrdersCountRequest, opts ...grpc.CallOption) (*QueryAccountDenomOrdersCountResponse, error)
+func (m *QuerySimulateOrderRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySimulateOrderRequest) GetType() OrderType {
+	if m != nil {
+		return m.Type
+	}
+	return ORDER_TYPE_UNSPECIFIED
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySimulateOrderRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOrderRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error) {
-	out := new(QueryOrderResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Order", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOrderRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Orders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error) {
-	out := new(QueryOrdersResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Orders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOrderRequest) GetPrice() string {
+	if m != nil {
+		return m.Price
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) OrderBooks(ctx context.Context, in *QueryOrderBooksRequest, opts ...grpc.CallOption) (*QueryOrderBooksResponse, error) {
-	out := new(QueryOrderBooksResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBooks", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOrderRequest) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) OrderBookParams(ctx context.Context, in *QueryOrderBookParamsRequest, opts ...grpc.CallOption) (*QueryOrderBookParamsResponse, error) {
-	out := new(QueryOrderBookParamsResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBookParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOrderRequest) GetSide() Side {
+	if m != nil {
+		return m.Side
 	}
-	return out, nil
+	return SIDE_UNSPECIFIED
 }
 
-func (c *queryClient) OrderBookOrders(ctx context.Context, in *QueryOrderBookOrdersRequest, opts ...grpc.CallOption) (*QueryOrderBookOrdersResponse, error) {
-	out := new(QueryOrderBookOrdersResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBookOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOrderRequest) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TIME_IN_FORCE_UNSPECIFIED
+}
+
+// QuerySimulateOrderResponse defines the response type for the `SimulateOrder` query.
+type QuerySimulateOrderResponse struct {
+	// fills are the maker orders matched by the order.
+	Fills []SimulatedFill `protobuf:"bytes,1,rep,name=fills,proto3" json:"fills"`
+	// spent_coin is the coin spent by the order.
+	SpentCoin types.Coin `protobuf:"bytes,2,opt,name=spent_coin,json=spentCoin,proto3" json:"spent_coin"`
+	// received_coin is the coin received by the order.
+	ReceivedCoin types.Coin `protobuf:"bytes,3,opt,name=received_coin,json=receivedCoin,proto3" json:"received_coin"`
+	// average_price is the average execution price, rounded to the worse price for the order, empty if the order isn't
+	// matched.
+	AveragePrice *Price `protobuf:"bytes,4,opt,name=average_price,json=averagePrice,proto3,customtype=Price" json:"average_price,omitempty"`
+	// remaining_base_quantity is the quantity of the order remaining in the order book after the matching.
+	RemainingBaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=remaining_base_quantity,json=remainingBaseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_base_quantity"`
+	// remaining_spendable_balance is the balance locked by the order remaining in the order book after the matching.
+	RemainingSpendableBalance cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=remaining_spendable_balance,json=remainingSpendableBalance,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_spendable_balance"`
+	// fee_coin is the part of the received coin charged as the trading fee.
+	FeeCoin types.Coin `protobuf:"bytes,7,opt,name=fee_coin,json=feeCoin,proto3" json:"fee_coin"`
+}
+
+func (m *QuerySimulateOrderResponse) Reset()         { *m = QuerySimulateOrderResponse{} }
+func (m *QuerySimulateOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateOrderResponse) ProtoMessage()    {}
+func (*QuerySimulateOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{17}
+}
+func (m *QuerySimulateOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySimulateOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateOrderResponse.Merge(m, src)
+}
+func (m *QuerySimulateOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateOrderResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AccountDenomOrdersCount(ctx context.Context, in *QueryAccountDenomOrdersCountRequest, opts ...grpc.CallOption) (*QueryAccountDenomOrdersCountResponse, error) {
-	out := new(QueryAccountDenomOrdersCountResponse)
-	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/AccountDenomOrdersCount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySimulateOrderResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateOrderResponse) GetFills() []SimulatedFill {
+	if m != nil {
+		return m.Fills
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/dex module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Order queries order by creator and ID.
-	Order(context.Context, *QueryOrderRequest) (*QueryOrderResponse, error)
-	// Orders queries creator orders.
-	Orders(context.Context, *QueryOrdersRequest) (*QueryOrdersResponse, error)
-	// OrderBooks queries order books.
-	OrderBooks(context.Context, *QueryOrderBooksRequest) (*QueryOrderBooksResponse, error)
-	// OrderBookParams queries order book params.
-	OrderBookParams(context.Context, *QueryOrderBookParamsRequest) (*QueryOrderBookParamsResponse, error)
-	// OrderBookOrders queries order book orders.
-	OrderBookOrders(context.Context, *QueryOrderBookOrdersRequest) (*QueryOrderBookOrdersResponse, error)
-	// AccountDenomOrdersCount queries account denom orders count.
-	AccountDenomOrdersCount(context.Context, *QueryAccountDenomOrdersCountRequest) (*QueryAccountDenomOrdersCountResponse, error)
+func (m *QuerySimulateOrderResponse) GetSpentCoin() types.Coin {
+	if m != nil {
+		return m.SpentCoin
+	}
+	return types.Coin{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QuerySimulateOrderResponse) GetReceivedCoin() types.Coin {
+	if m != nil {
+		return m.ReceivedCoin
+	}
+	return types.Coin{}
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySimulateOrderResponse) GetFeeCoin() types.Coin {
+	if m != nil {
+		return m.FeeCoin
+	}
+	return types.Coin{}
+}
+
+// SimulatedFill is the simulated match of the order with the maker order.
+type SimulatedFill struct {
+	// maker_creator is maker order creator's account.
+	MakerCreator string `protobuf:"bytes,1,opt,name=maker_creator,json=makerCreator,proto3" json:"maker_creator,omitempty"`
+	// maker_id is maker order ID.
+	MakerID string `protobuf:"bytes,2,opt,name=maker_id,json=makerId,proto3" json:"maker_id,omitempty"`
+	// maker_sequence is maker order sequence.
+	MakerSequence uint64 `protobuf:"varint,3,opt,name=maker_sequence,json=makerSequence,proto3" json:"maker_sequence,omitempty"`
+	// price is the execution price in the order book price terms, rounded to the worse price for the order.
+	Price *Price `protobuf:"bytes,4,opt,name=price,proto3,customtype=Price" json:"price,omitempty"`
+	// base_quantity is the executed quantity of the base denom.
+	BaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=base_quantity,json=baseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"base_quantity"`
+	// quote_quantity is the executed quantity of the quote denom.
+	QuoteQuantity cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=quote_quantity,json=quoteQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"quote_quantity"`
+}
+
+func (m *SimulatedFill) Reset()         { *m = SimulatedFill{} }
+func (m *SimulatedFill) String() string { return proto.CompactTextString(m) }
+func (*SimulatedFill) ProtoMessage()    {}
+func (*SimulatedFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{18}
+}
+func (m *SimulatedFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Order(ctx context.Context, req *QueryOrderRequest) (*QueryOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Order not implemented")
-}
-func (*UnimplementedQueryServer) Orders(ctx context.Context, req *QueryOrdersRequest) (*QueryOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Orders not implemented")
-}
-func (*UnimplementedQueryServer) OrderBooks(ctx context.Context, req *QueryOrderBooksRequest) (*QueryOrderBooksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OrderBooks not implemented")
+func (m *SimulatedFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SimulatedFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) OrderBookParams(ctx context.Context, req *QueryOrderBookParamsRequest) (*QueryOrderBookParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OrderBookParams not implemented")
+func (m *SimulatedFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulatedFill.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OrderBookOrders(ctx context.Context, req *QueryOrderBookOrdersRequest) (*QueryOrderBookOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OrderBookOrders not implemented")
+func (m *SimulatedFill) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AccountDenomOrdersCount(ctx context.Context, req *QueryAccountDenomOrdersCountRequest) (*QueryAccountDenomOrdersCountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountDenomOrdersCount not implemented")
+func (m *SimulatedFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulatedFill.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_SimulatedFill proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *SimulatedFill) GetMakerCreator() string {
+	if m != nil {
+		return m.MakerCreator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Order_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Order(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/Order",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Order(ctx, req.(*QueryOrderRequest))
+func (m *SimulatedFill) GetMakerID() string {
+	if m != nil {
+		return m.MakerID
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Orders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Orders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/Orders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Orders(ctx, req.(*QueryOrdersRequest))
+func (m *SimulatedFill) GetMakerSequence() uint64 {
+	if m != nil {
+		return m.MakerSequence
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_OrderBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderBooksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OrderBooks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/OrderBooks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OrderBooks(ctx, req.(*QueryOrderBooksRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAccumulatedFeesRequest defines the request type for the `AccumulatedFees` query.
+type QueryAccumulatedFeesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_OrderBookParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderBookParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OrderBookParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/OrderBookParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OrderBookParams(ctx, req.(*QueryOrderBookParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryAccumulatedFeesRequest) Reset()         { *m = QueryAccumulatedFeesRequest{} }
+func (m *QueryAccumulatedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccumulatedFeesRequest) ProtoMessage()    {}
+func (*QueryAccumulatedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{19}
 }
-
-func _Query_OrderBookOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderBookOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OrderBookOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/OrderBookOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OrderBookOrders(ctx, req.(*QueryOrderBookOrdersRequest))
+func (m *QueryAccumulatedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccumulatedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccumulatedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAccumulatedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccumulatedFeesRequest.Merge(m, src)
+}
+func (m *QueryAccumulatedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccumulatedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccumulatedFeesRequest.DiscardUnknown(m)
 }
 
-func _Query_AccountDenomOrdersCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountDenomOrdersCountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountDenomOrdersCount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.dex.v1.Query/AccountDenomOrdersCount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountDenomOrdersCount(ctx, req.(*QueryAccountDenomOrdersCountRequest))
+var xxx_messageInfo_QueryAccumulatedFeesRequest proto.InternalMessageInfo
+
+func (m *QueryAccumulatedFeesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "coreum.dex.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Order",
-			Handler:    _Query_Order_Handler,
-		},
-		{
-			MethodName: "Orders",
-			Handler:    _Query_Orders_Handler,
-		},
-		{
-			MethodName: "OrderBooks",
-			Handler:    _Query_OrderBooks_Handler,
-		},
-		{
-			MethodName: "OrderBookParams",
-			Handler:    _Query_OrderBookParams_Handler,
-		},
-		{
-			MethodName: "OrderBookOrders",
-			Handler:    _Query_OrderBookOrders_Handler,
-		},
-		{
-			MethodName: "AccountDenomOrdersCount",
-			Handler:    _Query_AccountDenomOrdersCount_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "coreum/dex/v1/query.proto",
+// QueryAccumulatedFeesResponse defines the response type for the `AccumulatedFees` query.
+type QueryAccumulatedFeesResponse struct {
+	// fees is the total amount of the fees charged by the DEX per denom.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccumulatedFeesResponse) Reset()         { *m = QueryAccumulatedFeesResponse{} }
+func (m *QueryAccumulatedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccumulatedFeesResponse) ProtoMessage()    {}
+func (*QueryAccumulatedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{20}
+}
+func (m *QueryAccumulatedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccumulatedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccumulatedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccumulatedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccumulatedFeesResponse.Merge(m, src)
+}
+func (m *QueryAccumulatedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccumulatedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccumulatedFeesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryAccumulatedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryAccumulatedFeesResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccumulatedFeesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTradesRequest defines the request type for the `Trades` query.
+type QueryTradesRequest struct {
+	// base_denom is base order book denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order book denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTradesRequest) Reset()         { *m = QueryTradesRequest{} }
+func (m *QueryTradesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesRequest) ProtoMessage()    {}
+func (*QueryTradesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{21}
+}
+func (m *QueryTradesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesRequest.Merge(m, src)
 }
-
-func (m *QueryOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTradesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryTradesRequest proto.InternalMessageInfo
+
+func (m *QueryTradesRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *QueryTradesRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTradesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTradesResponse defines the response type for the `Trades` query.
+type QueryTradesResponse struct {
+	Trades     []Trade             `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTradesResponse) Reset()         { *m = QueryTradesResponse{} }
+func (m *QueryTradesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesResponse) ProtoMessage()    {}
+func (*QueryTradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{22}
+}
+func (m *QueryTradesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesResponse.Merge(m, src)
 }
-
-func (m *QueryOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTradesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryTradesResponse proto.InternalMessageInfo
+
+func (m *QueryTradesResponse) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTradesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCandlesRequest defines the request type for the `Candles` query.
+type QueryCandlesRequest struct {
+	// base_denom is base order book denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order book denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// interval is the candle interval.
+	Interval CandleInterval `protobuf:"varint,3,opt,name=interval,proto3,enum=coreum.dex.v1.CandleInterval" json:"interval,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCandlesRequest) Reset()         { *m = QueryCandlesRequest{} }
+func (m *QueryCandlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesRequest) ProtoMessage()    {}
+func (*QueryCandlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{23}
+}
+func (m *QueryCandlesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCandlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesRequest.Merge(m, src)
+}
+func (m *QueryCandlesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOrderBooksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCandlesRequest proto.InternalMessageInfo
+
+func (m *QueryCandlesRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryOrderBooksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCandlesRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
 }
 
-func (m *QueryOrderBooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCandlesRequest) GetInterval() CandleInterval {
+	if m != nil {
+		return m.Interval
 	}
-	return len(dAtA) - i, nil
+	return CANDLE_INTERVAL_UNSPECIFIED
 }
 
-func (m *QueryOrderBooksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCandlesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOrderBooksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCandlesResponse defines the response type for the `Candles` query.
+type QueryCandlesResponse struct {
+	Candles    []Candle            `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryOrderBooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryCandlesResponse) Reset()         { *m = QueryCandlesResponse{} }
+func (m *QueryCandlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesResponse) ProtoMessage()    {}
+func (*QueryCandlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{24}
+}
+func (m *QueryCandlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.OrderBooks) > 0 {
-		for iNdEx := len(m.OrderBooks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.OrderBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryCandlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesResponse.Merge(m, src)
+}
+func (m *QueryCandlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandlesResponse proto.InternalMessageInfo
+
+func (m *QueryCandlesResponse) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryOrderBookParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCandlesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOrderBookParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCancelAllAfterRequest defines the request type for the `CancelAllAfter` query.
+type QueryCancelAllAfterRequest struct {
+	// account is orders creator address.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryOrderBookParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCancelAllAfterRequest) Reset()         { *m = QueryCancelAllAfterRequest{} }
+func (m *QueryCancelAllAfterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCancelAllAfterRequest) ProtoMessage()    {}
+func (*QueryCancelAllAfterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{25}
+}
+func (m *QueryCancelAllAfterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCancelAllAfterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCancelAllAfterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCancelAllAfterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCancelAllAfterRequest.Merge(m, src)
+}
+func (m *QueryCancelAllAfterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCancelAllAfterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCancelAllAfterRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOrderBookParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCancelAllAfterRequest proto.InternalMessageInfo
+
+func (m *QueryCancelAllAfterRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryOrderBookParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryCancelAllAfterResponse defines the response type for the `CancelAllAfter` query.
+type QueryCancelAllAfterResponse struct {
+	CancelAllAfter CancelAllAfter `protobuf:"bytes,1,opt,name=cancel_all_after,json=cancelAllAfter,proto3" json:"cancel_all_after"`
 }
 
-func (m *QueryOrderBookParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.QuoteDenomUnifiedRefAmount.Size()
-		i -= size
-		if _, err := m.QuoteDenomUnifiedRefAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.BaseDenomUnifiedRefAmount.Size()
-		i -= size
-		if _, err := m.BaseDenomUnifiedRefAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.QuantityStep.Size()
-		i -= size
-		if _, err := m.QuantityStep.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.PriceTick.Size()
-		i -= size
-		if _, err := m.PriceTick.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryCancelAllAfterResponse) Reset()         { *m = QueryCancelAllAfterResponse{} }
+func (m *QueryCancelAllAfterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCancelAllAfterResponse) ProtoMessage()    {}
+func (*QueryCancelAllAfterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{26}
+}
+func (m *QueryCancelAllAfterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCancelAllAfterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCancelAllAfterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryCancelAllAfterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCancelAllAfterResponse.Merge(m, src)
+}
+func (m *QueryCancelAllAfterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCancelAllAfterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCancelAllAfterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOrderBookOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCancelAllAfterResponse proto.InternalMessageInfo
+
+func (m *QueryCancelAllAfterResponse) GetCancelAllAfter() CancelAllAfter {
+	if m != nil {
+		return m.CancelAllAfter
 	}
-	return dAtA[:n], nil
+	return CancelAllAfter{}
 }
 
-func (m *QueryOrderBookOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOrderBookHaltRequest defines the request type for the `OrderBookHalt` query.
+type QueryOrderBookHaltRequest struct {
+	// base_denom is base order book denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is quote order book denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
 }
 
-func (m *QueryOrderBookOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryOrderBookHaltRequest) Reset()         { *m = QueryOrderBookHaltRequest{} }
+func (m *QueryOrderBookHaltRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookHaltRequest) ProtoMessage()    {}
+func (*QueryOrderBookHaltRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{27}
+}
+func (m *QueryOrderBookHaltRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderBookHaltRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderBookHaltRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Side != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.QuoteDenom) > 0 {
-		i -= len(m.QuoteDenom)
-		copy(dAtA[i:], m.QuoteDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryOrderBookHaltRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookHaltRequest.Merge(m, src)
+}
+func (m *QueryOrderBookHaltRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderBookHaltRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookHaltRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrderBookHaltRequest proto.InternalMessageInfo
+
+func (m *QueryOrderBookHaltRequest) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryOrderBookOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOrderBookHaltRequest) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryOrderBookOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOrderBookHaltResponse defines the response type for the `OrderBookHalt` query.
+type QueryOrderBookHaltResponse struct {
+	// halted is true if the trading in the order book is halted.
+	Halted bool `protobuf:"varint,1,opt,name=halted,proto3" json:"halted,omitempty"`
+	// halt is the order book halt, empty if the order book isn't halted.
+	Halt OrderBookHalt `protobuf:"bytes,2,opt,name=halt,proto3" json:"halt"`
 }
 
-func (m *QueryOrderBookOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryOrderBookHaltResponse) Reset()         { *m = QueryOrderBookHaltResponse{} }
+func (m *QueryOrderBookHaltResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookHaltResponse) ProtoMessage()    {}
+func (*QueryOrderBookHaltResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{28}
+}
+func (m *QueryOrderBookHaltResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderBookHaltResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderBookHaltResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAccountDenomOrdersCountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryOrderBookHaltResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookHaltResponse.Merge(m, src)
 }
-
-func (m *QueryAccountDenomOrdersCountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOrderBookHaltResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderBookHaltResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookHaltResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAccountDenomOrdersCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryOrderBookHaltResponse proto.InternalMessageInfo
+
+func (m *QueryOrderBookHaltResponse) GetHalted() bool {
+	if m != nil {
+		return m.Halted
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryAccountDenomOrdersCountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOrderBookHaltResponse) GetHalt() OrderBookHalt {
+	if m != nil {
+		return m.Halt
 	}
-	return dAtA[:n], nil
+	return OrderBookHalt{}
 }
 
-func (m *QueryAccountDenomOrdersCountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySimulateSwapRequest defines the request type for the `SimulateSwap` query.
+type QuerySimulateSwapRequest struct {
+	// sender is the swap creator account.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// route is the list of denoms the swap goes through, starting with the input denom and ending with the output
+	// denom.
+	Route []string `protobuf:"bytes,2,rep,name=route,proto3" json:"route,omitempty"`
+	// amount_in is the amount of the input denom to swap, set for the exact input swap.
+	AmountIn string `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	// amount_out is the amount of the output denom to receive, set for the exact output swap limited by the sender
+	// balance of the input denom.
+	AmountOut string `protobuf:"bytes,4,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
 }
 
-func (m *QueryAccountDenomOrdersCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Count != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QuerySimulateSwapRequest) Reset()         { *m = QuerySimulateSwapRequest{} }
+func (m *QuerySimulateSwapRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapRequest) ProtoMessage()    {}
+func (*QuerySimulateSwapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{29}
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QuerySimulateSwapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateSwapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSwapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySimulateSwapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapRequest.Merge(m, src)
+}
+func (m *QuerySimulateSwapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSwapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapRequest.DiscardUnknown(m)
 }
 
-func (m *QueryOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySimulateSwapRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateSwapRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return n
+	return ""
 }
 
-func (m *QueryOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateSwapRequest) GetRoute() []string {
+	if m != nil {
+		return m.Route
 	}
-	var l int
-	_ = l
-	l = m.Order.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySimulateSwapRequest) GetAmountIn() string {
+	if m != nil {
+		return m.AmountIn
 	}
-	return n
+	return ""
 }
 
-func (m *QueryOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySimulateSwapRequest) GetAmountOut() string {
+	if m != nil {
+		return m.AmountOut
 	}
-	return n
+	return ""
 }
 
-func (m *QueryOrderBooksRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QuerySimulateSwapResponse defines the response type for the `SimulateSwap` query.
+type QuerySimulateSwapResponse struct {
+	// spent_coin is the coin spent by the swap.
+	SpentCoin types.Coin `protobuf:"bytes,1,opt,name=spent_coin,json=spentCoin,proto3" json:"spent_coin"`
+	// received_coin is the coin received by the swap.
+	ReceivedCoin types.Coin `protobuf:"bytes,2,opt,name=received_coin,json=receivedCoin,proto3" json:"received_coin"`
+	// hops are the execution results of the route hops.
+	Hops []SwapHop `protobuf:"bytes,3,rep,name=hops,proto3" json:"hops"`
 }
 
-func (m *QueryOrderBooksResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.OrderBooks) > 0 {
-		for _, e := range m.OrderBooks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QuerySimulateSwapResponse) Reset()         { *m = QuerySimulateSwapResponse{} }
+func (m *QuerySimulateSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapResponse) ProtoMessage()    {}
+func (*QuerySimulateSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_23a17d94653a2124, []int{30}
+}
+func (m *QuerySimulateSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QuerySimulateSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapResponse.Merge(m, src)
+}
+func (m *QuerySimulateSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOrderBookParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QuerySimulateSwapResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateSwapResponse) GetSpentCoin() types.Coin {
+	if m != nil {
+		return m.SpentCoin
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *QueryOrderBookParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateSwapResponse) GetReceivedCoin() types.Coin {
+	if m != nil {
+		return m.ReceivedCoin
 	}
-	var l int
-	_ = l
-	l = m.PriceTick.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.QuantityStep.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BaseDenomUnifiedRefAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.QuoteDenomUnifiedRefAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return types.Coin{}
 }
 
-func (m *QueryOrderBookOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySimulateSwapResponse) GetHops() []SwapHop {
+	if m != nil {
+		return m.Hops
 	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.dex.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.dex.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryOrderRequest)(nil), "coreum.dex.v1.QueryOrderRequest")
+	proto.RegisterType((*QueryOrderResponse)(nil), "coreum.dex.v1.QueryOrderResponse")
+	proto.RegisterType((*QueryOrdersRequest)(nil), "coreum.dex.v1.QueryOrdersRequest")
+	proto.RegisterType((*QueryOrdersResponse)(nil), "coreum.dex.v1.QueryOrdersResponse")
+	proto.RegisterType((*QueryOrderBooksRequest)(nil), "coreum.dex.v1.QueryOrderBooksRequest")
+	proto.RegisterType((*QueryOrderBooksResponse)(nil), "coreum.dex.v1.QueryOrderBooksResponse")
+	proto.RegisterType((*QueryOrderBookParamsRequest)(nil), "coreum.dex.v1.QueryOrderBookParamsRequest")
+	proto.RegisterType((*QueryOrderBookParamsResponse)(nil), "coreum.dex.v1.QueryOrderBookParamsResponse")
+	proto.RegisterType((*QueryOrderBookOrdersRequest)(nil), "coreum.dex.v1.QueryOrderBookOrdersRequest")
+	proto.RegisterType((*QueryOrderBookOrdersResponse)(nil), "coreum.dex.v1.QueryOrderBookOrdersResponse")
+	proto.RegisterType((*QueryOrderBookDepthRequest)(nil), "coreum.dex.v1.QueryOrderBookDepthRequest")
+	proto.RegisterType((*QueryOrderBookDepthResponse)(nil), "coreum.dex.v1.QueryOrderBookDepthResponse")
+	proto.RegisterType((*QueryAccountDenomOrdersCountRequest)(nil), "coreum.dex.v1.QueryAccountDenomOrdersCountRequest")
+	proto.RegisterType((*QueryAccountDenomOrdersCountResponse)(nil), "coreum.dex.v1.QueryAccountDenomOrdersCountResponse")
+	proto.RegisterType((*QuerySimulateOrderRequest)(nil), "coreum.dex.v1.QuerySimulateOrderRequest")
+	proto.RegisterType((*QuerySimulateOrderResponse)(nil), "coreum.dex.v1.QuerySimulateOrderResponse")
+	proto.RegisterType((*SimulatedFill)(nil), "coreum.dex.v1.SimulatedFill")
+	proto.RegisterType((*QueryAccumulatedFeesRequest)(nil), "coreum.dex.v1.QueryAccumulatedFeesRequest")
+	proto.RegisterType((*QueryAccumulatedFeesResponse)(nil), "coreum.dex.v1.QueryAccumulatedFeesResponse")
+	proto.RegisterType((*QueryTradesRequest)(nil), "coreum.dex.v1.QueryTradesRequest")
+	proto.RegisterType((*QueryTradesResponse)(nil), "coreum.dex.v1.QueryTradesResponse")
+	proto.RegisterType((*QueryCandlesRequest)(nil), "coreum.dex.v1.QueryCandlesRequest")
+	proto.RegisterType((*QueryCandlesResponse)(nil), "coreum.dex.v1.QueryCandlesResponse")
+	proto.RegisterType((*QueryCancelAllAfterRequest)(nil), "coreum.dex.v1.QueryCancelAllAfterRequest")
+	proto.RegisterType((*QueryCancelAllAfterResponse)(nil), "coreum.dex.v1.QueryCancelAllAfterResponse")
+	proto.RegisterType((*QueryOrderBookHaltRequest)(nil), "coreum.dex.v1.QueryOrderBookHaltRequest")
+	proto.RegisterType((*QueryOrderBookHaltResponse)(nil), "coreum.dex.v1.QueryOrderBookHaltResponse")
+	proto.RegisterType((*QuerySimulateSwapRequest)(nil), "coreum.dex.v1.QuerySimulateSwapRequest")
+	proto.RegisterType((*QuerySimulateSwapResponse)(nil), "coreum.dex.v1.QuerySimulateSwapResponse")
+}
+
+func init() { proto.RegisterFile("coreum/dex/v1/query.proto", fileDescriptor_23a17d94653a2124) }
+
+var fileDescriptor_23a17d94653a2124 = []byte{
+	// 2131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x4a, 0x24, 0x25, 0x3e, 0x89, 0x72, 0x3a, 0x91, 0x6d, 0x8a, 0xb6, 0x25, 0x7b, 0xfd,
+	0xed, 0x58, 0x5c, 0x4b, 0x6e, 0x8d, 0x36, 0xb5, 0x1d, 0x88, 0x12, 0x14, 0x2b, 0x4d, 0x10, 0x67,
+	0x65, 0x5f, 0x5a, 0x04, 0xec, 0x68, 0x77, 0x44, 0x2d, 0xb4, 0xdc, 0xa5, 0x77, 0x97, 0xb4, 0x05,
+	0x41, 0x28, 0x50, 0x04, 0x48, 0x81, 0x5e, 0x8a, 0x16, 0x28, 0xfa, 0x85, 0xf6, 0xd8, 0xa0, 0x40,
+	0x8b, 0xf6, 0xd0, 0xff, 0xc1, 0x27, 0x23, 0x40, 0x2e, 0x41, 0x0f, 0x6e, 0x61, 0x17, 0xe8, 0x7f,
+	0xd0, 0x63, 0x51, 0xcc, 0xcc, 0x5b, 0xee, 0x87, 0x96, 0x1f, 0x91, 0x79, 0xc8, 0x49, 0x9c, 0x99,
+	0xf7, 0xde, 0xfc, 0xde, 0x7b, 0xf3, 0x66, 0x7e, 0x6f, 0x05, 0x73, 0x86, 0xeb, 0xb1, 0x76, 0x53,
+	0x33, 0xd9, 0x53, 0xad, 0xb3, 0xa4, 0x3d, 0x6e, 0x33, 0x6f, 0xaf, 0xda, 0xf2, 0xdc, 0xc0, 0x25,
+	0x25, 0xb9, 0x54, 0x35, 0xd9, 0xd3, 0x6a, 0x67, 0xa9, 0x92, 0x92, 0x74, 0x3d, 0x93, 0x79, 0x52,
+	0xb2, 0x52, 0x49, 0x2e, 0xb5, 0xa8, 0x47, 0x9b, 0x3e, 0xae, 0xa5, 0xd4, 0x02, 0x8f, 0x9a, 0x0c,
+	0x97, 0xae, 0x1b, 0xae, 0xdf, 0x74, 0x7d, 0x6d, 0x8b, 0xfa, 0x4c, 0xee, 0xac, 0x75, 0x96, 0xb6,
+	0x58, 0x40, 0xb9, 0x89, 0x86, 0xe5, 0xd0, 0xc0, 0x72, 0x1d, 0x94, 0x9d, 0x8f, 0xcb, 0x86, 0x52,
+	0x86, 0x6b, 0x85, 0xeb, 0xa7, 0x71, 0x3d, 0x34, 0x13, 0xf7, 0xa4, 0x32, 0xdb, 0x70, 0x1b, 0xae,
+	0xf8, 0xa9, 0xf1, 0x5f, 0x38, 0x7b, 0xa6, 0xe1, 0xba, 0x0d, 0x9b, 0x69, 0xb4, 0x65, 0x69, 0xd4,
+	0x71, 0xdc, 0x40, 0xec, 0x87, 0xb8, 0xd5, 0x59, 0x20, 0x1f, 0x71, 0x13, 0x0f, 0x84, 0x33, 0x3a,
+	0x7b, 0xdc, 0x66, 0x7e, 0xa0, 0xbe, 0x07, 0x6f, 0x26, 0x66, 0xfd, 0x96, 0xeb, 0xf8, 0x8c, 0xdc,
+	0x82, 0x82, 0x74, 0xba, 0xac, 0x9c, 0x53, 0xae, 0x4e, 0x2d, 0x9f, 0xa8, 0x26, 0x62, 0x57, 0x95,
+	0xe2, 0xb5, 0xdc, 0xb3, 0x17, 0x0b, 0xc7, 0x74, 0x14, 0x55, 0xef, 0xc2, 0x37, 0x84, 0xad, 0x0f,
+	0x79, 0x24, 0x71, 0x03, 0x52, 0x86, 0x09, 0xc3, 0x63, 0x34, 0x70, 0x3d, 0x61, 0xaa, 0xa8, 0x87,
+	0x43, 0x32, 0x03, 0x63, 0x96, 0x59, 0x1e, 0x13, 0x93, 0x63, 0x96, 0xa9, 0xae, 0x23, 0x40, 0x54,
+	0x47, 0x24, 0x37, 0x21, 0x2f, 0x32, 0x83, 0x40, 0x66, 0x53, 0x40, 0x84, 0x30, 0xe2, 0x90, 0x82,
+	0x6a, 0x27, 0x6e, 0xc7, 0x1f, 0x8c, 0x63, 0x1d, 0x20, 0xca, 0x8e, 0xc0, 0x33, 0xb5, 0x7c, 0xb9,
+	0x2a, 0xc3, 0x5f, 0xe5, 0xe9, 0xa9, 0xca, 0xd0, 0x63, 0x92, 0xaa, 0x0f, 0x68, 0x83, 0xa1, 0x55,
+	0x3d, 0xa6, 0xa9, 0xfe, 0x5c, 0xc1, 0x58, 0x86, 0x1b, 0xa3, 0x07, 0xcb, 0x50, 0x10, 0xc0, 0x78,
+	0x2c, 0xc7, 0x07, 0xb8, 0x80, 0x92, 0xe4, 0xdd, 0x0c, 0x4c, 0x57, 0x06, 0x62, 0x92, 0x1b, 0x26,
+	0x40, 0xfd, 0x10, 0x4e, 0x46, 0x98, 0x6a, 0xae, 0xbb, 0xdb, 0x0d, 0x48, 0xd2, 0x6d, 0xe5, 0xc8,
+	0x6e, 0xff, 0x51, 0x81, 0x53, 0x87, 0xb6, 0x40, 0xd7, 0x57, 0x61, 0x4a, 0x38, 0x54, 0xdf, 0xe2,
+	0xd3, 0xe8, 0xff, 0x99, 0x4c, 0xff, 0x5d, 0x77, 0x77, 0x8d, 0x06, 0x14, 0xe3, 0x00, 0x6e, 0xd7,
+	0xd8, 0xe8, 0x62, 0xf1, 0x31, 0x9c, 0x4e, 0x02, 0x4d, 0x94, 0x02, 0x39, 0x0b, 0xc0, 0xad, 0xd5,
+	0x4d, 0xe6, 0xb8, 0x4d, 0x3c, 0x24, 0x45, 0x3e, 0xb3, 0xc6, 0x27, 0xc8, 0x02, 0x4c, 0x3d, 0x6e,
+	0xbb, 0x41, 0xb8, 0x2e, 0xcf, 0x2d, 0x88, 0x29, 0x21, 0xa0, 0xfe, 0x77, 0x1c, 0xce, 0x64, 0xdb,
+	0xc7, 0x68, 0xdc, 0x00, 0x68, 0x79, 0x96, 0xc1, 0xea, 0x81, 0x65, 0xec, 0xca, 0x0d, 0x6a, 0x25,
+	0xee, 0xee, 0x3f, 0x5e, 0x2c, 0xe4, 0x1f, 0xf0, 0x15, 0xbd, 0x28, 0x04, 0x1e, 0x5a, 0xc6, 0x2e,
+	0xa9, 0x41, 0xe9, 0x71, 0x9b, 0x3a, 0x81, 0x15, 0xec, 0xd5, 0xfd, 0x80, 0xb5, 0xe4, 0x8e, 0xb5,
+	0xb3, 0xa8, 0x70, 0x42, 0x06, 0xc0, 0x37, 0x77, 0xab, 0x96, 0xab, 0x35, 0x69, 0xb0, 0x53, 0xdd,
+	0x70, 0x02, 0x7d, 0x3a, 0xd4, 0xd9, 0x0c, 0x58, 0x8b, 0x30, 0x38, 0x1b, 0xb9, 0x54, 0x6f, 0x3b,
+	0xd6, 0xb6, 0xc5, 0xcc, 0xba, 0xc7, 0xb6, 0xeb, 0xb4, 0xe9, 0xb6, 0x9d, 0xa0, 0x3c, 0x2e, 0x6c,
+	0x5e, 0x40, 0x9b, 0xa7, 0x0f, 0xdb, 0x7c, 0x9f, 0x35, 0xa8, 0xb1, 0xb7, 0xc6, 0x0c, 0x7d, 0xae,
+	0x1b, 0x8a, 0x47, 0xd2, 0x8e, 0xce, 0xb6, 0x57, 0x84, 0x15, 0xd2, 0x80, 0xf9, 0x58, 0x68, 0xb2,
+	0xf6, 0xc9, 0x0d, 0xbf, 0x4f, 0x25, 0x0a, 0xe9, 0xa1, 0x8d, 0x36, 0x60, 0xa6, 0x49, 0x77, 0x99,
+	0x57, 0xdf, 0x66, 0xac, 0xee, 0xd1, 0x80, 0x95, 0xf3, 0xc3, 0x1b, 0x9e, 0x16, 0xaa, 0xeb, 0x8c,
+	0xe9, 0x34, 0x60, 0xdc, 0x54, 0x90, 0x34, 0x55, 0xf8, 0x0a, 0xa6, 0x82, 0x98, 0x29, 0xf5, 0xb9,
+	0x92, 0x3e, 0x58, 0xc9, 0xab, 0xe7, 0x35, 0x0f, 0x16, 0xb9, 0x02, 0x39, 0xdf, 0x32, 0x99, 0x48,
+	0xd6, 0xcc, 0xf2, 0x9b, 0xa9, 0xf2, 0xd9, 0xb4, 0x4c, 0xa6, 0x0b, 0x81, 0x54, 0x49, 0xe7, 0x8e,
+	0x5c, 0xd2, 0xbf, 0x55, 0xd2, 0x27, 0xf9, 0xeb, 0x74, 0xa5, 0xfd, 0x41, 0x81, 0x4a, 0x12, 0xdd,
+	0x1a, 0x6b, 0x05, 0x3b, 0xa3, 0x8a, 0xf6, 0x49, 0x28, 0xd8, 0xac, 0xc3, 0x6c, 0x5f, 0xc4, 0xbb,
+	0xa4, 0xe3, 0x88, 0x5c, 0x83, 0x37, 0x2c, 0xc7, 0xb0, 0xdb, 0x26, 0xab, 0x5b, 0x4e, 0x87, 0x79,
+	0x01, 0x33, 0x45, 0x88, 0x27, 0xf5, 0xe3, 0x38, 0xbf, 0x81, 0xd3, 0xea, 0xa7, 0x87, 0x0e, 0x04,
+	0x22, 0xec, 0xbe, 0xae, 0xb9, 0x2d, 0xcb, 0x0c, 0x83, 0x37, 0x97, 0x7e, 0x5b, 0xf9, 0x15, 0xf0,
+	0x3e, 0xdf, 0x14, 0x23, 0x28, 0x84, 0xb9, 0x12, 0xf5, 0x77, 0xfd, 0xf2, 0xd8, 0x90, 0x4a, 0x5c,
+	0x58, 0x7d, 0x04, 0x17, 0x04, 0x90, 0x15, 0xc3, 0xe0, 0x05, 0x24, 0x3c, 0x94, 0xb9, 0x5c, 0xe5,
+	0xe3, 0xd8, 0xe3, 0x48, 0xa5, 0x44, 0xf8, 0x38, 0xe2, 0x90, 0xcc, 0x42, 0x3e, 0x1e, 0x28, 0x39,
+	0x50, 0xef, 0xc0, 0xc5, 0xfe, 0x66, 0xd1, 0xd1, 0x59, 0xc8, 0x47, 0x56, 0x73, 0xba, 0x1c, 0xa8,
+	0xcf, 0xc7, 0x60, 0x4e, 0xa8, 0x6f, 0x5a, 0xcd, 0xb6, 0x4d, 0x03, 0x36, 0x24, 0x61, 0xb8, 0x01,
+	0xb9, 0x60, 0xaf, 0xc5, 0x04, 0x94, 0x99, 0xe5, 0x72, 0xd6, 0x99, 0x7b, 0xb8, 0xd7, 0x62, 0xba,
+	0x90, 0x42, 0x7a, 0x31, 0x1e, 0xd2, 0x8b, 0xd4, 0xb9, 0xc8, 0x0d, 0x38, 0x17, 0xf9, 0x43, 0xe7,
+	0x62, 0x16, 0xf2, 0xe2, 0x72, 0x96, 0xf7, 0x84, 0x2e, 0x07, 0xa4, 0x02, 0x93, 0xe1, 0x8d, 0x5b,
+	0x9e, 0x10, 0x0b, 0xdd, 0x71, 0xb7, 0x6e, 0x27, 0x07, 0xd5, 0xed, 0x3d, 0x28, 0x05, 0x56, 0x93,
+	0x9f, 0xab, 0xfa, 0xb6, 0xeb, 0x19, 0xac, 0x5c, 0x14, 0x1a, 0x95, 0x94, 0xc6, 0x43, 0xab, 0xc9,
+	0x36, 0x9c, 0x75, 0x2e, 0xa1, 0x4f, 0x05, 0xd1, 0x40, 0xfd, 0xdf, 0x38, 0x56, 0x44, 0x2a, 0xa0,
+	0x98, 0x85, 0x6f, 0x43, 0x7e, 0xdb, 0xb2, 0xed, 0x5e, 0xef, 0x6f, 0xa8, 0x64, 0xae, 0x5b, 0x76,
+	0x78, 0x7a, 0xa4, 0x02, 0xb9, 0x07, 0xe0, 0xb7, 0x98, 0x13, 0xd4, 0x39, 0x31, 0xc5, 0x9a, 0x9d,
+	0x4b, 0xd4, 0x6c, 0x58, 0xad, 0xab, 0xae, 0xe5, 0xa0, 0x6e, 0x51, 0xa8, 0xf0, 0x09, 0xb2, 0x06,
+	0x25, 0x8f, 0x19, 0xcc, 0xea, 0x30, 0x53, 0x9a, 0x18, 0x1f, 0xce, 0xc4, 0x74, 0xa8, 0x25, 0xac,
+	0x54, 0xa1, 0x44, 0x3b, 0xcc, 0xa3, 0x0d, 0x56, 0x97, 0x19, 0x90, 0xaf, 0x49, 0x31, 0x7a, 0x36,
+	0xa7, 0x71, 0x5d, 0x8c, 0xc8, 0x23, 0x38, 0xe5, 0xb1, 0x26, 0xb5, 0x1c, 0xcb, 0x69, 0xd4, 0x45,
+	0xce, 0xbb, 0x29, 0xca, 0x0f, 0xf3, 0x86, 0x9e, 0xe8, 0x6a, 0xd7, 0xa8, 0xcf, 0x3e, 0x0a, 0xd3,
+	0xf9, 0x31, 0x9c, 0x8e, 0xcc, 0x72, 0x1f, 0x4d, 0xba, 0x65, 0xb3, 0xfa, 0x16, 0xb5, 0xa9, 0x13,
+	0x1e, 0x8b, 0x41, 0xa6, 0xe7, 0xba, 0x16, 0x36, 0x43, 0x03, 0x35, 0xa9, 0x4f, 0xde, 0x86, 0x49,
+	0xfe, 0x14, 0x89, 0x30, 0x4d, 0x0c, 0x17, 0xa6, 0x89, 0x6d, 0xc6, 0xf8, 0x50, 0xfd, 0xcb, 0x18,
+	0x94, 0x12, 0x69, 0x24, 0x17, 0xa0, 0x24, 0x5f, 0xca, 0x64, 0x2d, 0xc9, 0x37, 0x70, 0x15, 0x0b,
+	0xea, 0x32, 0x4c, 0x4a, 0xa1, 0x90, 0x87, 0xd7, 0xa6, 0x5e, 0xbe, 0x58, 0x98, 0xf8, 0x80, 0xcf,
+	0x6d, 0xac, 0xe9, 0x13, 0x62, 0x71, 0xc3, 0x24, 0x97, 0xc2, 0x67, 0xd7, 0xe7, 0x35, 0xca, 0x9d,
+	0x1d, 0x17, 0xf5, 0x2c, 0xb7, 0xd8, 0xc4, 0x49, 0xb2, 0x10, 0x56, 0xc8, 0xa1, 0xfc, 0x60, 0xb1,
+	0xd4, 0xa0, 0x74, 0x84, 0x74, 0x4c, 0x6f, 0xc5, 0xb3, 0xb0, 0x06, 0x33, 0xb2, 0x4e, 0xbb, 0x46,
+	0x86, 0x0a, 0x7c, 0x49, 0x28, 0x85, 0x56, 0x54, 0x86, 0x17, 0xf4, 0x8a, 0x61, 0xb4, 0xc3, 0xb0,
+	0x31, 0x36, 0x72, 0x6e, 0xfc, 0x2c, 0x7c, 0x48, 0x0f, 0xed, 0x83, 0xa5, 0x59, 0x87, 0xdc, 0x36,
+	0x63, 0xf1, 0x97, 0xa0, 0x47, 0xc2, 0x6f, 0x72, 0xf7, 0xfe, 0xf4, 0xcf, 0x85, 0xab, 0x0d, 0x2b,
+	0xd8, 0x69, 0x6f, 0x55, 0x0d, 0xb7, 0xa9, 0x61, 0x87, 0x28, 0xff, 0x2c, 0xfa, 0xe6, 0xae, 0xc6,
+	0x2f, 0x3e, 0x5f, 0x28, 0xf8, 0xba, 0x30, 0x3c, 0xba, 0x57, 0xf7, 0x77, 0x0a, 0xb6, 0x55, 0x0f,
+	0x79, 0xc3, 0x3b, 0x32, 0x6e, 0x93, 0x8c, 0xf4, 0xf8, 0xeb, 0x37, 0x5f, 0x21, 0xbc, 0x88, 0xa9,
+	0x88, 0x0e, 0xbd, 0x17, 0x53, 0x11, 0xe2, 0x21, 0x53, 0x91, 0x92, 0xa3, 0x8b, 0xd9, 0x97, 0x21,
+	0xa8, 0x55, 0xea, 0x98, 0xf6, 0xe8, 0x82, 0xf6, 0x1d, 0x98, 0xb4, 0x9c, 0x80, 0x79, 0x1d, 0x6a,
+	0x23, 0x29, 0x3c, 0x9b, 0x72, 0x4b, 0x6e, 0xb8, 0x81, 0x42, 0x7a, 0x57, 0x7c, 0x64, 0x14, 0xf1,
+	0x97, 0x0a, 0xcc, 0x26, 0x5d, 0xc3, 0x80, 0x7f, 0x0b, 0x26, 0x0c, 0x39, 0x85, 0x11, 0x3f, 0x91,
+	0x09, 0x2d, 0xbc, 0xc1, 0x50, 0x76, 0x74, 0x31, 0xbf, 0x8d, 0x4f, 0xe1, 0x2a, 0xbf, 0x54, 0xed,
+	0x15, 0xdb, 0x5e, 0xd9, 0x0e, 0x12, 0xe4, 0x22, 0x9b, 0xe8, 0xa8, 0x36, 0xde, 0x08, 0x69, 0x3d,
+	0x74, 0xeb, 0x03, 0x78, 0xc3, 0x10, 0x2b, 0x75, 0x6a, 0xdb, 0x75, 0xca, 0xd7, 0xf0, 0x5e, 0xc8,
+	0x08, 0x7d, 0xcc, 0x00, 0xfa, 0x39, 0x63, 0x24, 0x66, 0xd5, 0x1f, 0x20, 0x03, 0xea, 0x12, 0xc4,
+	0xfb, 0xd4, 0x0e, 0x46, 0xd5, 0x88, 0xda, 0x69, 0x7e, 0x2c, 0x8d, 0xa3, 0x27, 0x27, 0xa1, 0xb0,
+	0x43, 0x6d, 0xce, 0x5e, 0x15, 0xc1, 0x5e, 0x71, 0x44, 0x6e, 0x43, 0x8e, 0xff, 0xc2, 0xd8, 0xf7,
+	0x6c, 0xd2, 0xb9, 0xad, 0x90, 0x62, 0x72, 0x79, 0xf5, 0x13, 0x05, 0xca, 0x09, 0xf2, 0xb1, 0xf9,
+	0x84, 0xb6, 0x42, 0x57, 0x4e, 0x42, 0xc1, 0x67, 0x4e, 0xf8, 0xf9, 0xa6, 0xa8, 0xe3, 0x88, 0x93,
+	0x29, 0xcf, 0x6d, 0x07, 0x4c, 0xb0, 0xd9, 0xa2, 0x2e, 0x07, 0xe4, 0x34, 0x14, 0x65, 0xbf, 0x58,
+	0x47, 0xaa, 0x50, 0xd4, 0x27, 0xe5, 0xc4, 0x86, 0xc3, 0xa3, 0x82, 0x8b, 0x6e, 0x3b, 0x08, 0xf9,
+	0x9b, 0x9c, 0xf9, 0xb0, 0x1d, 0xa8, 0x5f, 0x28, 0x29, 0x52, 0x29, 0x61, 0xa0, 0xd3, 0x49, 0x22,
+	0xa3, 0xbc, 0x3e, 0x91, 0x19, 0x3b, 0x0a, 0x91, 0xb9, 0x09, 0xb9, 0x1d, 0xb7, 0xc5, 0x1b, 0x0b,
+	0x5e, 0x18, 0x27, 0xd3, 0x3c, 0xec, 0x09, 0x6d, 0xdd, 0x77, 0x5b, 0xdd, 0xe0, 0xba, 0x2d, 0x7f,
+	0xf9, 0x33, 0x02, 0x79, 0xe1, 0x15, 0xf1, 0xa1, 0x20, 0x3f, 0x27, 0x90, 0xf3, 0x29, 0xbd, 0xc3,
+	0x5f, 0xf5, 0x2a, 0x6a, 0x3f, 0x11, 0x19, 0x12, 0x55, 0xfd, 0xc9, 0x7f, 0xfe, 0x7a, 0x5d, 0xf9,
+	0xf1, 0x17, 0xff, 0xfe, 0xc5, 0xd8, 0x29, 0x72, 0x42, 0xcb, 0xfa, 0xe2, 0x49, 0x7e, 0x04, 0x79,
+	0x91, 0x78, 0x72, 0x2e, 0xcb, 0x60, 0x9c, 0xb6, 0x57, 0xce, 0xf7, 0x91, 0xc0, 0x1d, 0x97, 0xa2,
+	0x1d, 0x2f, 0x93, 0x8b, 0x5a, 0xc6, 0xe7, 0x57, 0x5f, 0xdb, 0x47, 0xbe, 0x72, 0xa0, 0xed, 0x5b,
+	0xe6, 0x01, 0x39, 0x80, 0x82, 0xec, 0x2b, 0x48, 0x6f, 0xfb, 0xfd, 0xbd, 0x4e, 0x76, 0xae, 0xea,
+	0x8d, 0x08, 0xc3, 0x79, 0xb2, 0x30, 0x00, 0x03, 0xf9, 0x44, 0x01, 0x88, 0x3e, 0x6b, 0x91, 0x4b,
+	0x3d, 0x37, 0x88, 0x7f, 0x59, 0xab, 0x5c, 0x1e, 0x24, 0x86, 0x58, 0xae, 0x44, 0x58, 0xce, 0x90,
+	0x4a, 0x16, 0x96, 0x45, 0xf1, 0xdd, 0x8c, 0xfc, 0x5a, 0x81, 0xe3, 0xa9, 0x8f, 0x4a, 0xe4, 0x7a,
+	0xdf, 0x4d, 0x92, 0xc7, 0xe1, 0xad, 0xa1, 0x64, 0x11, 0xd5, 0x62, 0x84, 0x4a, 0x25, 0xe7, 0x7a,
+	0xa2, 0x5a, 0xc4, 0x23, 0xf2, 0xf7, 0x38, 0x36, 0xcc, 0x55, 0x7f, 0x6c, 0xc9, 0xa4, 0xbd, 0x35,
+	0x94, 0x2c, 0x62, 0xdb, 0x88, 0xb0, 0xdd, 0x23, 0x77, 0x7a, 0x47, 0x4c, 0xdb, 0x8f, 0x2e, 0xd0,
+	0x03, 0x6d, 0x3f, 0x76, 0x5d, 0x1e, 0x60, 0x92, 0xc9, 0xdf, 0x14, 0x98, 0x49, 0xb6, 0xe7, 0xe4,
+	0x5a, 0x5f, 0x28, 0xf1, 0x8f, 0x0c, 0x95, 0xeb, 0xc3, 0x88, 0x22, 0xe8, 0xfb, 0x11, 0xe8, 0xbb,
+	0xe4, 0xbb, 0x47, 0x03, 0x6d, 0x0a, 0x80, 0xcf, 0x15, 0x38, 0xd5, 0xa3, 0xe5, 0x26, 0xcb, 0x59,
+	0x88, 0xfa, 0xb7, 0xfd, 0x95, 0x5b, 0x5f, 0x49, 0x07, 0xdd, 0x79, 0x2f, 0x72, 0xe7, 0x1d, 0x72,
+	0x37, 0xe5, 0x0e, 0xbe, 0xa6, 0xbe, 0xb6, 0x8f, 0xbf, 0x38, 0x74, 0xc7, 0x6d, 0xfa, 0xda, 0x7e,
+	0x22, 0xfc, 0x8b, 0xf2, 0xeb, 0xc2, 0x4f, 0x95, 0xa8, 0x6f, 0x91, 0x17, 0xcd, 0xd5, 0x2c, 0x48,
+	0x59, 0xdf, 0x09, 0x2a, 0xd7, 0x86, 0x90, 0x44, 0xc8, 0x97, 0x04, 0xda, 0x05, 0x72, 0x36, 0x85,
+	0xd6, 0x47, 0xe9, 0x45, 0x01, 0x8a, 0xfc, 0x4a, 0x81, 0xe3, 0x29, 0xa2, 0x9e, 0x7d, 0x94, 0xb3,
+	0xbb, 0x86, 0xec, 0xa3, 0xdc, 0x83, 0xf9, 0xf7, 0xbf, 0x88, 0x68, 0xa4, 0xb4, 0x28, 0x68, 0xfc,
+	0x67, 0x0a, 0xcc, 0x24, 0x89, 0x45, 0xf6, 0x69, 0xcd, 0x64, 0x3d, 0xd9, 0xa7, 0x35, 0x9b, 0xe8,
+	0xa8, 0xef, 0x44, 0xb8, 0xbe, 0x49, 0x96, 0x07, 0xa7, 0x57, 0x12, 0x9b, 0x45, 0x6a, 0xdb, 0x8b,
+	0x82, 0x15, 0x91, 0xdf, 0x28, 0x50, 0x90, 0x24, 0x3c, 0xfb, 0xce, 0x4e, 0xf4, 0x0f, 0xd9, 0x77,
+	0x76, 0x92, 0xc3, 0x8f, 0xa4, 0xea, 0x91, 0xda, 0xff, 0x5e, 0x81, 0x09, 0x64, 0xac, 0x44, 0xed,
+	0x11, 0x95, 0x18, 0x53, 0xaf, 0x5c, 0xe8, 0x2b, 0x33, 0x4c, 0x45, 0x0c, 0x89, 0x2f, 0xe4, 0xc1,
+	0x7f, 0x56, 0xa0, 0x94, 0xe0, 0x5a, 0xd9, 0x15, 0x91, 0xc5, 0x1b, 0x2b, 0xd7, 0x86, 0x90, 0x44,
+	0xc8, 0xef, 0x46, 0x90, 0xef, 0x90, 0xb7, 0x8f, 0x06, 0x99, 0xb3, 0x3f, 0xf2, 0xa9, 0x02, 0xd3,
+	0x71, 0xc6, 0x45, 0xae, 0xf4, 0x2b, 0xcb, 0x18, 0x35, 0xac, 0x5c, 0x1d, 0x2c, 0x88, 0x60, 0x2f,
+	0x0a, 0x9c, 0xf3, 0xe4, 0x4c, 0xaf, 0xf2, 0xf5, 0x9f, 0xd0, 0x56, 0xed, 0x7b, 0xcf, 0x5e, 0xce,
+	0x2b, 0x9f, 0xbf, 0x9c, 0x57, 0xfe, 0xf5, 0x72, 0x5e, 0xf9, 0xd9, 0xab, 0xf9, 0x63, 0x9f, 0xbf,
+	0x9a, 0x3f, 0xf6, 0xe5, 0xab, 0xf9, 0x63, 0xdf, 0x5f, 0x8a, 0xf5, 0xcc, 0xab, 0xc2, 0xc2, 0xba,
+	0xdb, 0x76, 0x4c, 0xd1, 0x2f, 0x84, 0x26, 0x3b, 0xb7, 0xb5, 0xa7, 0xc2, 0xae, 0x68, 0xa1, 0xb7,
+	0x0a, 0xe2, 0x7f, 0xa6, 0xb7, 0xfe, 0x1f, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x38, 0x32, 0x56, 0x4e,
+	0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/dex module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Order queries order by creator and ID.
+	Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error)
+	// Orders queries creator orders.
+	Orders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error)
+	// OrderBooks queries order books.
+	OrderBooks(ctx context.Context, in *QueryOrderBooksRequest, opts ...grpc.CallOption) (*QueryOrderBooksResponse, error)
+	// OrderBookParams queries order book params.
+	OrderBookParams(ctx context.Context, in *QueryOrderBookParamsRequest, opts ...grpc.CallOption) (*QueryOrderBookParamsResponse, error)
+	// OrderBookOrders queries order book orders.
+	OrderBookOrders(ctx context.Context, in *QueryOrderBookOrdersRequest, opts ...grpc.CallOption) (*QueryOrderBookOrdersResponse, error)
+	// OrderBookDepth queries order book orders aggregated by price levels.
+	OrderBookDepth(ctx context.Context, in *QueryOrderBookDepthRequest, opts ...grpc.CallOption) (*QueryOrderBookDepthResponse, error)
+	// AccountDenomOrdersCount queries account denom orders count.
+	AccountDenomOrdersCount(ctx context.Context, in *QueryAccountDenomOrdersCountRequest, opts ...grpc.CallOption) (*QueryAccountDenomOrdersCountResponse, error)
+	// SimulateOrder simulates the order placement and returns the order execution result without changing the state.
+	SimulateOrder(ctx context.Context, in *QuerySimulateOrderRequest, opts ...grpc.CallOption) (*QuerySimulateOrderResponse, error)
+	// AccumulatedFees queries the total amount of the fees charged by the DEX per denom.
+	AccumulatedFees(ctx context.Context, in *QueryAccumulatedFeesRequest, opts ...grpc.CallOption) (*QueryAccumulatedFeesResponse, error)
+	// CancelAllAfter queries the scheduled cancellation of the account orders.
+	CancelAllAfter(ctx context.Context, in *QueryCancelAllAfterRequest, opts ...grpc.CallOption) (*QueryCancelAllAfterResponse, error)
+	// Trades queries recent order book trades, the most recent first.
+	Trades(ctx context.Context, in *QueryTradesRequest, opts ...grpc.CallOption) (*QueryTradesResponse, error)
+	// Candles queries order book OHLCV candles.
+	Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error)
+	// OrderBookHalt queries the trading halt of the order book.
+	OrderBookHalt(ctx context.Context, in *QueryOrderBookHaltRequest, opts ...grpc.CallOption) (*QueryOrderBookHaltResponse, error)
+	// SimulateSwap simulates the swap through the route order books and returns the swap execution result without
+	// changing the state.
+	SimulateSwap(ctx context.Context, in *QuerySimulateSwapRequest, opts ...grpc.CallOption) (*QuerySimulateSwapResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.QuoteDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Order(ctx context.Context, in *QueryOrderRequest, opts ...grpc.CallOption) (*QueryOrderResponse, error) {
+	out := new(QueryOrderResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Order", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Side != 0 {
-		n += 1 + sovQuery(uint64(m.Side))
+	return out, nil
+}
+
+func (c *queryClient) Orders(ctx context.Context, in *QueryOrdersRequest, opts ...grpc.CallOption) (*QueryOrdersResponse, error) {
+	out := new(QueryOrdersResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Orders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) OrderBooks(ctx context.Context, in *QueryOrderBooksRequest, opts ...grpc.CallOption) (*QueryOrderBooksResponse, error) {
+	out := new(QueryOrderBooksResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBooks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryOrderBookOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) OrderBookParams(ctx context.Context, in *QueryOrderBookParamsRequest, opts ...grpc.CallOption) (*QueryOrderBookParamsResponse, error) {
+	out := new(QueryOrderBookParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBookParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) OrderBookOrders(ctx context.Context, in *QueryOrderBookOrdersRequest, opts ...grpc.CallOption) (*QueryOrderBookOrdersResponse, error) {
+	out := new(QueryOrderBookOrdersResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBookOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) OrderBookDepth(ctx context.Context, in *QueryOrderBookDepthRequest, opts ...grpc.CallOption) (*QueryOrderBookDepthResponse, error) {
+	out := new(QueryOrderBookDepthResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBookDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAccountDenomOrdersCountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AccountDenomOrdersCount(ctx context.Context, in *QueryAccountDenomOrdersCountRequest, opts ...grpc.CallOption) (*QueryAccountDenomOrdersCountResponse, error) {
+	out := new(QueryAccountDenomOrdersCountResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/AccountDenomOrdersCount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) SimulateOrder(ctx context.Context, in *QuerySimulateOrderRequest, opts ...grpc.CallOption) (*QuerySimulateOrderResponse, error) {
+	out := new(QuerySimulateOrderResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/SimulateOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) AccumulatedFees(ctx context.Context, in *QueryAccumulatedFeesRequest, opts ...grpc.CallOption) (*QueryAccumulatedFeesResponse, error) {
+	out := new(QueryAccumulatedFeesResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/AccumulatedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAccountDenomOrdersCountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CancelAllAfter(ctx context.Context, in *QueryCancelAllAfterRequest, opts ...grpc.CallOption) (*QueryCancelAllAfterResponse, error) {
+	out := new(QueryCancelAllAfterResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/CancelAllAfter", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Count != 0 {
-		n += 1 + sovQuery(uint64(m.Count))
+	return out, nil
+}
+
+func (c *queryClient) Trades(ctx context.Context, in *QueryTradesRequest, opts ...grpc.CallOption) (*QueryTradesResponse, error) {
+	out := new(QueryTradesResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Trades", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (c *queryClient) Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error) {
+	out := new(QueryCandlesResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/Candles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (c *queryClient) OrderBookHalt(ctx context.Context, in *QueryOrderBookHaltRequest, opts ...grpc.CallOption) (*QueryOrderBookHaltResponse, error) {
+	out := new(QueryOrderBookHaltResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/OrderBookHalt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
+
+func (c *queryClient) SimulateSwap(ctx context.Context, in *QuerySimulateSwapRequest, opts ...grpc.CallOption) (*QuerySimulateSwapResponse, error) {
+	out := new(QuerySimulateSwapResponse)
+	err := c.cc.Invoke(ctx, "/coreum.dex.v1.Query/SimulateSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/dex module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Order queries order by creator and ID.
+	Order(context.Context, *QueryOrderRequest) (*QueryOrderResponse, error)
+	// Orders queries creator orders.
+	Orders(context.Context, *QueryOrdersRequest) (*QueryOrdersResponse, error)
+	// OrderBooks queries order books.
+	OrderBooks(context.Context, *QueryOrderBooksRequest) (*QueryOrderBooksResponse, error)
+	// OrderBookParams queries order book params.
+	OrderBookParams(context.Context, *QueryOrderBookParamsRequest) (*QueryOrderBookParamsResponse, error)
+	// OrderBookOrders queries order book orders.
+	OrderBookOrders(context.Context, *QueryOrderBookOrdersRequest) (*QueryOrderBookOrdersResponse, error)
+	// OrderBookDepth queries order book orders aggregated by price levels.
+	OrderBookDepth(context.Context, *QueryOrderBookDepthRequest) (*QueryOrderBookDepthResponse, error)
+	// AccountDenomOrdersCount queries account denom orders count.
+	AccountDenomOrdersCount(context.Context, *QueryAccountDenomOrdersCountRequest) (*QueryAccountDenomOrdersCountResponse, error)
+	// SimulateOrder simulates the order placement and returns the order execution result without changing the state.
+	SimulateOrder(context.Context, *QuerySimulateOrderRequest) (*QuerySimulateOrderResponse, error)
+	// AccumulatedFees queries the total amount of the fees charged by the DEX per denom.
+	AccumulatedFees(context.Context, *QueryAccumulatedFeesRequest) (*QueryAccumulatedFeesResponse, error)
+	// CancelAllAfter queries the scheduled cancellation of the account orders.
+	CancelAllAfter(context.Context, *QueryCancelAllAfterRequest) (*QueryCancelAllAfterResponse, error)
+	// Trades queries recent order book trades, the most recent first.
+	Trades(context.Context, *QueryTradesRequest) (*QueryTradesResponse, error)
+	// Candles queries order book OHLCV candles.
+	Candles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error)
+	// OrderBookHalt queries the trading halt of the order book.
+	OrderBookHalt(context.Context, *QueryOrderBookHaltRequest) (*QueryOrderBookHaltResponse, error)
+	// SimulateSwap simulates the swap through the route order books and returns the swap execution result without
+	// changing the state.
+	SimulateSwap(context.Context, *QuerySimulateSwapRequest) (*QuerySimulateSwapResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Order(ctx context.Context, req *QueryOrderRequest) (*QueryOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Order not implemented")
+}
+func (*UnimplementedQueryServer) Orders(ctx context.Context, req *QueryOrdersRequest) (*QueryOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Orders not implemented")
+}
+func (*UnimplementedQueryServer) OrderBooks(ctx context.Context, req *QueryOrderBooksRequest) (*QueryOrderBooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBooks not implemented")
+}
+func (*UnimplementedQueryServer) OrderBookParams(ctx context.Context, req *QueryOrderBookParamsRequest) (*QueryOrderBookParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookParams not implemented")
+}
+func (*UnimplementedQueryServer) OrderBookOrders(ctx context.Context, req *QueryOrderBookOrdersRequest) (*QueryOrderBookOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookOrders not implemented")
+}
+func (*UnimplementedQueryServer) OrderBookDepth(ctx context.Context, req *QueryOrderBookDepthRequest) (*QueryOrderBookDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookDepth not implemented")
+}
+func (*UnimplementedQueryServer) AccountDenomOrdersCount(ctx context.Context, req *QueryAccountDenomOrdersCountRequest) (*QueryAccountDenomOrdersCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountDenomOrdersCount not implemented")
+}
+func (*UnimplementedQueryServer) SimulateOrder(ctx context.Context, req *QuerySimulateOrderRequest) (*QuerySimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+}
+func (*UnimplementedQueryServer) AccumulatedFees(ctx context.Context, req *QueryAccumulatedFeesRequest) (*QueryAccumulatedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccumulatedFees not implemented")
+}
+func (*UnimplementedQueryServer) CancelAllAfter(ctx context.Context, req *QueryCancelAllAfterRequest) (*QueryCancelAllAfterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllAfter not implemented")
+}
+func (*UnimplementedQueryServer) Trades(ctx context.Context, req *QueryTradesRequest) (*QueryTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Trades not implemented")
+}
+func (*UnimplementedQueryServer) Candles(ctx context.Context, req *QueryCandlesRequest) (*QueryCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Candles not implemented")
+}
+func (*UnimplementedQueryServer) OrderBookHalt(ctx context.Context, req *QueryOrderBookHaltRequest) (*QueryOrderBookHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBookHalt not implemented")
+}
+func (*UnimplementedQueryServer) SimulateSwap(ctx context.Context, req *QuerySimulateSwapRequest) (*QuerySimulateSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSwap not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Order_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Order(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/Order",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Order(ctx, req.(*QueryOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Orders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Orders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/Orders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Orders(ctx, req.(*QueryOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderBooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/OrderBooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBooks(ctx, req.(*QueryOrderBooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderBookParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBookParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBookParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/OrderBookParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBookParams(ctx, req.(*QueryOrderBookParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderBookOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBookOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBookOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/OrderBookOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBookOrders(ctx, req.(*QueryOrderBookOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderBookDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBookDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBookDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/OrderBookDepth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBookDepth(ctx, req.(*QueryOrderBookDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountDenomOrdersCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountDenomOrdersCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountDenomOrdersCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/AccountDenomOrdersCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountDenomOrdersCount(ctx, req.(*QueryAccountDenomOrdersCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/SimulateOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateOrder(ctx, req.(*QuerySimulateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccumulatedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccumulatedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccumulatedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/AccumulatedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccumulatedFees(ctx, req.(*QueryAccumulatedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CancelAllAfter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCancelAllAfterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CancelAllAfter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/CancelAllAfter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CancelAllAfter(ctx, req.(*QueryCancelAllAfterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Trades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Trades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/Trades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Trades(ctx, req.(*QueryTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Candles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Candles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/Candles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Candles(ctx, req.(*QueryCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderBookHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBookHaltRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBookHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/OrderBookHalt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBookHalt(ctx, req.(*QueryOrderBookHaltRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.dex.v1.Query/SimulateSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSwap(ctx, req.(*QuerySimulateSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.dex.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Order",
+			Handler:    _Query_Order_Handler,
+		},
+		{
+			MethodName: "Orders",
+			Handler:    _Query_Orders_Handler,
+		},
+		{
+			MethodName: "OrderBooks",
+			Handler:    _Query_OrderBooks_Handler,
+		},
+		{
+			MethodName: "OrderBookParams",
+			Handler:    _Query_OrderBookParams_Handler,
+		},
+		{
+			MethodName: "OrderBookOrders",
+			Handler:    _Query_OrderBookOrders_Handler,
+		},
+		{
+			MethodName: "OrderBookDepth",
+			Handler:    _Query_OrderBookDepth_Handler,
+		},
+		{
+			MethodName: "AccountDenomOrdersCount",
+			Handler:    _Query_AccountDenomOrdersCount_Handler,
+		},
+		{
+			MethodName: "SimulateOrder",
+			Handler:    _Query_SimulateOrder_Handler,
+		},
+		{
+			MethodName: "AccumulatedFees",
+			Handler:    _Query_AccumulatedFees_Handler,
+		},
+		{
+			MethodName: "CancelAllAfter",
+			Handler:    _Query_CancelAllAfter_Handler,
+		},
+		{
+			MethodName: "Trades",
+			Handler:    _Query_Trades_Handler,
+		},
+		{
+			MethodName: "Candles",
+			Handler:    _Query_Candles_Handler,
+		},
+		{
+			MethodName: "OrderBookHalt",
+			Handler:    _Query_OrderBookHalt_Handler,
+		},
+		{
+			MethodName: "SimulateSwap",
+			Handler:    _Query_SimulateSwap_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/dex/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBooksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBooksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBooksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBooksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBooksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBooksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderBooks) > 0 {
+		for iNdEx := len(m.OrderBooks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrderBooks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFeeRate.Size()
+		i -= size
+		if _, err := m.TakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MakerFeeRate.Size()
+		i -= size
+		if _, err := m.MakerFeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.QuoteDenomUnifiedRefAmount.Size()
+		i -= size
+		if _, err := m.QuoteDenomUnifiedRefAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BaseDenomUnifiedRefAmount.Size()
+		i -= size
+		if _, err := m.BaseDenomUnifiedRefAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.QuantityStep.Size()
+		i -= size
+		if _, err := m.QuantityStep.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.PriceTick.Size()
+		i -= size
+		if _, err := m.PriceTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Side != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookDepthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookDepthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookDepthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeInverted {
+		i--
+		if m.IncludeInverted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Levels != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Levels))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookDepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookDepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDenomOrdersCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDenomOrdersCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDenomOrdersCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountDenomOrdersCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountDenomOrdersCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountDenomOrdersCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeInForce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Side != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RemainingSpendableBalance.Size()
+		i -= size
+		if _, err := m.RemainingSpendableBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RemainingBaseQuantity.Size()
+		i -= size
+		if _, err := m.RemainingBaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.AveragePrice != nil {
+		{
+			size := m.AveragePrice.Size()
+			i -= size
+			if _, err := m.AveragePrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.ReceivedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.SpentCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulatedFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulatedFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulatedFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuoteQuantity.Size()
+		i -= size
+		if _, err := m.QuoteQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BaseQuantity.Size()
+		i -= size
+		if _, err := m.BaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Price != nil {
+		{
+			size := m.Price.Size()
+			i -= size
+			if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MakerSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MakerSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MakerID) > 0 {
+		i -= len(m.MakerID)
+		copy(dAtA[i:], m.MakerID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MakerID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MakerCreator) > 0 {
+		i -= len(m.MakerCreator)
+		copy(dAtA[i:], m.MakerCreator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MakerCreator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccumulatedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccumulatedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccumulatedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccumulatedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccumulatedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccumulatedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Interval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCancelAllAfterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCancelAllAfterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCancelAllAfterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCancelAllAfterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCancelAllAfterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCancelAllAfterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CancelAllAfter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookHaltRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookHaltRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookHaltRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookHaltResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookHaltResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookHaltResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Halt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmountOut) > 0 {
+		i -= len(m.AmountOut)
+		copy(dAtA[i:], m.AmountOut)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AmountIn) > 0 {
+		i -= len(m.AmountIn)
+		copy(dAtA[i:], m.AmountIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Route) > 0 {
+		for iNdEx := len(m.Route) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Route[iNdEx])
+			copy(dAtA[i:], m.Route[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Route[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.ReceivedCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.SpentCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBooksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBooksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.OrderBooks) > 0 {
+		for _, e := range m.OrderBooks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PriceTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QuantityStep.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BaseDenomUnifiedRefAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QuoteDenomUnifiedRefAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MakerFeeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TakerFeeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOrderBookOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovQuery(uint64(m.Side))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookDepthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Levels != 0 {
+		n += 1 + sovQuery(uint64(m.Levels))
+	}
+	if m.IncludeInverted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryOrderBookDepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountDenomOrdersCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountDenomOrdersCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *QuerySimulateOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovQuery(uint64(m.Side))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovQuery(uint64(m.TimeInForce))
+	}
+	return n
+}
+
+func (m *QuerySimulateOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.SpentCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReceivedCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AveragePrice != nil {
+		l = m.AveragePrice.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.RemainingBaseQuantity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingSpendableBalance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *SimulatedFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MakerCreator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MakerID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MakerSequence != 0 {
+		n += 1 + sovQuery(uint64(m.MakerSequence))
+	}
+	if m.Price != nil {
+		l = m.Price.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.BaseQuantity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.QuoteQuantity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAccumulatedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccumulatedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCandlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovQuery(uint64(m.Interval))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCandlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCancelAllAfterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCancelAllAfterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CancelAllAfter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOrderBookHaltRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderBookHaltResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Halted {
+		n += 2
+	}
+	l = m.Halt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateSwapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Route) > 0 {
+		for _, s := range m.Route {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.AmountIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountOut)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SpentCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ReceivedCoin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBooksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBooksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBooksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBooksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBooks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderBooks = append(m.OrderBooks, OrderBookData{})
+			if err := m.OrderBooks[len(m.OrderBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuantityStep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuantityStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenomUnifiedRefAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseDenomUnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenomUnifiedRefAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteDenomUnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookDepthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookDepthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookDepthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Levels", wireType)
+			}
+			m.Levels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Levels |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeInverted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeInverted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookDepthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookDepthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bids = append(m.Bids, PriceLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asks = append(m.Asks, PriceLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountDenomOrdersCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountDenomOrdersCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountDenomOrdersCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAccountDenomOrdersCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAccountDenomOrdersCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAccountDenomOrdersCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fills = append(m.Fills, SimulatedFill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpentCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AveragePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v Price
+			m.AveragePrice = &v
+			if err := m.AveragePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.RemainingBaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingSpendableBalance", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RemainingSpendableBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCoin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2056,7 +7152,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeeCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2081,7 +7177,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *SimulatedFill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2104,15 +7200,15 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SimulatedFill: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SimulatedFill: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerCreator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2140,11 +7236,11 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.MakerCreator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2172,63 +7268,102 @@ func (m *QueryOrderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.MakerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerSequence", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.MakerSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var v Price
+			m.Price = &v
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.BaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantity", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2238,22 +7373,23 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.QuoteQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2278,7 +7414,7 @@ func (m *QueryOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccumulatedFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2301,45 +7437,13 @@ func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccumulatedFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccumulatedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2396,7 +7500,7 @@ func (m *QueryOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccumulatedFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2419,15 +7523,15 @@ func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccumulatedFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccumulatedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2454,8 +7558,8 @@ func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2516,7 +7620,7 @@ func (m *QueryOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBooksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTradesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2539,13 +7643,77 @@ func (m *QueryOrderBooksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBooksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTradesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBooksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2602,7 +7770,7 @@ func (m *QueryOrderBooksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBooksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTradesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2625,15 +7793,15 @@ func (m *QueryOrderBooksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBooksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTradesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBooksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBooks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2660,8 +7828,8 @@ func (m *QueryOrderBooksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderBooks = append(m.OrderBooks, OrderBookData{})
-			if err := m.OrderBooks[len(m.OrderBooks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2722,7 +7890,7 @@ func (m *QueryOrderBooksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBookParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2745,10 +7913,10 @@ func (m *QueryOrderBookParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBookParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCandlesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBookParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2815,6 +7983,61 @@ func (m *QueryOrderBookParamsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= CandleInterval(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2836,7 +8059,7 @@ func (m *QueryOrderBookParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2859,17 +8082,17 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBookParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCandlesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBookParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2879,31 +8102,31 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuantityStep", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2913,29 +8136,81 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuantityStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCancelAllAfterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCancelAllAfterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCancelAllAfterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenomUnifiedRefAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2963,15 +8238,63 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseDenomUnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCancelAllAfterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCancelAllAfterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCancelAllAfterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenomUnifiedRefAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelAllAfter", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2981,23 +8304,22 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteDenomUnifiedRefAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CancelAllAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3022,7 +8344,7 @@ func (m *QueryOrderBookParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBookOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderBookHaltRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3045,10 +8367,10 @@ func (m *QueryOrderBookOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBookOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderBookHaltRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBookOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderBookHaltRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3115,61 +8437,6 @@ func (m *QueryOrderBookOrdersRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
-			}
-			m.Side = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Side |= Side(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3191,7 +8458,7 @@ func (m *QueryOrderBookOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOrderBookHaltResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3214,17 +8481,17 @@ func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderBookOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOrderBookHaltResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderBookOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOrderBookHaltResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3234,29 +8501,15 @@ func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Orders = append(m.Orders, Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Halted = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Halt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3283,10 +8536,7 @@ func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Halt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3311,7 +8561,7 @@ func (m *QueryOrderBookOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDenomOrdersCountRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSwapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3334,15 +8584,15 @@ func (m *QueryAccountDenomOrdersCountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDenomOrdersCountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateSwapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDenomOrdersCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateSwapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3370,11 +8620,11 @@ func (m *QueryAccountDenomOrdersCountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3402,7 +8652,71 @@ func (m *QueryAccountDenomOrdersCountRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Route = append(m.Route, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountOut = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3425,7 +8739,7 @@ func (m *QueryAccountDenomOrdersCountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountDenomOrdersCountResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulateSwapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3448,17 +8762,17 @@ func (m *QueryAccountDenomOrdersCountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountDenomOrdersCountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulateSwapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountDenomOrdersCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulateSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentCoin", wireType)
 			}
-			m.Count = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3468,11 +8782,92 @@ func (m *QueryAccountDenomOrdersCountResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpentCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceivedCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, SwapHop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
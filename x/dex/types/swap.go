@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxSwapRouteLength is the max number of denoms in the swap route.
+	MaxSwapRouteLength = 5
+	// SwapOrderIDPrefix is the prefix of the market order IDs used by the swap hops.
+	SwapOrderIDPrefix = "swap-hop-"
+)
+
+// BuildSwapOrderID returns the market order ID used by the swap hop.
+func BuildSwapOrderID(hopIndex int) string {
+	return fmt.Sprintf("%s%d", SwapOrderIDPrefix, hopIndex)
+}
+
+// ValidateSwapRoute validates the swap route denoms.
+func ValidateSwapRoute(route []string) error {
+	if len(route) < 2 {
+		return sdkerrors.Wrap(ErrInvalidInput, "swap route must contain at least two denoms")
+	}
+	if len(route) > MaxSwapRouteLength {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "swap route length %d exceeds the limit %d", len(route), MaxSwapRouteLength,
+		)
+	}
+
+	denoms := make(map[string]struct{}, len(route))
+	for _, denom := range route {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid swap route denom %s: %s", denom, err)
+		}
+		if _, ok := denoms[denom]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated swap route denom %s", denom)
+		}
+		denoms[denom] = struct{}{}
+	}
+
+	return nil
+}
+
+// NewSwapCoins returns the coins spent and received by the swap with the hops.
+func NewSwapCoins(hops []SwapHop) (sdk.Coin, sdk.Coin) {
+	if len(hops) == 0 {
+		return sdk.Coin{}, sdk.Coin{}
+	}
+
+	return hops[0].SpentCoin, hops[len(hops)-1].ReceivedCoin
+}
+
+func validateSwapAmount(name string, amount sdkmath.Int) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "%s must be positive", name)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+)
+
+const (
+	// MaxOrderBookTrades defines the maximum number of the recent trades kept per order book.
+	MaxOrderBookTrades = 1000
+	// MaxOrderBookCandles defines the maximum number of the recent candles kept per order book and interval.
+	MaxOrderBookCandles = 1000
+)
+
+// CandleIntervals is the list of the candle intervals the order book candles are kept for.
+var CandleIntervals = []CandleInterval{
+	CANDLE_INTERVAL_1M,
+	CANDLE_INTERVAL_5M,
+	CANDLE_INTERVAL_1H,
+	CANDLE_INTERVAL_1D,
+}
+
+// Validate validates the trade.
+func (t Trade) Validate() error {
+	if t.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "trade sequence must be greater than zero")
+	}
+	if err := validateTradeDenoms(t.BaseDenom, t.QuoteDenom); err != nil {
+		return err
+	}
+	if t.Price.Rat().Sign() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "trade price must be positive")
+	}
+	if t.BaseQuantity.IsNil() || !t.BaseQuantity.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "trade base quantity must be positive")
+	}
+	if t.QuoteQuantity.IsNil() || !t.QuoteQuantity.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "trade quote quantity must be positive")
+	}
+
+	return t.TakerSide.Validate()
+}
+
+// Validate validates the candle.
+func (c Candle) Validate() error {
+	if err := validateTradeDenoms(c.BaseDenom, c.QuoteDenom); err != nil {
+		return err
+	}
+	duration, err := c.Interval.Duration()
+	if err != nil {
+		return err
+	}
+	if !c.StartTime.Equal(c.StartTime.UTC().Truncate(duration)) {
+		return sdkerrors.Wrapf(ErrInvalidInput, "candle start time %s isn't aligned to the interval", c.StartTime)
+	}
+	for _, price := range []Price{c.Open, c.High, c.Low, c.Close} {
+		if price.Rat().Sign() <= 0 {
+			return sdkerrors.Wrap(ErrInvalidInput, "candle prices must be positive")
+		}
+	}
+	if c.BaseVolume.IsNil() || !c.BaseVolume.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "candle base volume must be positive")
+	}
+	if c.QuoteVolume.IsNil() || !c.QuoteVolume.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "candle quote volume must be positive")
+	}
+	if c.TradesCount == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "candle trades count must be greater than zero")
+	}
+
+	return nil
+}
+
+func validateTradeDenoms(baseDenom, quoteDenom string) error {
+	if baseDenom == "" || quoteDenom == "" {
+		return sdkerrors.Wrap(ErrInvalidInput, "base and quote denoms must be set")
+	}
+	if baseDenom == quoteDenom {
+		return sdkerrors.Wrap(ErrInvalidInput, "base and quote denoms must be different")
+	}
+
+	return nil
+}
+
+// Validate validates candle interval.
+func (i CandleInterval) Validate() error {
+	_, err := i.Duration()
+	return err
+}
+
+// Duration returns the candle interval duration.
+func (i CandleInterval) Duration() (time.Duration, error) {
+	switch i {
+	case CANDLE_INTERVAL_1M:
+		return time.Minute, nil
+	case CANDLE_INTERVAL_5M:
+		return 5 * time.Minute, nil
+	case CANDLE_INTERVAL_1H:
+		return time.Hour, nil
+	case CANDLE_INTERVAL_1D:
+		return 24 * time.Hour, nil
+	default:
+		return 0, sdkerrors.Wrapf(ErrInvalidInput, "invalid candle interval: %s", i)
+	}
+}
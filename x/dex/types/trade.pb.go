@@ -0,0 +1,1310 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/dex/v1/trade.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CandleInterval is the OHLCV candle interval.
+type CandleInterval int32
+
+const (
+	// unspecified reserves the default value, to protect against unexpected settings.
+	CANDLE_INTERVAL_UNSPECIFIED CandleInterval = 0
+	// 1m is one minute candle interval.
+	CANDLE_INTERVAL_1M CandleInterval = 1
+	// 5m is five minutes candle interval.
+	CANDLE_INTERVAL_5M CandleInterval = 2
+	// 1h is one hour candle interval.
+	CANDLE_INTERVAL_1H CandleInterval = 3
+	// 1d is one day candle interval.
+	CANDLE_INTERVAL_1D CandleInterval = 4
+)
+
+var CandleInterval_name = map[int32]string{
+	0: "CANDLE_INTERVAL_UNSPECIFIED",
+	1: "CANDLE_INTERVAL_1M",
+	2: "CANDLE_INTERVAL_5M",
+	3: "CANDLE_INTERVAL_1H",
+	4: "CANDLE_INTERVAL_1D",
+}
+
+var CandleInterval_value = map[string]int32{
+	"CANDLE_INTERVAL_UNSPECIFIED": 0,
+	"CANDLE_INTERVAL_1M":          1,
+	"CANDLE_INTERVAL_5M":          2,
+	"CANDLE_INTERVAL_1H":          3,
+	"CANDLE_INTERVAL_1D":          4,
+}
+
+func (x CandleInterval) String() string {
+	return proto.EnumName(CandleInterval_name, int32(x))
+}
+
+func (CandleInterval) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5ff122d7a0bd3f4f, []int{0}
+}
+
+// Trade is a single match of the taker and maker orders executed in the order book.
+type Trade struct {
+	// sequence is the trade sequence inside the order book.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,3,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// price is the trade price, the price of the maker order.
+	Price Price `protobuf:"bytes,4,opt,name=price,proto3,customtype=Price" json:"price"`
+	// base_quantity is the traded amount of the base denom.
+	BaseQuantity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=base_quantity,json=baseQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"base_quantity"`
+	// quote_quantity is the traded amount of the quote denom.
+	QuoteQuantity cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=quote_quantity,json=quoteQuantity,proto3,customtype=cosmossdk.io/math.Int" json:"quote_quantity"`
+	// taker_side is the side of the taker order in the order book.
+	TakerSide Side `protobuf:"varint,7,opt,name=taker_side,json=takerSide,proto3,enum=coreum.dex.v1.Side" json:"taker_side,omitempty"`
+	// height is the block height of the trade.
+	Height int64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the trade.
+	Time time.Time `protobuf:"bytes,9,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff122d7a0bd3f4f, []int{0}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+// Candle is the OHLCV candle of the order book trades.
+type Candle struct {
+	// base_denom is the order book base denom.
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// quote_denom is the order book quote denom.
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// interval is the candle interval.
+	Interval CandleInterval `protobuf:"varint,3,opt,name=interval,proto3,enum=coreum.dex.v1.CandleInterval" json:"interval,omitempty"`
+	// start_time is the candle interval start time.
+	StartTime time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// open is the price of the first trade in the interval.
+	Open Price `protobuf:"bytes,5,opt,name=open,proto3,customtype=Price" json:"open"`
+	// high is the highest trade price in the interval.
+	High Price `protobuf:"bytes,6,opt,name=high,proto3,customtype=Price" json:"high"`
+	// low is the lowest trade price in the interval.
+	Low Price `protobuf:"bytes,7,opt,name=low,proto3,customtype=Price" json:"low"`
+	// close is the price of the last trade in the interval.
+	Close Price `protobuf:"bytes,8,opt,name=close,proto3,customtype=Price" json:"close"`
+	// base_volume is the traded amount of the base denom in the interval.
+	BaseVolume cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=base_volume,json=baseVolume,proto3,customtype=cosmossdk.io/math.Int" json:"base_volume"`
+	// quote_volume is the traded amount of the quote denom in the interval.
+	QuoteVolume cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=quote_volume,json=quoteVolume,proto3,customtype=cosmossdk.io/math.Int" json:"quote_volume"`
+	// trades_count is the number of trades in the interval.
+	TradesCount uint64 `protobuf:"varint,11,opt,name=trades_count,json=tradesCount,proto3" json:"trades_count,omitempty"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ff122d7a0bd3f4f, []int{1}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("coreum.dex.v1.CandleInterval", CandleInterval_name, CandleInterval_value)
+	proto.RegisterType((*Trade)(nil), "coreum.dex.v1.Trade")
+	proto.RegisterType((*Candle)(nil), "coreum.dex.v1.Candle")
+}
+
+func init() { proto.RegisterFile("coreum/dex/v1/trade.proto", fileDescriptor_5ff122d7a0bd3f4f) }
+
+var fileDescriptor_5ff122d7a0bd3f4f = []byte{
+	// 657 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
+	0x18, 0xf5, 0x35, 0x4e, 0x48, 0xbe, 0x34, 0x51, 0x74, 0x40, 0x65, 0x82, 0x6a, 0xa7, 0x65, 0x89,
+	0x18, 0x6c, 0x25, 0x08, 0x04, 0x0b, 0xa2, 0xf9, 0x51, 0x11, 0xa9, 0x2d, 0xc5, 0x2d, 0x1d, 0x58,
+	0x22, 0xc7, 0x3e, 0x1c, 0xab, 0xb1, 0x2f, 0xb5, 0xcf, 0xa1, 0xfd, 0x0f, 0x18, 0xbb, 0xc1, 0xce,
+	0x3f, 0xd3, 0xb1, 0x23, 0x62, 0x28, 0xd0, 0xfe, 0x15, 0x6c, 0xe8, 0xce, 0x6e, 0xa4, 0xba, 0x95,
+	0x5a, 0xb6, 0xbb, 0xf7, 0xde, 0xf7, 0x9d, 0xef, 0xbd, 0xcf, 0x07, 0x8f, 0x6c, 0x1a, 0x92, 0xd8,
+	0x37, 0x1c, 0x72, 0x68, 0xcc, 0x5a, 0x06, 0x0b, 0x2d, 0x87, 0xe8, 0xd3, 0x90, 0x32, 0x8a, 0x2b,
+	0x09, 0xa5, 0x3b, 0xe4, 0x50, 0x9f, 0xb5, 0xea, 0x19, 0x25, 0x0d, 0x1d, 0x12, 0x26, 0xca, 0xfa,
+	0x03, 0x97, 0xba, 0x54, 0x2c, 0x0d, 0xbe, 0x4a, 0x51, 0xcd, 0xa5, 0xd4, 0x9d, 0x10, 0x43, 0xec,
+	0x46, 0xf1, 0x27, 0x83, 0x79, 0x3e, 0x89, 0x98, 0xe5, 0x4f, 0x13, 0xc1, 0xea, 0xb7, 0x1c, 0xe4,
+	0x77, 0xf9, 0x81, 0xb8, 0x0e, 0xc5, 0x88, 0x1c, 0xc4, 0x24, 0xb0, 0x89, 0x82, 0x1a, 0xa8, 0x29,
+	0x9b, 0xf3, 0x3d, 0x5e, 0x06, 0x18, 0x59, 0x11, 0x19, 0x3a, 0x24, 0xa0, 0xbe, 0xb2, 0xd0, 0x40,
+	0xcd, 0x92, 0x59, 0xe2, 0x48, 0x8f, 0x03, 0x58, 0x83, 0xf2, 0x41, 0x4c, 0xd9, 0x25, 0x9f, 0x13,
+	0x3c, 0x08, 0x28, 0x11, 0x3c, 0x81, 0xfc, 0x34, 0xf4, 0x6c, 0xa2, 0xc8, 0x9c, 0xea, 0x54, 0x4e,
+	0xce, 0x34, 0xe9, 0xe7, 0x99, 0x96, 0xdf, 0xe6, 0xa0, 0x99, 0x70, 0xb8, 0x03, 0x15, 0x71, 0xc8,
+	0x41, 0x6c, 0x05, 0xcc, 0x63, 0x47, 0x4a, 0x5e, 0x88, 0x97, 0x53, 0xf1, 0x43, 0x9b, 0x46, 0x3e,
+	0x8d, 0x22, 0x67, 0x5f, 0xf7, 0xa8, 0xe1, 0x5b, 0x6c, 0xac, 0x0f, 0x02, 0x66, 0x2e, 0xf2, 0x9a,
+	0xf7, 0x69, 0x09, 0xee, 0x41, 0x35, 0xf9, 0x92, 0x79, 0x93, 0xc2, 0x5d, 0x9a, 0x54, 0x44, 0xd1,
+	0xbc, 0x4b, 0x1b, 0x80, 0x59, 0xfb, 0x24, 0x1c, 0x46, 0x9e, 0x43, 0x94, 0x7b, 0x0d, 0xd4, 0xac,
+	0xb6, 0xef, 0xeb, 0x57, 0xa2, 0xd0, 0x77, 0x3c, 0x87, 0x98, 0x25, 0x21, 0xe3, 0x4b, 0xbc, 0x04,
+	0x85, 0x31, 0xf1, 0xdc, 0x31, 0x53, 0x8a, 0x0d, 0xd4, 0xcc, 0x99, 0xe9, 0x0e, 0xbf, 0x04, 0x99,
+	0x7b, 0xae, 0x94, 0x1a, 0xa8, 0x59, 0x6e, 0xd7, 0xf5, 0x24, 0x10, 0xfd, 0x32, 0x10, 0x7d, 0xf7,
+	0x32, 0x90, 0x4e, 0x91, 0x7f, 0xe3, 0xf1, 0x2f, 0x0d, 0x99, 0xa2, 0x62, 0xf5, 0x6f, 0x0e, 0x0a,
+	0x5d, 0x2b, 0x70, 0x26, 0x59, 0xff, 0xd1, 0x2d, 0xfe, 0x2f, 0x5c, 0xf3, 0xff, 0x15, 0x14, 0xbd,
+	0x80, 0x91, 0x70, 0x66, 0x4d, 0x44, 0x3a, 0xd5, 0xf6, 0x72, 0xe6, 0x3a, 0xc9, 0x41, 0x83, 0x54,
+	0x64, 0xce, 0xe5, 0xb8, 0x0b, 0x10, 0x31, 0x2b, 0x64, 0x43, 0x71, 0x0b, 0xf9, 0x3f, 0x6e, 0x51,
+	0x12, 0x75, 0x9c, 0xc1, 0x2b, 0x20, 0xd3, 0x29, 0x09, 0xd2, 0x44, 0x33, 0xf1, 0x0b, 0x8a, 0x4b,
+	0xc6, 0x9e, 0x3b, 0x4e, 0xf3, 0xca, 0x4a, 0x38, 0x85, 0x35, 0xc8, 0x4d, 0xe8, 0x67, 0x91, 0xc7,
+	0x35, 0x05, 0x67, 0xf8, 0x98, 0xd9, 0x13, 0x1a, 0x11, 0x11, 0xc1, 0xf5, 0x31, 0x13, 0x1c, 0x7e,
+	0x0d, 0x65, 0xe1, 0xe5, 0x8c, 0x4e, 0xe2, 0x34, 0x97, 0x5b, 0xe7, 0x43, 0xb8, 0xbf, 0x27, 0x0a,
+	0xf0, 0x1b, 0x58, 0x4c, 0xcc, 0x4e, 0x1b, 0xc0, 0x5d, 0x1a, 0x24, 0xf9, 0xa4, 0x1d, 0x56, 0x60,
+	0x51, 0xfc, 0xe3, 0xd1, 0xd0, 0xa6, 0x71, 0xc0, 0x94, 0xb2, 0xf8, 0xdb, 0xca, 0x09, 0xd6, 0xe5,
+	0xd0, 0xd3, 0xaf, 0x08, 0xaa, 0x57, 0x23, 0xc1, 0x1a, 0x3c, 0xee, 0xae, 0x6d, 0xf5, 0x36, 0xfa,
+	0xc3, 0xc1, 0xd6, 0x6e, 0xdf, 0xdc, 0x5b, 0xdb, 0x18, 0x7e, 0xd8, 0xda, 0xd9, 0xee, 0x77, 0x07,
+	0xeb, 0x83, 0x7e, 0xaf, 0x26, 0xe1, 0x25, 0xc0, 0x59, 0x41, 0x6b, 0xb3, 0x86, 0x6e, 0xc2, 0x9f,
+	0x6f, 0xd6, 0x16, 0x6e, 0xd4, 0xbf, 0xad, 0xe5, 0x6e, 0xc4, 0x7b, 0x35, 0xb9, 0x2e, 0x7f, 0xf9,
+	0xae, 0x4a, 0x9d, 0x77, 0x27, 0x7f, 0x54, 0xe9, 0xe4, 0x5c, 0x45, 0xa7, 0xe7, 0x2a, 0xfa, 0x7d,
+	0xae, 0xa2, 0xe3, 0x0b, 0x55, 0x3a, 0xbd, 0x50, 0xa5, 0x1f, 0x17, 0xaa, 0xf4, 0xb1, 0xe5, 0x7a,
+	0x6c, 0x1c, 0x8f, 0x74, 0x9b, 0xfa, 0x46, 0x57, 0x0c, 0xd8, 0x3a, 0x8d, 0x03, 0xc7, 0x62, 0x1e,
+	0x0d, 0x8c, 0xf4, 0xf1, 0x9a, 0xbd, 0x30, 0x0e, 0xc5, 0x0b, 0xc6, 0x8e, 0xa6, 0x24, 0x1a, 0x15,
+	0xc4, 0x10, 0x3d, 0xfb, 0x17, 0x00, 0x00, 0xff, 0xff, 0x21, 0xf6, 0xa4, 0x86, 0x06, 0x05, 0x00,
+	0x00,
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTrade(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if m.Height != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TakerSide != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.TakerSide))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.QuoteQuantity.Size()
+		i -= size
+		if _, err := m.QuoteQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BaseQuantity.Size()
+		i -= size
+		if _, err := m.BaseQuantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradesCount != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.TradesCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.QuoteVolume.Size()
+		i -= size
+		if _, err := m.QuoteVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BaseVolume.Size()
+		i -= size
+		if _, err := m.BaseVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrade(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTrade(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if m.Interval != 0 {
+		i = encodeVarintTrade(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTrade(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTrade(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTrade(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTrade(uint64(m.Sequence))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.BaseQuantity.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.QuoteQuantity.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	if m.TakerSide != 0 {
+		n += 1 + sovTrade(uint64(m.TakerSide))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTrade(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTrade(uint64(l))
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovTrade(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTrade(uint64(m.Interval))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.BaseVolume.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	l = m.QuoteVolume.Size()
+	n += 1 + l + sovTrade(uint64(l))
+	if m.TradesCount != 0 {
+		n += 1 + sovTrade(uint64(m.TradesCount))
+	}
+	return n
+}
+
+func sovTrade(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTrade(x uint64) (n int) {
+	return sovTrade(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteQuantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerSide", wireType)
+			}
+			m.TakerSide = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerSide |= Side(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= CandleInterval(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradesCount", wireType)
+			}
+			m.TradesCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradesCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTrade(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTrade
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrade
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTrade
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTrade
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTrade
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTrade        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTrade          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTrade = fmt.Errorf("proto: unexpected end of group")
+)
@@ -13,6 +13,7 @@ import (
 
 	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/nft/types"
+	dextypes "github.com/CoreumFoundation/coreum/v6/x/dex/types"
 	"github.com/CoreumFoundation/coreum/v6/x/wasm/types"
 )
 
@@ -20,15 +21,26 @@ import (
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetFTMsg struct {
-	Issue               *assetfttypes.MsgIssue               `json:"Issue"`
-	Mint                *assetfttypes.MsgMint                `json:"Mint"`
-	Burn                *assetfttypes.MsgBurn                `json:"Burn"`
-	Freeze              *assetfttypes.MsgFreeze              `json:"Freeze"`
-	Unfreeze            *assetfttypes.MsgUnfreeze            `json:"Unfreeze"`
-	SetFrozen           *assetfttypes.MsgSetFrozen           `json:"SetFrozen"`
-	GloballyFreeze      *assetfttypes.MsgGloballyFreeze      `json:"GloballyFreeze"`
-	GloballyUnfreeze    *assetfttypes.MsgGloballyUnfreeze    `json:"GloballyUnfreeze"`
-	SetWhitelistedLimit *assetfttypes.MsgSetWhitelistedLimit `json:"SetWhitelistedLimit"`
+	Issue                      *assetfttypes.MsgIssue                      `json:"Issue"`
+	Mint                       *assetfttypes.MsgMint                       `json:"Mint"`
+	Burn                       *assetfttypes.MsgBurn                       `json:"Burn"`
+	Freeze                     *assetfttypes.MsgFreeze                     `json:"Freeze"`
+	Unfreeze                   *assetfttypes.MsgUnfreeze                   `json:"Unfreeze"`
+	SetFrozen                  *assetfttypes.MsgSetFrozen                  `json:"SetFrozen"`
+	GloballyFreeze             *assetfttypes.MsgGloballyFreeze             `json:"GloballyFreeze"`
+	GloballyUnfreeze           *assetfttypes.MsgGloballyUnfreeze           `json:"GloballyUnfreeze"`
+	Clawback                   *assetfttypes.MsgClawback                   `json:"Clawback"`
+	SetWhitelistedLimit        *assetfttypes.MsgSetWhitelistedLimit        `json:"SetWhitelistedLimit"`
+	SetVelocityLimitOverride   *assetfttypes.MsgSetVelocityLimitOverride   `json:"SetVelocityLimitOverride"`
+	AddToDenylist              *assetfttypes.MsgAddToDenylist              `json:"AddToDenylist"`
+	RemoveFromDenylist         *assetfttypes.MsgRemoveFromDenylist         `json:"RemoveFromDenylist"`
+	TransferAdmin              *assetfttypes.MsgTransferAdmin              `json:"TransferAdmin"`
+	ClearAdmin                 *assetfttypes.MsgClearAdmin                 `json:"ClearAdmin"`
+	GrantRole                  *assetfttypes.MsgGrantRole                  `json:"GrantRole"`
+	RevokeRole                 *assetfttypes.MsgRevokeRole                 `json:"RevokeRole"`
+	UpdateMaxSupply            *assetfttypes.MsgUpdateMaxSupply            `json:"UpdateMaxSupply"`
+	UpdateDEXUnifiedRefAmount  *assetfttypes.MsgUpdateDEXUnifiedRefAmount  `json:"UpdateDEXUnifiedRefAmount"`
+	UpdateDEXWhitelistedDenoms *assetfttypes.MsgUpdateDEXWhitelistedDenoms `json:"UpdateDEXWhitelistedDenoms"`
 }
 
 // assetNFTMsgIssueClass defines message for the IssueClass method with string represented data field.
@@ -81,6 +93,15 @@ type nftMsg struct {
 	Send *nfttypes.MsgSend `json:"Send"`
 }
 
+// dexMsg represents dex module messages integrated with the wasm handler.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type dexMsg struct {
+	PlaceOrder          *dextypes.MsgPlaceOrder          `json:"PlaceOrder"`
+	CancelOrder         *dextypes.MsgCancelOrder         `json:"CancelOrder"`
+	CancelOrdersByDenom *dextypes.MsgCancelOrdersByDenom `json:"CancelOrdersByDenom"`
+}
+
 // coreumMsg represents all supported custom messages integrated with the wasm handler.
 //
 //nolint:tagliatelle // we keep the name same as consume
@@ -88,6 +109,7 @@ type coreumMsg struct {
 	AssetFT  *assetFTMsg  `json:"AssetFT"`
 	AssetNFT *assetNFTMsg `json:"AssetNFT"`
 	NFT      *nftMsg      `json:"nft"`
+	DEX      *dexMsg      `json:"DEX"`
 }
 
 // NewCoreumMsgHandler returns coreum handler that handles messages received from smart contracts.
@@ -131,6 +153,9 @@ func decodeCoreumMessage(coreumMessages coreumMsg, sender sdk.AccAddress) (sdk.M
 	if coreumMessages.NFT != nil {
 		return decodeNFTMessage(coreumMessages.NFT, sender.String())
 	}
+	if coreumMessages.DEX != nil {
+		return decodeDEXMessage(coreumMessages.DEX, sender.String())
+	}
 
 	//nolint:nilnil // we are ok with this.
 	return nil, nil
@@ -169,10 +194,54 @@ func decodeAssetFTMessage(assetFTMsg *assetFTMsg, sender string) (sdk.Msg, error
 		assetFTMsg.GloballyUnfreeze.Sender = sender
 		return assetFTMsg.GloballyUnfreeze, nil
 	}
+	if assetFTMsg.Clawback != nil {
+		assetFTMsg.Clawback.Sender = sender
+		return assetFTMsg.Clawback, nil
+	}
 	if assetFTMsg.SetWhitelistedLimit != nil {
 		assetFTMsg.SetWhitelistedLimit.Sender = sender
 		return assetFTMsg.SetWhitelistedLimit, nil
 	}
+	if assetFTMsg.SetVelocityLimitOverride != nil {
+		assetFTMsg.SetVelocityLimitOverride.Sender = sender
+		return assetFTMsg.SetVelocityLimitOverride, nil
+	}
+	if assetFTMsg.AddToDenylist != nil {
+		assetFTMsg.AddToDenylist.Sender = sender
+		return assetFTMsg.AddToDenylist, nil
+	}
+	if assetFTMsg.RemoveFromDenylist != nil {
+		assetFTMsg.RemoveFromDenylist.Sender = sender
+		return assetFTMsg.RemoveFromDenylist, nil
+	}
+	if assetFTMsg.TransferAdmin != nil {
+		assetFTMsg.TransferAdmin.Sender = sender
+		return assetFTMsg.TransferAdmin, nil
+	}
+	if assetFTMsg.ClearAdmin != nil {
+		assetFTMsg.ClearAdmin.Sender = sender
+		return assetFTMsg.ClearAdmin, nil
+	}
+	if assetFTMsg.GrantRole != nil {
+		assetFTMsg.GrantRole.Sender = sender
+		return assetFTMsg.GrantRole, nil
+	}
+	if assetFTMsg.RevokeRole != nil {
+		assetFTMsg.RevokeRole.Sender = sender
+		return assetFTMsg.RevokeRole, nil
+	}
+	if assetFTMsg.UpdateMaxSupply != nil {
+		assetFTMsg.UpdateMaxSupply.Sender = sender
+		return assetFTMsg.UpdateMaxSupply, nil
+	}
+	if assetFTMsg.UpdateDEXUnifiedRefAmount != nil {
+		assetFTMsg.UpdateDEXUnifiedRefAmount.Sender = sender
+		return assetFTMsg.UpdateDEXUnifiedRefAmount, nil
+	}
+	if assetFTMsg.UpdateDEXWhitelistedDenoms != nil {
+		assetFTMsg.UpdateDEXWhitelistedDenoms.Sender = sender
+		return assetFTMsg.UpdateDEXWhitelistedDenoms, nil
+	}
 
 	//nolint:nilnil // we are ok with this.
 	return nil, nil
@@ -274,6 +343,24 @@ func decodeNFTMessage(nftMsg *nftMsg, sender string) (sdk.Msg, error) {
 	return nil, nil
 }
 
+func decodeDEXMessage(dexMsg *dexMsg, sender string) (sdk.Msg, error) {
+	if dexMsg.PlaceOrder != nil {
+		dexMsg.PlaceOrder.Sender = sender
+		return dexMsg.PlaceOrder, nil
+	}
+	if dexMsg.CancelOrder != nil {
+		dexMsg.CancelOrder.Sender = sender
+		return dexMsg.CancelOrder, nil
+	}
+	if dexMsg.CancelOrdersByDenom != nil {
+		dexMsg.CancelOrdersByDenom.Sender = sender
+		return dexMsg.CancelOrdersByDenom, nil
+	}
+
+	//nolint:nilnil // we are ok with this.
+	return nil, nil
+}
+
 var _ wasmkeeper.Messenger = &MessengerWrapper{}
 
 // MessengerWrapper wraps WASM messenger and sets information about smart contract.
@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"reflect"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	assetfttypes "github.com/CoreumFoundation/coreum/v6/x/asset/ft/types"
+)
+
+// TestAssetFTMsgParity fails if a message of the asset ft Msg service can't be sent by the smart contract.
+func TestAssetFTMsgParity(t *testing.T) {
+	// the messages which are executed by the governance only
+	excludedMsgs := map[string]struct{}{
+		gogoproto.MessageName(&assetfttypes.MsgUpdateParams{}): {},
+	}
+
+	desc, err := gogoproto.HybridResolver.FindDescriptorByName("coreum.asset.ft.v1.Msg")
+	require.NoError(t, err)
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	require.True(t, ok)
+
+	boundMsgs := make(map[string]string)
+	msgType := reflect.TypeOf(assetFTMsg{})
+	for i := range msgType.NumField() {
+		field := msgType.Field(i)
+		msg, ok := reflect.New(field.Type.Elem()).Interface().(gogoproto.Message)
+		require.True(t, ok, field.Name)
+		boundMsgs[gogoproto.MessageName(msg)] = field.Tag.Get("json")
+	}
+
+	methods := serviceDesc.Methods()
+	require.Positive(t, methods.Len())
+	for i := range methods.Len() {
+		method := methods.Get(i)
+		msgName := string(method.Input().FullName())
+		if _, ok := excludedMsgs[msgName]; ok {
+			continue
+		}
+		jsonName, ok := boundMsgs[msgName]
+		require.Truef(t, ok, "message %s has no wasm binding", msgName)
+		require.Equal(t, string(method.Name()), jsonName, msgName)
+	}
+}
+
+func TestDecodeAssetFTMessageSetsSender(t *testing.T) {
+	const sender = "sender"
+
+	msgType := reflect.TypeOf(assetFTMsg{})
+	for i := range msgType.NumField() {
+		field := msgType.Field(i)
+		var ftMsg assetFTMsg
+		reflect.ValueOf(&ftMsg).Elem().Field(i).Set(reflect.New(field.Type.Elem()))
+
+		decodedMsg, err := decodeAssetFTMessage(&ftMsg, sender)
+		require.NoError(t, err)
+		require.NotNil(t, decodedMsg, field.Name)
+		require.IsType(t, reflect.New(field.Type.Elem()).Interface(), decodedMsg)
+
+		signerField := reflect.ValueOf(decodedMsg).Elem().FieldByName("Sender")
+		if !signerField.IsValid() {
+			signerField = reflect.ValueOf(decodedMsg).Elem().FieldByName("Issuer")
+		}
+		require.Equal(t, sender, signerField.String(), field.Name)
+	}
+}
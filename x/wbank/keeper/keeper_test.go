@@ -61,7 +61,7 @@ func TestBaseKeeperWrapper_SpendableBalances(t *testing.T) {
 	denom := denoms[5]
 	// freeze tokens
 	coinToFreeze := sdk.NewCoin(denom, sdkmath.NewInt(10))
-	err = ftKeeper.Freeze(ctx, issuer, recipient, coinToFreeze)
+	err = ftKeeper.Freeze(ctx, issuer, recipient, coinToFreeze, nil)
 	requireT.NoError(err)
 
 	// check that after the freezing the spendable balance is different
@@ -75,7 +75,7 @@ func TestBaseKeeperWrapper_SpendableBalances(t *testing.T) {
 	)
 
 	// check with global freeze
-	err = ftKeeper.GloballyFreeze(ctx, issuer, denom)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, denom, nil)
 	requireT.NoError(err)
 	spendableBalancesRes, err = bankKeeper.SpendableBalances(ctx, &banktypes.QuerySpendableBalancesRequest{
 		Address: recipient.String(),
@@ -131,7 +131,7 @@ func TestBaseKeeperWrapper_SpendableBalanceByDenom(t *testing.T) {
 
 	// freeze tokens
 	coinToFreeze := sdk.NewCoin(denom, sdkmath.NewInt(10))
-	err = ftKeeper.Freeze(ctx, issuer, recipient, coinToFreeze)
+	err = ftKeeper.Freeze(ctx, issuer, recipient, coinToFreeze, nil)
 	requireT.NoError(err)
 
 	// check that after the freezing the balance is the same
@@ -158,7 +158,7 @@ func TestBaseKeeperWrapper_SpendableBalanceByDenom(t *testing.T) {
 	requireT.Equal(balance.Sub(coinToFreeze).String(), spendableBalanceRes.Balance.String())
 
 	// freeze globally
-	err = ftKeeper.GloballyFreeze(ctx, issuer, denom)
+	err = ftKeeper.GloballyFreeze(ctx, issuer, denom, nil)
 	requireT.NoError(err)
 	// check that it is fully frozen now
 	spendableBalanceRes, err = bankKeeper.SpendableBalanceByDenom(ctx, &banktypes.QuerySpendableBalanceByDenomRequest{